@@ -339,6 +339,20 @@ func (suite AnteTestSuite) TestAnteHandler() {
 				return txBuilder.GetTx()
 			}, false, false, false,
 		},
+		{
+			"fail - DeliverTx (cosmos tx with fee granter)",
+			func() sdk.Tx {
+				nonce, err := suite.app.AccountKeeper.GetSequence(suite.ctx, acc.GetAddress())
+				suite.Require().NoError(err)
+				signedTx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &to, big.NewInt(10), 100000, big.NewInt(1), nil, nil, nil, nil)
+				signedTx.From = addr.Hex()
+
+				// the wrapper tx isn't signed, so the granter could be set by anyone relaying the tx
+				txBuilder := suite.CreateTestTxBuilder(signedTx, privKey, 1, false)
+				txBuilder.SetFeeGranter(sdk.AccAddress(tests.GenerateAddress().Bytes()))
+				return txBuilder.GetTx()
+			}, false, false, false,
+		},
 		{
 			"success - DeliverTx EIP712 signed Cosmos Tx with MsgSend",
 			func() sdk.Tx {
@@ -31,7 +31,7 @@ import (
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
 	"github.com/ethereum/go-ethereum/common"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core"
 )
 
 // EthAccountVerificationDecorator validates an account balance checks
@@ -54,6 +54,10 @@ func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper)
 // - any of the msgs is not a MsgEthereumTx
 // - from address is empty
 // - account balance is lower than the transaction cost
+// - account balance minus the value reserved by the sender txs already accepted in the mempool
+// is lower than the transaction cost
+//
+// The value of the accepted txs is then reserved until the next commit.
 func (avd EthAccountVerificationDecorator) AnteHandle(
 	ctx sdk.Context,
 	tx sdk.Tx,
@@ -64,6 +68,8 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		return next(ctx, tx, simulate)
 	}
 
+	evmParams := avd.evmKeeper.GetParams(ctx)
+
 	for i, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -94,8 +100,23 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
-		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
-			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+		// the fee denom balance is checked when deducting the fees if it differs from the evm denom,
+		// or if the fee tokens can be converted to pay them
+		checkBalance := keeper.CheckSenderBalance
+		if evmParams.IsDualGasToken() || evmParams.HasFeeTokens() {
+			checkBalance = keeper.CheckSenderValueBalance
+		}
+
+		// the value of the sender txs already accepted in the mempool isn't available, the fees
+		// being deducted from the balance
+		reserved := avd.evmKeeper.GetReservedBalance(ctx, fromAddr)
+		available := new(big.Int).Sub(acct.Balance, reserved)
+		if err := checkBalance(sdkmath.NewIntFromBigInt(available), txData); err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to check sender balance, %s reserved by pending txs", reserved)
+		}
+
+		if ctx.IsCheckTx() && !simulate {
+			avd.evmKeeper.ReserveBalance(ctx, fromAddr, txData.GetValue())
 		}
 	}
 	return next(ctx, tx, simulate)
@@ -190,8 +211,33 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
+		// the fees are paid in the fee denom if it differs from the evm denom
+		fees = evmParams.FeeCoins(fees.AmountOf(evmDenom), true)
+
+		// the fee tokens approved by the sender are converted if its balance doesn't cover the fees,
+		// the gas of the conversion being charged to the tx
+		from := common.HexToAddress(msgEthTx.From)
+		conversionGas, err := egcd.evmKeeper.ConvertFeeTokens(ctx, from, fees, txData.GetGas())
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to convert the fee tokens")
+		}
+		if conversionGas > 0 {
+			// the gas left by the conversion must cover the intrinsic gas of the tx
+			intrinsicGas, err := core.IntrinsicGas(txData.GetData(), txData.GetAccessList(), txData.GetTo() == nil, homestead, istanbul)
+			if err != nil {
+				return ctx, errorsmod.Wrap(err, "failed to retrieve intrinsic gas")
+			}
+			if txData.GetGas() < conversionGas+intrinsicGas {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrOutOfGas,
+					"gas limit too low: %d (gas limit) < %d (fee conversion gas) + %d (intrinsic gas)",
+					txData.GetGas(), conversionGas, intrinsicGas,
+				)
+			}
+			egcd.evmKeeper.SetFeeConversionGasTransient(ctx, common.HexToHash(msgEthTx.Hash), conversionGas)
+		}
 
-		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.HexToAddress(msgEthTx.From))
+		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, from)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
@@ -260,7 +306,7 @@ func NewCanTransferDecorator(evmKeeper EVMKeeper) CanTransferDecorator {
 func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	params := ctd.evmKeeper.GetParams(ctx)
 	ethCfg := params.ChainConfig.EthereumConfig(ctd.evmKeeper.ChainID())
-	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()))
+	signer := evmtypes.MakeSigner(ethCfg, ctx.BlockHeight())
 
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -322,19 +368,20 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 // EthIncrementSenderSequenceDecorator increments the sequence of the signers.
 type EthIncrementSenderSequenceDecorator struct {
-	ak evmtypes.AccountKeeper
+	evmKeeper EVMKeeper
 }
 
 // NewEthIncrementSenderSequenceDecorator creates a new EthIncrementSenderSequenceDecorator.
-func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper) EthIncrementSenderSequenceDecorator {
+func NewEthIncrementSenderSequenceDecorator(ek EVMKeeper) EthIncrementSenderSequenceDecorator {
 	return EthIncrementSenderSequenceDecorator{
-		ak: ak,
+		evmKeeper: ek,
 	}
 }
 
-// AnteHandle handles incrementing the sequence of the signer (i.e sender). If the transaction is a
-// contract creation, the nonce will be incremented during the transaction execution and not within
-// this AnteHandler decorator.
+// AnteHandle handles incrementing the sequence of the signer (i.e sender). The nonce of the tx must
+// be the sequence of the sender, shared with the cosmos txs it signs. If the transaction is a
+// contract creation, the nonce is set back during the transaction execution for the contract
+// address derivation. A sender with a base account is upgraded to an eth account.
 func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -347,30 +394,78 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx s
 			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
 		}
 
-		// increase sequence of sender
-		acc := issd.ak.GetAccount(ctx, msgEthTx.GetFrom())
-		if acc == nil {
-			return ctx, errorsmod.Wrapf(
-				errortypes.ErrUnknownAddress,
-				"account %s is nil", common.BytesToAddress(msgEthTx.GetFrom().Bytes()),
-			)
-		}
-		nonce := acc.GetSequence()
-
 		// we merged the nonce verification to nonce increment, so when tx includes multiple messages
 		// with same sender, they'll be accepted.
-		if txData.GetNonce() != nonce {
-			return ctx, errorsmod.Wrapf(
-				errortypes.ErrInvalidSequence,
-				"invalid nonce; got %d, expected %d", txData.GetNonce(), nonce,
-			)
+		if err := issd.evmKeeper.IncrementNonce(ctx, msgEthTx.GetFrom(), txData.GetNonce()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthSpeculativeExecutionDecorator executes the ethereum transactions in CheckTx, on a branch of the
+// check state, so that DeliverTx can reuse the results if the state they depend on is unchanged.
+type EthSpeculativeExecutionDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewEthSpeculativeExecutionDecorator creates a new EthSpeculativeExecutionDecorator.
+func NewEthSpeculativeExecutionDecorator(evmKeeper EVMKeeper) EthSpeculativeExecutionDecorator {
+	return EthSpeculativeExecutionDecorator{
+		evmKeeper: evmKeeper,
+	}
+}
+
+// AnteHandle speculatively executes the messages of the transaction in CheckTx, the results are
+// cached by the keeper and the check state is left unchanged. The recheck of the mempool
+// transactions doesn't execute them again.
+func (sed EthSpeculativeExecutionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
 		}
 
-		if err := acc.SetSequence(nonce + 1); err != nil {
-			return ctx, errorsmod.Wrapf(err, "failed to set sequence to %d", acc.GetSequence()+1)
+		sed.evmKeeper.SpeculateTransaction(ctx, msgEthTx)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthMempoolTTLDecorator evicts the ethereum transactions unconfirmed after the mempool TTL, by
+// failing their recheck.
+type EthMempoolTTLDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewEthMempoolTTLDecorator creates a new EthMempoolTTLDecorator.
+func NewEthMempoolTTLDecorator(evmKeeper EVMKeeper) EthMempoolTTLDecorator {
+	return EthMempoolTTLDecorator{
+		evmKeeper: evmKeeper,
+	}
+}
+
+// AnteHandle records the first CheckTx of the ethereum transactions and rejects their recheck once
+// the TTL is elapsed. The delivered transactions stop being tracked.
+func (mtd EthMempoolTTLDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
 		}
 
-		issd.ak.SetAccount(ctx, acc)
+		if err := mtd.evmKeeper.CheckMempoolTTL(ctx, common.HexToHash(msgEthTx.Hash)); err != nil {
+			return ctx, err
+		}
 	}
 
 	return next(ctx, tx, simulate)
@@ -4,15 +4,19 @@ import (
 	"math"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/evmos/ethermint/app/ante"
 	"github.com/evmos/ethermint/server/config"
 	"github.com/evmos/ethermint/tests"
 	ethermint "github.com/evmos/ethermint/types"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -104,9 +108,92 @@ func (suite AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 	}
 }
 
+func (suite AnteTestSuite) TestEthAccountVerificationDecoratorReservesValue() {
+	dec := ante.NewEthAccountVerificationDecorator(
+		suite.app.AccountKeeper, suite.app.EvmKeeper,
+	)
+
+	addr := tests.GenerateAddress()
+	vmdb := suite.StateDB()
+	vmdb.AddBalance(addr, big.NewInt(1000))
+	suite.Require().NoError(vmdb.Commit())
+
+	// cost of 700, including a value of 600
+	newTx := func() *evmtypes.MsgEthereumTx {
+		tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(600), 100, big.NewInt(1), nil, nil, nil, nil)
+		tx.From = addr.Hex()
+		return tx
+	}
+	ctx, _ := suite.ctx.WithIsCheckTx(true).CacheContext()
+
+	// simulations don't reserve the value
+	_, err := dec.AnteHandle(ctx, newTx(), true, NextFn)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(0), suite.app.EvmKeeper.GetReservedBalance(ctx, addr))
+
+	_, err = dec.AnteHandle(ctx, newTx(), false, NextFn)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(600), suite.app.EvmKeeper.GetReservedBalance(ctx, addr))
+
+	// the balance minus the reserved value doesn't cover the cost of a second tx
+	_, err = dec.AnteHandle(ctx, newTx(), false, NextFn)
+	suite.Require().ErrorContains(err, "600 reserved by pending txs")
+	suite.Require().Equal(big.NewInt(600), suite.app.EvmKeeper.GetReservedBalance(ctx, addr))
+
+	// the reservations are per sender
+	suite.Require().Equal(big.NewInt(0), suite.app.EvmKeeper.GetReservedBalance(ctx, tests.GenerateAddress()))
+
+	// nothing is reserved when delivering the txs
+	ctx, _ = suite.ctx.WithIsCheckTx(false).CacheContext()
+	_, err = dec.AnteHandle(ctx, newTx(), false, NextFn)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(0), suite.app.EvmKeeper.GetReservedBalance(ctx, addr))
+}
+
+func (suite AnteTestSuite) TestEthSpeculativeExecutionDecorator() {
+	dec := ante.NewEthSpeculativeExecutionDecorator(suite.app.EvmKeeper)
+	cache, err := evmkeeper.NewSpeculativeCache(10)
+	suite.Require().NoError(err)
+	suite.app.EvmKeeper.SetSpeculativeCache(cache)
+
+	addr, privKey := tests.NewAddrKey()
+	tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 0, big.NewInt(0), 100000, big.NewInt(1), nil, nil, nil, nil)
+	tx.From = addr.Hex()
+	suite.Require().NoError(tx.Sign(suite.ethSigner, tests.NewSigner(privKey)))
+
+	testCases := []struct {
+		name      string
+		tx        sdk.Tx
+		checkTx   bool
+		reCheckTx bool
+		simulate  bool
+		expPass   bool
+		expCache  int
+	}{
+		{"invalid transaction type", &invalidTx{}, true, false, false, false, 0},
+		{"deliver tx", tx, false, false, false, true, 0},
+		{"simulation", tx, true, false, true, true, 0},
+		{"recheck tx", tx, true, true, false, true, 0},
+		{"check tx", tx, true, false, false, true, 1},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.WithIsCheckTx(tc.checkTx).WithIsReCheckTx(tc.reCheckTx).CacheContext()
+			_, err := dec.AnteHandle(ctx, tc.tx, tc.simulate, NextFn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+			suite.Require().Equal(tc.expCache, cache.Len())
+		})
+	}
+}
+
 func (suite AnteTestSuite) TestEthNonceVerificationDecorator() {
 	suite.SetupTest()
-	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.EvmKeeper)
 
 	addr := tests.GenerateAddress()
 
@@ -317,6 +404,49 @@ func (suite AnteTestSuite) TestEthGasConsumeDecorator() {
 	}
 }
 
+func (suite AnteTestSuite) TestEthGasConsumeDecoratorFeeDenom() {
+	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, config.DefaultMaxTxGasWanted)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.FeeDenom = "ufee"
+	params.FeeConversionRate = sdk.NewDec(2)
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	defer func() {
+		params.FeeDenom = ""
+		suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	}()
+
+	addr := tests.GenerateAddress()
+	ethCfg := params.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
+	gasPrice := new(big.Int).Add(baseFee, evmtypes.DefaultPriorityReduction.BigInt())
+	gasLimit := uint64(1000000)
+	tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), gasLimit, gasPrice, nil, nil, nil, &ethtypes.AccessList{{Address: addr, StorageKeys: nil}})
+	tx.From = addr.Hex()
+
+	// the evm denom balance doesn't pay the fees
+	vmdb := suite.StateDB()
+	vmdb.AddBalance(addr, big.NewInt(1001000000000000))
+	suite.Require().NoError(vmdb.Commit())
+	ctx := suite.ctx.WithIsCheckTx(true).WithGasMeter(sdk.NewInfiniteGasMeter()).WithBlockGasMeter(sdk.NewGasMeter(10000000000000000000))
+	_, err := dec.AnteHandle(ctx, tx, false, NextFn)
+	suite.Require().Error(err)
+
+	// the fees are paid in the fee denom, at the conversion rate
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+	feeCoins := sdk.NewCoins(sdk.NewCoin("ufee", sdkmath.NewIntFromBigInt(fee).MulRaw(3)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, feeCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, evmtypes.ModuleName, addr.Bytes(), feeCoins))
+
+	_, err = dec.AnteHandle(ctx, tx, false, NextFn)
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		sdkmath.NewIntFromBigInt(fee),
+		suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), "ufee").Amount,
+	)
+	suite.Require().Equal(big.NewInt(1001000000000000), suite.app.EvmKeeper.GetBalance(suite.ctx, addr))
+}
+
 func (suite AnteTestSuite) TestCanTransferDecorator() {
 	dec := ante.NewCanTransferDecorator(suite.app.EvmKeeper)
 
@@ -402,7 +532,7 @@ func (suite AnteTestSuite) TestCanTransferDecorator() {
 }
 
 func (suite AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
-	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.EvmKeeper)
 	addr, privKey := tests.NewAddrKey()
 
 	contract := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 0, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
@@ -491,3 +621,28 @@ func (suite AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
 		})
 	}
 }
+
+func (suite AnteTestSuite) TestEthIncrementSenderSequenceDecoratorUpgradeBaseAccount() {
+	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.EvmKeeper)
+	addr, privKey := tests.NewAddrKey()
+
+	to := tests.GenerateAddress()
+	tx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 3, &to, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
+	tx.From = addr.Hex()
+	suite.Require().NoError(tx.Sign(suite.ethSigner, tests.NewSigner(privKey)))
+
+	// account created by a bank transfer
+	accNumber := suite.app.AccountKeeper.GetNextAccountNumber(suite.ctx)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccount(addr.Bytes(), nil, accNumber, 3))
+
+	_, err := dec.AnteHandle(suite.ctx, tx, false, NextFn)
+	suite.Require().NoError(err)
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr.Bytes())
+	ethAcc, ok := acc.(*ethermint.EthAccount)
+	suite.Require().True(ok, "got %T", acc)
+	suite.Require().Equal(accNumber, ethAcc.GetAccountNumber())
+	suite.Require().Equal(uint64(4), ethAcc.GetSequence())
+	suite.Require().Equal(common.BytesToHash(evmtypes.EmptyCodeHash), ethAcc.GetCodeHash())
+	suite.Require().Equal(ethermint.AccountTypeEOA, ethAcc.Type())
+}
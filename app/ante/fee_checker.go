@@ -50,7 +50,7 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) authante.TxFeeChecker {
 		}
 
 		params := k.GetParams(ctx)
-		denom := params.EvmDenom
+		denom := params.GasFeeDenom()
 		ethCfg := params.ChainConfig.EthereumConfig(k.ChainID())
 
 		baseFee := k.GetBaseFee(ctx, ethCfg)
@@ -74,7 +74,8 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) authante.TxFeeChecker {
 
 		gas := feeTx.GetGas()
 		feeCoins := feeTx.GetFee()
-		fee := feeCoins.AmountOfNoDenomValidation(denom)
+		// the base fee and the gas prices are expressed in the evm denom
+		fee := params.ToEVMAmount(feeCoins.AmountOfNoDenomValidation(denom))
 
 		feeCap := fee.Quo(sdkmath.NewIntFromUint64(gas))
 		baseFeeInt := sdkmath.NewIntFromBigInt(baseFee)
@@ -90,7 +91,7 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) authante.TxFeeChecker {
 		effectiveFee := sdk.Coins{
 			{
 				Denom:  denom,
-				Amount: effectivePrice.Mul(sdkmath.NewIntFromUint64(gas)),
+				Amount: params.ToFeeAmount(effectivePrice.Mul(sdkmath.NewIntFromUint64(gas)), true),
 			},
 		}
 
@@ -89,10 +89,10 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return next(ctx, tx, simulate)
 	}
 	evmParams := mpd.evmKeeper.GetParams(ctx)
-	evmDenom := evmParams.GetEvmDenom()
+	feeDenom := evmParams.GasFeeDenom()
 	minGasPrices := sdk.DecCoins{
 		{
-			Denom:  evmDenom,
+			Denom:  feeDenom,
 			Amount: minGasPrice,
 		},
 	}
@@ -107,7 +107,8 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	gasLimit := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gas))
 
 	for _, gp := range minGasPrices {
-		fee := gp.Amount.Mul(gasLimit).Ceil().RoundInt()
+		// the min gas price is expressed in the evm denom
+		fee := evmParams.ToFeeAmount(gp.Amount.Mul(gasLimit).Ceil().RoundInt(), true)
 		if fee.IsPositive() {
 			requiredFees = requiredFees.Add(sdk.Coin{Denom: gp.Denom, Amount: fee})
 		}
@@ -187,7 +188,10 @@ func (empd EthMinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 // AnteHandle ensures that the provided fees meet a minimum threshold for the validator.
 // This check only for local mempool purposes, and thus it is only run on (Re)CheckTx.
-// The logic is also skipped if the London hard fork and EIP-1559 are enabled.
+// The threshold is the validator min-gas-prices before the London hard fork, and the
+// base fee once EIP-1559 is enabled, scaled by the node local multiplier adjusted with the block
+// fullness. Transactions under the threshold are rejected with ErrTxUnderpriced, carrying the
+// minimum gas price accepted by the node.
 func (mfd EthMempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	if !ctx.IsCheckTx() || simulate {
 		return next(ctx, tx, simulate)
@@ -196,14 +200,17 @@ func (mfd EthMempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 	chainCfg := evmParams.GetChainConfig()
 	ethCfg := chainCfg.EthereumConfig(mfd.evmKeeper.ChainID())
 
-	baseFee := mfd.evmKeeper.GetBaseFee(ctx, ethCfg)
-	// skip check as the London hard fork and EIP-1559 are enabled
-	if baseFee != nil {
-		return next(ctx, tx, simulate)
+	minGasPrice := ctx.MinGasPrices().AmountOf(evmParams.GasFeeDenom())
+	if evmParams.IsDualGasToken() {
+		// convert the validator min gas price of the fee denom to the evm denom
+		minGasPrice = minGasPrice.Quo(evmParams.FeeConversionRate)
 	}
-
-	evmDenom := evmParams.GetEvmDenom()
-	minGasPrice := ctx.MinGasPrices().AmountOf(evmDenom)
+	// the validator min-gas-prices are replaced by the base fee once the
+	// London hard fork and EIP-1559 are enabled
+	if baseFee := mfd.evmKeeper.GetBaseFee(ctx, ethCfg); baseFee != nil {
+		minGasPrice = sdk.NewDecFromBigInt(baseFee)
+	}
+	minGasPrice = minGasPrice.Mul(mfd.evmKeeper.GetMinGasPriceMultiplier())
 
 	for _, msg := range tx.GetMsgs() {
 		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -211,15 +218,18 @@ func (mfd EthMempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
 		}
 
-		fee := sdk.NewDecFromBigInt(ethMsg.GetFee())
-		gasLimit := sdk.NewDecFromBigInt(new(big.Int).SetUint64(ethMsg.GetGas()))
-		requiredFee := minGasPrice.Mul(gasLimit)
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to unpack tx data %s", ethMsg.Hash)
+		}
 
-		if fee.LT(requiredFee) {
+		// the gas price of legacy and access list txs, the gas fee cap of dynamic fee txs
+		gasPrice := txData.GetGasFeeCap()
+		if sdk.NewDecFromBigInt(gasPrice).LT(minGasPrice) {
 			return ctx, errorsmod.Wrapf(
-				errortypes.ErrInsufficientFee,
-				"insufficient fee; got: %s required: %s",
-				fee, requiredFee,
+				evmtypes.ErrTxUnderpriced,
+				"gas price %s < minimum gas price %s",
+				gasPrice, minGasPrice.Ceil().TruncateInt(),
 			)
 		}
 	}
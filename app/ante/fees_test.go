@@ -346,6 +346,92 @@ func (s AnteTestSuite) TestEthMinGasPriceDecorator() {
 	}
 }
 
-func (suite AnteTestSuite) TestEthMempoolFeeDecorator() {
-	// TODO: add test
+func (s AnteTestSuite) TestEthMempoolFeeDecorator() {
+	from, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+	emptyAccessList := ethtypes.AccessList{}
+
+	testCases := []struct {
+		name           string
+		enableLondonHF bool
+		malleate       func() sdk.Tx
+		expPass        bool
+		errMsg         string
+	}{
+		{
+			"invalid tx type",
+			true,
+			func() sdk.Tx {
+				return &invalidTx{}
+			},
+			false,
+			"invalid message type",
+		},
+		{
+			"valid legacy tx before London, gasPrice = min-gas-prices",
+			false,
+			func() sdk.Tx {
+				msg := s.BuildTestEthTx(from, to, nil, make([]byte, 0), big.NewInt(10), nil, nil, nil)
+				return s.CreateTestTx(msg, privKey, 1, false)
+			},
+			true,
+			"",
+		},
+		{
+			"invalid legacy tx before London, gasPrice < min-gas-prices",
+			false,
+			func() sdk.Tx {
+				msg := s.BuildTestEthTx(from, to, nil, make([]byte, 0), big.NewInt(9), nil, nil, nil)
+				return s.CreateTestTx(msg, privKey, 1, false)
+			},
+			false,
+			"gas price 9 < minimum gas price 10: transaction underpriced",
+		},
+		{
+			"valid dynamic tx after London, GasFeeCap = BaseFee < min-gas-prices",
+			true,
+			func() sdk.Tx {
+				msg := s.BuildTestEthTx(from, to, nil, make([]byte, 0), nil, big.NewInt(5), big.NewInt(0), &emptyAccessList)
+				return s.CreateTestTx(msg, privKey, 1, false)
+			},
+			true,
+			"",
+		},
+		{
+			"invalid dynamic tx after London, GasFeeCap < BaseFee",
+			true,
+			func() sdk.Tx {
+				msg := s.BuildTestEthTx(from, to, nil, make([]byte, 0), nil, big.NewInt(4), big.NewInt(4), &emptyAccessList)
+				return s.CreateTestTx(msg, privKey, 1, false)
+			},
+			false,
+			"gas price 4 < minimum gas price 5: transaction underpriced",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.enableLondonHF = tc.enableLondonHF
+			s.SetupTest()
+			feemarketParams := s.app.FeeMarketKeeper.GetParams(s.ctx)
+			feemarketParams.BaseFee = sdkmath.NewInt(5)
+			s.app.FeeMarketKeeper.SetParams(s.ctx, feemarketParams)
+
+			ctx := s.ctx.WithIsCheckTx(true).
+				WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(evmtypes.DefaultEVMDenom, sdkmath.NewInt(10))))
+			dec := ante.NewEthMempoolFeeDecorator(s.app.EvmKeeper)
+			_, err := dec.AnteHandle(ctx, tc.malleate(), false, NextFn)
+
+			if tc.expPass {
+				s.Require().NoError(err, tc.name)
+			} else {
+				s.Require().Error(err, tc.name)
+				s.Require().Contains(err.Error(), tc.errMsg, tc.name)
+			}
+
+			// the check is only run on CheckTx
+			_, err = dec.AnteHandle(ctx.WithIsCheckTx(false), tc.malleate(), false, NextFn)
+			s.Require().NoError(err, tc.name)
+		})
+	}
 }
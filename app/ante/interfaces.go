@@ -46,10 +46,19 @@ type EVMKeeper interface {
 
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) evm.EVM
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	ConvertFeeTokens(ctx sdk.Context, payer common.Address, fees sdk.Coins, gasLimit uint64) (uint64, error)
+	SetFeeConversionGasTransient(ctx sdk.Context, txHash common.Hash, gas uint64)
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	SetLastAdmittedTxTransient(ctx sdk.Context, msgCount uint64)
 	GetParams(ctx sdk.Context) evmtypes.Params
+	GetReservedBalance(ctx sdk.Context, addr common.Address) *big.Int
+	ReserveBalance(ctx sdk.Context, addr common.Address, amount *big.Int)
+	SpeculateTransaction(ctx sdk.Context, msgEth *evmtypes.MsgEthereumTx)
+	CheckMempoolTTL(ctx sdk.Context, txHash common.Hash) error
+	GetMinGasPriceMultiplier() sdk.Dec
+	IncrementNonce(ctx sdk.Context, sender sdk.AccAddress, nonce uint64) error
 }
 
 type protoTxProvider interface {
@@ -89,6 +89,9 @@ func (eeed EthEmitEventDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 		))
 	}
 
+	// record the admitted tx to report it if its execution doesn't fit in the block gas
+	eeed.evmKeeper.SetLastAdmittedTxTransient(ctx, uint64(len(tx.GetMsgs())))
+
 	return next(ctx, tx, simulate)
 }
 
@@ -186,6 +189,25 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 			return ctx, errorsmod.Wrap(evmtypes.ErrCallDisabled, "failed to call contract")
 		}
 
+		// the size limits protect the block propagation
+		if evmParams.MaxTxSize > 0 {
+			if size := uint64(msgEthTx.AsTransaction().Size()); size > evmParams.MaxTxSize {
+				return ctx, errorsmod.Wrapf(errortypes.ErrTxTooLarge, "tx size %d exceeds the limit %d", size, evmParams.MaxTxSize)
+			}
+		}
+		if evmParams.MaxCalldataSize > 0 {
+			if size := uint64(len(txData.GetData())); size > evmParams.MaxCalldataSize {
+				return ctx, errorsmod.Wrapf(errortypes.ErrTxTooLarge, "tx data size %d exceeds the limit %d", size, evmParams.MaxCalldataSize)
+			}
+		}
+
+		// the value must be transferable in the evm denom without precision loss
+		if value := txData.GetValue(); value != nil {
+			if _, err := evmParams.FromWei(value); err != nil {
+				return ctx, errorsmod.Wrap(err, "invalid tx value")
+			}
+		}
+
 		if baseFee == nil && txData.TxType() == ethtypes.DynamicFeeTxType {
 			return ctx, errorsmod.Wrap(ethtypes.ErrTxTypeNotSupported, "dynamic fee tx not supported")
 		}
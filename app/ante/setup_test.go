@@ -5,7 +5,9 @@ import (
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/evmos/ethermint/app/ante"
+	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -40,3 +42,91 @@ func (suite AnteTestSuite) TestEthSetupContextDecorator() {
 		})
 	}
 }
+
+func (suite AnteTestSuite) TestEthValidateBasicDecoratorSizeLimits() {
+	dec := ante.NewEthValidateBasicDecorator(suite.app.EvmKeeper)
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	msg := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 0, &to, big.NewInt(10), 100000, big.NewInt(1), nil, nil, make([]byte, 100), nil)
+	msg.From = addr.Hex()
+	tx := suite.CreateTestTx(msg, privKey, 1, false)
+	size := uint64(msg.AsTransaction().Size())
+
+	testCases := []struct {
+		name            string
+		maxTxSize       uint64
+		maxCalldataSize uint64
+		expErr          bool
+	}{
+		{"no limits", 0, 0, false},
+		{"within the limits", size, 100, false},
+		{"tx size exceeded", size - 1, 0, true},
+		{"calldata size exceeded", 0, 99, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.MaxTxSize = tc.maxTxSize
+			params.MaxCalldataSize = tc.maxCalldataSize
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			_, err := dec.AnteHandle(suite.ctx, tx, false, NextFn)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, errortypes.ErrTxTooLarge)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.MaxTxSize, params.MaxCalldataSize = 0, 0
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+}
+
+func (suite AnteTestSuite) TestEthValidateBasicDecoratorPrecisionLoss() {
+	dec := ante.NewEthValidateBasicDecorator(suite.app.EvmKeeper)
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	newTx := func(value int64) sdk.Tx {
+		msg := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 0, &to, big.NewInt(value), 100000, big.NewInt(1), nil, nil, nil, nil)
+		msg.From = addr.Hex()
+		return suite.CreateTestTx(msg, privKey, 1, false)
+	}
+
+	testCases := []struct {
+		name      string
+		exponent  uint32
+		roundDown bool
+		value     int64
+		expErr    bool
+	}{
+		{"no conversion", 0, false, 1234, false},
+		{"multiple of the unit", 3, false, 2000, false},
+		{"precision loss rejected", 3, false, 1234, true},
+		{"precision loss rounded down", 3, true, 1234, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.WeiConversionExponent = tc.exponent
+			params.RoundDownPrecisionLoss = tc.roundDown
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			_, err := dec.AnteHandle(suite.ctx, newTx(tc.value), false, NextFn)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, evmtypes.ErrPrecisionLoss)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.WeiConversionExponent, params.RoundDownPrecisionLoss = 0, false
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+}
@@ -105,6 +105,11 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	ica "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts"
+	icahost "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
@@ -125,11 +130,18 @@ import (
 	"github.com/evmos/ethermint/x/evm"
 	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	evmvm "github.com/evmos/ethermint/x/evm/vm"
 	"github.com/evmos/ethermint/x/evm/vm/geth"
+	"github.com/evmos/ethermint/x/evmbridge"
+	evmbridgekeeper "github.com/evmos/ethermint/x/evmbridge/keeper"
+	evmbridgetypes "github.com/evmos/ethermint/x/evmbridge/types"
 	"github.com/evmos/ethermint/x/feemarket"
 	feemarketkeeper "github.com/evmos/ethermint/x/feemarket/keeper"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
@@ -174,10 +186,12 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		icaModuleBasic{},
 		vesting.AppModuleBasic{},
 		// Ethermint modules
 		evm.AppModuleBasic{},
 		feemarket.AppModuleBasic{},
+		evmbridge.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -189,6 +203,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:            nil,
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
 	}
 
@@ -233,14 +248,18 @@ type EthermintApp struct {
 	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper   evidencekeeper.Keeper
 	TransferKeeper   ibctransferkeeper.Keeper
+	ICAHostKeeper    icahostkeeper.Keeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper       capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper  capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper   capabilitykeeper.ScopedKeeper
+	ScopedEvmBridgeKeeper capabilitykeeper.ScopedKeeper
 
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
 	FeeMarketKeeper feemarketkeeper.Keeper
+	EvmBridgeKeeper evmbridgekeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -288,9 +307,9 @@ func NewEthermintApp(
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
 		feegrant.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibchost.StoreKey, ibctransfertypes.StoreKey,
+		ibchost.StoreKey, ibctransfertypes.StoreKey, icahosttypes.StoreKey,
 		// ethermint keys
-		evmtypes.StoreKey, feemarkettypes.StoreKey,
+		evmtypes.StoreKey, feemarkettypes.StoreKey, evmbridgetypes.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -324,6 +343,8 @@ func NewEthermintApp(
 
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedEvmBridgeKeeper := app.CapabilityKeeper.ScopeToModule(evmbridgetypes.ModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -409,6 +430,15 @@ func NewEthermintApp(
 
 	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
 
+	interpreter := cast.ToString(appOpts.Get(srvflags.EVMInterpreter))
+	if interpreter == "" {
+		interpreter = geth.ConstructorName
+	}
+	evmConstructor, err := evmvm.GetConstructor(interpreter)
+	if err != nil {
+		panic(err)
+	}
+
 	// Create Ethermint keepers
 	feeMarketSs := app.GetSubspace(feemarkettypes.ModuleName)
 	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
@@ -421,9 +451,43 @@ func NewEthermintApp(
 	app.EvmKeeper = evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
-		nil, geth.NewEVM, tracer, evmSs,
+		nil, evmConstructor, tracer, evmSs,
 	)
 
+	if size := cast.ToInt(appOpts.Get(srvflags.EVMSpeculativeCacheSize)); size > 0 {
+		cache, err := evmkeeper.NewSpeculativeCache(size)
+		if err != nil {
+			panic(err)
+		}
+		app.EvmKeeper.SetSpeculativeCache(cache)
+	}
+	app.EvmKeeper.SetStateDBCacheBudget(cast.ToUint64(appOpts.Get(srvflags.EVMStateDBCacheBudget)))
+	app.EvmKeeper.SetDenomMetadataDecimals(cast.ToUint32(appOpts.Get(srvflags.EVMDenomMetadataDecimals)))
+	app.EvmKeeper.SetStorageHashMaxSlots(cast.ToUint64(appOpts.Get(srvflags.EVMStorageHashMaxSlots)))
+	ttlBlocks := cast.ToInt64(appOpts.Get(srvflags.EVMMempoolTTLBlocks))
+	ttlDuration := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolTTLDuration))
+	if ttlBlocks > 0 || ttlDuration > 0 {
+		ttl, err := evmkeeper.NewMempoolTTL(ttlBlocks, ttlDuration)
+		if err != nil {
+			panic(err)
+		}
+		app.EvmKeeper.SetMempoolTTL(ttl)
+	}
+	if maxMultiplier := cast.ToFloat64(appOpts.Get(srvflags.EVMAdaptiveGasPriceMaxMultiplier)); maxMultiplier > 0 {
+		adaptive, err := evmkeeper.NewAdaptiveGasPrice(
+			maxMultiplier, cast.ToFloat64(appOpts.Get(srvflags.EVMAdaptiveGasPriceTargetFullness)),
+		)
+		if err != nil {
+			panic(err)
+		}
+		app.EvmKeeper.SetAdaptiveGasPrice(adaptive)
+	}
+
+	// the storage proofs are generated from the committed multistore
+	if queryable, ok := app.CommitMultiStore().(storetypes.Queryable); ok {
+		app.EvmKeeper.SetProofQuerier(queryable)
+	}
+
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
@@ -432,7 +496,7 @@ func NewEthermintApp(
 	// register the proposal types
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
@@ -461,9 +525,29 @@ func NewEthermintApp(
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	// Create the interchain accounts host keeper, the controller chains call the EVM contracts
+	// with MsgEthereumCall, MsgEthereumTx requiring the eth ante handler
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+	)
+	icaModule := ica.NewAppModule(nil, &app.ICAHostKeeper)
+	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+
+	// Create the EVM bridge keeper, relaying the contract events matching its routes as IBC packets
+	app.EvmBridgeKeeper = evmbridgekeeper.NewKeeper(
+		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName), keys[evmbridgetypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper, scopedEvmBridgeKeeper,
+	)
+	app.EvmKeeper.SetHooks(evmkeeper.NewMultiEvmHooks(app.EvmBridgeKeeper.Hooks()))
+	evmBridgeIBCModule := evmbridge.NewIBCModule(app.EvmBridgeKeeper)
+
+	// Create static IBC router, add transfer, ica host and EVM bridge routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(evmbridgetypes.ModuleName, evmBridgeIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// create evidence keeper with router
@@ -506,9 +590,11 @@ func NewEthermintApp(
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
+		icaModule,
 		// Ethermint app modules
 		feemarket.NewAppModule(app.FeeMarketKeeper, feeMarketSs),
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
+		evmbridge.NewAppModule(app.EvmBridgeKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -530,6 +616,8 @@ func NewEthermintApp(
 		ibchost.ModuleName,
 		// no-op modules
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
+		evmbridgetypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
@@ -551,6 +639,8 @@ func NewEthermintApp(
 		// no-op modules
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
+		evmbridgetypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -591,6 +681,8 @@ func NewEthermintApp(
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
+		evmbridgetypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
@@ -648,6 +740,8 @@ func NewEthermintApp(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedICAHostKeeper = scopedICAHostKeeper
+	app.ScopedEvmBridgeKeeper = scopedEvmBridgeKeeper
 
 	return app
 }
@@ -829,6 +923,12 @@ func (app *EthermintApp) RegisterNodeService(clientCtx client.Context) {
 	node.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
 }
 
+// SubscribeMempoolEvictions subscribes to the hashes of the ethereum transactions evicted from the
+// mempool after their TTL.
+func (app *EthermintApp) SubscribeMempoolEvictions(ch chan<- common.Hash) event.Subscription {
+	return app.EvmKeeper.SubscribeMempoolEvictions(ch)
+}
+
 // RegisterSwaggerAPI registers swagger route with API Server
 func RegisterSwaggerAPI(_ client.Context, rtr *mux.Router) {
 	statikFS, err := fs.New()
@@ -864,6 +964,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	// ethermint subspaces
 	paramsKeeper.Subspace(evmtypes.ModuleName).WithKeyTable(evmtypes.ParamKeyTable()) //nolint: staticcheck
 	paramsKeeper.Subspace(feemarkettypes.ModuleName).WithKeyTable(feemarkettypes.ParamKeyTable())
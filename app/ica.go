@@ -0,0 +1,47 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ica "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts"
+	genesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// ICAHostParams returns the params of the interchain accounts host, the controller chains can
+// only execute EVM calls with MsgEthereumCall. The ibc-go default allows any message.
+func ICAHostParams() icahosttypes.Params {
+	return icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(&evmtypes.MsgEthereumCall{})})
+}
+
+// icaModuleBasic overrides the default genesis of the interchain accounts module with the
+// ICAHostParams.
+type icaModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the default genesis state of the interchain accounts module.
+func (icaModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := genesistypes.DefaultGenesis()
+	genesis.HostGenesisState.Params = ICAHostParams()
+	return cdc.MustMarshalJSON(genesis)
+}
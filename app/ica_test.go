@@ -0,0 +1,31 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestICAHostParams(t *testing.T) {
+	expAllowMessages := []string{"/ethermint.evm.v1.MsgEthereumCall"}
+
+	// the default genesis only allows the evm calls
+	app := Setup(false, nil)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	require.Equal(t, expAllowMessages, app.ICAHostKeeper.GetParams(ctx).AllowMessages)
+
+	// the upgrade adding the module initializes it with the same params
+	app = Setup(false, func(app *EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		delete(genesis, icatypes.ModuleName)
+		return genesis
+	})
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	ctx.KVStore(app.GetKey(upgradetypes.StoreKey)).Delete(append([]byte{upgradetypes.VersionMapByte}, icatypes.ModuleName...))
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "integration-test-upgrade", Height: 1})
+	require.Equal(t, expAllowMessages, app.ICAHostKeeper.GetParams(ctx).AllowMessages)
+	require.True(t, app.ICAHostKeeper.IsBound(ctx, icatypes.HostPortID))
+}
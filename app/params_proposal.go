@@ -0,0 +1,58 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package app
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// migratedParamSubspaces are the subspaces of the modules storing their params in their own store,
+// which are only kept for the store migrations. Their params are updated by the MsgUpdateParams of
+// the modules executed by the v1 governance proposals.
+var migratedParamSubspaces = map[string]bool{
+	evmtypes.ModuleName:       true,
+	feemarkettypes.ModuleName: true,
+}
+
+// NewParamChangeProposalHandler returns the legacy ParameterChangeProposal handler, rejecting the
+// changes of the migrated subspaces, which would be written to the legacy subspace without
+// affecting the params of the module.
+func NewParamChangeProposalHandler(k paramskeeper.Keeper) govv1beta1.Handler {
+	handler := params.NewParamChangeProposalHandler(k)
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		if c, ok := content.(*paramproposal.ParameterChangeProposal); ok {
+			for _, change := range c.Changes {
+				if migratedParamSubspaces[change.Subspace] {
+					return errorsmod.Wrapf(
+						errortypes.ErrInvalidRequest,
+						"the %s params can't be changed by a ParameterChangeProposal, submit a proposal with a MsgUpdateParams instead",
+						change.Subspace,
+					)
+				}
+			}
+		}
+		return handler(ctx, content)
+	}
+}
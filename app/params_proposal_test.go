@@ -0,0 +1,34 @@
+package app
+
+import (
+	"testing"
+
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	app := Setup(false, nil)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	handler := NewParamChangeProposalHandler(app.ParamsKeeper)
+
+	// the params of the migrated subspaces are updated with MsgUpdateParams
+	for _, subspace := range []string{evmtypes.ModuleName, feemarkettypes.ModuleName} {
+		proposal := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			{Subspace: subspace, Key: string(evmtypes.ParamStoreKeyEnableCreate), Value: "false"},
+		})
+		require.Error(t, handler(ctx, proposal), subspace)
+	}
+	require.True(t, app.EvmKeeper.GetParams(ctx).EnableCreate)
+
+	// the other subspaces are still changed by the legacy proposals
+	proposal := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		{Subspace: "staking", Key: "MaxValidators", Value: "1"},
+	})
+	require.NoError(t, handler(ctx, proposal))
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+}
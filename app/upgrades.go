@@ -16,14 +16,49 @@
 package app
 
 import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ica "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+
+	evmbridgetypes "github.com/evmos/ethermint/x/evmbridge/types"
 )
 
+// RegisterUpgradeHandlers registers the upgrade handlers of the app, system contracts are deployed
+// from the handlers with app.EvmKeeper.DeploySystemContract rather than with direct store writes.
 func (app *EthermintApp) RegisterUpgradeHandlers() {
 	planName := "integration-test-upgrade"
 	app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// the interchain accounts host is initialized with the ICAHostParams rather than the
+		// default genesis allowing any message
+		icaModule, ok := app.mm.Modules[icatypes.ModuleName].(ica.AppModule)
+		if !ok {
+			return nil, fmt.Errorf("invalid interchain accounts module type %T", app.mm.Modules[icatypes.ModuleName])
+		}
+		if _, ok := fromVM[icatypes.ModuleName]; !ok {
+			fromVM[icatypes.ModuleName] = icaModule.ConsensusVersion()
+			icaModule.InitModule(ctx, icacontrollertypes.Params{}, ICAHostParams())
+		}
 		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Errorf("failed to read upgrade info from disk: %w", err))
+	}
+
+	if upgradeInfo.Name == planName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		// the EVM bridge module is initialized by the migrations, the interchain accounts host
+		// by the upgrade handler
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{icahosttypes.StoreKey, evmbridgetypes.StoreKey},
+		}
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
 }
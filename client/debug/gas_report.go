@@ -0,0 +1,249 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package debug
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+	flagFormat     = "format"
+
+	formatCSV  = "csv"
+	formatJSON = "json"
+
+	selectorCreate   = "create"
+	selectorFallback = "fallback"
+)
+
+// callFrame is a call of the output of the callTracer.
+type callFrame struct {
+	Type    string         `json:"type"`
+	To      common.Address `json:"to"`
+	Input   hexutil.Bytes  `json:"input"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Error   string         `json:"error,omitempty"`
+	Calls   []callFrame    `json:"calls,omitempty"`
+}
+
+// txTrace is a transaction trace of the debug_traceBlockByNumber output.
+type txTrace struct {
+	Result *callFrame `json:"result"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// GasReportRow is the gas usage of a function of a contract.
+type GasReportRow struct {
+	Contract string `json:"contract"`
+	// Selector is the 4 bytes function selector, or "create" for the contract creations and
+	// "fallback" for the calls without data.
+	Selector string `json:"selector"`
+	Calls    uint64 `json:"calls"`
+	Failed   uint64 `json:"failed"`
+	// GasUsed includes the gas used by the nested calls, which SelfGasUsed excludes.
+	GasUsed     uint64 `json:"gasUsed"`
+	SelfGasUsed uint64 `json:"selfGasUsed"`
+}
+
+// GasReportCmd creates a command building a gas usage report from stored block traces.
+func GasReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-report [trace-dir]",
+		Short: "Report the gas used per contract and function from stored block traces",
+		Long: `Report the gas used per contract and function selector from the block traces stored in
+trace-dir, without a running node. Each block trace is stored in a <height>.json file holding the
+output of debug_traceBlockByNumber with the callTracer, the files outside of the height range being
+skipped. The rows are sorted by decreasing gas used.`,
+		Example: fmt.Sprintf(
+			`$ curl -s -H 'Content-Type: application/json' -d '{"jsonrpc":"2.0","id":1,"method":"debug_traceBlockByNumber","params":["0x64",{"tracer":"callTracer"}]}' localhost:8545 | jq .result > traces/100.json
+$ %s debug gas-report traces --from-height 100 --to-height 200 --format json`,
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := cmd.Flags().GetInt64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagToHeight)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+			if format != formatCSV && format != formatJSON {
+				return fmt.Errorf("invalid format %s, expected %s or %s", format, formatCSV, formatJSON)
+			}
+
+			rows, err := BuildGasReport(args[0], from, to)
+			if err != nil {
+				return err
+			}
+			return writeGasReport(cmd.OutOrStdout(), rows, format)
+		},
+	}
+
+	cmd.Flags().Int64(flagFromHeight, 0, "first block height of the report, 0 for the first stored trace")
+	cmd.Flags().Int64(flagToHeight, 0, "last block height of the report, 0 for the last stored trace")
+	cmd.Flags().String(flagFormat, formatCSV, "output format, csv or json")
+	return cmd
+}
+
+// BuildGasReport aggregates the gas used per contract and function selector by the block traces
+// stored in dir within the [from, to] height range, a zero bound being ignored.
+func BuildGasReport(dir string, from, to int64) ([]GasReportRow, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make(map[[2]string]*GasReportRow)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".json" {
+			continue
+		}
+		height, err := strconv.ParseInt(strings.TrimSuffix(name, ".json"), 10, 64)
+		if err != nil {
+			// not a block trace
+			continue
+		}
+		if (from > 0 && height < from) || (to > 0 && height > to) {
+			continue
+		}
+
+		bz, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		var traces []txTrace
+		if err := json.Unmarshal(bz, &traces); err != nil {
+			return nil, fmt.Errorf("invalid block trace %s: %w", name, err)
+		}
+		for _, trace := range traces {
+			// the failed traces don't hold any call
+			if trace.Result != nil {
+				addCallFrame(report, trace.Result)
+			}
+		}
+	}
+
+	rows := make([]GasReportRow, 0, len(report))
+	for _, row := range report {
+		rows = append(rows, *row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].GasUsed != rows[j].GasUsed {
+			return rows[i].GasUsed > rows[j].GasUsed
+		}
+		if rows[i].Contract != rows[j].Contract {
+			return rows[i].Contract < rows[j].Contract
+		}
+		return rows[i].Selector < rows[j].Selector
+	})
+	return rows, nil
+}
+
+// addCallFrame adds a call and its nested calls to the report.
+func addCallFrame(report map[[2]string]*GasReportRow, frame *callFrame) {
+	selfGasUsed := uint64(frame.GasUsed)
+	for i := range frame.Calls {
+		addCallFrame(report, &frame.Calls[i])
+		if nested := uint64(frame.Calls[i].GasUsed); nested < selfGasUsed {
+			selfGasUsed -= nested
+		} else {
+			selfGasUsed = 0
+		}
+	}
+
+	// the self destructs don't execute any code
+	if frame.Type == "SELFDESTRUCT" {
+		return
+	}
+
+	key := [2]string{frame.To.Hex(), callSelector(frame)}
+	row, ok := report[key]
+	if !ok {
+		row = &GasReportRow{Contract: key[0], Selector: key[1]}
+		report[key] = row
+	}
+	row.Calls++
+	if frame.Error != "" {
+		row.Failed++
+	}
+	row.GasUsed += uint64(frame.GasUsed)
+	row.SelfGasUsed += selfGasUsed
+}
+
+// callSelector returns the function selector of a call.
+func callSelector(frame *callFrame) string {
+	switch {
+	case strings.HasPrefix(frame.Type, "CREATE"):
+		return selectorCreate
+	case len(frame.Input) < 4:
+		return selectorFallback
+	default:
+		return hexutil.Encode(frame.Input[:4])
+	}
+}
+
+// writeGasReport writes the report rows in the given format.
+func writeGasReport(w io.Writer, rows []GasReportRow, format string) error {
+	if format == formatJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"contract", "selector", "calls", "failed", "gas_used", "self_gas_used"}); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := []string{
+			row.Contract,
+			row.Selector,
+			strconv.FormatUint(row.Calls, 10),
+			strconv.FormatUint(row.Failed, 10),
+			strconv.FormatUint(row.GasUsed, 10),
+			strconv.FormatUint(row.SelfGasUsed, 10),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
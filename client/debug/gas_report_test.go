@@ -0,0 +1,80 @@
+package debug
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tokenAddr = "0x1111111111111111111111111111111111111111"
+	libAddr   = "0x2222222222222222222222222222222222222222"
+)
+
+func writeTrace(t *testing.T, dir, name, content string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+func TestBuildGasReport(t *testing.T) {
+	dir := t.TempDir()
+	// a transfer delegating to a library, and a failed call without data
+	writeTrace(t, dir, "100.json", `[
+		{"result": {"type": "CALL", "to": "`+tokenAddr+`", "input": "0xa9059cbb0000", "gasUsed": "0x7530", "calls": [
+			{"type": "DELEGATECALL", "to": "`+libAddr+`", "input": "0x12345678", "gasUsed": "0x2710"}
+		]}},
+		{"result": {"type": "CALL", "to": "`+tokenAddr+`", "input": "0x", "gasUsed": "0x5208", "error": "execution reverted"}},
+		{"error": "tracer failed"}
+	]`)
+	writeTrace(t, dir, "101.json", `[
+		{"result": {"type": "CREATE", "to": "`+libAddr+`", "input": "0x6080", "gasUsed": "0x186a0"}},
+		{"result": {"type": "CALL", "to": "`+tokenAddr+`", "input": "0xa9059cbb", "gasUsed": "0x7530"}}
+	]`)
+	// out of the range
+	writeTrace(t, dir, "300.json", `[{"result": {"type": "CALL", "to": "`+tokenAddr+`", "input": "0x", "gasUsed": "0x1"}}]`)
+	// not a block trace
+	writeTrace(t, dir, "notes.json", `{}`)
+
+	rows, err := BuildGasReport(dir, 100, 200)
+	require.NoError(t, err)
+	require.Equal(t, []GasReportRow{
+		{Contract: libAddr, Selector: selectorCreate, Calls: 1, GasUsed: 100000, SelfGasUsed: 100000},
+		{Contract: tokenAddr, Selector: "0xa9059cbb", Calls: 2, GasUsed: 60000, SelfGasUsed: 50000},
+		{Contract: tokenAddr, Selector: selectorFallback, Calls: 1, Failed: 1, GasUsed: 21000, SelfGasUsed: 21000},
+		{Contract: libAddr, Selector: "0x12345678", Calls: 1, GasUsed: 10000, SelfGasUsed: 10000},
+	}, rows)
+
+	rows, err = BuildGasReport(dir, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), rows[2].Calls)
+
+	writeTrace(t, dir, "150.json", `{}`)
+	_, err = BuildGasReport(dir, 0, 0)
+	require.Error(t, err)
+}
+
+func TestGasReportCmd(t *testing.T) {
+	dir := t.TempDir()
+	writeTrace(t, dir, "1.json", `[{"result": {"type": "CALL", "to": "`+tokenAddr+`", "input": "0xa9059cbb", "gasUsed": "0x7530"}}]`)
+
+	cmd := GasReportCmd()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{dir})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "contract,selector,calls,failed,gas_used,self_gas_used\n"+tokenAddr+",0xa9059cbb,1,0,30000,30000\n", out.String())
+
+	cmd = GasReportCmd()
+	out.Reset()
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{dir, "--format", "json"})
+	require.NoError(t, cmd.Execute())
+	require.True(t, strings.Contains(out.String(), `"selfGasUsed": 30000`))
+
+	cmd = GasReportCmd()
+	cmd.SetArgs([]string{dir, "--format", "xml"})
+	require.Error(t, cmd.Execute())
+}
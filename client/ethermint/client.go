@@ -0,0 +1,133 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+
+// Package ethermint provides a Go client of the ethermint JSON-RPC extensions, wrapping the
+// go-ethereum ethclient so that the integrators don't hand-roll the raw RPC calls.
+package ethermint
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// Client defines typed wrappers of the ethermint namespace on top of the eth ones.
+type Client struct {
+	*ethclient.Client
+	c *rpc.Client
+}
+
+// Dial connects a client to the given URL.
+func Dial(rawurl string) (*Client, error) {
+	return DialContext(context.Background(), rawurl)
+}
+
+// DialContext connects a client to the given URL with the given context.
+func DialContext(ctx context.Context, rawurl string) (*Client, error) {
+	c, err := rpc.DialContext(ctx, rawurl)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(c), nil
+}
+
+// NewClient creates a client that uses the given RPC client.
+func NewClient(c *rpc.Client) *Client {
+	return &Client{
+		Client: ethclient.NewClient(c),
+		c:      c,
+	}
+}
+
+// RPCClient returns the underlying RPC client, to call the methods without a typed wrapper.
+func (ec *Client) RPCClient() *rpc.Client {
+	return ec.c
+}
+
+// Capabilities returns the methods served by the node and the limits set in its config.
+func (ec *Client) Capabilities(ctx context.Context) (*rpctypes.Capabilities, error) {
+	var res rpctypes.Capabilities
+	if err := ec.c.CallContext(ctx, &res, "ethermint_capabilities"); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// BalancesAt returns the balances of all the denoms held by the account, keyed by denom. The block
+// number can be nil, in which case the balances are taken from the latest known block.
+func (ec *Client) BalancesAt(ctx context.Context, account common.Address, blockNumber *big.Int) (map[string]*big.Int, error) {
+	var res map[string]*hexutil.Big
+	if err := ec.c.CallContext(ctx, &res, "ethermint_getBalances", account, toBlockNumArg(blockNumber)); err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]*big.Int, len(res))
+	for denom, amount := range res {
+		balances[denom] = amount.ToInt()
+	}
+	return balances, nil
+}
+
+// ChainStats returns the aggregated statistics of the ethereum txs executed in the block range. The
+// block numbers can be nil, in which case the latest known block is used.
+func (ec *Client) ChainStats(ctx context.Context, fromBlock, toBlock *big.Int) (*rpctypes.ChainStats, error) {
+	var res rpctypes.ChainStats
+	err := ec.c.CallContext(ctx, &res, "ethermint_getChainStats", toBlockNumArg(fromBlock), toBlockNumArg(toBlock))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ValidatorAccount returns the hex account, operator and consensus addresses of the validator
+// identified by any of them.
+func (ec *Client) ValidatorAccount(ctx context.Context, address string) (*rpctypes.ValidatorAccount, error) {
+	var res rpctypes.ValidatorAccount
+	if err := ec.c.CallContext(ctx, &res, "ethermint_getValidatorAccount", address); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// AccountProofForHeight returns the account and storage proofs with the app hash they are verified
+// against. The block number can be nil, in which case the latest committed proofs are returned.
+func (ec *Client) AccountProofForHeight(
+	ctx context.Context, account common.Address, storageKeys []string, blockNumber *big.Int,
+) (*rpctypes.AccountProofBundle, error) {
+	if storageKeys == nil {
+		storageKeys = []string{}
+	}
+
+	var res rpctypes.AccountProofBundle
+	err := ec.c.CallContext(ctx, &res, "ethermint_getAccountProofForHeight", account, storageKeys, toBlockNumArg(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	return hexutil.EncodeBig(number)
+}
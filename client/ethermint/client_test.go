@@ -0,0 +1,105 @@
+package ethermint
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+type fakeEthermintAPI struct{}
+
+func (fakeEthermintAPI) Capabilities() *rpctypes.Capabilities {
+	return &rpctypes.Capabilities{Methods: []string{"eth_call"}, Unsupported: []string{}}
+}
+
+func (fakeEthermintAPI) GetBalances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (map[string]*hexutil.Big, error) {
+	return map[string]*hexutil.Big{
+		"aphoton": (*hexutil.Big)(big.NewInt(int64(*blockNrOrHash.BlockNumber))),
+	}, nil
+}
+
+func (fakeEthermintAPI) GetChainStats(fromBlock, toBlock rpctypes.BlockNumber) (*rpctypes.ChainStats, error) {
+	return &rpctypes.ChainStats{FromBlock: hexutil.Uint64(fromBlock), ToBlock: hexutil.Uint64(toBlock)}, nil
+}
+
+type fakeDebugAPI struct{}
+
+func (fakeDebugAPI) TraceTransaction(hash common.Hash, config map[string]interface{}) (interface{}, error) {
+	return map[string]interface{}{
+		"type": "CALL",
+		"from": "0x0000000000000000000000000000000000000001",
+		"to":   "0x0000000000000000000000000000000000000002",
+		"calls": []interface{}{
+			map[string]interface{}{
+				"type": "CALL",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to":   "0x0000000000000000000000000000000000000003",
+				"calls": []interface{}{
+					map[string]interface{}{
+						"type":  "CREATE",
+						"from":  "0x0000000000000000000000000000000000000003",
+						"to":    "0x0000000000000000000000000000000000000004",
+						"error": "execution reverted",
+					},
+				},
+			},
+			map[string]interface{}{
+				"type":  "STATICCALL",
+				"from":  "0x0000000000000000000000000000000000000002",
+				"to":    "0x0000000000000000000000000000000000000005",
+				"input": "0x01",
+			},
+		},
+	}, nil
+}
+
+func newTestClient(t *testing.T) *Client {
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("ethermint", fakeEthermintAPI{}))
+	require.NoError(t, srv.RegisterName("debug", fakeDebugAPI{}))
+	t.Cleanup(srv.Stop)
+	return NewClient(rpc.DialInProc(srv))
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	capabilities, err := client.Capabilities(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"eth_call"}, capabilities.Methods)
+
+	balances, err := client.BalancesAt(ctx, common.Address{}, big.NewInt(7))
+	require.NoError(t, err)
+	require.Equal(t, map[string]*big.Int{"aphoton": big.NewInt(7)}, balances)
+
+	stats, err := client.ChainStats(ctx, big.NewInt(2), big.NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(2), stats.FromBlock)
+	require.Equal(t, hexutil.Uint64(5), stats.ToBlock)
+}
+
+func TestInternalTransactions(t *testing.T) {
+	client := newTestClient(t)
+
+	internalTxs, err := client.InternalTransactions(context.Background(), common.Hash{})
+	require.NoError(t, err)
+
+	var summary [][]interface{}
+	for _, tx := range internalTxs {
+		summary = append(summary, []interface{}{tx.Type, tx.To.Hex(), tx.Depth, tx.Error})
+	}
+	require.Equal(t, [][]interface{}{
+		{"CALL", common.BigToAddress(big.NewInt(3)).Hex(), 1, ""},
+		{"CREATE", common.BigToAddress(big.NewInt(4)).Hex(), 2, "execution reverted"},
+		{"STATICCALL", common.BigToAddress(big.NewInt(5)).Hex(), 1, ""},
+	}, summary)
+	require.Equal(t, hexutil.Bytes{1}, internalTxs[2].Input)
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ethermint
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// InternalTx defines a call or contract creation made by a contract during the execution of a
+// transaction, as reported by the `callTracer`.
+type InternalTx struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	// Depth is the call depth of the internal tx, 1 for the calls made by the tx recipient
+	Depth int `json:"depth"`
+}
+
+// callFrame is the output of the `callTracer`
+type callFrame struct {
+	InternalTx
+	Calls []callFrame `json:"calls,omitempty"`
+}
+
+// InternalTransactions returns the internal txs of the transaction in execution order, traced with
+// `debug_traceTransaction`. The debug namespace must be enabled on the node.
+func (ec *Client) InternalTransactions(ctx context.Context, txHash common.Hash) ([]InternalTx, error) {
+	var root callFrame
+	config := map[string]interface{}{"tracer": "callTracer"}
+	if err := ec.c.CallContext(ctx, &root, "debug_traceTransaction", txHash, config); err != nil {
+		return nil, err
+	}
+
+	internalTxs := []InternalTx{}
+	var walk func(frames []callFrame, depth int)
+	walk = func(frames []callFrame, depth int) {
+		for _, frame := range frames {
+			frame.Depth = depth
+			internalTxs = append(internalTxs, frame.InternalTx)
+			walk(frame.Calls, depth+1)
+		}
+	}
+	walk(root.Calls, 1)
+	return internalTxs, nil
+}
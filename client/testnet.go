@@ -72,6 +72,11 @@ var (
 	flagRPCAddress        = "rpc.address"
 	flagAPIAddress        = "api.address"
 	flagPrintMnemonic     = "print-mnemonic"
+	flagDevAccounts       = "dev-accounts"
+	flagDevAccountBalance = "dev-account-balance"
+	flagPredeploys        = "predeploys"
+	flagDockerCompose     = "docker-compose"
+	flagDockerImage       = "docker-image"
 )
 
 type initArgs struct {
@@ -84,6 +89,11 @@ type initArgs struct {
 	numValidators     int
 	outputDir         string
 	startingIPAddress string
+	devAccounts       int
+	devAccountBalance string
+	predeploys        string
+	dockerCompose     bool
+	dockerImage       string
 }
 
 type startArgs struct {
@@ -141,8 +151,13 @@ or a similar setup where each node has a manually configurable IP address.
 
 Note, strict routability for addresses is turned off in the config file.
 
+The genesis can prefund development accounts, derived from a new mnemonic written with their
+private keys to dev_accounts.json, and include the predeployed contracts of a fixture file.
+With --docker-compose, a docker-compose.yml running the nodes is written to the output directory.
+
 Example:
 	evmosd testnet init-files --v 4 --output-dir ./.testnets --starting-ip-address 192.168.10.2
+	evmosd testnet init-files --v 4 --dev-accounts 10 --predeploys ./predeploys.json --docker-compose
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -162,6 +177,11 @@ Example:
 			args.startingIPAddress, _ = cmd.Flags().GetString(flagStartingIPAddress)
 			args.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
 			args.algo, _ = cmd.Flags().GetString(flags.FlagKeyAlgorithm)
+			args.devAccounts, _ = cmd.Flags().GetInt(flagDevAccounts)
+			args.devAccountBalance, _ = cmd.Flags().GetString(flagDevAccountBalance)
+			args.predeploys, _ = cmd.Flags().GetString(flagPredeploys)
+			args.dockerCompose, _ = cmd.Flags().GetBool(flagDockerCompose)
+			args.dockerImage, _ = cmd.Flags().GetString(flagDockerImage)
 
 			return initTestnetFiles(clientCtx, cmd, serverCtx.Config, mbm, genBalIterator, args)
 		},
@@ -174,6 +194,13 @@ Example:
 		"192.168.0.1",
 		"Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().Int(flagDevAccounts, 0, "Number of development accounts to prefund in the genesis")
+	cmd.Flags().String(flagDevAccountBalance,
+		fmt.Sprintf("1000000000000000000000%s", ethermint.AttoPhoton),
+		"Initial balance of each development account")
+	cmd.Flags().String(flagPredeploys, "", "Fixture file of the contracts and accounts to predeploy in the genesis")
+	cmd.Flags().Bool(flagDockerCompose, false, "Write a docker-compose.yml running the nodes to the output directory")
+	cmd.Flags().String(flagDockerImage, "ethermintd/node", "Docker image of the nodes in the docker-compose.yml")
 
 	return cmd
 }
@@ -244,9 +271,13 @@ func initTestnetFiles(
 	appConfig.Telemetry.EnableHostnameLabel = false
 	appConfig.Telemetry.GlobalLabels = [][]string{{"chain_id", args.chainID}}
 
+	appConfig.JSONRPC.Address = "0.0.0.0:8545"
+	appConfig.JSONRPC.WsAddress = "0.0.0.0:8546"
+
 	var (
 		genAccounts []authtypes.GenesisAccount
 		genBalances []banktypes.Balance
+		evmAccounts []evmtypes.GenesisAccount
 		genFiles    []string
 	)
 
@@ -371,7 +402,38 @@ func initTestnetFiles(
 		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config/app.toml"), appConfig)
 	}
 
-	if err := initGenFiles(clientCtx, mbm, args.chainID, ethermint.AttoPhoton, genAccounts, genBalances, genFiles, args.numValidators); err != nil {
+	if args.devAccounts > 0 {
+		coins, err := sdk.ParseCoinsNormalized(args.devAccountBalance)
+		if err != nil {
+			return fmt.Errorf("failed to parse dev account balance: %w", err)
+		}
+
+		devAccs, accounts, balances, err := genDevAccounts(args.devAccounts, coins)
+		if err != nil {
+			return err
+		}
+		if err := writeDevAccounts(args.outputDir, devAccs); err != nil {
+			return err
+		}
+
+		genAccounts = append(genAccounts, accounts...)
+		genBalances = append(genBalances, balances...)
+	}
+
+	if args.predeploys != "" {
+		accounts, balances, predeploys, err := genPredeploys(args.predeploys, ethermint.AttoPhoton)
+		if err != nil {
+			return fmt.Errorf("failed to read predeploys: %w", err)
+		}
+
+		genAccounts = append(genAccounts, accounts...)
+		genBalances = append(genBalances, balances...)
+		evmAccounts = append(evmAccounts, predeploys...)
+	}
+
+	if err := initGenFiles(
+		clientCtx, mbm, args.chainID, ethermint.AttoPhoton, genAccounts, genBalances, evmAccounts, genFiles, args.numValidators,
+	); err != nil {
 		return err
 	}
 
@@ -383,6 +445,12 @@ func initTestnetFiles(
 		return err
 	}
 
+	if args.dockerCompose {
+		if err := writeDockerCompose(args); err != nil {
+			return err
+		}
+	}
+
 	cmd.PrintErrf("Successfully initialized %d node directories\n", args.numValidators)
 	return nil
 }
@@ -394,6 +462,7 @@ func initGenFiles(
 	coinDenom string,
 	genAccounts []authtypes.GenesisAccount,
 	genBalances []banktypes.Balance,
+	evmAccounts []evmtypes.GenesisAccount,
 	genFiles []string,
 	numValidators int,
 ) error {
@@ -445,6 +514,7 @@ func initGenFiles(
 	clientCtx.Codec.MustUnmarshalJSON(appGenState[evmtypes.ModuleName], &evmGenState)
 
 	evmGenState.Params.EvmDenom = coinDenom
+	evmGenState.Accounts = evmAccounts
 	appGenState[evmtypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&evmGenState)
 
 	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
@@ -0,0 +1,253 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/template"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdkhd "github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/evmos/ethermint/crypto/hd"
+	"github.com/evmos/ethermint/testutil/fixtures"
+	"github.com/evmos/ethermint/testutil/network"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	devAccountsFile   = "dev_accounts.json"
+	dockerComposeFile = "docker-compose.yml"
+)
+
+// devAccount is a prefunded development account of a local testnet.
+type devAccount struct {
+	Address    common.Address `json:"address"`
+	PrivateKey string         `json:"privateKey"`
+}
+
+// devAccounts are the development accounts of a local testnet, derived from the mnemonic along the
+// Ethereum BIP44 path, so that they can be imported in the wallets.
+type devAccounts struct {
+	Mnemonic string       `json:"mnemonic"`
+	Accounts []devAccount `json:"accounts"`
+}
+
+// genDevAccounts derives the given number of development accounts from a new mnemonic and returns
+// them with their genesis accounts and balances.
+func genDevAccounts(num int, coins sdk.Coins) (*devAccounts, []authtypes.GenesisAccount, []banktypes.Balance, error) {
+	entropy, err := bip39.NewEntropy(256)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	devAccs := &devAccounts{Mnemonic: mnemonic}
+	genAccounts := make([]authtypes.GenesisAccount, 0, num)
+	genBalances := make([]banktypes.Balance, 0, num)
+
+	for i := 0; i < num; i++ {
+		path := sdkhd.CreateHDPath(ethermint.Bip44CoinType, 0, uint32(i)).String()
+		bz, err := hd.EthSecp256k1.Derive()(mnemonic, keyring.DefaultBIP39Passphrase, path)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		privKey := hd.EthSecp256k1.Generate()(bz)
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+
+		devAccs.Accounts = append(devAccs.Accounts, devAccount{
+			Address:    common.BytesToAddress(addr),
+			PrivateKey: hexutil.Encode(privKey.Bytes()),
+		})
+		genAccounts = append(genAccounts, &ethermint.EthAccount{
+			BaseAccount: authtypes.NewBaseAccount(addr, nil, 0, 0),
+			CodeHash:    common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+		})
+		genBalances = append(genBalances, banktypes.Balance{Address: addr.String(), Coins: coins.Sort()})
+	}
+
+	return devAccs, genAccounts, genBalances, nil
+}
+
+// genPredeploys returns the genesis accounts, balances and EVM accounts of the contracts and accounts
+// captured in the fixture file, the balances being in the given denomination.
+func genPredeploys(path, denom string) ([]authtypes.GenesisAccount, []banktypes.Balance, []evmtypes.GenesisAccount, error) {
+	fixture, err := fixtures.ReadFile(path)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	addrs := make([]common.Address, 0, len(fixture.Alloc))
+	for addr := range fixture.Alloc {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Hex() < addrs[j].Hex()
+	})
+
+	var (
+		genAccounts []authtypes.GenesisAccount
+		genBalances []banktypes.Balance
+		evmAccounts []evmtypes.GenesisAccount
+	)
+
+	for _, addr := range addrs {
+		account := fixture.Alloc[addr]
+		accAddr := sdk.AccAddress(addr.Bytes())
+
+		codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+		if len(account.Code) > 0 {
+			codeHash = crypto.Keccak256Hash(account.Code)
+		}
+		genAccounts = append(genAccounts, &ethermint.EthAccount{
+			BaseAccount: authtypes.NewBaseAccount(accAddr, nil, 0, account.Nonce),
+			CodeHash:    codeHash.Hex(),
+		})
+
+		if account.Balance != nil && account.Balance.Sign() > 0 {
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(new(big.Int).Set(account.Balance))))
+			genBalances = append(genBalances, banktypes.Balance{Address: accAddr.String(), Coins: coins})
+		}
+
+		storage := make(evmtypes.Storage, 0, len(account.Storage))
+		for key, value := range account.Storage {
+			storage = append(storage, evmtypes.NewState(key, value))
+		}
+		sort.Slice(storage, func(i, j int) bool {
+			return storage[i].Key < storage[j].Key
+		})
+
+		evmAccounts = append(evmAccounts, evmtypes.GenesisAccount{
+			Address: addr.Hex(),
+			Code:    common.Bytes2Hex(account.Code),
+			Storage: storage,
+		})
+	}
+
+	return genAccounts, genBalances, evmAccounts, nil
+}
+
+// writeDevAccounts writes the development accounts to the output directory of the testnet.
+func writeDevAccounts(outputDir string, devAccs *devAccounts) error {
+	bz, err := json.MarshalIndent(devAccs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return network.WriteFile(devAccountsFile, outputDir, bz)
+}
+
+var dockerComposeTemplate = template.Must(template.New("docker-compose").Parse(`version: "3"
+
+services:
+{{- range .Nodes }}
+  {{ .Name }}:
+    container_name: {{ .Name }}
+    image: "{{ $.Image }}"
+    ports:
+      - "{{ .RPCPort }}:26657"
+      - "{{ .JSONRPCPort }}:8545"
+      - "{{ .WsPort }}:8546"
+    volumes:
+      - ./{{ .Dir }}:/ethermint:Z
+    command: "./ethermintd start --home /ethermint"
+    networks:
+      localnet:
+        ipv4_address: {{ .IP }}
+{{- end }}
+
+networks:
+  localnet:
+    driver: bridge
+    ipam:
+      config:
+        - subnet: {{ .Subnet }}
+          gateway: {{ .Gateway }}
+`))
+
+type dockerComposeNode struct {
+	Name        string
+	Dir         string
+	IP          string
+	RPCPort     int
+	JSONRPCPort int
+	WsPort      int
+}
+
+// writeDockerCompose writes the Docker Compose file running the testnet nodes in the output directory,
+// each node container having the IP address its peers were configured with.
+func writeDockerCompose(args initArgs) error {
+	startIP := net.ParseIP(args.startingIPAddress).To4()
+	if startIP == nil {
+		return fmt.Errorf("docker compose requires an IPv4 starting IP address, got %s", args.startingIPAddress)
+	}
+	subnet := &net.IPNet{IP: startIP.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}
+	gateway := make(net.IP, len(subnet.IP))
+	copy(gateway, subnet.IP)
+	gateway[3] = 254
+
+	nodes := make([]dockerComposeNode, args.numValidators)
+	for i := range nodes {
+		ip, err := getIP(i, args.startingIPAddress)
+		if err != nil {
+			return err
+		}
+		if parsed := net.ParseIP(ip); !subnet.Contains(parsed) || parsed.Equal(gateway) {
+			return fmt.Errorf("IP address %s of node %d is out of the %s subnet", ip, i, subnet)
+		}
+
+		nodeDirName := fmt.Sprintf("%s%d", args.nodeDirPrefix, i)
+		nodes[i] = dockerComposeNode{
+			Name:        nodeDirName,
+			Dir:         filepath.ToSlash(filepath.Join(nodeDirName, args.nodeDaemonHome)),
+			IP:          ip,
+			RPCPort:     26657 + i,
+			JSONRPCPort: 8545 + 10*i,
+			WsPort:      8546 + 10*i,
+		}
+	}
+
+	file, err := os.Create(filepath.Join(args.outputDir, dockerComposeFile))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return dockerComposeTemplate.Execute(file, map[string]interface{}{
+		"Image":   args.dockerImage,
+		"Nodes":   nodes,
+		"Subnet":  subnet.String(),
+		"Gateway": gateway.String(),
+	})
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/testutil/fixtures"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestGenDevAccounts(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(ethermint.AttoPhoton, 1000))
+
+	devAccs, genAccounts, genBalances, err := genDevAccounts(3, coins)
+	require.NoError(t, err)
+	require.Len(t, devAccs.Accounts, 3)
+	require.Len(t, genAccounts, 3)
+	require.Len(t, genBalances, 3)
+
+	for i, acc := range devAccs.Accounts {
+		key, err := crypto.HexToECDSA(acc.PrivateKey[2:])
+		require.NoError(t, err)
+		require.Equal(t, acc.Address, crypto.PubkeyToAddress(key.PublicKey))
+		require.Equal(t, sdk.AccAddress(acc.Address.Bytes()), genAccounts[i].GetAddress())
+		require.Equal(t, sdk.AccAddress(acc.Address.Bytes()).String(), genBalances[i].Address)
+		require.Equal(t, coins, genBalances[i].Coins)
+	}
+}
+
+func TestGenPredeploys(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	funded := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	code := []byte{0x60, 0x00}
+
+	fixture := &fixtures.Fixture{Alloc: core.GenesisAlloc{
+		contract: {
+			Code:    code,
+			Storage: map[common.Hash]common.Hash{common.HexToHash("0x01"): common.HexToHash("0x02")},
+			Balance: big.NewInt(0),
+			Nonce:   1,
+		},
+		funded: {Balance: big.NewInt(100)},
+	}}
+	path := filepath.Join(t.TempDir(), "predeploys.json")
+	require.NoError(t, fixture.Save(path))
+
+	genAccounts, genBalances, evmAccounts, err := genPredeploys(path, ethermint.AttoPhoton)
+	require.NoError(t, err)
+	require.Len(t, genAccounts, 2)
+	require.Len(t, evmAccounts, 2)
+
+	contractAcc := genAccounts[0].(*ethermint.EthAccount)
+	require.Equal(t, sdk.AccAddress(contract.Bytes()), contractAcc.GetAddress())
+	require.Equal(t, crypto.Keccak256Hash(code).Hex(), contractAcc.CodeHash)
+	require.Equal(t, uint64(1), contractAcc.GetSequence())
+	require.Equal(t, common.Bytes2Hex(code), evmAccounts[0].Code)
+	require.Equal(t, evmtypes.Storage{evmtypes.NewState(common.HexToHash("0x01"), common.HexToHash("0x02"))}, evmAccounts[0].Storage)
+
+	require.Len(t, genBalances, 1)
+	require.Equal(t, sdk.AccAddress(funded.Bytes()).String(), genBalances[0].Address)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(ethermint.AttoPhoton, 100)), genBalances[0].Coins)
+
+	_, _, _, err = genPredeploys(filepath.Join(t.TempDir(), "missing.json"), ethermint.AttoPhoton)
+	require.Error(t, err)
+}
+
+func TestWriteDockerCompose(t *testing.T) {
+	args := initArgs{
+		outputDir:         t.TempDir(),
+		nodeDirPrefix:     "node",
+		nodeDaemonHome:    "ethermintd",
+		numValidators:     2,
+		startingIPAddress: "192.168.10.2",
+		dockerImage:       "ethermintd/node",
+	}
+	require.NoError(t, writeDockerCompose(args))
+
+	bz, err := os.ReadFile(filepath.Join(args.outputDir, dockerComposeFile))
+	require.NoError(t, err)
+	compose := string(bz)
+	require.Contains(t, compose, "ipv4_address: 192.168.10.2")
+	require.Contains(t, compose, "ipv4_address: 192.168.10.3")
+	require.Contains(t, compose, "./node1/ethermintd:/ethermint:Z")
+	require.Contains(t, compose, "\"8555:8545\"")
+	require.Contains(t, compose, "subnet: 192.168.10.0/24")
+
+	args.startingIPAddress = "192.168.10.253"
+	require.Error(t, writeDockerCompose(args), "node IP on the gateway")
+
+	args.startingIPAddress = "::1"
+	require.Error(t, writeDockerCompose(args), "IPv6 starting address")
+}
+
+func TestWriteDevAccounts(t *testing.T) {
+	dir := t.TempDir()
+	devAccs := &devAccounts{Mnemonic: "test", Accounts: []devAccount{{Address: common.HexToAddress("0x01"), PrivateKey: "0x02"}}}
+	require.NoError(t, writeDevAccounts(dir, devAccs))
+
+	bz, err := os.ReadFile(filepath.Join(dir, devAccountsFile))
+	require.NoError(t, err)
+
+	var read devAccounts
+	require.NoError(t, json.Unmarshal(bz, &read))
+	require.Equal(t, *devAccs, read)
+}
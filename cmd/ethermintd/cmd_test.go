@@ -2,16 +2,23 @@ package main_test
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
 	"github.com/evmos/ethermint/app"
 	ethermintd "github.com/evmos/ethermint/cmd/ethermintd"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 func TestInitCmd(t *testing.T) {
@@ -26,3 +33,35 @@ func TestInitCmd(t *testing.T) {
 	err := svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
 	require.NoError(t, err)
 }
+
+func TestAddGenesisEthAccountCmd(t *testing.T) {
+	home := t.TempDir()
+	execute := func(args ...string) error {
+		rootCmd, _ := ethermintd.NewRootCmd()
+		rootCmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+		return svrcmd.Execute(rootCmd, "", home)
+	}
+
+	require.NoError(t, execute("init", "etherminttest", fmt.Sprintf("--%s=%s", flags.FlagChainID, "ethermint_9000-1")))
+
+	hexAddr := common.HexToAddress("0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+	require.NoError(t, execute("add-genesis-eth-account", hexAddr.Hex(), "1000"))
+	require.Error(t, execute("add-genesis-eth-account", hexAddr.Hex(), "1000"), "existing account")
+	require.Error(t, execute("add-genesis-eth-account", "ethm1invalid", "1000"), "invalid hex address")
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	_, encodingConfig := ethermintd.NewRootCmd()
+	cdc := encodingConfig.Codec
+	addr := sdk.AccAddress(hexAddr.Bytes())
+
+	accs, err := authtypes.UnpackAccounts(authtypes.GetGenesisStateFromAppState(cdc, appState).Accounts)
+	require.NoError(t, err)
+	require.True(t, accs.Contains(addr))
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, bankGenState.Balances, 1)
+	require.Equal(t, addr.String(), bankGenState.Balances[0].Address)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1000)), bankGenState.Balances[0].Coins)
+}
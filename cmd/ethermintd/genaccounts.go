@@ -25,8 +25,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -145,46 +147,76 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
 			}
 
-			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+			if err := addGenesisAccount(clientCtx.Codec, appState, genAccount, balances); err != nil {
+				return err
+			}
 
-			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			appStateJSON, err := json.Marshal(appState)
 			if err != nil {
-				return fmt.Errorf("failed to get accounts from any: %w", err)
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
 			}
 
-			if accs.Contains(addr) {
-				return fmt.Errorf("cannot add account at existing address %s", addr)
-			}
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
 
-			// Add the new account to the set of genesis accounts and sanitize the
-			// accounts afterwards.
-			accs = append(accs, genAccount)
-			accs = authtypes.SanitizeGenesisAccounts(accs)
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
+	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
+	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	flags.AddQueryFlagsToCmd(cmd)
 
-			genAccs, err := authtypes.PackAccounts(accs)
-			if err != nil {
-				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	return cmd
+}
+
+// AddGenesisEthAccountCmd returns add-genesis-eth-account cobra Command.
+func AddGenesisEthAccountCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-eth-account HEX_ADDRESS AMOUNT",
+		Short: "Add a genesis Ethereum account to genesis.json",
+		Long: `Add a genesis Ethereum account to genesis.json. The account is identified by its
+hex address, its bech32 address is derived from it. The amount is either an integer
+amount of the EVM denomination set in the genesis EVM params, or a list of coins.
+`,
+		Example: fmt.Sprintf("%s add-genesis-eth-account 0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E 1000000000000000000", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid hex address %s", args[0])
 			}
-			authGenState.Accounts = genAccs
+			addr := sdk.AccAddress(common.HexToAddress(args[0]).Bytes())
 
-			authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
 			if err != nil {
-				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
 			}
 
-			appState[authtypes.ModuleName] = authGenStateBz
-
-			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
-			bankGenState.Balances = append(bankGenState.Balances, balances)
-			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
-			bankGenState.Supply = bankGenState.Supply.Add(balances.Coins...)
-
-			bankGenStateBz, err := clientCtx.Codec.MarshalJSON(bankGenState)
+			coins, err := parseEthAccountAmount(clientCtx.Codec, appState, args[1])
 			if err != nil {
-				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+				return err
 			}
 
-			appState[banktypes.ModuleName] = bankGenStateBz
+			genAccount := &ethermint.EthAccount{
+				BaseAccount: authtypes.NewBaseAccount(addr, nil, 0, 0),
+				CodeHash:    common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+			}
+			if err := genAccount.Validate(); err != nil {
+				return fmt.Errorf("failed to validate new genesis account: %w", err)
+			}
+
+			balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
+			if err := addGenesisAccount(clientCtx.Codec, appState, genAccount, balances); err != nil {
+				return err
+			}
 
 			appStateJSON, err := json.Marshal(appState)
 			if err != nil {
@@ -197,11 +229,80 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
-	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
-	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
-	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
-	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
-	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseEthAccountAmount parses the amount of a genesis Ethereum account, either an integer amount
+// of the EVM denomination of the genesis EVM params or a list of coins.
+func parseEthAccountAmount(cdc codec.JSONCodec, appState map[string]json.RawMessage, amount string) (sdk.Coins, error) {
+	if value, ok := sdk.NewIntFromString(amount); ok {
+		var evmGenState evmtypes.GenesisState
+		if err := cdc.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+		}
+
+		coin := sdk.Coin{Denom: evmGenState.Params.EvmDenom, Amount: value}
+		if err := coin.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid amount: %w", err)
+		}
+		return sdk.NewCoins(coin), nil
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse coins: %w", err)
+	}
+	return coins, nil
+}
+
+// addGenesisAccount adds the account to the auth genesis state of the app state, and its
+// balance to the bank genesis state.
+func addGenesisAccount(
+	cdc codec.Codec,
+	appState map[string]json.RawMessage,
+	genAccount authtypes.GenesisAccount,
+	balances banktypes.Balance,
+) error {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	if accs.Contains(genAccount.GetAddress()) {
+		return fmt.Errorf("cannot add account at existing address %s", genAccount.GetAddress())
+	}
+
+	// Add the new account to the set of genesis accounts and sanitize the
+	// accounts afterwards.
+	accs = append(accs, genAccount)
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	bankGenState.Balances = append(bankGenState.Balances, balances)
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	bankGenState.Supply = bankGenState.Supply.Add(balances.Coins...)
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appState[banktypes.ModuleName] = bankGenStateBz
+	return nil
+}
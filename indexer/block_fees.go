@@ -0,0 +1,85 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// GetBlockFees returns the fee data of the block at the given height, nil if
+// not stored.
+func (kv *KVIndexer) GetBlockFees(height int64) (*rpctypes.BlockFees, error) {
+	bz, err := kv.db.Get(BlockFeesKey(height))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBlockFees %d", height)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var fees rpctypes.BlockFees
+	if err := json.Unmarshal(bz, &fees); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBlockFees %d", height)
+	}
+	return &fees, nil
+}
+
+// SetBlockFees stores the fee data of the block at the given height.
+func (kv *KVIndexer) SetBlockFees(height int64, fees *rpctypes.BlockFees) error {
+	bz, err := json.Marshal(fees)
+	if err != nil {
+		return errorsmod.Wrapf(err, "SetBlockFees %d", height)
+	}
+	return kv.db.Set(BlockFeesKey(height), bz)
+}
+
+// PruneBlockFees deletes the fee data of the blocks below the given height.
+func (kv *KVIndexer) PruneBlockFees(height int64) error {
+	it, err := kv.db.Iterator([]byte{KeyPrefixBlockFees}, BlockFeesKey(height))
+	if err != nil {
+		return errorsmod.Wrap(err, "PruneBlockFees")
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Close(); err != nil {
+		return errorsmod.Wrap(err, "PruneBlockFees")
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return errorsmod.Wrap(err, "PruneBlockFees")
+		}
+	}
+	return batch.Write()
+}
+
+// BlockFeesKey returns the key for db entry: `block number -> block fees`
+func BlockFeesKey(height int64) []byte {
+	return append([]byte{KeyPrefixBlockFees}, sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,54 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/ethermint/indexer"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestBlockFees(t *testing.T) {
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), client.Context{})
+
+	fees, err := idxer.GetBlockFees(1)
+	require.NoError(t, err)
+	require.Nil(t, fees)
+
+	for height := int64(1); height <= 3; height++ {
+		fees := &rpctypes.BlockFees{
+			BaseFee:      (*hexutil.Big)(big.NewInt(height)),
+			GasUsed:      hexutil.Uint64(21000 * height),
+			GasUsedRatio: 0.5,
+			Txs: []rpctypes.TxFees{
+				{GasUsed: 21000, Reward: (*hexutil.Big)(big.NewInt(10))},
+			},
+		}
+		require.NoError(t, idxer.SetBlockFees(height, fees))
+
+		stored, err := idxer.GetBlockFees(height)
+		require.NoError(t, err)
+		require.Equal(t, fees, stored)
+	}
+
+	// the blocks below the given height are deleted
+	require.NoError(t, idxer.PruneBlockFees(3))
+	for height := int64(1); height <= 2; height++ {
+		fees, err := idxer.GetBlockFees(height)
+		require.NoError(t, err)
+		require.Nil(t, fees)
+	}
+	fees, err = idxer.GetBlockFees(3)
+	require.NoError(t, err)
+	require.NotNil(t, fees)
+
+	// the tx index isn't affected
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+}
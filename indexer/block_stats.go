@@ -0,0 +1,67 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// GetBlockStats returns the statistics of the ethereum txs executed in the block at
+// the given height, nil if the block isn't indexed.
+func (kv *KVIndexer) GetBlockStats(height int64) (*evmtypes.BlockStats, error) {
+	bz, err := kv.db.Get(BlockStatsKey(height))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBlockStats %d", height)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var stats evmtypes.BlockStats
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &stats); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBlockStats %d", height)
+	}
+	return &stats, nil
+}
+
+// IterateBlockStats iterates over the statistics of the indexed blocks in the
+// [from, to] height range, in ascending order, until cb returns true.
+func (kv *KVIndexer) IterateBlockStats(from, to int64, cb func(height int64, stats *evmtypes.BlockStats) (stop bool)) error {
+	it, err := kv.db.Iterator(BlockStatsKey(from), BlockStatsKey(to+1))
+	if err != nil {
+		return errorsmod.Wrap(err, "IterateBlockStats")
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var stats evmtypes.BlockStats
+		if err := kv.clientCtx.Codec.Unmarshal(it.Value(), &stats); err != nil {
+			return errorsmod.Wrap(err, "IterateBlockStats")
+		}
+		if cb(int64(sdk.BigEndianToUint64(it.Key()[1:])), &stats) {
+			break
+		}
+	}
+	return it.Error()
+}
+
+// BlockStatsKey returns the key for db entry: `block number -> block stats`
+func BlockStatsKey(height int64) []byte {
+	return append([]byte{KeyPrefixBlockStats}, sdk.Uint64ToBigEndian(uint64(height))...)
+}
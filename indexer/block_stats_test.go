@@ -0,0 +1,120 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestBlockStats(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	to := common.BigToAddress(big.NewInt(1))
+	var (
+		txs    []tmtypes.Tx
+		hashes []common.Hash
+	)
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx := types.NewTx(nil, nonce, &to, big.NewInt(1000), 21000, big.NewInt(10), nil, nil, nil, nil)
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+		txs = append(txs, txBz)
+		hashes = append(hashes, tx.AsTransaction().Hash())
+	}
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+
+	stats, err := idxer.GetBlockStats(1)
+	require.NoError(t, err)
+	require.Nil(t, stats)
+
+	// a failed tx paying the emitted gas price and a tx indexed without it
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: txs}}
+	require.NoError(t, idxer.IndexBlock(block, []*abci.ResponseDeliverTx{
+		{
+			Code:    0,
+			GasUsed: 30000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: []byte(types.AttributeKeyEthereumTxHash), Value: []byte(hashes[0].Hex())},
+					{Key: []byte(types.AttributeKeyTxIndex), Value: []byte("0")},
+					{Key: []byte(types.AttributeKeyTxGasUsed), Value: []byte("30000")},
+					{Key: []byte(types.AttributeKeyEffectiveGasPrice), Value: []byte("7")},
+					{Key: []byte(types.AttributeKeyEthereumTxFailed), Value: []byte("execution reverted")},
+				}},
+			},
+		},
+		{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: []byte(types.AttributeKeyEthereumTxHash), Value: []byte(hashes[1].Hex())},
+					{Key: []byte(types.AttributeKeyTxIndex), Value: []byte("1")},
+					{Key: []byte(types.AttributeKeyTxGasUsed), Value: []byte("21000")},
+				}},
+			},
+		},
+	}))
+
+	expStats := types.BlockStats{TxCount: 2, FailedTxCount: 1, GasUsed: 51000, GasPriceSum: sdkmath.NewInt(17)}
+	stats, err = idxer.GetBlockStats(1)
+	require.NoError(t, err)
+	require.Equal(t, &expStats, stats)
+
+	// the txs discarded for exceeding the block gas limit aren't executed
+	block = &tmtypes.Block{Header: tmtypes.Header{Height: 2}, Data: tmtypes.Data{Txs: txs[:1]}}
+	require.NoError(t, idxer.IndexBlock(block, []*abci.ResponseDeliverTx{
+		{Code: 11, Log: "out of gas in location: block gas meter; gasWanted: 21000"},
+	}))
+
+	// the zero statistics of a block without eth txs are stored
+	block = &tmtypes.Block{Header: tmtypes.Header{Height: 3}}
+	require.NoError(t, idxer.IndexBlock(block, nil))
+
+	zeroStats := types.BlockStats{GasPriceSum: sdkmath.ZeroInt()}
+	var heights []int64
+	require.NoError(t, idxer.IterateBlockStats(1, 3, func(height int64, stats *types.BlockStats) bool {
+		heights = append(heights, height)
+		if height == 1 {
+			require.Equal(t, &expStats, stats)
+		} else {
+			require.Equal(t, &zeroStats, stats)
+		}
+		return false
+	}))
+	require.Equal(t, []int64{1, 2, 3}, heights)
+
+	// the iteration stops when the callback returns true
+	heights = nil
+	require.NoError(t, idxer.IterateBlockStats(2, 10, func(height int64, _ *types.BlockStats) bool {
+		heights = append(heights, height)
+		return true
+	}))
+	require.Equal(t, []int64{2}, heights)
+}
@@ -0,0 +1,192 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// exportMagic identifies the format of the exported indexer db.
+const exportMagic = "ethermint-evmindexer-v1"
+
+// importBatchSize is the number of entries written per batch when importing.
+const importBatchSize = 10000
+
+// maxEntrySize bounds the size of the exported keys and values, to reject corrupted files
+// before allocating.
+const maxEntrySize = 1 << 20
+
+// ExportDB writes all the entries of the indexer db to w as a gzip compressed stream of
+// length prefixed key/value pairs, and returns the number of exported entries.
+func ExportDB(db dbm.DB, w io.Writer) (int, error) {
+	zw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(zw)
+
+	if _, err := bw.WriteString(exportMagic); err != nil {
+		return 0, err
+	}
+
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var count int
+	for ; it.Valid(); it.Next() {
+		if err := writeEntry(bw, it.Key()); err != nil {
+			return count, err
+		}
+		if err := writeEntry(bw, it.Value()); err != nil {
+			return count, err
+		}
+		count++
+	}
+	if err := it.Error(); err != nil {
+		return count, err
+	}
+
+	if err := bw.Flush(); err != nil {
+		return count, err
+	}
+	return count, zw.Close()
+}
+
+// ImportDB writes the entries exported by ExportDB into the indexer db, and returns the
+// number of imported entries. The db must be empty unless overwrite is set, in which case
+// the imported entries replace the existing ones with the same keys.
+func ImportDB(db dbm.DB, r io.Reader, overwrite bool) (int, error) {
+	if !overwrite {
+		empty, err := isEmpty(db)
+		if err != nil {
+			return 0, err
+		}
+		if !empty {
+			return 0, errors.New("indexer db is not empty")
+		}
+	}
+
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, fmt.Errorf("invalid indexer export: %w", err)
+	}
+	defer zr.Close()
+	br := bufio.NewReader(zr)
+
+	magic := make([]byte, len(exportMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != exportMagic {
+		return 0, errors.New("invalid indexer export: unknown format")
+	}
+
+	batch := db.NewBatch()
+	defer func() {
+		batch.Close()
+	}()
+
+	var count int
+	for {
+		key, err := readEntry(br)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return count, err
+		}
+		if len(key) == 0 || !isExportedPrefix(key[0]) {
+			return count, fmt.Errorf("invalid indexer export: unknown key %x", key)
+		}
+
+		value, err := readEntry(br)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return count, err
+		}
+
+		if err := batch.Set(key, value); err != nil {
+			return count, err
+		}
+		count++
+
+		if count%importBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return count, err
+			}
+			batch.Close()
+			batch = db.NewBatch()
+		}
+	}
+
+	return count, batch.WriteSync()
+}
+
+// isExportedPrefix returns true for the key prefixes of the entries written by the indexer.
+func isExportedPrefix(prefix byte) bool {
+	switch prefix {
+	case KeyPrefixTxHash, KeyPrefixTxIndex, KeyPrefixBlockFees, KeyPrefixBlockStats:
+		return true
+	default:
+		return false
+	}
+}
+
+func isEmpty(db dbm.DB) (bool, error) {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+	return !it.Valid(), it.Error()
+}
+
+func writeEntry(w *bufio.Writer, bz []byte) error {
+	var lenBz [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBz[:], uint64(len(bz)))
+	if _, err := w.Write(lenBz[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(bz)
+	return err
+}
+
+// readEntry reads a length prefixed entry, it returns io.EOF only if the stream ends before
+// the entry.
+func readEntry(r *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxEntrySize {
+		return nil, fmt.Errorf("invalid indexer export: entry size %d exceeds the maximum of %d", size, maxEntrySize)
+	}
+
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return bz, nil
+}
@@ -0,0 +1,95 @@
+package indexer_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/evmos/ethermint/indexer"
+)
+
+func TestExportImportDB(t *testing.T) {
+	src := dbm.NewMemDB()
+	entries := map[string][]byte{
+		string([]byte{indexer.KeyPrefixTxHash, 1, 2, 3}):  []byte("tx result"),
+		string([]byte{indexer.KeyPrefixTxIndex, 0, 0, 1}): {1, 2, 3},
+		string([]byte{indexer.KeyPrefixTxIndex, 0, 0, 2}): {},
+		string(indexer.BlockStatsKey(1)):                  []byte("block stats"),
+	}
+	for key, value := range entries {
+		require.NoError(t, src.Set([]byte(key), value))
+	}
+
+	var buf bytes.Buffer
+	count, err := indexer.ExportDB(src, &buf)
+	require.NoError(t, err)
+	require.Equal(t, len(entries), count)
+	export := buf.Bytes()
+
+	testCases := []struct {
+		name      string
+		malleate  func(db dbm.DB) []byte
+		overwrite bool
+		expErr    string
+	}{
+		{
+			"pass - empty db",
+			func(dbm.DB) []byte { return export },
+			false,
+			"",
+		},
+		{
+			"fail - non-empty db",
+			func(db dbm.DB) []byte {
+				require.NoError(t, db.Set([]byte{indexer.KeyPrefixTxHash, 9}, []byte{9}))
+				return export
+			},
+			false,
+			"indexer db is not empty",
+		},
+		{
+			"pass - non-empty db with overwrite",
+			func(db dbm.DB) []byte {
+				require.NoError(t, db.Set([]byte{indexer.KeyPrefixTxHash, 1, 2, 3}, []byte("stale")))
+				return export
+			},
+			true,
+			"",
+		},
+		{
+			"fail - not an export",
+			func(dbm.DB) []byte { return []byte("not an export") },
+			false,
+			"invalid indexer export",
+		},
+		{
+			"fail - truncated export",
+			func(dbm.DB) []byte { return export[:len(export)-12] },
+			false,
+			"unexpected EOF",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dst := dbm.NewMemDB()
+			bz := tc.malleate(dst)
+
+			count, err := indexer.ImportDB(dst, bytes.NewReader(bz), tc.overwrite)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, len(entries), count)
+			for key, value := range entries {
+				bz, err := dst.Get([]byte(key))
+				require.NoError(t, err)
+				require.Equal(t, value, bz)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,8 +36,10 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixBlockFees  = 3
+	KeyPrefixBlockStats = 4
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
@@ -61,6 +64,8 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Aggregates and stores the statistics of the executed eth txs of the block, the zero
+// statistics of a block without eth txs being stored too so that the history has no gap
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
 
@@ -69,6 +74,7 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
+	stats := evmtypes.BlockStats{GasPriceSum: sdkmath.ZeroInt()}
 	for txIndex, tx := range block.Txs {
 		result := txResults[txIndex]
 		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
@@ -118,6 +124,19 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
+				txResult.EffectiveTip = parsedTx.EffectiveTipString()
+
+				stats.TxCount++
+				if parsedTx.Failed {
+					stats.FailedTxCount++
+				}
+				stats.GasUsed += parsedTx.GasUsed
+				gasPrice := parsedTx.EffectiveGasPrice
+				if gasPrice == nil {
+					// the blocks executed before the gas price was emitted
+					gasPrice = ethMsg.AsTransaction().GasPrice()
+				}
+				stats.GasPriceSum = stats.GasPriceSum.Add(sdkmath.NewIntFromBigInt(gasPrice))
 			}
 
 			cumulativeGasUsed += txResult.GasUsed
@@ -129,6 +148,9 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			}
 		}
 	}
+	if err := batch.Set(BlockStatsKey(height), kv.clientCtx.Codec.MustMarshal(&stats)); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set block stats", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
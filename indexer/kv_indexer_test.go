@@ -57,6 +57,7 @@ func TestKVIndexer(t *testing.T) {
 		block       *tmtypes.Block
 		blockResult []*abci.ResponseDeliverTx
 		expSuccess  bool
+		expTip      string
 	}{
 		{
 			"success, format 1",
@@ -77,6 +78,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			true,
+			"",
 		},
 		{
 			"success, format 2",
@@ -94,11 +96,13 @@ func TestKVIndexer(t *testing.T) {
 							{Key: []byte("txGasUsed"), Value: []byte("21000")},
 							{Key: []byte("txHash"), Value: []byte("14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57")},
 							{Key: []byte("recipient"), Value: []byte("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")},
+							{Key: []byte("effectiveTip"), Value: []byte("5")},
 						}},
 					},
 				},
 			},
 			true,
+			"5",
 		},
 		{
 			"success, exceed block gas limit",
@@ -111,6 +115,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			true,
+			"",
 		},
 		{
 			"fail, failed eth tx",
@@ -123,6 +128,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			false,
+			"",
 		},
 		{
 			"fail, invalid events",
@@ -134,6 +140,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			false,
+			"",
 		},
 		{
 			"fail, not eth tx",
@@ -145,6 +152,7 @@ func TestKVIndexer(t *testing.T) {
 				},
 			},
 			false,
+			"",
 		},
 	}
 
@@ -175,6 +183,7 @@ func TestKVIndexer(t *testing.T) {
 				res1, err := idxer.GetByTxHash(txHash)
 				require.NoError(t, err)
 				require.NotNil(t, res1)
+				require.Equal(t, tc.expTip, res1.EffectiveTip)
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
@@ -17,6 +17,9 @@ package rpc
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -27,11 +30,13 @@ import (
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	ethermintapi "github.com/evmos/ethermint/rpc/namespaces/ethereum/ethermint"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/miner"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/net"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/personal"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/ethermint/rpc/namespaces/ethereum/web3"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 	ethermint "github.com/evmos/ethermint/types"
 
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
@@ -53,6 +58,10 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// Ethermint namespaces
+
+	EthermintNamespace = "ethermint"
+
 	apiVersion = "1.0"
 )
 
@@ -128,12 +137,18 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -170,6 +185,22 @@ func init() {
 				},
 			}
 		},
+		EthermintNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EthermintNamespace,
+					Version:   apiVersion,
+					Service:   ethermintapi.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -194,6 +225,58 @@ func GetRPCAPIs(ctx *server.Context,
 	return apis
 }
 
+// Methods returns the sorted names of the JSON-RPC methods served by the given
+// APIs, named after their service methods as the go-ethereum RPC server does.
+// The unsupported methods are omitted.
+func Methods(apis []rpc.API) []string {
+	unsupported := make(map[string]bool, len(rpctypes.UnsupportedMethods))
+	for _, method := range rpctypes.UnsupportedMethods {
+		unsupported[method] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, api := range apis {
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			method := typ.Method(i)
+			name := api.Namespace + "_" + strings.ToLower(method.Name[:1]) + method.Name[1:]
+			if isSubscription(method.Type) {
+				// subscriptions are served by the subscribe and unsubscribe methods of the namespace
+				seen[api.Namespace+"_subscribe"] = true
+				seen[api.Namespace+"_unsubscribe"] = true
+				continue
+			}
+			if !unsupported[name] {
+				seen[name] = true
+			}
+		}
+	}
+
+	methods := make([]string, 0, len(seen))
+	for name := range seen {
+		methods = append(methods, name)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// isSubscription returns true if the method creates a go-ethereum RPC subscription.
+func isSubscription(methodType reflect.Type) bool {
+	subscriptionType := reflect.TypeOf((*rpc.Subscription)(nil))
+	return methodType.NumOut() == 2 && methodType.Out(0) == subscriptionType
+}
+
+// SetCapabilities sets the methods served by the given APIs to the ethermint
+// namespace API, if enabled, for `ethermint_capabilities`.
+func SetCapabilities(apis []rpc.API) {
+	methods := Methods(apis)
+	for _, api := range apis {
+		if service, ok := api.Service.(*ethermintapi.API); ok {
+			ethermintapi.SetMethods(service, methods)
+		}
+	}
+}
+
 // RegisterAPINamespace registers a new API namespace with the API creator.
 // This function fails if the namespace is already registered.
 func RegisterAPINamespace(ns string, creator APICreator) error {
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth"
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/web3"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+func TestMethods(t *testing.T) {
+	apis := []rpc.API{
+		{Namespace: Web3Namespace, Service: web3.NewPublicAPI()},
+		{Namespace: EthNamespace, Service: eth.NewPublicAPI(log.NewNopLogger(), nil)},
+	}
+
+	methods := Methods(apis)
+	require.IsIncreasing(t, methods)
+	require.Contains(t, methods, "web3_clientVersion")
+	require.Contains(t, methods, "web3_sha3")
+	require.Contains(t, methods, "eth_getCompilers")
+	require.Contains(t, methods, "eth_chainId")
+	for _, method := range rpctypes.UnsupportedMethods {
+		require.NotContains(t, methods, method)
+	}
+}
+
+func TestUnsupportedMethods(t *testing.T) {
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.NoError(t, server.RegisterName(EthNamespace, eth.NewPublicAPI(log.NewNopLogger(), nil)))
+
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	var compilers []string
+	require.NoError(t, client.Call(&compilers, "eth_getCompilers"))
+	require.NotNil(t, compilers)
+	require.Empty(t, compilers)
+
+	for _, method := range rpctypes.UnsupportedMethods {
+		err := client.Call(nil, method, "contract Test {}")
+		var rpcErr rpc.Error
+		require.True(t, errors.As(err, &rpcErr), method)
+		require.Equal(t, rpctypes.ErrCodeMethodNotSupported, rpcErr.ErrorCode(), method)
+	}
+}
@@ -24,7 +24,10 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
@@ -101,7 +104,8 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	// query EVM account
 	req := &evmtypes.QueryAccountRequest{
-		Address: address.String(),
+		Address:         address.String(),
+		WithStorageHash: true,
 	}
 
 	res, err := b.queryClient.Account(ctx, req)
@@ -127,11 +131,49 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
-		StorageHash:  common.Hash{}, // NOTE: Ethermint doesn't have a storage hash. TODO: implement?
+		StorageHash:  common.HexToHash(res.StorageHash), // NOTE: hash of the sorted storage, not a trie root
 		StorageProof: storageProofs,
 	}, nil
 }
 
+// AccountProofForHeight returns the account and storage proofs of the address at the given height,
+// along with the app hash committed in the header of the next block, which the proofs are verified
+// against. The latest and pending heights resolve to the last height whose app hash is committed, so
+// that the returned bundle is always verifiable.
+func (b *Backend) AccountProofForHeight(
+	address common.Address, storageKeys []string, blockNum rpctypes.BlockNumber,
+) (*rpctypes.AccountProofBundle, error) {
+	height := blockNum.Int64()
+	if blockNum < 0 {
+		bn, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = int64(bn) - 1
+		if height < 1 {
+			height = 1
+		}
+	}
+
+	commitBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height + 1))
+	if err != nil || commitBlock == nil {
+		return nil, fmt.Errorf("app hash of height %d isn't committed yet", height)
+	}
+
+	proofHeight := rpctypes.BlockNumber(height)
+	res, err := b.GetProof(address, storageKeys, rpctypes.BlockNumberOrHash{BlockNumber: &proofHeight})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.AccountProofBundle{
+		AccountResult: *res,
+		Height:        hexutil.Uint64(height),
+		CommitHeight:  hexutil.Uint64(commitBlock.Block.Height),
+		AppHash:       hexutil.Bytes(commitBlock.Block.AppHash),
+	}, nil
+}
+
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -187,6 +229,40 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 	return (*hexutil.Big)(val.BigInt()), nil
 }
 
+// Balances returns the bank balances of all the denoms held by the address at the given block, keyed
+// by denom.
+func (b *Backend) Balances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (map[string]*hexutil.Big, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := b.TendermintBlockByNumber(blockNum); err != nil {
+		return nil, err
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	req := &banktypes.QueryAllBalancesRequest{
+		Address:    sdk.AccAddress(address.Bytes()).String(),
+		Pagination: &query.PageRequest{},
+	}
+
+	balances := make(map[string]*hexutil.Big)
+	for {
+		res, err := b.queryClient.Bank.AllBalances(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, coin := range res.Balances {
+			balances[coin.Denom] = (*hexutil.Big)(coin.Amount.BigInt())
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return balances, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
 func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error) {
 	n := hexutil.Uint64(0)
@@ -222,3 +298,64 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 	n = hexutil.Uint64(nonce)
 	return &n, nil
 }
+
+// ValidatorAccount returns the addresses of the validator identified by its
+// bech32 consensus or operator address, or by its account hex address.
+func (b *Backend) ValidatorAccount(address string) (*rpctypes.ValidatorAccount, error) {
+	operator, err := b.validatorOperator(address)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.Staking.Validator(b.ctx, &stakingtypes.QueryValidatorRequest{
+		ValidatorAddr: operator.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validator := res.Validator
+	if err := validator.UnpackInterfaces(b.clientCtx.Codec); err != nil {
+		return nil, err
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.ValidatorAccount{
+		Address:          common.BytesToAddress(operator),
+		OperatorAddress:  operator.String(),
+		ConsensusAddress: sdk.ConsAddress(consAddr).String(),
+	}, nil
+}
+
+// validatorOperator returns the operator address of the validator identified by
+// its bech32 consensus or operator address, or by its account hex address.
+func (b *Backend) validatorOperator(address string) (sdk.ValAddress, error) {
+	if common.IsHexAddress(address) {
+		return sdk.ValAddress(common.HexToAddress(address).Bytes()), nil
+	}
+
+	if operator, err := sdk.ValAddressFromBech32(address); err == nil {
+		return operator, nil
+	}
+
+	if _, err := sdk.ConsAddressFromBech32(address); err != nil {
+		return nil, fmt.Errorf("invalid validator address %s, expected a consensus, operator or hex address", address)
+	}
+
+	res, err := b.queryClient.QueryClient.ValidatorAccount(b.ctx, &evmtypes.QueryValidatorAccountRequest{
+		ConsAddress: address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(res.AccountAddress)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ValAddress(accAddr), nil
+}
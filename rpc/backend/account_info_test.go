@@ -4,11 +4,18 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
 	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/evmos/ethermint/rpc/backend/mocks"
@@ -153,7 +160,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 				Balance:      (*hexutil.Big)(big.NewInt(0)),
 				CodeHash:     common.HexToHash(""),
 				Nonce:        0x0,
-				StorageHash:  common.Hash{},
+				StorageHash:  ethtypes.EmptyRootHash,
 				StorageProof: []rpctypes.StorageResult{
 					{
 						Key:   "0x0",
@@ -181,6 +188,71 @@ func (suite *BackendTestSuite) TestGetProof() {
 	}
 }
 
+func (suite *BackendTestSuite) TestAccountProofForHeight() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(4))
+	address1 := tests.GenerateAddress()
+	appHash := common.HexToHash("0x579917054e325746fda5c3ee431d73d26255bc4e10b51163862368629ae19739").Bytes()
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - app hash not committed yet",
+			func() {
+				suite.backend.ctx = rpctypes.ContextWithHeight(blockNr.Int64())
+
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, blockNr.Int64())
+			},
+			false,
+		},
+		{
+			"pass",
+			func() {
+				suite.backend.ctx = rpctypes.ContextWithHeight(blockNr.Int64())
+
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				commitBlock := types.MakeBlock(blockNr.Int64()+1, []types.Tx{}, nil, nil)
+				commitBlock.AppHash = appHash
+				client.On("Block", suite.backend.ctx, mock.MatchedBy(func(height *int64) bool {
+					return *height == blockNr.Int64()+1
+				})).Return(&tmrpctypes.ResultBlock{Block: commitBlock}, nil)
+				RegisterBlock(client, blockNr.Int64(), nil)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccount(queryClient, address1, blockNr.Int64())
+				RegisterABCIQueryWithOptions(
+					client,
+					blockNr.Int64(),
+					"store/acc/key",
+					authtypes.AddressStoreKey(sdk.AccAddress(address1.Bytes())),
+					tmrpcclient.ABCIQueryOptions{Height: blockNr.Int64(), Prove: true},
+				)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			bundle, err := suite.backend.AccountProofForHeight(address1, []string{}, blockNr)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(address1, bundle.Address)
+				suite.Require().Equal(hexutil.Uint64(blockNr), bundle.Height)
+				suite.Require().Equal(hexutil.Uint64(blockNr+1), bundle.CommitHeight)
+				suite.Require().Equal(hexutil.Bytes(appHash), bundle.AppHash)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetStorageAt() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
@@ -347,6 +419,68 @@ func (suite *BackendTestSuite) TestGetBalance() {
 	}
 }
 
+func (suite *BackendTestSuite) TestBalances() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr := tests.GenerateAddress()
+	balances := sdk.NewCoins(sdk.NewInt64Coin("aphoton", 10), sdk.NewInt64Coin("ufee", 3))
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+		expBalances  map[string]*hexutil.Big
+	}{
+		{
+			"fail - tendermint client failed to get block",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - bank query client failed to get the balances",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+				bankClient := suite.backend.queryClient.Bank.(*mocks.BankQueryClient)
+				RegisterAllBalancesError(bankClient, addr, 1)
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - balances of all the pages",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+				bankClient := suite.backend.queryClient.Bank.(*mocks.BankQueryClient)
+				RegisterAllBalances(bankClient, addr, 1, balances)
+			},
+			true,
+			map[string]*hexutil.Big{
+				"aphoton": (*hexutil.Big)(big.NewInt(10)),
+				"ufee":    (*hexutil.Big)(big.NewInt(3)),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			res, err := suite.backend.Balances(addr, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBalances, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetTransactionCount() {
 	testCases := []struct {
 		name         string
@@ -423,3 +557,96 @@ func (suite *BackendTestSuite) TestGetTransactionCount() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestValidatorAccount() {
+	accAddr := tests.GenerateAddress()
+	operator := sdk.ValAddress(accAddr.Bytes())
+	pubKey := ed25519.GenPrivKey().PubKey()
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	validator, err := stakingtypes.NewValidator(operator, pubKey, stakingtypes.Description{})
+	suite.Require().NoError(err)
+
+	expAccount := &rpctypes.ValidatorAccount{
+		Address:          accAddr,
+		OperatorAddress:  operator.String(),
+		ConsensusAddress: consAddr.String(),
+	}
+
+	testCases := []struct {
+		name         string
+		address      string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - invalid address",
+			"invalid",
+			func() {},
+			false,
+		},
+		{
+			"fail - validator not found",
+			accAddr.Hex(),
+			func() {
+				stakingClient := suite.backend.queryClient.Staking.(*mocks.StakingQueryClient)
+				RegisterStakingValidatorError(stakingClient, operator)
+			},
+			false,
+		},
+		{
+			"fail - consensus address not found",
+			consAddr.String(),
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				queryClient.On("ValidatorAccount", rpctypes.ContextWithHeight(1), &evmtypes.QueryValidatorAccountRequest{ConsAddress: consAddr.String()}).
+					Return(nil, sdkerrors.ErrNotFound)
+			},
+			false,
+		},
+		{
+			"pass - hex address",
+			accAddr.Hex(),
+			func() {
+				stakingClient := suite.backend.queryClient.Staking.(*mocks.StakingQueryClient)
+				RegisterStakingValidator(stakingClient, validator)
+			},
+			true,
+		},
+		{
+			"pass - operator address",
+			operator.String(),
+			func() {
+				stakingClient := suite.backend.queryClient.Staking.(*mocks.StakingQueryClient)
+				RegisterStakingValidator(stakingClient, validator)
+			},
+			true,
+		},
+		{
+			"pass - consensus address",
+			consAddr.String(),
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				queryClient.On("ValidatorAccount", rpctypes.ContextWithHeight(1), &evmtypes.QueryValidatorAccountRequest{ConsAddress: consAddr.String()}).
+					Return(&evmtypes.QueryValidatorAccountResponse{AccountAddress: sdk.AccAddress(accAddr.Bytes()).String()}, nil)
+				stakingClient := suite.backend.queryClient.Staking.(*mocks.StakingQueryClient)
+				RegisterStakingValidator(stakingClient, validator)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			account, err := suite.backend.ValidatorAccount(tc.address)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAccount, account)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
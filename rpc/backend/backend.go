@@ -64,17 +64,26 @@ type EVMBackend interface {
 	SetGasPrice(gasPrice hexutil.Big) bool
 	ImportRawKey(privkey, password string) (common.Address, error)
 	ListAccounts() ([]common.Address, error)
+	KeyringAccounts() ([]rpctypes.KeyringAccount, error)
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
 	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
+	RPCEstimateGasMultiplier() float64
 	RPCMinGasPrice() int64
+	MinGasPriceMultiplier() sdk.Dec
+	RPCFilterCap() int32
+	RPCLogsCap() int32
+	RPCBlockRangeCap() int32
+	RPCConfirmationDepth() uint64
+	RPCLimits() rpctypes.RPCLimits
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
+	SendPendingTransaction(requestID string) (common.Hash, error)
 
 	// Blocks Info
 	BlockNumber() (hexutil.Uint64, error)
@@ -85,6 +94,8 @@ type EVMBackend interface {
 	TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error)
 	TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error)
 	TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error)
+	TendermintMetadata(height int64) (*rpctypes.TendermintMetadata, error)
+	TxFinality(height int64) (*rpctypes.TxFinality, error)
 	BlockNumberFromTendermint(blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error)
 	BlockNumberFromTendermintByHash(blockHash common.Hash) (*big.Int, error)
 	EthMsgsFromTendermintBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
@@ -98,9 +109,12 @@ type EVMBackend interface {
 	// Account Info
 	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
+	Balances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (map[string]*hexutil.Big, error)
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
+	AccountProofForHeight(address common.Address, storageKeys []string, blockNum rpctypes.BlockNumber) (*rpctypes.AccountProofBundle, error)
 	GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
+	ValidatorAccount(address string) (*rpctypes.ValidatorAccount, error)
 
 	// Chain Info
 	ChainID() (*hexutil.Big, error)
@@ -112,6 +126,7 @@ type EVMBackend interface {
 	GetCoinbase() (sdk.AccAddress, error)
 	FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
+	ChainStats(fromBlock, toBlock rpctypes.BlockNumber) (*rpctypes.ChainStats, error)
 
 	// Tx Info
 	GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error)
@@ -126,8 +141,13 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGasDetailed(ctx context.Context, args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (*rpctypes.GasEstimate, error)
+	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (*evmtypes.MsgEthereumTxResponse, error)
+	DryRunTransaction(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (*rpctypes.DryRunResult, error)
+	SimulateBundle(calls []evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) ([]*rpctypes.BundleCallResult, error)
+	CallBatch(calls []evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) ([]*rpctypes.CallBatchResult, error)
+	EstimateGasBulk(calls []evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) ([]*rpctypes.GasEstimateBulkResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
@@ -136,12 +156,28 @@ type EVMBackend interface {
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
-	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
-	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceTransaction(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
+	TraceBlock(ctx context.Context, height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.TraceCallConfig) (interface{}, error)
+	MethodSignature(input []byte) (string, bool)
 }
 
 var _ BackendI = (*Backend)(nil)
 
+// FeeHistoryIndexer is implemented by the indexers persisting the fee data of
+// the blocks backing `eth_feeHistory`.
+type FeeHistoryIndexer interface {
+	GetBlockFees(height int64) (*rpctypes.BlockFees, error)
+	SetBlockFees(height int64, fees *rpctypes.BlockFees) error
+	PruneBlockFees(height int64) error
+}
+
+// ChainStatsIndexer is implemented by the indexers aggregating the statistics of
+// the ethereum txs of the blocks backing `ethermint_getChainStats`.
+type ChainStatsIndexer interface {
+	IterateBlockStats(from, to int64, cb func(height int64, stats *evmtypes.BlockStats) (stop bool)) error
+}
+
 var bAttributeKeyEthereumBloom = []byte(evmtypes.AttributeKeyEthereumBloom)
 
 // Backend implements the BackendI interface
@@ -154,6 +190,9 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             ethermint.EVMTxIndexer
+	signatures          *rpctypes.SignatureDB
+	nonceGapQueue       *nonceGapQueue
+	blockCache          *blockCache
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -174,6 +213,14 @@ func NewBackend(
 		panic(err)
 	}
 
+	var signatures *rpctypes.SignatureDB
+	if appConf.JSONRPC.DecodeSignatures {
+		signatures, err = rpctypes.LoadSignatureDB(appConf.JSONRPC.FourByteDBPath)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	return &Backend{
 		ctx:                 context.Background(),
 		clientCtx:           clientCtx,
@@ -183,5 +230,8 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		signatures:          signatures,
+		nonceGapQueue:       newNonceGapQueue(appConf.JSONRPC.NonceGapTolerance),
+		blockCache:          newBlockCache(appConf.JSONRPC.BlockCacheSize),
 	}
 }
@@ -83,6 +83,8 @@ func (suite *BackendTestSuite) SetupTest() {
 	suite.backend.queryClient.QueryClient = mocks.NewEVMQueryClient(suite.T())
 	suite.backend.clientCtx.Client = mocks.NewClient(suite.T())
 	suite.backend.queryClient.FeeMarket = mocks.NewFeeMarketQueryClient(suite.T())
+	suite.backend.queryClient.Staking = mocks.NewStakingQueryClient(suite.T())
+	suite.backend.queryClient.Bank = mocks.NewBankQueryClient(suite.T())
 	suite.backend.ctx = rpctypes.ContextWithHeight(1)
 
 	// Add codec
@@ -0,0 +1,39 @@
+package backend
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpc "github.com/evmos/ethermint/rpc/types"
+)
+
+var _ banktypes.QueryClient = &mocks.BankQueryClient{}
+
+// AllBalances, returning the balances in pages of one coin
+func RegisterAllBalances(bankClient *mocks.BankQueryClient, addr common.Address, height int64, balances sdk.Coins) {
+	key := []byte(nil)
+	for i, coin := range balances {
+		var nextKey []byte
+		if i < len(balances)-1 {
+			nextKey = []byte(balances[i+1].Denom)
+		}
+		bankClient.On("AllBalances", rpc.ContextWithHeight(height), &banktypes.QueryAllBalancesRequest{
+			Address:    sdk.AccAddress(addr.Bytes()).String(),
+			Pagination: &query.PageRequest{Key: key},
+		}).Return(&banktypes.QueryAllBalancesResponse{
+			Balances:   sdk.Coins{coin},
+			Pagination: &query.PageResponse{NextKey: nextKey},
+		}, nil).Once()
+		key = nextKey
+	}
+}
+
+func RegisterAllBalancesError(bankClient *mocks.BankQueryClient, addr common.Address, height int64) {
+	bankClient.On("AllBalances", rpc.ContextWithHeight(height), &banktypes.QueryAllBalancesRequest{
+		Address:    sdk.AccAddress(addr.Bytes()).String(),
+		Pagination: &query.PageRequest{},
+	}).Return(nil, sdkerrors.ErrInvalidRequest)
+}
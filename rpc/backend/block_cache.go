@@ -0,0 +1,157 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// cachedBlock is the Ethereum formatted block of a height, with the transaction hashes or objects.
+type cachedBlock struct {
+	hash   common.Hash
+	blocks [2]map[string]interface{} // indexed by fullTx
+}
+
+// blockCache keeps the Ethereum formatted blocks of the most recent heights, so that the polling
+// clients are served without fetching and assembling the same blocks again. The cache follows the
+// latest committed height: the blocks out of the window are evicted, and the blocks above the latest
+// height are dropped if the chain is rolled back. A nil cache is disabled.
+type blockCache struct {
+	mu     sync.Mutex
+	size   int64
+	latest int64
+	blocks map[int64]*cachedBlock
+	hashes map[common.Hash]int64
+}
+
+// newBlockCache returns a cache of the blocks of the size most recent heights, nil if size is 0.
+func newBlockCache(size int) *blockCache {
+	if size <= 0 {
+		return nil
+	}
+	return &blockCache{
+		size:   int64(size),
+		blocks: make(map[int64]*cachedBlock),
+		hashes: make(map[common.Hash]int64),
+	}
+}
+
+// Commit records the latest committed height, evicting the blocks out of the cache window.
+func (c *blockCache) Commit(height int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.commit(height)
+}
+
+func (c *blockCache) commit(height int64) {
+	if height == c.latest {
+		return
+	}
+	c.latest = height
+	for h, block := range c.blocks {
+		if h > height || h <= height-c.size {
+			delete(c.hashes, block.hash)
+			delete(c.blocks, h)
+		}
+	}
+}
+
+// Get returns a copy of the cached block of the height.
+func (c *blockCache) Get(height int64, fullTx bool) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.get(height, fullTx)
+}
+
+// GetByHash returns a copy of the cached block of the hash.
+func (c *blockCache) GetByHash(hash common.Hash, fullTx bool) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	height, ok := c.hashes[hash]
+	if !ok {
+		telemetry.IncrCounter(1, "json_rpc", "block_cache", "miss")
+		return nil, false
+	}
+	return c.get(height, fullTx)
+}
+
+func (c *blockCache) get(height int64, fullTx bool) (map[string]interface{}, bool) {
+	cached, ok := c.blocks[height]
+	if !ok || cached.blocks[fullTxIndex(fullTx)] == nil {
+		telemetry.IncrCounter(1, "json_rpc", "block_cache", "miss")
+		return nil, false
+	}
+	telemetry.IncrCounter(1, "json_rpc", "block_cache", "hit")
+	return copyBlock(cached.blocks[fullTxIndex(fullTx)]), true
+}
+
+// Add caches a copy of the block of the height and hash if the height is in the cache window, the
+// block existing means the latest height is at least its height.
+func (c *blockCache) Add(height int64, hash common.Hash, fullTx bool, block map[string]interface{}) {
+	if c == nil || block == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height > c.latest {
+		c.commit(height)
+	}
+	if height <= c.latest-c.size {
+		return
+	}
+
+	cached, ok := c.blocks[height]
+	if !ok || cached.hash != hash {
+		if ok {
+			delete(c.hashes, cached.hash)
+		}
+		cached = &cachedBlock{hash: hash}
+		c.blocks[height] = cached
+		c.hashes[hash] = height
+	}
+	cached.blocks[fullTxIndex(fullTx)] = copyBlock(block)
+}
+
+func fullTxIndex(fullTx bool) int {
+	if fullTx {
+		return 1
+	}
+	return 0
+}
+
+// copyBlock returns a shallow copy of the block, so that the fields added by the callers aren't
+// cached.
+func copyBlock(block map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(block))
+	for k, v := range block {
+		res[k] = v
+	}
+	return res
+}
@@ -0,0 +1,87 @@
+package backend
+
+import (
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	ethrpc "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/tests"
+)
+
+func (suite *BackendTestSuite) TestBlockCache() {
+	suite.Require().Nil(newBlockCache(0))
+	// the disabled cache is a noop
+	var disabled *blockCache
+	disabled.Commit(1)
+	disabled.Add(1, common.HexToHash("0x1"), true, map[string]interface{}{})
+	_, ok := disabled.Get(1, true)
+	suite.Require().False(ok)
+
+	c := newBlockCache(2)
+	block := func(height int64) map[string]interface{} {
+		return map[string]interface{}{"number": height}
+	}
+	hash := func(height int64) common.Hash {
+		return common.BigToHash(types.NewInt(height).BigInt())
+	}
+
+	c.Add(1, hash(1), true, block(1))
+	c.Add(2, hash(2), true, block(2))
+	res, ok := c.Get(1, true)
+	suite.Require().True(ok)
+	suite.Require().Equal(block(1), res)
+	_, ok = c.Get(1, false)
+	suite.Require().False(ok, "the blocks with tx hashes aren't cached")
+
+	// the copies returned aren't cached
+	res["tendermint"] = true
+	res, _ = c.Get(1, true)
+	suite.Require().Equal(block(1), res)
+
+	// the new commit evicts the blocks out of the window
+	c.Commit(3)
+	_, ok = c.Get(1, true)
+	suite.Require().False(ok)
+	_, ok = c.GetByHash(hash(1), true)
+	suite.Require().False(ok)
+	res, ok = c.GetByHash(hash(2), true)
+	suite.Require().True(ok)
+	suite.Require().Equal(block(2), res)
+	c.Add(1, hash(1), true, block(1))
+	_, ok = c.Get(1, true)
+	suite.Require().False(ok, "the blocks out of the window aren't cached")
+
+	// the rollback drops the blocks above the latest height
+	c.Add(3, hash(3), true, block(3))
+	c.Commit(2)
+	_, ok = c.Get(3, true)
+	suite.Require().False(ok)
+	_, ok = c.Get(2, true)
+	suite.Require().True(ok)
+}
+
+func (suite *BackendTestSuite) TestGetBlockByNumberCached() {
+	suite.backend.blockCache = newBlockCache(4)
+	height := int64(1)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	resBlock, _ := RegisterBlock(client, height, nil)
+	RegisterBlockResults(client, height)
+	RegisterConsensusParams(client, height)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBaseFee(queryClient, types.NewInt(1))
+	RegisterValidatorAccount(queryClient, types.AccAddress(tests.GenerateAddress().Bytes()))
+
+	block, err := suite.backend.GetBlockByNumber(ethrpc.BlockNumber(height), true)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(block)
+
+	cached, err := suite.backend.GetBlockByNumber(ethrpc.BlockNumber(height), true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(block, cached)
+	cached, err = suite.backend.GetBlockByHash(common.BytesToHash(resBlock.Block.Hash()), true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(block, cached)
+	client.AssertNumberOfCalls(suite.T(), "Block", 1)
+	client.AssertNumberOfCalls(suite.T(), "BlockResults", 1)
+}
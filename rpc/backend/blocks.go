@@ -31,6 +31,7 @@ import (
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/pkg/errors"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -57,21 +58,35 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 		return 0, fmt.Errorf("failed to parse block height: %w", err)
 	}
 
+	b.blockCache.Commit(int64(height))
 	return hexutil.Uint64(height), nil
 }
 
 // GetBlockByNumber returns the JSON-RPC compatible Ethereum block identified by
 // block number. Depending on fullTx it either returns the full transaction
-// objects or if false only the hashes of the transactions.
+// objects or if false only the hashes of the transactions. The blocks of the
+// recent heights are served from the block cache.
 func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
-	resBlock, err := b.TendermintBlockByNumber(blockNum)
-	if err != nil {
-		return nil, nil
+	if b.blockCache != nil && blockNum.Int64() <= 0 {
+		// resolve the latest height once, for both the cache and the tendermint block
+		if n, err := b.BlockNumber(); err == nil {
+			blockNum = rpctypes.BlockNumber(n)
+		}
+	}
+	if cached, ok := b.blockCache.Get(blockNum.Int64(), fullTx); ok {
+		return cached, nil
 	}
 
-	// return if requested block height is greater than the current one
-	if resBlock == nil || resBlock.Block == nil {
-		return nil, nil
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		// the blocks produced under a previous chain-id aren't available to the current node, they're
+		// served by the archive of their epoch if configured.
+		archived, archiveErr := b.archiveBlockByNumber(blockNum, fullTx)
+		if archiveErr != nil {
+			b.logger.Debug("failed to fetch block from the epoch archive", "height", blockNum, "error", archiveErr.Error())
+		}
+		// return nil if requested block height is greater than the current one
+		return archived, nil
 	}
 
 	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
@@ -86,12 +101,17 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 		return nil, err
 	}
 
+	b.blockCache.Add(resBlock.Block.Height, common.BytesToHash(resBlock.Block.Hash()), fullTx, res)
 	return res, nil
 }
 
 // GetBlockByHash returns the JSON-RPC compatible Ethereum block identified by
 // hash.
 func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	if cached, ok := b.blockCache.GetByHash(hash, fullTx); ok {
+		return cached, nil
+	}
+
 	resBlock, err := b.TendermintBlockByHash(hash)
 	if err != nil {
 		return nil, err
@@ -114,6 +134,7 @@ func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]inte
 		return nil, err
 	}
 
+	b.blockCache.Add(resBlock.Block.Height, hash, fullTx, res)
 	return res, nil
 }
 
@@ -190,6 +211,97 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 	return resBlock, nil
 }
 
+// TendermintMetadata returns the consensus details of the block at the given
+// height, added to the Ethereum formatted blocks on request.
+func (b *Backend) TendermintMetadata(height int64) (*rpctypes.TendermintMetadata, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, fmt.Errorf("block not found for height %d", height)
+	}
+
+	commit, err := b.clientCtx.Client.Commit(b.ctx, &height)
+	if err != nil {
+		b.logger.Debug("tendermint client failed to get commit", "height", height, "error", err.Error())
+		return nil, err
+	}
+
+	block := resBlock.Block
+	return &rpctypes.TendermintMetadata{
+		ProposerAddress: sdk.ConsAddress(block.ProposerAddress).String(),
+		Round:           hexutil.Uint64(commit.Commit.Round),
+		EvidenceCount:   hexutil.Uint64(len(block.Evidence.Evidence)),
+		AppHash:         hexutil.Bytes(block.AppHash),
+	}, nil
+}
+
+// TxFinality returns the Tendermint finality of the block at the given height,
+// added to the receipts of its transactions on request. The voting power of the
+// precommits is computed from the commit of the block and its validator set.
+func (b *Backend) TxFinality(height int64) (*rpctypes.TxFinality, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, fmt.Errorf("block not found for height %d", height)
+	}
+
+	commit, err := b.clientCtx.Client.Commit(b.ctx, &height)
+	if err != nil {
+		b.logger.Debug("tendermint client failed to get commit", "height", height, "error", err.Error())
+		return nil, err
+	}
+
+	validators, err := b.validatorSet(height)
+	if err != nil {
+		return nil, err
+	}
+
+	powers := make(map[string]int64, len(validators))
+	var totalPower int64
+	for _, val := range validators {
+		powers[val.Address.String()] = val.VotingPower
+		totalPower += val.VotingPower
+	}
+
+	var signedPower int64
+	for _, sig := range commit.Commit.Signatures {
+		if sig.ForBlock() {
+			signedPower += powers[sig.ValidatorAddress.String()]
+		}
+	}
+
+	return &rpctypes.TxFinality{
+		Round:       hexutil.Uint64(commit.Commit.Round),
+		Timestamp:   hexutil.Uint64(resBlock.Block.Time.Unix()),
+		SignedPower: hexutil.Uint64(signedPower),
+		TotalPower:  hexutil.Uint64(totalPower),
+		Final:       totalPower > 0 && signedPower*3 > totalPower*2,
+	}, nil
+}
+
+// validatorSet returns the validators of the block at the given height, fetching
+// all the pages of the Tendermint query.
+func (b *Backend) validatorSet(height int64) ([]*tmtypes.Validator, error) {
+	var validators []*tmtypes.Validator
+	perPage := 100
+	for page := 1; ; page++ {
+		res, err := b.clientCtx.Client.Validators(b.ctx, &height, &page, &perPage)
+		if err != nil {
+			b.logger.Debug("tendermint client failed to get validators", "height", height, "error", err.Error())
+			return nil, err
+		}
+
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			return validators, nil
+		}
+	}
+}
+
 // TendermintBlockResultByNumber returns a Tendermint-formatted block result
 // by block number
 func (b *Backend) TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error) {
@@ -357,9 +469,29 @@ func (b *Backend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.
 			}
 		}
 	}
+	// the bloom of a block without ethereum transactions is empty, even if the event is missing,
+	// e.g. for the blocks produced before the evm module was added
+	if !hasEthereumTxs(blockRes) {
+		return ethtypes.Bloom{}, nil
+	}
 	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
 }
 
+// hasEthereumTxs returns true if the block results contain the events of an ethereum transaction.
+func hasEthereumTxs(blockRes *tmrpctypes.ResultBlockResults) bool {
+	for _, txRes := range blockRes.TxsResults {
+		if txRes == nil {
+			continue
+		}
+		for _, event := range txRes.Events {
+			if event.Type == evmtypes.EventTypeEthereumTx {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // RPCBlockFromTendermintBlock returns a JSON-RPC compatible Ethereum block from a
 // given Tendermint block and its block result.
 func (b *Backend) RPCBlockFromTendermintBlock(
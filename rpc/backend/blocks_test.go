@@ -3,13 +3,18 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"net/http/httptest"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/mock"
 	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/metadata"
@@ -221,6 +226,81 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 	}
 }
 
+// epochArchive serves the blocks of a previous chain epoch
+type epochArchive struct{}
+
+func (epochArchive) GetBlockByNumber(number hexutil.Uint64, fullTx bool) map[string]interface{} {
+	return map[string]interface{}{"number": number.String(), "fullTx": fullTx}
+}
+
+func (suite *BackendTestSuite) TestGetBlockByNumberEpochArchive() {
+	server := gethrpc.NewServer()
+	suite.Require().NoError(server.RegisterName("eth", epochArchive{}))
+	archive := httptest.NewServer(server)
+	defer archive.Close()
+	defer server.Stop()
+
+	epochs := []evmtypes.ChainEpoch{
+		{ChainID: "ethermint_9000-0", StartHeight: 1, EndHeight: 99},
+		{ChainID: ChainID, StartHeight: 100},
+	}
+
+	testCases := []struct {
+		name         string
+		blockNumber  ethrpc.BlockNumber
+		archives     []string
+		registerMock func(ethrpc.BlockNumber)
+		expBlock     map[string]interface{}
+	}{
+		{
+			"pass - no archive configured",
+			ethrpc.BlockNumber(5),
+			nil,
+			func(blockNum ethrpc.BlockNumber) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, blockNum.Int64())
+			},
+			nil,
+		},
+		{
+			"pass - block of the current epoch not found",
+			ethrpc.BlockNumber(150),
+			[]string{"ethermint_9000-0=" + archive.URL},
+			func(blockNum ethrpc.BlockNumber) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockError(client, blockNum.Int64())
+				RegisterChainEpochs(queryClient, epochs)
+			},
+			nil,
+		},
+		{
+			"pass - block forwarded to the archive of its epoch",
+			ethrpc.BlockNumber(5),
+			[]string{"ethermint_9000-0=" + archive.URL},
+			func(blockNum ethrpc.BlockNumber) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlockError(client, blockNum.Int64())
+				RegisterChainEpochs(queryClient, epochs)
+			},
+			map[string]interface{}{"number": "0x5", "fullTx": true},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.EpochArchives = tc.archives
+			suite.backend.ctx = ethrpc.ContextWithHeight(tc.blockNumber.Int64())
+			tc.registerMock(tc.blockNumber)
+
+			block, err := suite.backend.GetBlockByNumber(tc.blockNumber, true)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBlock, block)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetBlockByHash() {
 	var (
 		blockRes *tmrpctypes.ResultBlockResults
@@ -622,6 +702,179 @@ func (suite *BackendTestSuite) TestTendermintBlockByNumber() {
 	}
 }
 
+func (suite *BackendTestSuite) TestTendermintMetadata() {
+	height := int64(1)
+	proposer := sdk.ConsAddress(tests.GenerateAddress().Bytes())
+	appHash := common.BytesToHash([]byte("app hash")).Bytes()
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expMetadata  *ethrpc.TendermintMetadata
+		expPass      bool
+	}{
+		{
+			"fail - block error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, height)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockNotFound(client, height)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - commit error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, height, nil)
+				RegisterCommitError(client, height)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				resBlock, _ := RegisterBlock(client, height, nil)
+				resBlock.Block.ProposerAddress = proposer.Bytes()
+				resBlock.Block.AppHash = appHash
+				RegisterCommit(client, height, 2)
+			},
+			&ethrpc.TendermintMetadata{
+				ProposerAddress: proposer.String(),
+				Round:           2,
+				EvidenceCount:   0,
+				AppHash:         appHash,
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+
+			tc.registerMock()
+			metadata, err := suite.backend.TendermintMetadata(height)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expMetadata, metadata)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTxFinality() {
+	height := int64(1)
+	validators := make([]*tmtypes.Validator, 3)
+	for i := range validators {
+		validators[i] = tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10)
+	}
+
+	registerCommit := func(client *mocks.Client, signers int) {
+		sigs := make([]tmtypes.CommitSig, len(validators))
+		for i, val := range validators {
+			sigs[i] = tmtypes.NewCommitSigAbsent()
+			if i < signers {
+				sigs[i] = tmtypes.CommitSig{BlockIDFlag: tmtypes.BlockIDFlagCommit, ValidatorAddress: val.Address}
+			}
+		}
+		res := &tmrpctypes.ResultCommit{
+			SignedHeader: tmtypes.SignedHeader{Commit: &tmtypes.Commit{Height: height, Round: 1, Signatures: sigs}},
+		}
+		client.On("Commit", ethrpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).Return(res, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expFinality  *ethrpc.TxFinality
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockNotFound(client, height)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - commit error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, height, nil)
+				RegisterCommitError(client, height)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - validators error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, height, nil)
+				registerCommit(client, 3)
+				RegisterValidatorsError(client, height)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - 2/3 of the voting power isn't final",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				resBlock, _ := RegisterBlock(client, height, nil)
+				resBlock.Block.Time = time.Unix(1000, 0)
+				registerCommit(client, 2)
+				RegisterValidators(client, height, validators)
+			},
+			&ethrpc.TxFinality{Round: 1, Timestamp: 1000, SignedPower: 20, TotalPower: 30, Final: false},
+			true,
+		},
+		{
+			"pass - final",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				resBlock, _ := RegisterBlock(client, height, nil)
+				resBlock.Block.Time = time.Unix(1000, 0)
+				registerCommit(client, 3)
+				RegisterValidators(client, height, validators)
+			},
+			&ethrpc.TxFinality{Round: 1, Timestamp: 1000, SignedPower: 30, TotalPower: 30, Final: true},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+
+			tc.registerMock()
+			finality, err := suite.backend.TxFinality(height)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFinality, finality)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestTendermintBlockResultByNumber() {
 	var expBlockRes *tmrpctypes.ResultBlockResults
 
@@ -815,14 +1068,23 @@ func (suite *BackendTestSuite) TestBlockBloom() {
 		expPass       bool
 	}{
 		{
-			"fail - empty block result",
+			"pass - empty block result, no ethereum txs",
 			&tmrpctypes.ResultBlockResults{},
 			ethtypes.Bloom{},
+			true,
+		},
+		{
+			"fail - block bloom event missing with ethereum txs",
+			&tmrpctypes.ResultBlockResults{
+				TxsResults: []*types.ResponseDeliverTx{{Events: []types.Event{{Type: evmtypes.EventTypeEthereumTx}}}},
+			},
+			ethtypes.Bloom{},
 			false,
 		},
 		{
 			"fail - non block bloom event type",
 			&tmrpctypes.ResultBlockResults{
+				TxsResults:     []*types.ResponseDeliverTx{{Events: []types.Event{{Type: evmtypes.EventTypeEthereumTx}}}},
 				EndBlockEvents: []types.Event{{Type: evmtypes.EventTypeEthereumTx}},
 			},
 			ethtypes.Bloom{},
@@ -831,6 +1093,7 @@ func (suite *BackendTestSuite) TestBlockBloom() {
 		{
 			"fail - nonblock bloom attribute key",
 			&tmrpctypes.ResultBlockResults{
+				TxsResults: []*types.ResponseDeliverTx{{Events: []types.Event{{Type: evmtypes.EventTypeEthereumTx}}}},
 				EndBlockEvents: []types.Event{
 					{
 						Type: evmtypes.EventTypeBlockBloom,
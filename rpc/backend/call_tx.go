@@ -20,13 +20,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
+	"sort"
+	"sync"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
@@ -37,6 +43,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// estimateGasBulkConcurrency is the max number of EstimateGasBulk queries of a bulk estimation
+// executed concurrently.
+const estimateGasBulkConcurrency = 4
+
 // Resend accepts an existing transaction and a new gas price and limit. It will remove
 // the given transaction from the pool and reinsert it with the new gas price and limit.
 func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error) {
@@ -148,32 +158,98 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
-	cosmosTx, err := ethereumTx.BuildTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
+	// Encode transaction by default Tx encoder
+	txBytes, err := ethereumTx.BuildTxBytes(b.clientCtx.TxConfig, res.Params.EvmDenom)
 	if err != nil {
 		b.logger.Error("failed to build cosmos tx", "error", err.Error())
 		return common.Hash{}, err
 	}
 
-	// Encode transaction by default Tx encoder
-	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(cosmosTx)
-	if err != nil {
-		b.logger.Error("failed to encode eth tx using default encoder", "error", err.Error())
-		return common.Hash{}, err
+	txHash := ethereumTx.AsTransaction().Hash()
+
+	if err := b.broadcastTxSync(txBytes); err != nil {
+		if b.nonceGapQueue != nil && errorsmod.IsOf(err, errortypes.ErrInvalidSequence) && b.queueFutureTx(tx, txHash, txBytes) {
+			b.logger.Debug("queued future nonce tx", "hash", txHash.Hex(), "nonce", tx.Nonce())
+			return txHash, nil
+		}
+		b.logger.Error("failed to broadcast tx", "error", err.Error())
+		return txHash, b.translateTxError(tx, err)
 	}
 
-	txHash := ethereumTx.AsTransaction().Hash()
+	if b.nonceGapQueue != nil {
+		b.promoteQueuedTxs(tx)
+	}
+
+	return txHash, nil
+}
 
+// broadcastTxSync broadcasts the tx bytes, returning the CheckTx error if any.
+func (b *Backend) broadcastTxSync(txBytes []byte) error {
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
 	if rsp != nil && rsp.Code != 0 {
 		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
 	}
+	return err
+}
+
+// translateTxError translates a broadcast error to the go-ethereum error the wallets expect. A
+// nonce lower than the pending nonce of the sender but not than its committed nonce is the one of a
+// mempool transaction, which can't be replaced.
+func (b *Backend) translateTxError(tx *ethtypes.Transaction, err error) error {
+	err = rpctypes.TranslateTxError(err)
+	var txErr *rpctypes.TxError
+	if !errors.As(err, &txErr) || txErr.Reason != core.ErrNonceTooLow {
+		return err
+	}
+
+	sender, senderErr := ethtypes.Sender(evmtypes.TxSigner(tx), tx)
+	if senderErr != nil {
+		return err
+	}
+	nonce, nonceErr := b.getAccountNonce(sender, false, 0, b.logger)
+	if nonceErr != nil || tx.Nonce() < nonce {
+		return err
+	}
+	return &rpctypes.TxError{Reason: core.ErrReplaceUnderpriced, Err: txErr.Err}
+}
+
+// queueFutureTx holds the transaction rejected for its nonce in the local queue if the nonce is
+// within the gap tolerance above the pending nonce of the sender.
+func (b *Backend) queueFutureTx(tx *ethtypes.Transaction, txHash common.Hash, txBytes []byte) bool {
+	sender, err := ethtypes.Sender(evmtypes.TxSigner(tx), tx)
 	if err != nil {
-		b.logger.Error("failed to broadcast tx", "error", err.Error())
-		return txHash, err
+		return false
 	}
+	pendingNonce, err := b.getAccountNonce(sender, true, 0, b.logger)
+	if err != nil {
+		b.logger.Debug("failed to get the pending nonce", "address", sender.Hex(), "error", err.Error())
+		return false
+	}
+	return b.nonceGapQueue.add(sender, tx.Nonce(), pendingNonce, queuedTx{
+		hash:    txHash,
+		txBytes: txBytes,
+		added:   time.Now(),
+	})
+}
 
-	return txHash, nil
+// promoteQueuedTxs broadcasts the queued transactions of the sender of the given transaction that
+// follow its nonce, until the next nonce gap.
+func (b *Backend) promoteQueuedTxs(tx *ethtypes.Transaction) {
+	sender, err := ethtypes.Sender(evmtypes.TxSigner(tx), tx)
+	if err != nil {
+		return
+	}
+	for nonce := tx.Nonce() + 1; ; nonce++ {
+		queued, found := b.nonceGapQueue.pop(sender, nonce)
+		if !found {
+			return
+		}
+		if err := b.broadcastTxSync(queued.txBytes); err != nil {
+			b.logger.Error("failed to broadcast queued tx", "hash", queued.hash.Hex(), "error", err.Error())
+			return
+		}
+	}
 }
 
 // SetTxDefaults populates tx message with default values in case they are not
@@ -289,7 +365,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(context.Background(), callArgs, &blockNr)
 		if err != nil {
 			return args, err
 		}
@@ -305,7 +381,17 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (b *Backend) EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+	estimate, err := b.EstimateGasDetailed(ctx, args, blockNrOptional)
+	if err != nil {
+		return 0, err
+	}
+	return estimate.Gas, nil
+}
+
+// EstimateGasDetailed returns the raw gas estimation of the call, and the estimation adjusted by the
+// estimate gas multiplier of the node, capped by the RPC gas cap.
+func (b *Backend) EstimateGasDetailed(ctx context.Context, args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (*rpctypes.GasEstimate, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -313,13 +399,13 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 
 	bz, err := json.Marshal(&args)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
-		return 0, errors.New("header not found")
+		return nil, errors.New("header not found")
 	}
 
 	req := evmtypes.EthCallRequest{
@@ -332,17 +418,216 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	res, err := b.queryClient.EstimateGas(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	res, err := b.queryClient.EstimateGas(rpctypes.QueryContext(ctx, blockNr.Int64()), &req)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return hexutil.Uint64(res.Gas), nil
+
+	return b.newGasEstimate(res.Gas), nil
 }
 
-// DoCall performs a simulated call operation through the evmtypes. It returns the
+// newGasEstimate adjusts the raw gas estimation by the estimate gas multiplier of the node, capped
+// by the RPC gas cap.
+func (b *Backend) newGasEstimate(rawGas uint64) *rpctypes.GasEstimate {
+	multiplier := b.RPCEstimateGasMultiplier()
+	gas := rawGas
+	if adjusted := math.Ceil(float64(rawGas) * multiplier); adjusted > float64(gas) {
+		gas = uint64(adjusted)
+		// the buffer doesn't exceed the gas cap, the raw estimation being already capped
+		if gasCap := b.RPCGasCap(); gasCap > 0 && gas > gasCap {
+			gas = gasCap
+		}
+	}
+
+	return &rpctypes.GasEstimate{
+		Gas:        hexutil.Uint64(gas),
+		RawGas:     hexutil.Uint64(rawGas),
+		Multiplier: multiplier,
+	}
+}
+
+// EstimateGasBulk estimates the gas of the calls independently on the state of the given block, like
+// EstimateGasDetailed, and returns the estimation or the error eth_estimateGas would return for each
+// call. The calls are split in queries of up to MaxBundleCalls calls, each estimating its calls on a
+// single state branch sharing the state reads, and up to estimateGasBulkConcurrency queries are
+// executed concurrently.
+func (b *Backend) EstimateGasBulk(calls []evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) ([]*rpctypes.GasEstimateBulkResult, error) {
+	if len(calls) == 0 {
+		return []*rpctypes.GasEstimateBulkResult{}, nil
+	}
+
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	encoded := make([][]byte, len(calls))
+	for i := range calls {
+		if encoded[i], err = json.Marshal(&calls[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	var cancel context.CancelFunc
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	var (
+		results = make([]*rpctypes.GasEstimateBulkResult, len(calls))
+		slots   = make(chan struct{}, estimateGasBulkConcurrency)
+		wg      sync.WaitGroup
+		errOnce sync.Once
+		errRes  error
+	)
+	for start := 0; start < len(calls); start += evmtypes.MaxBundleCalls {
+		end := start + evmtypes.MaxBundleCalls
+		if end > len(calls) {
+			end = len(calls)
+		}
+
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(start, end int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			res, err := b.queryClient.EstimateGasBulk(ctx, &evmtypes.QueryEstimateGasBulkRequest{
+				Calls:           encoded[start:end],
+				GasCap:          b.RPCGasCap(),
+				ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+				ChainId:         b.chainID.Int64(),
+			})
+			if err == nil && len(res.Results) != end-start {
+				err = fmt.Errorf("got %d estimations for %d calls", len(res.Results), end-start)
+			}
+			if err != nil {
+				errOnce.Do(func() {
+					errRes = err
+					cancel()
+				})
+				return
+			}
+
+			for i, estimate := range res.Results {
+				results[start+i] = b.newGasEstimateBulkResult(estimate)
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	if errRes != nil {
+		return nil, errRes
+	}
+	return results, nil
+}
+
+// newGasEstimateBulkResult converts the estimation of a call, the revert errors holding the revert
+// data like the ones of eth_estimateGas.
+func (b *Backend) newGasEstimateBulkResult(estimate evmtypes.GasEstimateResult) *rpctypes.GasEstimateBulkResult {
+	switch {
+	case estimate.Error == "":
+		return &rpctypes.GasEstimateBulkResult{GasEstimate: b.newGasEstimate(estimate.Gas)}
+	case estimate.Reverted:
+		revertErr := evmtypes.NewExecErrorWithReason(estimate.RevertData)
+		return &rpctypes.GasEstimateBulkResult{Error: &rpctypes.CallBatchError{
+			Code:    revertErr.ErrorCode(),
+			Message: revertErr.Error(),
+			Data:    revertErr.ErrorData(),
+		}}
+	default:
+		return &rpctypes.GasEstimateBulkResult{Error: &rpctypes.CallBatchError{
+			Code:    rpctypes.DefaultErrorCode,
+			Message: estimate.Error,
+		}}
+	}
+}
+
+// pendingGasPrice returns the lowest effective gas price of the pending ethereum
+// transactions that fit in the next block, or nil if the mempool backlog fits in a
+// single block. Errors are logged and ignored, as the mempool only refines the
+// suggested gas price.
+func (b *Backend) pendingGasPrice(head *ethtypes.Header) *big.Int {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		b.logger.Debug("failed to get pending transactions", "error", err.Error())
+		return nil
+	}
+
+	type pendingTx struct {
+		gas   uint64
+		price *big.Int
+	}
+
+	var (
+		pending    []pendingTx
+		pendingGas uint64
+	)
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				continue
+			}
+
+			price := txData.GetGasPrice()
+			if head.BaseFee != nil {
+				price = txData.EffectiveGasPrice(head.BaseFee)
+			}
+			pending = append(pending, pendingTx{gas: txData.GetGas(), price: price})
+			pendingGas += txData.GetGas()
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(b.ctx, b.clientCtx, head.Number.Int64())
+	if err != nil {
+		b.logger.Debug("failed to get block gas limit", "error", err.Error())
+		return nil
+	}
+	if pendingGas <= uint64(gasLimit) {
+		return nil
+	}
+
+	// fill the next block with the best paying transactions
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].price.Cmp(pending[j].price) > 0
+	})
+
+	var (
+		blockGas uint64
+		price    *big.Int
+	)
+	for _, tx := range pending {
+		if blockGas+tx.gas > uint64(gasLimit) {
+			break
+		}
+		blockGas += tx.gas
+		price = tx.price
+	}
+
+	return price
+}
+
+// DoCall performs a simulated call operation through the evmtypes, with the
+// optional state overrides applied on top of the queried state. It returns the
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	ctx context.Context, args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -361,10 +646,16 @@ func (b *Backend) DoCall(
 		ChainId:         b.chainID.Int64(),
 	}
 
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	ctx = rpctypes.QueryContext(ctx, blockNr.Int64())
 	timeout := b.RPCEVMTimeout()
 
 	// Setup context so it may be canceled the call has completed
@@ -395,13 +686,191 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateBundle executes the calls in order on the state of the given block, each call seeing the
+// state changes of the previous ones. The reverted or failed calls don't stop the bundle, their
+// error is returned in their result.
+func (b *Backend) SimulateBundle(
+	calls []evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) ([]*rpctypes.BundleCallResult, error) {
+	if len(calls) == 0 {
+		return []*rpctypes.BundleCallResult{}, nil
+	}
+
+	res, err := b.simulateBundle(calls, blockNr, overrides, false)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*rpctypes.BundleCallResult, len(res.Results))
+	for i, callRes := range res.Results {
+		results[i] = newBundleCallResult(callRes)
+	}
+
+	return results, nil
+}
+
+// CallBatch executes the calls independently on the state of the given block, like a batch of
+// eth_call sharing the state reads, and returns the result or the error eth_call would return for
+// each call.
+func (b *Backend) CallBatch(calls []evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) ([]*rpctypes.CallBatchResult, error) {
+	if len(calls) == 0 {
+		return []*rpctypes.CallBatchResult{}, nil
+	}
+
+	res, err := b.simulateBundle(calls, blockNr, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*rpctypes.CallBatchResult, len(res.Results))
+	for i, callRes := range res.Results {
+		switch {
+		case !callRes.Failed():
+			results[i] = &rpctypes.CallBatchResult{Result: (*hexutil.Bytes)(&callRes.Ret)}
+		case callRes.VmError == vm.ErrExecutionReverted.Error():
+			revertErr := evmtypes.NewExecErrorWithReason(callRes.Ret)
+			results[i] = &rpctypes.CallBatchResult{Error: &rpctypes.CallBatchError{
+				Code:    revertErr.ErrorCode(),
+				Message: revertErr.Error(),
+				Data:    revertErr.ErrorData(),
+			}}
+		default:
+			results[i] = &rpctypes.CallBatchResult{Error: &rpctypes.CallBatchError{
+				Code:    rpctypes.DefaultErrorCode,
+				Message: status.Error(codes.Internal, callRes.VmError).Error(),
+			}}
+		}
+	}
+
+	return results, nil
+}
+
+// simulateBundle executes the calls with the SimulateBundle query on the state of the given block.
+func (b *Backend) simulateBundle(
+	calls []evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride, isolated bool,
+) (*evmtypes.QuerySimulateBundleResponse, error) {
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.QuerySimulateBundleRequest{
+		Calls:           make([][]byte, len(calls)),
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Isolated:        isolated,
+	}
+	for i := range calls {
+		if req.Calls[i], err = json.Marshal(&calls[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	var cancel context.CancelFunc
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	return b.queryClient.SimulateBundle(ctx, &req)
+}
+
+// newBundleCallResult converts the response of an executed call, the execution error including the
+// revert reason.
+func newBundleCallResult(res *evmtypes.MsgEthereumTxResponse) *rpctypes.BundleCallResult {
+	result := &rpctypes.BundleCallResult{
+		ReturnData: res.Ret,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Logs:       evmtypes.LogsToEthereum(res.Logs),
+	}
+	if res.Failed() {
+		result.Error = res.VmError
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			result.Error = evmtypes.NewExecErrorWithReason(res.Ret).Error()
+		}
+	}
+	return result
+}
+
+// DryRunTransaction executes the call on the state of the given block and returns its result with
+// the state changes the execution would apply. The fees and the nonce increment of the sender
+// aren't included.
+func (b *Backend) DryRunTransaction(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) (*rpctypes.DryRunResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	var cancel context.CancelFunc
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.StateDiff(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.DryRunResult{
+		BundleCallResult: *newBundleCallResult(res.Result),
+		StateDiff:        make(map[common.Address]*rpctypes.AccountDiff, len(res.Accounts)),
+	}
+	for _, account := range res.Accounts {
+		diff, err := rpctypes.NewAccountDiff(account)
+		if err != nil {
+			return nil, err
+		}
+		result.StateDiff[common.HexToAddress(account.Address)] = diff
+	}
+
+	return result, nil
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
+// When the mempool backlog exceeds the block gas limit, the price is raised to
+// the one needed to be included in the next block. The price is scaled by the node
+// adaptive multiplier, raised while the recent blocks are full.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
 		result *big.Int
 		err    error
 	)
-	if head := b.CurrentHeader(); head.BaseFee != nil {
+	head := b.CurrentHeader()
+	if head.BaseFee != nil {
 		result, err = b.SuggestGasTipCap(head.BaseFee)
 		if err != nil {
 			return nil, err
@@ -410,6 +879,13 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	} else {
 		result = big.NewInt(b.RPCMinGasPrice())
 	}
+	if multiplier := b.MinGasPriceMultiplier(); multiplier.GT(sdk.OneDec()) {
+		result = sdk.NewDecFromBigInt(result).Mul(multiplier).Ceil().TruncateInt().BigInt()
+	}
+
+	if pendingPrice := b.pendingGasPrice(head); pendingPrice != nil && result.Cmp(pendingPrice) < 0 {
+		result = pendingPrice
+	}
 
 	// return at least GlobalMinGasPrice from FeeMarket module
 	minGasPrice, err := b.GlobalMinGasPrice()
@@ -1,18 +1,25 @@
 package backend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -360,6 +367,28 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSendRawTransactionWalletError() {
+	ethTx, _ := suite.buildEthereumTx()
+	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
+	cosmosTx, _ := ethTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+
+	suite.SetupTest()
+	suite.backend.allowUnprotectedTxs = true
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	client.On("BroadcastTxSync", context.Background(), types.Tx(txBytes)).Return(&tmrpctypes.ResultBroadcastTx{
+		Code:      errortypes.ErrInsufficientFunds.ABCICode(),
+		Codespace: errortypes.ErrInsufficientFunds.Codespace(),
+		Log:       "failed to deduct full gas cost",
+	}, nil)
+
+	_, err := suite.backend.SendRawTransaction(rlpEncodedBz)
+	suite.Require().ErrorIs(err, core.ErrInsufficientFunds)
+	suite.Require().True(strings.HasPrefix(err.Error(), core.ErrInsufficientFunds.Error()))
+}
+
 func (suite *BackendTestSuite) TestDoCall() {
 	_, bz := suite.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
@@ -422,7 +451,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(context.Background(), tc.callArgs, tc.blockNum, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -433,9 +462,216 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSimulateBundle() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	calls := []evmtypes.TransactionArgs{{To: &toAddr}, {To: &toAddr}}
+	callBz, err := json.Marshal(&calls[0])
+	suite.Require().NoError(err)
+
+	// Error("COUNTER_TOO_LOW")
+	revertRet := common.FromHex("0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f434f554e5445525f544f4f5f4c4f570000000000000000000000000000000000")
+
+	suite.SetupTest()
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBlock(client, 1, bz)
+	req := &evmtypes.QuerySimulateBundleRequest{Calls: [][]byte{callBz, callBz}, ChainId: suite.backend.chainID.Int64()}
+	queryClient.On("SimulateBundle", mock.Anything, req).Return(&evmtypes.QuerySimulateBundleResponse{
+		Results: []*evmtypes.MsgEthereumTxResponse{
+			{Ret: []byte{0x01}, GasUsed: 21000, Logs: []*evmtypes.Log{{Address: toAddr.Hex(), Index: 0}}},
+			{Ret: revertRet, GasUsed: 30000, VmError: "execution reverted"},
+		},
+	}, nil)
+
+	results, err := suite.backend.SimulateBundle(calls, rpctypes.BlockNumber(1), nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 2)
+	suite.Require().Equal(hexutil.Bytes{0x01}, results[0].ReturnData)
+	suite.Require().Equal(hexutil.Uint64(21000), results[0].GasUsed)
+	suite.Require().Len(results[0].Logs, 1)
+	suite.Require().Equal(toAddr, results[0].Logs[0].Address)
+	suite.Require().Empty(results[0].Error)
+	suite.Require().Equal("execution reverted: COUNTER_TOO_LOW", results[1].Error)
+
+	// empty bundle
+	results, err = suite.backend.SimulateBundle(nil, rpctypes.BlockNumber(1), nil)
+	suite.Require().NoError(err)
+	suite.Require().Empty(results)
+}
+
+func (suite *BackendTestSuite) TestCallBatch() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	calls := []evmtypes.TransactionArgs{{To: &toAddr}, {To: &toAddr}, {To: &toAddr}}
+	callBz, err := json.Marshal(&calls[0])
+	suite.Require().NoError(err)
+
+	// Error("COUNTER_TOO_LOW")
+	revertRet := common.FromHex("0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f434f554e5445525f544f4f5f4c4f570000000000000000000000000000000000")
+
+	suite.SetupTest()
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBlock(client, 1, bz)
+	req := &evmtypes.QuerySimulateBundleRequest{
+		Calls:    [][]byte{callBz, callBz, callBz},
+		ChainId:  suite.backend.chainID.Int64(),
+		Isolated: true,
+	}
+	queryClient.On("SimulateBundle", mock.Anything, req).Return(&evmtypes.QuerySimulateBundleResponse{
+		Results: []*evmtypes.MsgEthereumTxResponse{
+			{GasUsed: 21000},
+			{Ret: revertRet, GasUsed: 30000, VmError: "execution reverted"},
+			{GasUsed: 30000, VmError: "out of gas"},
+		},
+	}, nil)
+
+	results, err := suite.backend.CallBatch(calls, rpctypes.BlockNumber(1))
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 3)
+	suite.Require().Nil(results[0].Error)
+	suite.Require().Equal(hexutil.Bytes(nil), *results[0].Result)
+	suite.Require().Nil(results[1].Result)
+	suite.Require().Equal(3, results[1].Error.Code)
+	suite.Require().Equal("execution reverted: COUNTER_TOO_LOW", results[1].Error.Message)
+	suite.Require().Equal(hexutil.Encode(revertRet), results[1].Error.Data)
+	suite.Require().Equal(rpctypes.DefaultErrorCode, results[2].Error.Code)
+	suite.Require().Contains(results[2].Error.Message, "out of gas")
+
+	// the successful calls keep their result when empty
+	bz, err = json.Marshal(results[0])
+	suite.Require().NoError(err)
+	suite.Require().JSONEq(`{"result":"0x"}`, string(bz))
+
+	// empty batch
+	results, err = suite.backend.CallBatch(nil, rpctypes.BlockNumber(1))
+	suite.Require().NoError(err)
+	suite.Require().Empty(results)
+}
+
+func (suite *BackendTestSuite) TestEstimateGasBulk() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.GenerateAddress()
+	calls := make([]evmtypes.TransactionArgs, evmtypes.MaxBundleCalls+10)
+	for i := range calls {
+		calls[i] = evmtypes.TransactionArgs{To: &toAddr}
+	}
+
+	// Error("COUNTER_TOO_LOW")
+	revertData := common.FromHex("0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f434f554e5445525f544f4f5f4c4f570000000000000000000000000000000000")
+
+	suite.SetupTest()
+	suite.backend.cfg.JSONRPC.EstimateGasMultiplier = 1.5
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBlock(client, 1, bz)
+
+	// the calls are split in queries of MaxBundleCalls calls
+	firstChunk := func(req *evmtypes.QueryEstimateGasBulkRequest) bool { return len(req.Calls) == evmtypes.MaxBundleCalls }
+	lastChunk := func(req *evmtypes.QueryEstimateGasBulkRequest) bool { return len(req.Calls) == 10 }
+	results := make([]evmtypes.GasEstimateResult, evmtypes.MaxBundleCalls)
+	for i := range results {
+		results[i] = evmtypes.GasEstimateResult{Gas: 21000}
+	}
+	queryClient.On("EstimateGasBulk", mock.Anything, mock.MatchedBy(firstChunk)).
+		Return(&evmtypes.QueryEstimateGasBulkResponse{Results: results}, nil)
+	lastResults := make([]evmtypes.GasEstimateResult, 10)
+	for i := range lastResults {
+		lastResults[i] = evmtypes.GasEstimateResult{Gas: 30000}
+	}
+	lastResults[8] = evmtypes.GasEstimateResult{Error: "execution reverted: COUNTER_TOO_LOW", Reverted: true, RevertData: revertData}
+	lastResults[9] = evmtypes.GasEstimateResult{Error: "gas required exceeds allowance (25000000)"}
+	queryClient.On("EstimateGasBulk", mock.Anything, mock.MatchedBy(lastChunk)).
+		Return(&evmtypes.QueryEstimateGasBulkResponse{Results: lastResults}, nil)
+
+	estimates, err := suite.backend.EstimateGasBulk(calls, rpctypes.BlockNumber(1))
+	suite.Require().NoError(err)
+	suite.Require().Len(estimates, len(calls))
+	suite.Require().Equal(hexutil.Uint64(31500), estimates[0].Gas)
+	suite.Require().Equal(hexutil.Uint64(21000), estimates[0].RawGas)
+	suite.Require().Nil(estimates[0].Error)
+	suite.Require().Equal(hexutil.Uint64(30000), estimates[evmtypes.MaxBundleCalls].RawGas)
+
+	reverted := estimates[len(calls)-2]
+	suite.Require().Nil(reverted.GasEstimate)
+	suite.Require().Equal(3, reverted.Error.Code)
+	suite.Require().Equal("execution reverted: COUNTER_TOO_LOW", reverted.Error.Message)
+	suite.Require().Equal(hexutil.Encode(revertData), reverted.Error.Data)
+
+	failed := estimates[len(calls)-1]
+	suite.Require().Equal(rpctypes.DefaultErrorCode, failed.Error.Code)
+	suite.Require().Equal("gas required exceeds allowance (25000000)", failed.Error.Message)
+
+	bz, err = json.Marshal(failed)
+	suite.Require().NoError(err)
+	suite.Require().JSONEq(`{"error":{"code":-32000,"message":"gas required exceeds allowance (25000000)"}}`, string(bz))
+
+	// empty bulk
+	estimates, err = suite.backend.EstimateGasBulk(nil, rpctypes.BlockNumber(1))
+	suite.Require().NoError(err)
+	suite.Require().Empty(estimates)
+}
+
+func (suite *BackendTestSuite) TestDryRunTransaction() {
+	_, bz := suite.buildEthereumTx()
+	from, toAddr := tests.GenerateAddress(), tests.GenerateAddress()
+	args := evmtypes.TransactionArgs{From: &from, To: &toAddr}
+	argsBz, err := json.Marshal(&args)
+	suite.Require().NoError(err)
+	key := common.BigToHash(big.NewInt(1))
+
+	suite.SetupTest()
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBlock(client, 1, bz)
+	req := &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()}
+	queryClient.On("StateDiff", mock.Anything, req).Return(&evmtypes.QueryStateDiffResponse{
+		Result: &evmtypes.MsgEthereumTxResponse{GasUsed: 21000},
+		Accounts: []evmtypes.AccountDiff{{
+			Address:        toAddr.Hex(),
+			BalanceBefore:  "10",
+			BalanceAfter:   "15",
+			NonceBefore:    1,
+			NonceAfter:     1,
+			CodeHashBefore: common.Hash{}.Hex(),
+			CodeHashAfter:  common.Hash{}.Hex(),
+			Storage:        []evmtypes.StorageDiff{{Key: key.Hex(), Before: common.Hash{}.Hex(), After: key.Hex()}},
+		}},
+	}, nil)
+
+	res, err := suite.backend.DryRunTransaction(args, rpctypes.BlockNumber(1), nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(hexutil.Uint64(21000), res.GasUsed)
+	suite.Require().Len(res.StateDiff, 1)
+	diff := res.StateDiff[toAddr]
+	suite.Require().NotNil(diff)
+	suite.Require().Equal(big.NewInt(10), diff.BalanceBefore.ToInt())
+	suite.Require().Equal(big.NewInt(15), diff.BalanceAfter.ToInt())
+	suite.Require().Equal(hexutil.Uint64(1), diff.NonceAfter)
+	suite.Require().Equal(rpctypes.StorageDiff{After: key}, diff.Storage[key])
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
+	buildPendingTx := func(gas uint64, gasPrice int64) []byte {
+		msgEthTx := evmtypes.NewTx(suite.backend.chainID, 0, &common.Address{}, big.NewInt(0), gas, big.NewInt(gasPrice), nil, nil, nil, nil)
+		txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(txBuilder.SetMsgs(msgEthTx))
+		bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+		suite.Require().NoError(err)
+		return bz
+	}
+	pendingTxs := []types.Tx{buildPendingTx(100000, 3), buildPendingTx(100000, 5), buildPendingTx(50000, 4)}
+
+	registerBlockGasLimit := func(client *mocks.Client, maxGas int64) {
+		consensusParams := types.DefaultConsensusParams()
+		consensusParams.Block.MaxGas = maxGas
+		client.On("ConsensusParams", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+			Return(&tmrpctypes.ResultConsensusParams{ConsensusParams: *consensusParams}, nil)
+	}
+
 	testCases := []struct {
 		name         string
 		registerMock func()
@@ -454,10 +690,68 @@ func (suite *BackendTestSuite) TestGasPrice() {
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				RegisterMinGasPriceMultiplier(queryClient, sdk.OneDec())
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			defaultGasPrice,
+			true,
+		},
+		{
+			"pass - mempool backlog fits in the next block",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterParams(queryClient, &header, 1)
+				RegisterBlock(client, 1, nil)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				RegisterMinGasPriceMultiplier(queryClient, sdk.OneDec())
+				RegisterUnconfirmedTxs(client, nil, pendingTxs)
+				registerBlockGasLimit(client, 250000)
 			},
 			defaultGasPrice,
 			true,
 		},
+		{
+			"pass - mempool backlog raises the gas price",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterParams(queryClient, &header, 1)
+				RegisterBlock(client, 1, nil)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				RegisterMinGasPriceMultiplier(queryClient, sdk.OneDec())
+				RegisterUnconfirmedTxs(client, nil, pendingTxs)
+				registerBlockGasLimit(client, 200000)
+			},
+			(*hexutil.Big)(big.NewInt(4)),
+			true,
+		},
+		{
+			"pass - adaptive multiplier raises the gas price",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterParams(queryClient, &header, 1)
+				RegisterBlock(client, 1, nil)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(4))
+				RegisterMinGasPriceMultiplier(queryClient, sdk.NewDecWithPrec(15, 1))
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			(*hexutil.Big)(big.NewInt(6)),
+			true,
+		},
 		{
 			"fail - can't get gasFee, FeeMarketParams error",
 			func() {
@@ -490,3 +784,43 @@ func (suite *BackendTestSuite) TestGasPrice() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestEstimateGasDetailed() {
+	blockNr := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name       string
+		multiplier float64
+		gasCap     uint64
+		expGas     uint64
+	}{
+		{"pass - default multiplier", 0, 25000000, 100000},
+		{"pass - adjusted estimation", 1.25, 25000000, 125000},
+		{"pass - adjusted estimation rounded up", 1.000005, 25000000, 100001},
+		{"pass - adjusted estimation capped", 1.5, 120000, 120000},
+		{"pass - uncapped", 2, 0, 200000},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.EstimateGasMultiplier = tc.multiplier
+			suite.backend.cfg.JSONRPC.GasCap = tc.gasCap
+
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			_, err := RegisterBlock(client, 1, nil)
+			suite.Require().NoError(err)
+			queryClient.On("EstimateGas", rpctypes.ContextWithHeight(1), mock.Anything).
+				Return(&evmtypes.EstimateGasResponse{Gas: 100000}, nil)
+
+			estimate, err := suite.backend.EstimateGasDetailed(context.Background(), evmtypes.TransactionArgs{}, &blockNr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(hexutil.Uint64(tc.expGas), estimate.Gas)
+			suite.Require().Equal(hexutil.Uint64(100000), estimate.RawGas)
+
+			gas, err := suite.backend.EstimateGas(context.Background(), evmtypes.TransactionArgs{}, &blockNr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(estimate.Gas, gas)
+		})
+	}
+}
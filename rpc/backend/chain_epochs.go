@@ -0,0 +1,76 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// epochArchiveURL returns the JSON-RPC endpoint configured for the previous chain epoch the given
+// height was produced in, it returns an empty url if the height belongs to the current chain-id or
+// if no archive is configured for its epoch.
+func (b *Backend) epochArchiveURL(height int64) (string, error) {
+	archives, err := b.cfg.JSONRPC.EpochArchiveURLs()
+	if err != nil || len(archives) == 0 {
+		return "", err
+	}
+
+	res, err := b.queryClient.ChainEpochs(b.ctx, &evmtypes.QueryChainEpochsRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	for _, epoch := range res.Epochs {
+		if height < epoch.StartHeight || (epoch.EndHeight != 0 && height > epoch.EndHeight) {
+			continue
+		}
+		if epoch.ChainID == b.clientCtx.ChainID {
+			return "", nil
+		}
+		return archives[epoch.ChainID], nil
+	}
+	return "", nil
+}
+
+// archiveBlockByNumber forwards the block query of a height produced under a previous chain-id to
+// the archive endpoint of its epoch, it returns a nil block if the height isn't archived.
+func (b *Backend) archiveBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if blockNum < 1 {
+		return nil, nil
+	}
+
+	url, err := b.epochArchiveURL(blockNum.Int64())
+	if err != nil || url == "" {
+		return nil, err
+	}
+
+	client, err := gethrpc.DialContext(b.ctx, url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial the epoch archive %s", url)
+	}
+	defer client.Close()
+
+	var block map[string]interface{}
+	if err := client.CallContext(b.ctx, &block, "eth_getBlockByNumber", hexutil.Uint64(blockNum), fullTx); err != nil {
+		return nil, errors.Wrapf(err, "failed to query the epoch archive %s", url)
+	}
+	return block, nil
+}
@@ -16,6 +16,7 @@
 package backend
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -32,6 +33,9 @@ import (
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
+// maxChainStatsBlocks is the max number of blocks aggregated by a single ChainStats call.
+const maxChainStatsBlocks = 100_000
+
 // ChainID is the EIP-155 replay-protection chain id for the current ethereum chain config.
 func (b *Backend) ChainID() (*hexutil.Big, error) {
 	eip155ChainID, err := ethermint.ParseChainID(b.clientCtx.ChainID)
@@ -198,29 +202,16 @@ func (b *Backend) FeeHistory(
 	// fetch block
 	for blockID := blockStart; blockID <= blockEnd; blockID++ {
 		index := int32(blockID - blockStart)
-		// tendermint block
-		tendermintblock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(blockID))
-		if tendermintblock == nil {
-			return nil, err
-		}
-
-		// eth block
-		ethBlock, err := b.GetBlockByNumber(rpctypes.BlockNumber(blockID), true)
-		if ethBlock == nil {
+		blockFees, err := b.blockFees(blockID)
+		if blockFees == nil {
 			return nil, err
 		}
 
-		// tendermint block result
-		tendermintBlockResult, err := b.TendermintBlockResultByNumber(&tendermintblock.Block.Height)
-		if tendermintBlockResult == nil {
-			b.logger.Debug("block result not found", "height", tendermintblock.Block.Height, "error", err.Error())
-			return nil, err
-		}
-
-		oneFeeHistory := rpctypes.OneFeeHistory{}
-		err = b.processBlock(tendermintblock, &ethBlock, rewardPercentiles, tendermintBlockResult, &oneFeeHistory)
-		if err != nil {
-			return nil, err
+		oneFeeHistory := rpctypes.OneFeeHistory{
+			BaseFee:      blockFees.BaseFee.ToInt(),
+			NextBaseFee:  b.nextBaseFee(blockID),
+			GasUsedRatio: blockFees.GasUsedRatio,
+			Reward:       blockFees.Rewards(rewardPercentiles),
 		}
 
 		// copy
@@ -250,6 +241,71 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
+// blockFees returns the fee data of the block at the given height, read from
+// the indexer when persisted within the fee history retention window.
+func (b *Backend) blockFees(height int64) (*rpctypes.BlockFees, error) {
+	store := b.feeHistoryIndexer()
+	if store != nil {
+		blockFees, err := store.GetBlockFees(height)
+		if err != nil {
+			b.logger.Debug("failed to read the stored block fees", "height", height, "error", err.Error())
+		} else if blockFees != nil {
+			return blockFees, nil
+		}
+	}
+
+	// tendermint block
+	tendermintblock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if tendermintblock == nil {
+		return nil, err
+	}
+
+	// eth block
+	ethBlock, err := b.GetBlockByNumber(rpctypes.BlockNumber(height), true)
+	if ethBlock == nil {
+		return nil, err
+	}
+
+	// tendermint block result
+	tendermintBlockResult, err := b.TendermintBlockResultByNumber(&tendermintblock.Block.Height)
+	if tendermintBlockResult == nil {
+		b.logger.Debug("block result not found", "height", tendermintblock.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
+	blockFees, err := b.processBlock(tendermintblock, &ethBlock, tendermintBlockResult)
+	if err != nil {
+		return nil, err
+	}
+
+	if store != nil {
+		if err := store.SetBlockFees(height, blockFees); err != nil {
+			b.logger.Debug("failed to store the block fees", "height", height, "error", err.Error())
+		}
+		retention := int64(b.cfg.JSONRPC.FeeHistoryRetention)
+		if height > retention {
+			if err := store.PruneBlockFees(height - retention); err != nil {
+				b.logger.Debug("failed to prune the block fees", "height", height, "error", err.Error())
+			}
+		}
+	}
+
+	return blockFees, nil
+}
+
+// feeHistoryIndexer returns the indexer persisting the block fees, nil if the
+// indexer is disabled or the fee history retention is zero.
+func (b *Backend) feeHistoryIndexer() FeeHistoryIndexer {
+	if b.cfg.JSONRPC.FeeHistoryRetention == 0 {
+		return nil
+	}
+	store, ok := b.indexer.(FeeHistoryIndexer)
+	if !ok {
+		return nil
+	}
+	return store
+}
+
 // SuggestGasTipCap returns the suggested tip cap
 // Although we don't support tx prioritization yet, but we return a positive value to help client to
 // mitigate the base fee changes.
@@ -280,3 +336,66 @@ func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	}
 	return big.NewInt(maxDelta), nil
 }
+
+// ChainStats returns the aggregated statistics of the ethereum transactions executed in the
+// [fromBlock, toBlock] range, the latest and pending block numbers resolve to the latest block. The
+// statistics are aggregated by the evm indexer, which must be enabled.
+func (b *Backend) ChainStats(fromBlock, toBlock rpctypes.BlockNumber) (*rpctypes.ChainStats, error) {
+	store, ok := b.indexer.(ChainStatsIndexer)
+	if !ok {
+		return nil, errors.New("chain statistics require the evm indexer, see json-rpc.enable-indexer")
+	}
+
+	from, to := fromBlock.Int64(), toBlock.Int64()
+	if from < 0 || to < 0 {
+		blockNumber, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		if from < 0 {
+			from = int64(blockNumber)
+		}
+		if to < 0 {
+			to = int64(blockNumber)
+		}
+	}
+	// the genesis block has no transactions
+	if from == 0 {
+		from = 1
+	}
+	if to < from {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if to-from >= maxChainStatsBlocks {
+		return nil, fmt.Errorf("block range exceeds the maximum of %d blocks", maxChainStatsBlocks)
+	}
+
+	res := &rpctypes.ChainStats{
+		FromBlock: hexutil.Uint64(from),
+		ToBlock:   hexutil.Uint64(to),
+	}
+	gasPriceSum := new(big.Int)
+	err := store.IterateBlockStats(from, to, func(_ int64, stats *evmtypes.BlockStats) bool {
+		if stats.TxCount == 0 {
+			return false
+		}
+		res.BlockCount++
+		res.TxCount += hexutil.Uint64(stats.TxCount)
+		res.FailedTxCount += hexutil.Uint64(stats.FailedTxCount)
+		res.GasUsed += hexutil.Uint64(stats.GasUsed)
+		gasPriceSum.Add(gasPriceSum, stats.GasPriceSum.BigInt())
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	averageGasPrice := new(big.Int)
+	if res.TxCount > 0 {
+		averageGasPrice.Quo(gasPriceSum, new(big.Int).SetUint64(uint64(res.TxCount)))
+		res.FailureRatio = float64(res.FailedTxCount) / float64(res.TxCount)
+	}
+	res.AverageGasPrice = (*hexutil.Big)(averageGasPrice)
+
+	return res, nil
+}
@@ -15,6 +15,7 @@ import (
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/evmos/ethermint/rpc/backend/mocks"
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 )
@@ -429,6 +430,42 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			sdk.AccAddress(tests.GenerateAddress().Bytes()),
 			true,
 		},
+		{
+			"pass - block fees persisted by the indexer",
+			func(validator sdk.AccAddress) {
+				var header metadata.MD
+				baseFee := sdk.NewInt(1)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				suite.backend.cfg.JSONRPC.FeeHistoryRetention = 100
+				// the next base fee is still computed from the current header
+				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, baseFee)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+
+				store := suite.backend.indexer.(FeeHistoryIndexer)
+				err := store.SetBlockFees(1, &rpc.BlockFees{
+					BaseFee:      (*hexutil.Big)(big.NewInt(2)),
+					GasUsed:      21000,
+					GasUsedRatio: 0.5,
+					Txs:          []rpc.TxFees{{GasUsed: 21000, Reward: (*hexutil.Big)(big.NewInt(7))}},
+				})
+				suite.Require().NoError(err)
+			},
+			1,
+			1,
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(2)), (*hexutil.Big)(big.NewInt(1))},
+				GasUsedRatio: []float64{0.5},
+				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(7)), (*hexutil.Big)(big.NewInt(7)), (*hexutil.Big)(big.NewInt(7)), (*hexutil.Big)(big.NewInt(7))}},
+			},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -446,3 +483,132 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestFeeHistoryPersistsBlockFees() {
+	var header metadata.MD
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+	suite.backend.cfg.JSONRPC.FeeHistoryRetention = 100
+	RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
+	RegisterBlockResults(client, 1)
+	RegisterBaseFee(queryClient, sdk.NewInt(1))
+	RegisterValidatorAccount(queryClient, sdk.AccAddress(tests.GenerateAddress().Bytes()))
+	RegisterConsensusParams(client, 1)
+	RegisterParams(queryClient, &header, 1)
+	RegisterParamsWithoutHeader(queryClient, 1)
+
+	_, err := suite.backend.FeeHistory(1, 1, []float64{50})
+	suite.Require().NoError(err)
+
+	blockFees, err := suite.backend.indexer.(FeeHistoryIndexer).GetBlockFees(1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&rpc.BlockFees{
+		BaseFee:      (*hexutil.Big)(big.NewInt(1)),
+		GasUsed:      0,
+		GasUsedRatio: 0,
+		Txs:          []rpc.TxFees{},
+	}, blockFees)
+}
+
+// blockStatsIndexer serves the block statistics of the ChainStats tests.
+type blockStatsIndexer struct {
+	ethermint.EVMTxIndexer
+	stats map[int64]*evmtypes.BlockStats
+}
+
+func (idxer blockStatsIndexer) IterateBlockStats(from, to int64, cb func(int64, *evmtypes.BlockStats) bool) error {
+	for height := from; height <= to; height++ {
+		if stats, ok := idxer.stats[height]; ok && cb(height, stats) {
+			break
+		}
+	}
+	return nil
+}
+
+func (suite *BackendTestSuite) TestChainStats() {
+	indexer := blockStatsIndexer{stats: map[int64]*evmtypes.BlockStats{
+		2:  {TxCount: 2, FailedTxCount: 1, GasUsed: 42000, GasPriceSum: sdk.NewInt(1500000000)},
+		5:  {GasPriceSum: sdk.ZeroInt()},
+		10: {TxCount: 2, GasUsed: 42000, GasPriceSum: sdk.NewInt(2500000000)},
+		11: {TxCount: 1, GasUsed: 21000, GasPriceSum: sdk.NewInt(1000000000)},
+	}}
+
+	testCases := []struct {
+		name          string
+		indexer       ethermint.EVMTxIndexer
+		fromBlock     rpc.BlockNumber
+		toBlock       rpc.BlockNumber
+		expChainStats *rpc.ChainStats
+		expPass       bool
+	}{
+		{
+			"fail - indexer disabled",
+			nil,
+			rpc.BlockNumber(1),
+			rpc.BlockNumber(10),
+			nil,
+			false,
+		},
+		{
+			"fail - invalid block range",
+			indexer,
+			rpc.BlockNumber(2),
+			rpc.BlockNumber(1),
+			nil,
+			false,
+		},
+		{
+			"fail - block range too large",
+			indexer,
+			rpc.BlockNumber(1),
+			rpc.BlockNumber(maxChainStatsBlocks + 1),
+			nil,
+			false,
+		},
+		{
+			"pass - earliest block starts after genesis",
+			indexer,
+			rpc.EthEarliestBlockNumber,
+			rpc.BlockNumber(10),
+			&rpc.ChainStats{
+				FromBlock:       1,
+				ToBlock:         10,
+				BlockCount:      2,
+				TxCount:         4,
+				FailedTxCount:   1,
+				GasUsed:         84000,
+				AverageGasPrice: (*hexutil.Big)(big.NewInt(1000000000)),
+				FailureRatio:    0.25,
+			},
+			true,
+		},
+		{
+			"pass - no transactions in the range",
+			indexer,
+			rpc.BlockNumber(3),
+			rpc.BlockNumber(9),
+			&rpc.ChainStats{
+				FromBlock:       3,
+				ToBlock:         9,
+				AverageGasPrice: (*hexutil.Big)(big.NewInt(0)),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.indexer = tc.indexer
+
+			chainStats, err := suite.backend.ChainStats(tc.fromBlock, tc.toBlock)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expChainStats, chainStats)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
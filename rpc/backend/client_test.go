@@ -152,6 +152,39 @@ func TestRegisterBlock(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// Commit
+func RegisterCommit(client *mocks.Client, height int64, round int32) {
+	res := &tmrpctypes.ResultCommit{
+		SignedHeader: types.SignedHeader{Commit: &types.Commit{Height: height, Round: round}},
+	}
+	client.On("Commit", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(res, nil)
+}
+
+func RegisterCommitError(client *mocks.Client, height int64) {
+	client.On("Commit", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// Validators
+func RegisterValidators(client *mocks.Client, height int64, validators []*types.Validator) {
+	res := &tmrpctypes.ResultValidators{
+		BlockHeight: height,
+		Validators:  validators,
+		Count:       len(validators),
+		Total:       len(validators),
+	}
+	client.On("Validators", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64"),
+		mock.AnythingOfType("*int"), mock.AnythingOfType("*int")).
+		Return(res, nil)
+}
+
+func RegisterValidatorsError(client *mocks.Client, height int64) {
+	client.On("Validators", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64"),
+		mock.AnythingOfType("*int"), mock.AnythingOfType("*int")).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // ConsensusParams
 func RegisterConsensusParams(client *mocks.Client, height int64) {
 	consensusParams := types.DefaultConsensusParams()
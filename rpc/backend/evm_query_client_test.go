@@ -11,6 +11,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpc "github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/tests"
@@ -62,6 +63,13 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -162,6 +170,12 @@ func RegisterBaseFee(queryClient *mocks.EVMQueryClient, baseFee sdk.Int) {
 		Return(&evmtypes.QueryBaseFeeResponse{BaseFee: &baseFee}, nil)
 }
 
+// MinGasPriceMultiplier
+func RegisterMinGasPriceMultiplier(queryClient *mocks.EVMQueryClient, multiplier sdk.Dec) {
+	queryClient.On("MinGasPriceMultiplier", mock.Anything, &evmtypes.QueryMinGasPriceMultiplierRequest{}).
+		Return(&evmtypes.QueryMinGasPriceMultiplierResponse{Multiplier: multiplier}, nil)
+}
+
 // Base fee returns error
 func RegisterBaseFeeError(queryClient *mocks.EVMQueryClient) {
 	queryClient.On("BaseFee", rpc.ContextWithHeight(1), &evmtypes.QueryBaseFeeRequest{}).
@@ -243,11 +257,12 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 }
 
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
-	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
+	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String(), WithStorageHash: true}).
 		Return(&evmtypes.QueryAccountResponse{
-			Balance:  "0",
-			CodeHash: "",
-			Nonce:    0,
+			Balance:     "0",
+			CodeHash:    "",
+			Nonce:       0,
+			StorageHash: ethtypes.EmptyRootHash.Hex(),
 		},
 			nil,
 		)
@@ -273,3 +288,9 @@ func RegisterBalanceError(queryClient *mocks.EVMQueryClient, addr common.Address
 	queryClient.On("Balance", rpc.ContextWithHeight(height), &evmtypes.QueryBalanceRequest{Address: addr.String()}).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
+
+// ChainEpochs
+func RegisterChainEpochs(queryClient *mocks.EVMQueryClient, epochs []evmtypes.ChainEpoch) {
+	queryClient.On("ChainEpochs", mock.Anything, &evmtypes.QueryChainEpochsRequest{}).
+		Return(&evmtypes.QueryChainEpochsResponse{Epochs: epochs}, nil)
+}
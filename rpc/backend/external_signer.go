@@ -0,0 +1,118 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// externalSignerURL returns the JSON-RPC endpoint of the external signer configured for the account,
+// it returns an empty url if the account is signed with the node keyring.
+func (b *Backend) externalSignerURL(address common.Address) (string, error) {
+	signers, err := b.cfg.JSONRPC.ExternalSignerURLs()
+	if err != nil {
+		return "", err
+	}
+	return signers[address], nil
+}
+
+// externalSignerAddresses returns the accounts signed by an external signer, sorted by address.
+func (b *Backend) externalSignerAddresses() ([]common.Address, error) {
+	signers, err := b.cfg.JSONRPC.ExternalSignerURLs()
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]common.Address, 0, len(signers))
+	for address := range signers {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+	return addresses, nil
+}
+
+// callExternalSigner calls the method of the web3signer-compatible JSON-RPC api of the external signer.
+func (b *Backend) callExternalSigner(url string, result interface{}, method string, args ...interface{}) error {
+	client, err := gethrpc.DialContext(b.ctx, url)
+	if err != nil {
+		return errors.Wrapf(err, "failed to dial the external signer %s", url)
+	}
+	defer client.Close()
+
+	if err := client.CallContext(b.ctx, result, method, args...); err != nil {
+		return errors.Wrapf(err, "external signer %s failed to %s", url, method)
+	}
+	return nil
+}
+
+// signTxExternally signs the transaction of the arguments with the external signer, through its
+// `eth_signTransaction` method. The signed transaction is checked to be the requested one, signed by
+// the sender.
+func (b *Backend) signTxExternally(
+	url string, args evmtypes.TransactionArgs, tx *ethtypes.Transaction, signer ethtypes.Signer,
+) (*ethtypes.Transaction, error) {
+	var raw hexutil.Bytes
+	if err := b.callExternalSigner(url, &raw, "eth_signTransaction", args); err != nil {
+		return nil, err
+	}
+
+	signed := new(ethtypes.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the transaction signed by the external signer")
+	}
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, fmt.Errorf("the external signer signed a different transaction %s", signed.Hash())
+	}
+
+	sender, err := ethtypes.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature of the external signer")
+	}
+	if sender != args.GetFrom() {
+		return nil, fmt.Errorf("the external signer signed the transaction with %s instead of %s", sender, args.GetFrom())
+	}
+	return signed, nil
+}
+
+// signExternally signs the data with the external signer through the method, e.g. `eth_sign` or
+// `eth_signTypedData`, returning the signature with V as 27/28.
+func (b *Backend) signExternally(url, method string, address common.Address, data interface{}) (hexutil.Bytes, error) {
+	var signature hexutil.Bytes
+	if err := b.callExternalSigner(url, &signature, method, address, data); err != nil {
+		return nil, err
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length %d of the external signer", len(signature))
+	}
+
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+	return signature, nil
+}
@@ -0,0 +1,113 @@
+package backend
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// remoteSigner is an external signer signing with its key, or with another key if it's faulty.
+type remoteSigner struct {
+	key     *ecdsa.PrivateKey
+	chainID *big.Int
+}
+
+func (s remoteSigner) Sign(_ common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	// the signers returning V as 0/1 are supported
+	return crypto.Sign(crypto.Keccak256(data), s.key)
+}
+
+func (s remoteSigner) SignTransaction(args evmtypes.TransactionArgs) (hexutil.Bytes, error) {
+	tx, err := ethtypes.SignTx(args.ToTransaction().AsTransaction(), ethtypes.LatestSignerForChainID(s.chainID), s.key)
+	if err != nil {
+		return nil, err
+	}
+	return tx.MarshalBinary()
+}
+
+func (suite *BackendTestSuite) newRemoteSigner(key *ecdsa.PrivateKey) string {
+	server := gethrpc.NewServer()
+	suite.Require().NoError(server.RegisterName("eth", remoteSigner{key: key, chainID: suite.backend.chainID}))
+	signer := httptest.NewServer(server)
+	suite.T().Cleanup(func() {
+		signer.Close()
+		server.Stop()
+	})
+	return signer.URL
+}
+
+func (suite *BackendTestSuite) TestExternalSigner() {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	otherKey, _ := crypto.GenerateKey()
+	data := hexutil.Bytes("data")
+
+	testCases := []struct {
+		name    string
+		key     *ecdsa.PrivateKey
+		expPass bool
+	}{
+		{"pass - signed by the account", key, true},
+		{"fail - signed by another account", otherKey, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			url := suite.newRemoteSigner(tc.key)
+			suite.backend.cfg.JSONRPC.ExternalSigners = []string{fmt.Sprintf("%s=%s", from.Hex(), url)}
+
+			accounts, err := suite.backend.Accounts()
+			suite.Require().NoError(err)
+			suite.Require().Equal([]common.Address{from}, accounts)
+
+			signature, err := suite.backend.Sign(from, data)
+			suite.Require().NoError(err)
+			suite.Require().Contains([]byte{27, 28}, signature[crypto.RecoveryIDOffset])
+			signature[crypto.RecoveryIDOffset] -= 27
+			pubKey, err := crypto.SigToPub(crypto.Keccak256(data), signature)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPass, crypto.PubkeyToAddress(*pubKey) == from)
+
+			to := tests.GenerateAddress()
+			gas := hexutil.Uint64(21000)
+			nonce := hexutil.Uint64(1)
+			args := evmtypes.TransactionArgs{
+				From:     &from,
+				To:       &to,
+				GasPrice: (*hexutil.Big)(big.NewInt(1)),
+				Gas:      &gas,
+				Nonce:    &nonce,
+				ChainID:  (*hexutil.Big)(suite.backend.chainID),
+			}
+			tx := args.ToTransaction().AsTransaction()
+			signer := ethtypes.LatestSignerForChainID(suite.backend.chainID)
+
+			signed, err := suite.backend.signTxExternally(url, args, tx, signer)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(signer.Hash(tx), signer.Hash(signed))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestExternalSignerUnavailable() {
+	from := tests.GenerateAddress()
+	suite.backend.cfg.JSONRPC.ExternalSigners = []string{fmt.Sprintf("%s=http://127.0.0.1:1", from.Hex())}
+
+	_, err := suite.backend.Sign(from, hexutil.Bytes("data"))
+	suite.Require().Error(err)
+}
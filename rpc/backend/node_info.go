@@ -18,6 +18,7 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -41,25 +42,85 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
-// Accounts returns the list of accounts available to this node.
+// Accounts returns the list of accounts available to this node, sorted by key name and
+// excluding the hidden accounts.
 func (b *Backend) Accounts() ([]common.Address, error) {
-	addresses := make([]common.Address, 0) // return [] instead of nil if empty
+	accounts, err := b.KeyringAccounts()
+	if err != nil {
+		return []common.Address{}, err
+	}
+
+	addresses := make([]common.Address, 0, len(accounts)) // return [] instead of nil if empty
+	for _, account := range accounts {
+		if !account.Hidden {
+			addresses = append(addresses, account.Address)
+		}
+	}
+
+	externals, err := b.externalSignerAddresses()
+	if err != nil {
+		return []common.Address{}, err
+	}
+	for _, address := range externals {
+		if !containsAddress(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses, nil
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
 
-	infos, err := b.clientCtx.Keyring.List()
+// KeyringAccounts returns the accounts of the keyring with their metadata, sorted by key name.
+// The hidden accounts are included and flagged.
+func (b *Backend) KeyringAccounts() ([]rpctypes.KeyringAccount, error) {
+	records, err := b.clientCtx.Keyring.List()
 	if err != nil {
-		return addresses, err
+		return nil, err
 	}
 
-	for _, info := range infos {
-		pubKey, err := info.GetPubKey()
+	hidden := make(map[string]bool, len(b.cfg.JSONRPC.HiddenAccounts))
+	for _, account := range b.cfg.JSONRPC.HiddenAccounts {
+		if common.IsHexAddress(account) {
+			account = common.HexToAddress(account).Hex()
+		}
+		hidden[account] = true
+	}
+
+	accounts := make([]rpctypes.KeyringAccount, 0, len(records))
+	for _, record := range records {
+		pubKey, err := record.GetPubKey()
 		if err != nil {
 			return nil, err
 		}
-		addressBytes := pubKey.Address().Bytes()
-		addresses = append(addresses, common.BytesToAddress(addressBytes))
+
+		address := common.BytesToAddress(pubKey.Address().Bytes())
+		account := rpctypes.KeyringAccount{
+			Name:      record.Name,
+			Address:   address,
+			Type:      record.GetType().String(),
+			PublicKey: pubKey.Bytes(),
+			Hidden:    hidden[record.Name] || hidden[address.Hex()],
+		}
+		if ledger := record.GetLedger(); ledger != nil && ledger.Path != nil {
+			account.DerivationPath = ledger.Path.String()
+		}
+		accounts = append(accounts, account)
 	}
 
-	return addresses, nil
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Name < accounts[j].Name
+	})
+
+	return accounts, nil
 }
 
 // Syncing returns false in case the node is currently not syncing with the network. It can be up to date or has not
@@ -229,22 +290,7 @@ func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error)
 
 // ListAccounts will return a list of addresses for accounts this node manages.
 func (b *Backend) ListAccounts() ([]common.Address, error) {
-	addrs := []common.Address{}
-
-	list, err := b.clientCtx.Keyring.List()
-	if err != nil {
-		return nil, err
-	}
-
-	for _, info := range list {
-		pubKey, err := info.GetPubKey()
-		if err != nil {
-			return nil, err
-		}
-		addrs = append(addrs, common.BytesToAddress(pubKey.Address()))
-	}
-
-	return addrs, nil
+	return b.Accounts()
 }
 
 // NewAccount will create a new account and returns the address for the new account.
@@ -261,7 +307,8 @@ func (b *Backend) NewMnemonic(uid string,
 	return info, err
 }
 
-// SetGasPrice sets the minimum accepted gas price for the miner.
+// SetGasPrice sets the minimum accepted gas price for the miner. The node still scales it with its
+// adaptive multiplier when the blocks are full.
 // NOTE: this function accepts only integers to have the same interface than go-eth
 // to use float values, the gas prices must be configured using the configuration file
 func (b *Backend) SetGasPrice(gasPrice hexutil.Big) bool {
@@ -290,7 +337,10 @@ func (b *Backend) SetGasPrice(gasPrice hexutil.Big) bool {
 
 	appConf.SetMinGasPrices(sdk.DecCoins{c})
 	sdkconfig.WriteConfigFile(b.clientCtx.Viper.ConfigFileUsed(), appConf)
-	b.logger.Info("Your configuration file was modified. Please RESTART your node.", "gas-price", c.String())
+	b.logger.Info(
+		"Your configuration file was modified. Please RESTART your node.",
+		"gas-price", c.String(), "adaptive-multiplier", b.MinGasPriceMultiplier().String(),
+	)
 	return true
 }
 
@@ -315,6 +365,14 @@ func (b *Backend) RPCTxFeeCap() float64 {
 	return b.cfg.JSONRPC.TxFeeCap
 }
 
+// RPCEstimateGasMultiplier is the multiplier applied to the gas estimations.
+func (b *Backend) RPCEstimateGasMultiplier() float64 {
+	if b.cfg.JSONRPC.EstimateGasMultiplier == 0 {
+		return config.DefaultEstimateGasMultiplier
+	}
+	return b.cfg.JSONRPC.EstimateGasMultiplier
+}
+
 // RPCFilterCap is the limit for total number of filters that can be created
 func (b *Backend) RPCFilterCap() int32 {
 	return b.cfg.JSONRPC.FilterCap
@@ -335,6 +393,26 @@ func (b *Backend) RPCBlockRangeCap() int32 {
 	return b.cfg.JSONRPC.BlockRangeCap
 }
 
+// RPCConfirmationDepth defines the number of blocks on top of a block before its events are emitted
+// by the filters and the subscriptions.
+func (b *Backend) RPCConfirmationDepth() uint64 {
+	return b.cfg.JSONRPC.ConfirmationDepth
+}
+
+// RPCLimits returns the limits of the JSON-RPC server set in the node config.
+func (b *Backend) RPCLimits() rpctypes.RPCLimits {
+	return rpctypes.RPCLimits{
+		GasCap:            hexutil.Uint64(b.RPCGasCap()),
+		EVMTimeout:        b.RPCEVMTimeout().String(),
+		TxFeeCap:          b.RPCTxFeeCap(),
+		FilterCap:         b.RPCFilterCap(),
+		FeeHistoryCap:     b.RPCFeeHistoryCap(),
+		LogsCap:           b.RPCLogsCap(),
+		BlockRangeCap:     b.RPCBlockRangeCap(),
+		ConfirmationDepth: hexutil.Uint64(b.RPCConfirmationDepth()),
+	}
+}
+
 // RPCMinGasPrice returns the minimum gas price for a transaction obtained from
 // the node config. If set value is 0, it will default to 20.
 
@@ -344,11 +422,25 @@ func (b *Backend) RPCMinGasPrice() int64 {
 		return ethermint.DefaultGasPrice
 	}
 
-	minGasPrice := b.cfg.GetMinGasPrices()
-	amt := minGasPrice.AmountOf(evmParams.Params.EvmDenom).TruncateInt64()
+	minGasPrice := b.cfg.GetMinGasPrices().AmountOf(evmParams.Params.GasFeeDenom())
+	if evmParams.Params.IsDualGasToken() {
+		// convert the min gas price of the fee denom to the evm denom
+		minGasPrice = minGasPrice.Quo(evmParams.Params.FeeConversionRate)
+	}
+	amt := minGasPrice.TruncateInt64()
 	if amt == 0 {
 		return ethermint.DefaultGasPrice
 	}
 
 	return amt
 }
+
+// MinGasPriceMultiplier returns the node local multiplier of the min gas price, adjusted with the
+// fullness of the recent blocks. It defaults to 1 when the query fails.
+func (b *Backend) MinGasPriceMultiplier() sdk.Dec {
+	res, err := b.queryClient.MinGasPriceMultiplier(b.ctx, &evmtypes.QueryMinGasPriceMultiplierRequest{})
+	if err != nil || res.Multiplier.IsNil() || !res.Multiplier.IsPositive() {
+		return sdk.OneDec()
+	}
+	return res.Multiplier
+}
@@ -3,12 +3,15 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/crypto/hd"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/spf13/viper"
@@ -160,6 +163,72 @@ func (suite *BackendTestSuite) TestAccounts() {
 	}
 }
 
+func (suite *BackendTestSuite) TestKeyringAccounts() {
+	testCases := []struct {
+		name      string
+		hidden    func(bob common.Address) []string
+		expNames  []string
+		expHidden []bool
+	}{
+		{
+			"pass - sorted by key name",
+			func(common.Address) []string { return nil },
+			[]string{"alice", "bob", "carol"},
+			[]bool{false, false, false},
+		},
+		{
+			"pass - hidden by name",
+			func(common.Address) []string { return []string{"carol"} },
+			[]string{"alice", "bob", "carol"},
+			[]bool{false, false, true},
+		},
+		{
+			"pass - hidden by address",
+			func(bob common.Address) []string { return []string{strings.ToLower(bob.Hex())} },
+			[]string{"alice", "bob", "carol"},
+			[]bool{false, true, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+
+			addrs := make(map[string]common.Address)
+			for _, name := range []string{"carol", "alice", "bob"} {
+				_, err := suite.backend.NewMnemonic(name, keyring.English, ethermint.BIP44HDPath, "", hd.EthSecp256k1)
+				suite.Require().NoError(err)
+				record, err := suite.backend.clientCtx.Keyring.Key(name)
+				suite.Require().NoError(err)
+				addr, err := record.GetAddress()
+				suite.Require().NoError(err)
+				addrs[name] = common.BytesToAddress(addr)
+			}
+			suite.backend.cfg.JSONRPC.HiddenAccounts = tc.hidden(addrs["bob"])
+
+			accounts, err := suite.backend.KeyringAccounts()
+			suite.Require().NoError(err)
+			suite.Require().Len(accounts, len(tc.expNames))
+
+			expAddrs := []common.Address{}
+			for i, account := range accounts {
+				suite.Require().Equal(tc.expNames[i], account.Name)
+				suite.Require().Equal(addrs[account.Name], account.Address)
+				suite.Require().Equal(tc.expHidden[i], account.Hidden)
+				suite.Require().Equal("local", account.Type)
+				suite.Require().NotEmpty(account.PublicKey)
+				if !tc.expHidden[i] {
+					expAddrs = append(expAddrs, account.Address)
+				}
+			}
+
+			output, err := suite.backend.Accounts()
+			suite.Require().NoError(err)
+			suite.Require().Equal(expAddrs, output)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestSyncing() {
 	testCases := []struct {
 		name         string
@@ -0,0 +1,134 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// nonceGapQueueLifetime is the max time a future-nonce transaction waits in the local queue for
+	// its nonce gap to be filled.
+	nonceGapQueueLifetime = 30 * time.Minute
+	// nonceGapMaxSenders is the max number of senders with queued future-nonce transactions.
+	nonceGapMaxSenders = 1024
+)
+
+// queuedTx is a future-nonce transaction waiting in the local queue.
+type queuedTx struct {
+	hash    common.Hash
+	txBytes []byte
+	added   time.Time
+}
+
+// nonceGapQueue holds the future-nonce transactions of each sender until the nonce gap is filled,
+// for the senders broadcasting sequences of transactions out of order. It's local to the node, the
+// consensus rules still require the transactions to be executed in nonce order.
+type nonceGapQueue struct {
+	mu        sync.Mutex
+	tolerance uint64
+	txs       map[common.Address]map[uint64]queuedTx
+}
+
+// newNonceGapQueue returns a queue accepting the transactions whose nonce is at most tolerance
+// above the pending nonce of their sender, it returns nil if the tolerance is 0.
+func newNonceGapQueue(tolerance uint64) *nonceGapQueue {
+	if tolerance == 0 {
+		return nil
+	}
+	return &nonceGapQueue{
+		tolerance: tolerance,
+		txs:       make(map[common.Address]map[uint64]queuedTx),
+	}
+}
+
+// add queues the transaction if its nonce is within the tolerance above the pending nonce of the
+// sender, replacing a queued transaction with the same nonce. It returns false if the transaction
+// isn't queued.
+func (q *nonceGapQueue) add(sender common.Address, nonce, pendingNonce uint64, tx queuedTx) bool {
+	if nonce <= pendingNonce || nonce-pendingNonce > q.tolerance {
+		return false
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	senderTxs, found := q.txs[sender]
+	if !found {
+		if len(q.txs) >= nonceGapMaxSenders {
+			q.pruneExpired(tx.added)
+		}
+		if len(q.txs) >= nonceGapMaxSenders {
+			return false
+		}
+		senderTxs = make(map[uint64]queuedTx)
+		q.txs[sender] = senderTxs
+	}
+
+	// drop the transactions made stale by the pending nonce, the nonces being within the tolerance
+	// bounds the number of queued transactions per sender.
+	for queuedNonce, queued := range senderTxs {
+		if queuedNonce < pendingNonce || tx.added.Sub(queued.added) > nonceGapQueueLifetime {
+			delete(senderTxs, queuedNonce)
+		}
+	}
+	senderTxs[nonce] = tx
+	return true
+}
+
+// pruneExpired drops the transactions queued for longer than the queue lifetime.
+func (q *nonceGapQueue) pruneExpired(now time.Time) {
+	for sender, senderTxs := range q.txs {
+		for nonce, queued := range senderTxs {
+			if now.Sub(queued.added) > nonceGapQueueLifetime {
+				delete(senderTxs, nonce)
+			}
+		}
+		if len(senderTxs) == 0 {
+			delete(q.txs, sender)
+		}
+	}
+}
+
+// pop removes and returns the queued transaction of the sender with the given nonce.
+func (q *nonceGapQueue) pop(sender common.Address, nonce uint64) (queuedTx, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	senderTxs := q.txs[sender]
+	tx, found := senderTxs[nonce]
+	if !found {
+		return queuedTx{}, false
+	}
+	delete(senderTxs, nonce)
+	if len(senderTxs) == 0 {
+		delete(q.txs, sender)
+	}
+	if time.Since(tx.added) > nonceGapQueueLifetime {
+		return queuedTx{}, false
+	}
+	return tx, true
+}
+
+// len returns the number of queued transactions of the sender.
+func (q *nonceGapQueue) len(sender common.Address) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.txs[sender])
+}
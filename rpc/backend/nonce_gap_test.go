@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestNonceGapQueue() {
+	suite.Require().Nil(newNonceGapQueue(0))
+
+	q := newNonceGapQueue(2)
+	sender := tests.GenerateAddress()
+	now := time.Now()
+
+	suite.Require().False(q.add(sender, 5, 5, queuedTx{added: now}), "pending nonce")
+	suite.Require().False(q.add(sender, 8, 5, queuedTx{added: now}), "beyond the tolerance")
+	suite.Require().True(q.add(sender, 6, 5, queuedTx{hash: common.HexToHash("0x1"), added: now}))
+	suite.Require().True(q.add(sender, 7, 5, queuedTx{added: now}))
+	// replacement of the same nonce
+	suite.Require().True(q.add(sender, 6, 5, queuedTx{hash: common.HexToHash("0x2"), added: now}))
+	suite.Require().Equal(2, q.len(sender))
+
+	// the pending nonce moved on, the stale transaction is dropped
+	suite.Require().True(q.add(sender, 8, 7, queuedTx{added: now}))
+	suite.Require().Equal(2, q.len(sender))
+
+	_, found := q.pop(sender, 6)
+	suite.Require().False(found)
+	tx, found := q.pop(sender, 7)
+	suite.Require().True(found)
+	suite.Require().Equal(now, tx.added)
+	_, found = q.pop(sender, 7)
+	suite.Require().False(found)
+
+	// the expired transactions aren't returned
+	expired := now.Add(-2 * nonceGapQueueLifetime)
+	suite.Require().True(q.add(sender, 10, 9, queuedTx{added: expired}))
+	_, found = q.pop(sender, 10)
+	suite.Require().False(found)
+}
+
+func (suite *BackendTestSuite) TestSendRawTransactionPromotesQueuedTxs() {
+	from, priv := tests.NewAddrKey()
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(suite.backend.chainID)
+
+	msg := evmtypes.NewTx(suite.backend.chainID, 0, &common.Address{}, big.NewInt(0), 100000, big.NewInt(1), nil, nil, nil, nil)
+	msg.From = from.Hex()
+	suite.Require().NoError(msg.Sign(ethSigner, signer))
+	rawTx, err := msg.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
+
+	// the tx bytes built by SendRawTransaction
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+	suite.Require().NoError(ethereumTx.FromEthereumTx(msg.AsTransaction()))
+	txBytes, err := ethereumTx.BuildTxBytes(suite.backend.clientCtx.TxConfig, "aphoton")
+	suite.Require().NoError(err)
+
+	suite.backend.allowUnprotectedTxs = true
+	suite.backend.nonceGapQueue = newNonceGapQueue(2)
+	queued := []byte("queued tx")
+	suite.Require().True(suite.backend.nonceGapQueue.add(from, 1, 0, queuedTx{txBytes: queued, added: time.Now()}))
+	suite.Require().True(suite.backend.nonceGapQueue.add(from, 2, 0, queuedTx{txBytes: queued, added: time.Now()}))
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	RegisterBroadcastTx(client, txBytes)
+	RegisterBroadcastTx(client, tmtypes.Tx(queued))
+
+	hash, err := suite.backend.SendRawTransaction(rawTx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(msg.AsTransaction().Hash(), hash)
+	suite.Require().Equal(0, suite.backend.nonceGapQueue.len(from))
+	client.AssertNumberOfCalls(suite.T(), "BroadcastTxSync", 3)
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// pendingSignaturesSize is the max number of transactions waiting for the signature of an
+// asynchronous signer, the oldest ones are forgotten.
+const pendingSignaturesSize = 1000
+
+// pendingTx is a transaction of eth_sendTransaction waiting for the signature of its signing request.
+type pendingTx struct {
+	msg       *evmtypes.MsgEthereumTx
+	ethSigner ethtypes.Signer
+	signer    evmtypes.AsyncSigner
+}
+
+// pendingSignatures are the transactions waiting for a signature by signing request id, shared by
+// the backends of all the namespaces.
+var pendingSignatures = newPendingTxs()
+
+func newPendingTxs() *lru.Cache {
+	txs, err := lru.New(pendingSignaturesSize)
+	if err != nil {
+		panic(err)
+	}
+	return txs
+}
+
+// addPendingSignature keeps the transaction until the signature of the request is produced, it
+// returns the pending signature error to be returned to the client.
+func (b *Backend) addPendingSignature(
+	pending *evmtypes.PendingSignatureError, msg *evmtypes.MsgEthereumTx, ethSigner ethtypes.Signer, signer evmtypes.Signer,
+) error {
+	asyncSigner, ok := signer.(evmtypes.AsyncSigner)
+	if !ok {
+		return fmt.Errorf("signer %T returned a pending signature but it isn't asynchronous", signer)
+	}
+
+	pendingSignatures.Add(pending.RequestID, &pendingTx{msg: msg, ethSigner: ethSigner, signer: asyncSigner})
+	b.logger.Info("transaction pending signature", "request", pending.RequestID, "from", common.BytesToAddress(msg.GetFrom()))
+	return pending
+}
+
+// SendPendingTransaction broadcasts the transaction of eth_sendTransaction once the signature of its
+// signing request is produced by the asynchronous signer. It returns a pending signature error while
+// the signature isn't produced yet.
+func (b *Backend) SendPendingTransaction(requestID string) (common.Hash, error) {
+	// the transaction is taken out so that it's broadcasted once
+	value, ok := pendingSignatures.Peek(requestID)
+	if !ok || !pendingSignatures.Remove(requestID) {
+		return common.Hash{}, fmt.Errorf("no transaction pending signature for the signing request %s", requestID)
+	}
+	pending := value.(*pendingTx)
+
+	sig, err := pending.signer.Signature(b.ctx, requestID)
+	if err != nil {
+		pendingSignatures.Add(requestID, pending)
+		if errors.Is(err, evmtypes.ErrSignaturePending) {
+			return common.Hash{}, &evmtypes.PendingSignatureError{RequestID: requestID}
+		}
+		return common.Hash{}, err
+	}
+
+	if err := pending.msg.ApplySignature(pending.ethSigner, sig); err != nil {
+		b.logger.Error("invalid signature of the signing request", "request", requestID, "error", err.Error())
+		return common.Hash{}, err
+	}
+	return b.broadcastEthereumTx(pending.msg)
+}
@@ -0,0 +1,120 @@
+package backend
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const asyncSignerName = "backend-test-async"
+
+// asyncSigner signs the requests with the wrapped signer once they're approved.
+type asyncSigner struct {
+	mtx      sync.Mutex
+	signer   evmtypes.Signer
+	from     common.Address
+	requests map[string]common.Hash
+	approved map[string]bool
+}
+
+var (
+	testAsyncSigner   = &asyncSigner{}
+	registerAsyncOnce sync.Once
+)
+
+func (s *asyncSigner) reset(signer evmtypes.Signer) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.signer = signer
+	s.requests = make(map[string]common.Hash)
+	s.approved = make(map[string]bool)
+}
+
+func (s *asyncSigner) SignHash(_ context.Context, address common.Address, hash common.Hash) ([]byte, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.from = address
+	s.requests[hash.Hex()] = hash
+	return nil, &evmtypes.PendingSignatureError{RequestID: hash.Hex()}
+}
+
+func (s *asyncSigner) Signature(ctx context.Context, requestID string) ([]byte, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if !s.approved[requestID] {
+		return nil, evmtypes.ErrSignaturePending
+	}
+	return s.signer.SignHash(ctx, s.from, s.requests[requestID])
+}
+
+func (suite *BackendTestSuite) TestSendPendingTransaction() {
+	registerAsyncOnce.Do(func() {
+		suite.Require().NoError(evmtypes.RegisterSigner(asyncSignerName, testAsyncSigner))
+	})
+
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	to := tests.GenerateAddress()
+	gas := hexutil.Uint64(1)
+	nonce := hexutil.Uint64(1)
+	args := evmtypes.TransactionArgs{
+		From:     &from,
+		To:       &to,
+		GasPrice: new(hexutil.Big),
+		Gas:      &gas,
+		Nonce:    &nonce,
+	}
+
+	// the keys of the signer aren't in the node keyring
+	kr := suite.backend.clientCtx.Keyring
+	suite.Require().NoError(kr.ImportPrivKey("mpc_key", crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1"), ""))
+	testAsyncSigner.reset(evmtypes.NewKeyringSigner(kr))
+	suite.backend.cfg.JSONRPC.TxSigner = asyncSignerName
+	suite.backend.clientCtx = suite.backend.clientCtx.WithKeyring(nil)
+
+	var header metadata.MD
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterParams(queryClient, &header, 1)
+	RegisterBlock(client, 1, nil)
+	RegisterBlockResults(client, 1)
+	RegisterBaseFee(queryClient, sdk.NewInt(1))
+	RegisterParamsWithoutHeader(queryClient, 1)
+
+	_, err := suite.backend.SendTransaction(args)
+	suite.Require().ErrorIs(err, evmtypes.ErrSignaturePending)
+	requestID := err.(*evmtypes.PendingSignatureError).RequestID
+
+	// the transaction stays pending until the request is signed
+	_, err = suite.backend.SendPendingTransaction(requestID)
+	suite.Require().ErrorIs(err, evmtypes.ErrSignaturePending)
+	_, err = suite.backend.SendPendingTransaction("unknown")
+	suite.Require().Error(err)
+	suite.Require().NotErrorIs(err, evmtypes.ErrSignaturePending)
+
+	msg := args.ToTransaction()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSigner(suite.backend.ChainConfig()), kr))
+	tx, _ := msg.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	RegisterBroadcastTx(client, txBytes)
+
+	testAsyncSigner.approved[requestID] = true
+	hash, err := suite.backend.SendPendingTransaction(requestID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(msg.AsTransaction().Hash(), hash)
+
+	// the transaction is broadcasted once
+	_, err = suite.backend.SendPendingTransaction(requestID)
+	suite.Require().Error(err)
+}
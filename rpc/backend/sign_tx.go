@@ -26,7 +26,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
@@ -35,11 +34,23 @@ import (
 
 // SendTransaction sends transaction based on received args using Node's key to sign it
 func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
+	externalSigner, err := b.externalSignerURL(args.GetFrom())
 	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+		return common.Hash{}, err
+	}
+
+	txSigner, err := evmtypes.GetSigner(b.cfg.JSONRPC.TxSigner, b.clientCtx.Keyring)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// Look up the wallet containing the requested signer, unless the account is signed externally
+	if externalSigner == "" && (b.cfg.JSONRPC.TxSigner == "" || b.cfg.JSONRPC.TxSigner == evmtypes.KeyringSignerName) {
+		_, err = b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
+		if err != nil {
+			b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
+			return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+		}
 	}
 
 	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
@@ -63,14 +74,33 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
-	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
+	// the transaction is included in the next block
+	signer := evmtypes.MakeSigner(b.ChainConfig(), int64(bn)+1)
 
 	// Sign transaction
-	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
+	if externalSigner != "" {
+		signed, err := b.signTxExternally(externalSigner, args, msg.AsTransaction(), signer)
+		if err != nil {
+			b.logger.Error("failed to sign tx with the external signer", "address", args.GetFrom(), "error", err.Error())
+			return common.Hash{}, err
+		}
+		if err := msg.FromEthereumTx(signed); err != nil {
+			return common.Hash{}, err
+		}
+	} else if err := msg.SignWith(b.ctx, signer, txSigner); err != nil {
+		var pending *evmtypes.PendingSignatureError
+		if errors.As(err, &pending) {
+			return common.Hash{}, b.addPendingSignature(pending, msg, signer, txSigner)
+		}
 		b.logger.Debug("failed to sign tx", "error", err.Error())
 		return common.Hash{}, err
 	}
 
+	return b.broadcastEthereumTx(msg)
+}
+
+// broadcastEthereumTx broadcasts the signed transaction of eth_sendTransaction.
+func (b *Backend) broadcastEthereumTx(msg *evmtypes.MsgEthereumTx) (common.Hash, error) {
 	// Query params to use the EVM denomination
 	res, err := b.queryClient.QueryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
 	if err != nil {
@@ -112,7 +142,7 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 	}
 	if err != nil {
 		b.logger.Error("failed to broadcast tx", "error", err.Error())
-		return txHash, err
+		return txHash, b.translateTxError(ethTx, err)
 	}
 
 	// Return transaction hash
@@ -121,9 +151,17 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 
 // Sign signs the provided data using the private key of address via Geth's signature standard.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	externalSigner, err := b.externalSignerURL(address)
+	if err != nil {
+		return nil, err
+	}
+	if externalSigner != "" {
+		return b.signExternally(externalSigner, "eth_sign", address, data)
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+	_, err = b.clientCtx.Keyring.KeyByAddress(from)
 	if err != nil {
 		b.logger.Error("failed to find key in keyring", "address", address.String())
 		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
@@ -142,9 +180,17 @@ func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Byte
 
 // SignTypedData signs EIP-712 conformant typed data
 func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	externalSigner, err := b.externalSignerURL(address)
+	if err != nil {
+		return nil, err
+	}
+	if externalSigner != "" {
+		return b.signExternally(externalSigner, "eth_signTypedData", address, typedData)
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+	_, err = b.clientCtx.Keyring.KeyByAddress(from)
 	if err != nil {
 		b.logger.Error("failed to find key in keyring", "address", address.String())
 		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
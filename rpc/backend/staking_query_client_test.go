@@ -0,0 +1,22 @@
+package backend
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpc "github.com/evmos/ethermint/rpc/types"
+)
+
+var _ stakingtypes.QueryClient = &mocks.StakingQueryClient{}
+
+// Validator
+func RegisterStakingValidator(stakingClient *mocks.StakingQueryClient, validator stakingtypes.Validator) {
+	stakingClient.On("Validator", rpc.ContextWithHeight(1), &stakingtypes.QueryValidatorRequest{ValidatorAddr: validator.OperatorAddress}).
+		Return(&stakingtypes.QueryValidatorResponse{Validator: validator}, nil)
+}
+
+func RegisterStakingValidatorError(stakingClient *mocks.StakingQueryClient, operator sdk.ValAddress) {
+	stakingClient.On("Validator", rpc.ContextWithHeight(1), &stakingtypes.QueryValidatorRequest{ValidatorAddr: operator.String()}).
+		Return(nil, sdkerrors.ErrNotFound)
+}
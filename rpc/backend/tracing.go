@@ -16,8 +16,11 @@
 package backend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -30,7 +33,11 @@ import (
 
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+func (b *Backend) TraceTransaction(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+	if err := b.checkCustomTracer(config); err != nil {
+		return nil, err
+	}
+
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(hash)
 	if err != nil {
@@ -103,9 +110,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		ChainId:         b.chainID.Int64(),
 	}
 
-	if config != nil {
-		traceTxRequest.TraceConfig = config
-	}
+	traceTxRequest.TraceConfig = b.applyTraceLimits(config)
 
 	// minus one to get the context of block beginning
 	contextHeight := transaction.Height - 1
@@ -113,7 +118,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
-	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
+	traceResult, err := b.queryClient.TraceTx(rpctypes.QueryContext(ctx, contextHeight), &traceTxRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -126,13 +131,14 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		return nil, err
 	}
 
+	b.annotateTrace(config, decodedResult)
 	return decodedResult, nil
 }
 
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
-func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
+func (b *Backend) TraceBlock(ctx context.Context, height rpctypes.BlockNumber,
 	config *evmtypes.TraceConfig,
 	block *tmrpctypes.ResultBlock,
 ) ([]*evmtypes.TxTraceResult, error) {
@@ -172,17 +178,21 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		}
 	}
 
+	if err := b.checkCustomTracer(config); err != nil {
+		return nil, err
+	}
+
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
 	if contextHeight < 1 {
 		// 0 is a special value for `ContextWithHeight`.
 		contextHeight = 1
 	}
-	ctxWithHeight := rpctypes.ContextWithHeight(int64(contextHeight))
+	ctxWithHeight := rpctypes.QueryContext(ctx, int64(contextHeight))
 
 	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
 		Txs:             txsMessages,
-		TraceConfig:     config,
+		TraceConfig:     b.applyTraceLimits(config),
 		BlockNumber:     block.Block.Height,
 		BlockTime:       block.Block.Time,
 		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
@@ -200,5 +210,151 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return nil, err
 	}
 
+	for _, result := range decodedResults {
+		if result != nil {
+			b.annotateTrace(config, result.Result)
+		}
+	}
+
 	return decodedResults, nil
 }
+
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the requested block, with the
+// optional state overrides applied. The return value is dependent on the requested tracer.
+func (b *Backend) TraceCall(
+	ctx context.Context,
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	var traceConfig *evmtypes.TraceConfig
+	if config != nil {
+		traceConfig = &config.TraceConfig
+	}
+	if err := b.checkCustomTracer(traceConfig); err != nil {
+		return nil, err
+	}
+
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		TraceConfig:     b.applyTraceLimits(traceConfig),
+	}
+
+	if config != nil && config.StateOverrides != nil {
+		if traceCallRequest.Overrides, err = json.Marshal(config.StateOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.QueryContext(ctx, blockNr.Int64()), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	b.annotateTrace(traceConfig, decodedResult)
+	return decodedResult, nil
+}
+
+// MethodSignature returns the human-readable signature of the function called by the call data,
+// if the signature decoding is enabled on the node.
+func (b *Backend) MethodSignature(input []byte) (string, bool) {
+	if b.signatures == nil {
+		return "", false
+	}
+	return b.signatures.Lookup(input)
+}
+
+// annotateTrace sets the signatures of the called functions in the call frames of the
+// tracer output, the default struct logger output having no call frame.
+func (b *Backend) annotateTrace(config *evmtypes.TraceConfig, result interface{}) {
+	if b.signatures == nil || config == nil || config.Tracer == "" {
+		return
+	}
+	b.signatures.AnnotateCallFrames(result)
+}
+
+// applyTraceLimits caps the capture limits of the trace config with the global
+// limits of the node. A nil config is kept as is if no global limit is set.
+func (b *Backend) applyTraceLimits(config *evmtypes.TraceConfig) *evmtypes.TraceConfig {
+	cfg := b.cfg.JSONRPC
+	if config == nil {
+		if cfg.TraceMaxMemorySize == 0 && cfg.TraceMaxStackSize == 0 &&
+			cfg.TraceMaxStorageSize == 0 && cfg.TraceMaxReturnDataSize == 0 {
+			return nil
+		}
+		config = &evmtypes.TraceConfig{}
+	}
+
+	config.MaxMemorySize = capTraceLimit(config.MaxMemorySize, cfg.TraceMaxMemorySize)
+	config.MaxStackSize = capTraceLimit(config.MaxStackSize, cfg.TraceMaxStackSize)
+	config.MaxStorageSize = capTraceLimit(config.MaxStorageSize, cfg.TraceMaxStorageSize)
+	config.MaxReturnDataSize = capTraceLimit(config.MaxReturnDataSize, cfg.TraceMaxReturnDataSize)
+	return config
+}
+
+// capTraceLimit returns the requested limit capped by the global one, zero meaning unlimited.
+func capTraceLimit(limit, globalLimit uint64) uint64 {
+	if globalLimit > 0 && (limit == 0 || limit > globalLimit) {
+		return globalLimit
+	}
+	return limit
+}
+
+// namedTracerRegex matches the names of the tracers built in the node, any other
+// tracer is user supplied JavaScript code.
+var namedTracerRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// checkCustomTracer rejects the user supplied JavaScript tracers unless they are
+// enabled on the node, and caps their execution time.
+func (b *Backend) checkCustomTracer(config *evmtypes.TraceConfig) error {
+	if config == nil || config.Tracer == "" || namedTracerRegex.MatchString(config.Tracer) {
+		return nil
+	}
+
+	if !b.cfg.JSONRPC.EnableUnsafeJSTracers {
+		return errors.New("custom JavaScript tracers are disabled, enable them with the 'json-rpc.enable-unsafe-js-tracers' option")
+	}
+
+	maxTimeout := b.cfg.JSONRPC.JSTracerTimeout
+	if maxTimeout == 0 {
+		return nil
+	}
+
+	if config.Timeout != "" {
+		timeout, err := time.ParseDuration(config.Timeout)
+		if err != nil {
+			return errors.Wrap(err, "invalid tracer timeout")
+		}
+		if timeout <= maxTimeout {
+			return nil
+		}
+	}
+
+	config.Timeout = maxTimeout.String()
+	return nil
+}
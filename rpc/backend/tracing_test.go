@@ -1,14 +1,19 @@
 package backend
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/ethermint/indexer"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmlog "github.com/tendermint/tendermint/libs/log"
@@ -182,7 +187,7 @@ func (suite *BackendTestSuite) TestTraceTransaction() {
 
 			err := suite.backend.indexer.IndexBlock(tc.block, tc.responseBlock)
 			suite.Require().NoError(err)
-			txResult, err := suite.backend.TraceTransaction(txHash, nil)
+			txResult, err := suite.backend.TraceTransaction(context.Background(), txHash, nil)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -239,7 +244,7 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			traceResults, err := suite.backend.TraceBlock(1, tc.config, tc.resBlock)
+			traceResults, err := suite.backend.TraceBlock(context.Background(), 1, tc.config, tc.resBlock)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -250,3 +255,220 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	from := common.BytesToAddress(suite.acc)
+	to := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	callArgs := evmtypes.TransactionArgs{From: &from, To: &to}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	code := hexutil.Bytes{0x00}
+	overrides := rpctypes.StateOverride{to: {Code: &code}}
+	overridesBz, err := json.Marshal(&overrides)
+	suite.Require().NoError(err)
+
+	blockNr := rpctypes.BlockNumber(1)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}
+
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		config         *rpctypes.TraceCallConfig
+		expTraceResult interface{}
+		expPass        bool
+	}{
+		{
+			"fail - custom JavaScript tracer disabled",
+			func() {},
+			&rpctypes.TraceCallConfig{TraceConfig: evmtypes.TraceConfig{Tracer: "{}"}},
+			nil,
+			false,
+		},
+		{
+			"pass - without config",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz, ChainId: 9000})
+			},
+			nil,
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+		{
+			"pass - with state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBlock(client, 1, nil)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:        argsBz,
+					ChainId:     9000,
+					Overrides:   overridesBz,
+					TraceConfig: &evmtypes.TraceConfig{},
+				})
+			},
+			&rpctypes.TraceCallConfig{StateOverrides: &overrides},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			traceResult, err := suite.backend.TraceCall(context.Background(), callArgs, blockNrOrHash, tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTraceResult, traceResult)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestApplyTraceLimits() {
+	testCases := []struct {
+		name      string
+		limit     uint64
+		config    *evmtypes.TraceConfig
+		expConfig *evmtypes.TraceConfig
+	}{
+		{
+			"no global limit, nil config",
+			0,
+			nil,
+			nil,
+		},
+		{
+			"no global limit, requested limit kept",
+			0,
+			&evmtypes.TraceConfig{MaxMemorySize: 64},
+			&evmtypes.TraceConfig{MaxMemorySize: 64},
+		},
+		{
+			"global limit, nil config",
+			32,
+			nil,
+			&evmtypes.TraceConfig{MaxMemorySize: 32},
+		},
+		{
+			"global limit caps requested limit",
+			32,
+			&evmtypes.TraceConfig{MaxMemorySize: 64},
+			&evmtypes.TraceConfig{MaxMemorySize: 32},
+		},
+		{
+			"requested limit below global limit",
+			128,
+			&evmtypes.TraceConfig{MaxMemorySize: 64},
+			&evmtypes.TraceConfig{MaxMemorySize: 64},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.TraceMaxMemorySize = tc.limit
+
+			suite.Require().Equal(tc.expConfig, suite.backend.applyTraceLimits(tc.config))
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestCheckCustomTracer() {
+	jsTracer := "{data: [], fault: function(log) {}, step: function(log) {}, result: function() { return this.data; }}"
+
+	testCases := []struct {
+		name       string
+		enableJS   bool
+		config     *evmtypes.TraceConfig
+		expTimeout string
+		expPass    bool
+	}{
+		{"nil config", false, nil, "", true},
+		{"struct logger", false, &evmtypes.TraceConfig{}, "", true},
+		{"named tracer", false, &evmtypes.TraceConfig{Tracer: "callTracer"}, "", true},
+		{"custom tracer disabled", false, &evmtypes.TraceConfig{Tracer: jsTracer}, "", false},
+		{"custom tracer, default timeout", true, &evmtypes.TraceConfig{Tracer: jsTracer}, "5s", true},
+		{"custom tracer, timeout below cap", true, &evmtypes.TraceConfig{Tracer: jsTracer, Timeout: "1s"}, "1s", true},
+		{"custom tracer, timeout capped", true, &evmtypes.TraceConfig{Tracer: jsTracer, Timeout: "1m"}, "5s", true},
+		{"custom tracer, invalid timeout", true, &evmtypes.TraceConfig{Tracer: jsTracer, Timeout: "forever"}, "", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.EnableUnsafeJSTracers = tc.enableJS
+			suite.backend.cfg.JSONRPC.JSTracerTimeout = 5 * time.Second
+
+			err := suite.backend.checkCustomTracer(tc.config)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			if tc.config != nil {
+				suite.Require().Equal(tc.expTimeout, tc.config.Timeout)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestAnnotateTrace() {
+	transfer := "0xa9059cbb0000000000000000000000000000000000000000000000000000000000000001"
+	newTrace := func() map[string]interface{} {
+		return map[string]interface{}{
+			"type":  "CALL",
+			"input": transfer,
+			"calls": []interface{}{
+				map[string]interface{}{"type": "CREATE", "input": transfer},
+				map[string]interface{}{"type": "STATICCALL", "input": "0x12345678"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		decode    bool
+		config    *evmtypes.TraceConfig
+		expMethod bool
+	}{
+		{"decoding disabled", false, &evmtypes.TraceConfig{Tracer: "callTracer"}, false},
+		{"struct logger", true, &evmtypes.TraceConfig{}, false},
+		{"call tracer", true, &evmtypes.TraceConfig{Tracer: "callTracer"}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.signatures = nil
+			if tc.decode {
+				suite.backend.signatures = rpctypes.NewSignatureDB()
+			}
+
+			trace := newTrace()
+			suite.backend.annotateTrace(tc.config, trace)
+
+			calls := trace["calls"].([]interface{})
+			suite.Require().NotContains(calls[0], "method")
+			suite.Require().NotContains(calls[1], "method")
+			if !tc.expMethod {
+				suite.Require().NotContains(trace, "method")
+				return
+			}
+			suite.Require().Equal("transfer(address,uint256)", trace["method"])
+
+			signature, ok := suite.backend.MethodSignature(common.FromHex(transfer))
+			suite.Require().True(ok)
+			suite.Require().Equal("transfer(address,uint256)", signature)
+		})
+	}
+}
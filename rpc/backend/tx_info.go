@@ -29,6 +29,7 @@ import (
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
@@ -188,12 +189,8 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	} else {
 		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
 	}
-	chainID, err := b.ChainID()
-	if err != nil {
-		return nil, err
-	}
-
-	from, err := ethMsg.GetSender(chainID.ToInt())
+	ethTx := ethMsg.AsTransaction()
+	from, err := ethtypes.Sender(evmtypes.TxSigner(ethTx), ethTx)
 	if err != nil {
 		return nil, err
 	}
@@ -241,11 +238,18 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(ethTx.Type()),
 	}
 
 	if logs == nil {
-		receipt["logs"] = [][]*ethtypes.Log{}
+		receipt["logs"] = []*ethtypes.Log{}
+	}
+
+	// expose the revert data of reverted txs as an ethermint specific extension field
+	if res.Failed {
+		if revertReason := b.revertReason(blockRes.TxsResults[res.TxIndex], tx, hash); revertReason != nil {
+			receipt["revertReason"] = hexutil.Bytes(revertReason)
+		}
 	}
 
 	// If the ContractAddress is 20 0x0 bytes, assume it is not a contract creation
@@ -266,6 +270,23 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	return receipt, nil
 }
 
+// revertReason returns the revert data of a reverted ethereum tx parsed from the
+// tx result events, nil if the events don't include it.
+func (b *Backend) revertReason(txResult *abci.ResponseDeliverTx, tx sdk.Tx, hash common.Hash) []byte {
+	parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
+	if err != nil {
+		b.logger.Debug("failed to parse tx result", "hash", hash.Hex(), "error", err.Error())
+		return nil
+	}
+
+	parsedTx := parsedTxs.GetTxByHash(hash)
+	if parsedTx == nil {
+		return nil
+	}
+
+	return parsedTx.RevertReason
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (b *Backend) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	b.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -42,22 +43,6 @@ import (
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
 )
 
-type txGasAndReward struct {
-	gasUsed uint64
-	reward  *big.Int
-}
-
-type sortGasAndReward []txGasAndReward
-
-func (s sortGasAndReward) Len() int { return len(s) }
-func (s sortGasAndReward) Swap(i, j int) {
-	s[i], s[j] = s[j], s[i]
-}
-
-func (s sortGasAndReward) Less(i, j int) bool {
-	return s[i].reward.Cmp(s[j].reward) < 0
-}
-
 // getAccountNonce returns the account nonce for the given account address.
 // If the pending value is true, it will iterate over the mempool (pending)
 // txs in order to compute and return the pending tx sequence.
@@ -94,76 +79,83 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 		return nonce, nil
 	}
 
-	// add the uncommitted txs to the nonce counter
-	// only supports `MsgEthereumTx` style tx
+	// add the uncommitted txs to the nonce counter, the ethereum and cosmos txs signed by the account
+	// share its sequence
 	for _, tx := range pendingTxs {
-		for _, msg := range (*tx).GetMsgs() {
-			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
-			if !ok {
-				// not ethereum tx
-				break
-			}
+		nonce += pendingSequences(*tx, accAddr)
+	}
 
-			sender, err := ethMsg.GetSender(b.chainID)
-			if err != nil {
-				continue
-			}
-			if sender == accAddr {
-				nonce++
+	return nonce, nil
+}
+
+// pendingSequences returns the number of sequences of the account consumed by a pending tx: one per
+// ethereum tx it sends, or one if it signs a cosmos tx.
+func pendingSequences(tx sdk.Tx, accAddr common.Address) uint64 {
+	msgs := tx.GetMsgs()
+	if len(msgs) > 0 {
+		if _, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+			var n uint64
+			for _, msg := range msgs {
+				ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+				if !ok {
+					continue
+				}
+				ethTx := ethMsg.AsTransaction()
+				sender, err := ethtypes.Sender(evmtypes.TxSigner(ethTx), ethTx)
+				if err == nil && sender == accAddr {
+					n++
+				}
 			}
+			return n
 		}
 	}
 
-	return nonce, nil
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return 0
+	}
+	for _, signer := range sigTx.GetSigners() {
+		if bytes.Equal(signer, accAddr.Bytes()) {
+			return 1
+		}
+	}
+	return 0
 }
 
-// output: targetOneFeeHistory
+// processBlock returns the fee data of a block, used to build the fee history.
 func (b *Backend) processBlock(
 	tendermintBlock *tmrpctypes.ResultBlock,
 	ethBlock *map[string]interface{},
-	rewardPercentiles []float64,
 	tendermintBlockResult *tmrpctypes.ResultBlockResults,
-	targetOneFeeHistory *types.OneFeeHistory,
-) error {
+) (*types.BlockFees, error) {
 	blockHeight := tendermintBlock.Block.Height
 	blockBaseFee, err := b.BaseFee(tendermintBlockResult)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// set basefee
-	targetOneFeeHistory.BaseFee = blockBaseFee
-	cfg := b.ChainConfig()
-	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
-		targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, b.CurrentHeader())
-	} else {
-		targetOneFeeHistory.NextBaseFee = new(big.Int)
-	}
 	// set gas used ratio
 	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
 	if !ok {
-		return fmt.Errorf("invalid gas limit type: %T", (*ethBlock)["gasLimit"])
+		return nil, fmt.Errorf("invalid gas limit type: %T", (*ethBlock)["gasLimit"])
 	}
 
 	gasUsedBig, ok := (*ethBlock)["gasUsed"].(*hexutil.Big)
 	if !ok {
-		return fmt.Errorf("invalid gas used type: %T", (*ethBlock)["gasUsed"])
+		return nil, fmt.Errorf("invalid gas used type: %T", (*ethBlock)["gasUsed"])
 	}
 
 	gasusedfloat, _ := new(big.Float).SetInt(gasUsedBig.ToInt()).Float64()
 
 	if gasLimitUint64 <= 0 {
-		return fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockHeight, gasLimitUint64)
+		return nil, fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockHeight, gasLimitUint64)
 	}
 
-	gasUsedRatio := gasusedfloat / float64(gasLimitUint64)
-	blockGasUsed := gasusedfloat
-	targetOneFeeHistory.GasUsedRatio = gasUsedRatio
-
-	rewardCount := len(rewardPercentiles)
-	targetOneFeeHistory.Reward = make([]*big.Int, rewardCount)
-	for i := 0; i < rewardCount; i++ {
-		targetOneFeeHistory.Reward[i] = big.NewInt(0)
+	blockFees := &types.BlockFees{
+		BaseFee:      (*hexutil.Big)(blockBaseFee),
+		GasUsed:      hexutil.Uint64(gasUsedBig.ToInt().Uint64()),
+		GasUsedRatio: gasusedfloat / float64(gasLimitUint64),
+		Txs:          []types.TxFees{},
 	}
 
 	// check tendermintTxs
@@ -171,8 +163,6 @@ func (b *Backend) processBlock(
 	tendermintTxResults := tendermintBlockResult.TxsResults
 	tendermintTxCount := len(tendermintTxs)
 
-	var sorter sortGasAndReward
-
 	for i := 0; i < tendermintTxCount; i++ {
 		eachTendermintTx := tendermintTxs[i]
 		eachTendermintTxResult := tendermintTxResults[i]
@@ -182,42 +172,51 @@ func (b *Backend) processBlock(
 			b.logger.Debug("failed to decode transaction in block", "height", blockHeight, "error", err.Error())
 			continue
 		}
-		txGasUsed := uint64(eachTendermintTxResult.GasUsed)
-		for _, msg := range tx.GetMsgs() {
+		// the gas used and the tip actually paid by each eth tx are parsed from the events
+		parsedTxs, err := types.ParseTxResult(eachTendermintTxResult, tx)
+		if err != nil {
+			b.logger.Debug("failed to parse transaction events in block", "height", blockHeight, "error", err.Error())
+			parsedTxs = &types.ParsedTxs{}
+		}
+		for msgIndex, msg := range tx.GetMsgs() {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
 			if !ok {
 				continue
 			}
-			tx := ethMsg.AsTransaction()
-			reward := tx.EffectiveGasTipValue(blockBaseFee)
+			txGasUsed := uint64(eachTendermintTxResult.GasUsed)
+			var reward *big.Int
+			if parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex); parsedTx != nil {
+				txGasUsed = parsedTx.GasUsed
+				reward = parsedTx.EffectiveTip
+			}
+			if reward == nil {
+				// the blocks preceding the effective tip events fall back to the tip declared by the tx
+				reward = ethMsg.AsTransaction().EffectiveGasTipValue(blockBaseFee)
+			}
 			if reward == nil {
 				reward = big.NewInt(0)
 			}
-			sorter = append(sorter, txGasAndReward{gasUsed: txGasUsed, reward: reward})
+			blockFees.Txs = append(blockFees.Txs, types.TxFees{
+				GasUsed: hexutil.Uint64(txGasUsed),
+				Reward:  (*hexutil.Big)(reward),
+			})
 		}
 	}
 
-	// return an all zero row if there are no transactions to gather data from
-	ethTxCount := len(sorter)
-	if ethTxCount == 0 {
-		return nil
-	}
-
-	sort.Sort(sorter)
+	sort.SliceStable(blockFees.Txs, func(i, j int) bool {
+		return blockFees.Txs[i].Reward.ToInt().Cmp(blockFees.Txs[j].Reward.ToInt()) < 0
+	})
 
-	var txIndex int
-	sumGasUsed := sorter[0].gasUsed
+	return blockFees, nil
+}
 
-	for i, p := range rewardPercentiles {
-		thresholdGasUsed := uint64(blockGasUsed * p / 100)
-		for sumGasUsed < thresholdGasUsed && txIndex < ethTxCount-1 {
-			txIndex++
-			sumGasUsed += sorter[txIndex].gasUsed
-		}
-		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
+// nextBaseFee returns the base fee of the block following the given height.
+func (b *Backend) nextBaseFee(height int64) *big.Int {
+	cfg := b.ChainConfig()
+	if cfg.IsLondon(big.NewInt(height + 1)) {
+		return misc.CalcBaseFee(cfg, b.CurrentHeader())
 	}
-
-	return nil
+	return new(big.Int)
 }
 
 // AllTxLogsFromEvents parses all ethereum logs from cosmos events
@@ -1,9 +1,22 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func mookProofs(num int, withData bool) *crypto.ProofOps {
@@ -50,3 +63,142 @@ func (suite *BackendTestSuite) TestGetHexProofs() {
 		})
 	}
 }
+
+// goldenTxLogs are the tx_log event attributes of two eth txs of a block, as emitted by the evm
+// module. Changing their encoding or order breaks the logs served by every node.
+var goldenTxLogs = [][]string{
+	{
+		`{"address":"0x0000000000000000000000000000000000000001","topics":["0x0000000000000000000000000000000000000000000000000000000000000010"],"data":"AQ==","blockNumber":5,"transactionHash":"0x00000000000000000000000000000000000000000000000000000000000000a0","transactionIndex":0,"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000b0","logIndex":0}`,
+		`{"address":"0x0000000000000000000000000000000000000002","topics":["0x0000000000000000000000000000000000000000000000000000000000000020","0x0000000000000000000000000000000000000000000000000000000000000021"],"data":"Ag==","blockNumber":5,"transactionHash":"0x00000000000000000000000000000000000000000000000000000000000000a0","transactionIndex":0,"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000b0","logIndex":1}`,
+	},
+	{
+		`{"address":"0x0000000000000000000000000000000000000001","topics":["0x0000000000000000000000000000000000000000000000000000000000000010"],"data":"Aw==","blockNumber":5,"transactionHash":"0x00000000000000000000000000000000000000000000000000000000000000a1","transactionIndex":1,"blockHash":"0x00000000000000000000000000000000000000000000000000000000000000b0","logIndex":2}`,
+	},
+}
+
+func goldenTxLogEvents() []abci.Event {
+	events := []abci.Event{{Type: "coin_spent"}}
+	for _, txLogs := range goldenTxLogs {
+		event := abci.Event{Type: evmtypes.EventTypeTxLog}
+		for _, log := range txLogs {
+			event.Attributes = append(event.Attributes,
+				abci.EventAttribute{Key: []byte(evmtypes.AttributeKeyTxLog), Value: []byte(log)},
+				// unrelated attributes don't affect the order
+				abci.EventAttribute{Key: []byte("other"), Value: []byte("{}")},
+			)
+		}
+		events = append(events, event, abci.Event{Type: evmtypes.EventTypeEthereumTx})
+	}
+	return events
+}
+
+func (suite *BackendTestSuite) TestTxLogsGoldenEncoding() {
+	for _, txLogs := range goldenTxLogs {
+		for _, golden := range txLogs {
+			var log evmtypes.Log
+			suite.Require().NoError(json.Unmarshal([]byte(golden), &log))
+			bz, err := json.Marshal(&log)
+			suite.Require().NoError(err)
+			suite.Require().Equal(golden, string(bz))
+		}
+	}
+}
+
+func (suite *BackendTestSuite) TestAllTxLogsFromEventsOrder() {
+	allLogs, err := AllTxLogsFromEvents(goldenTxLogEvents())
+	suite.Require().NoError(err)
+	suite.Require().Len(allLogs, len(goldenTxLogs))
+
+	var logIndex uint
+	for i, logs := range allLogs {
+		suite.Require().Len(logs, len(goldenTxLogs[i]))
+		for _, log := range logs {
+			suite.Require().Equal(logIndex, log.Index)
+			suite.Require().Equal(uint(i), log.TxIndex)
+			suite.Require().Equal(common.BigToHash(big.NewInt(int64(0xa0+i))), log.TxHash)
+			suite.Require().Equal(uint64(5), log.BlockNumber)
+			suite.Require().Equal([]byte{byte(logIndex + 1)}, log.Data)
+			logIndex++
+		}
+	}
+
+	// the logs of a single tx are parsed identically
+	for i := range goldenTxLogs {
+		logs, err := TxLogsFromEvents(goldenTxLogEvents(), i)
+		suite.Require().NoError(err)
+		suite.Require().Equal(allLogs[i], logs)
+	}
+}
+
+func (suite *BackendTestSuite) TestPendingSequences() {
+	from, priv := tests.NewAddrKey()
+	other := tests.GenerateAddress()
+
+	msgEthTx := evmtypes.NewTx(suite.backend.chainID, 0, &other, big.NewInt(1), 21000, big.NewInt(1), nil, nil, nil, nil)
+	msgEthTx.From = from.Hex()
+	suite.Require().NoError(msgEthTx.Sign(ethtypes.LatestSignerForChainID(suite.backend.chainID), tests.NewSigner(priv)))
+	ethTx, err := msgEthTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	suite.Require().NoError(err)
+
+	txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("aphoton", 1))
+	suite.Require().NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(from.Bytes(), other.Bytes(), coins)))
+	cosmosTx := txBuilder.GetTx()
+
+	testCases := []struct {
+		name   string
+		tx     sdk.Tx
+		addr   common.Address
+		expSeq uint64
+	}{
+		{"ethereum tx of the account", ethTx, from, 1},
+		{"ethereum tx of another account", ethTx, other, 0},
+		{"cosmos tx signed by the account", cosmosTx, from, 1},
+		{"cosmos tx signed by another account", cosmosTx, other, 0},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expSeq, pendingSequences(tc.tx, tc.addr))
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestProcessBlockEffectiveTip() {
+	_, bz := suite.buildEthereumTx()
+	block := tmtypes.MakeBlock(1, []tmtypes.Tx{bz, bz}, nil, nil)
+	txEvents := func(gasUsed string, tip string) []abci.Event {
+		attrs := []abci.EventAttribute{
+			{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(common.Hash{}.Hex())},
+			{Key: []byte(evmtypes.AttributeKeyTxIndex), Value: []byte("0")},
+			{Key: []byte(evmtypes.AttributeKeyTxGasUsed), Value: []byte(gasUsed)},
+		}
+		if tip != "" {
+			attrs = append(attrs, abci.EventAttribute{Key: []byte(evmtypes.AttributeKeyEffectiveTip), Value: []byte(tip)})
+		}
+		return []abci.Event{{Type: evmtypes.EventTypeEthereumTx, Attributes: attrs}}
+	}
+	blockRes := &tmrpctypes.ResultBlockResults{
+		Height: 1,
+		TxsResults: []*abci.ResponseDeliverTx{
+			{GasUsed: 30000, Events: txEvents("30000", "5")},
+			// the events of the blocks preceding the effective tip attribute
+			{GasUsed: 21000, Events: txEvents("21000", "")},
+		},
+	}
+	ethBlock := map[string]interface{}{
+		"gasLimit": hexutil.Uint64(100000),
+		"gasUsed":  (*hexutil.Big)(big.NewInt(51000)),
+	}
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBaseFee(queryClient, sdk.NewInt(1))
+
+	blockFees, err := suite.backend.processBlock(&tmrpctypes.ResultBlock{Block: block}, &ethBlock, blockRes)
+	suite.Require().NoError(err)
+	// the tip actually paid is used when emitted, else the tip declared by the tx
+	suite.Require().Len(blockFees.Txs, 2)
+	suite.Require().Equal(hexutil.Uint64(21000), blockFees.Txs[0].GasUsed)
+	suite.Require().Zero(blockFees.Txs[0].Reward.ToInt().Sign())
+	suite.Require().Equal(hexutil.Uint64(30000), blockFees.Txs[1].GasUsed)
+	suite.Require().Equal(big.NewInt(5), blockFees.Txs[1].Reward.ToInt())
+}
@@ -0,0 +1,327 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/libs/log"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// callBatchIDPrefix prefixes the IDs of the ethermint_callBatch requests replacing the eth_call
+// groups of a batch.
+const callBatchIDPrefix = "ethermint-call-batch-"
+
+// CallBatcher is an HTTP middleware executing the eth_call requests of a JSON-RPC batch targeting
+// the same block with a single ethermint_callBatch request, so that the calls share the state reads
+// instead of each one querying the state of the block.
+type CallBatcher struct {
+	logger log.Logger
+}
+
+// NewCallBatcher creates a new CallBatcher, the ethermint namespace serving ethermint_callBatch
+// must be enabled.
+func NewCallBatcher(logger log.Logger) *CallBatcher {
+	return &CallBatcher{
+		logger: logger.With("module", "call-batcher"),
+	}
+}
+
+// batchRequest contains the request fields used to group the eth_call requests.
+type batchRequest struct {
+	ID     json.RawMessage   `json:"id,omitempty"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params,omitempty"`
+}
+
+// batchResponse contains the response fields used to dispatch the responses.
+type batchResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   json.RawMessage `json:"error,omitempty"`
+}
+
+// callGroup is a chunk of the eth_call requests of a batch targeting the same block.
+type callGroup struct {
+	id      string
+	block   json.RawMessage
+	indexes []int
+}
+
+// Handler wraps the given handler, replacing the eth_call requests of the batches by a
+// ethermint_callBatch request per block, and the response of the latter by the responses of the
+// calls in the original order. The batches without such calls are forwarded as-is.
+func (cb *CallBatcher) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readRequestBody(w, r)
+		if !ok {
+			return
+		}
+
+		if !isBatch(body) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var rawReqs []json.RawMessage
+		if err := json.Unmarshal(body, &rawReqs); err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		reqs := make([]batchRequest, len(rawReqs))
+		for i, raw := range rawReqs {
+			// the invalid requests are reported by the rpc server
+			_ = json.Unmarshal(raw, &reqs[i])
+		}
+
+		groups, grouped := groupCalls(reqs)
+		if len(groups) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rewritten, err := rewriteBatch(rawReqs, reqs, groups, grouped)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+		r2 := r.Clone(r.Context())
+		r2.Body = io.NopCloser(bytes.NewReader(rewritten))
+		r2.ContentLength = int64(len(rewritten))
+		next.ServeHTTP(rec, r2)
+
+		// the response of a batch rejected as a whole is returned as-is, the original batch isn't
+		// served again since its other requests could be executed twice
+		var responses []batchResponse
+		if rec.status != http.StatusOK || json.Unmarshal(rec.body.Bytes(), &responses) != nil {
+			cb.logger.Debug("failed to serve the rewritten batch", "status", rec.status)
+			rec.writeTo(w)
+			return
+		}
+
+		// the calls of a failed group are executed one by one, each one getting its own response
+		out := dispatchResponses(reqs, groups, grouped, responses, func(i int) batchResponse {
+			return serveCall(next, r, rawReqs[i], reqs[i].ID)
+		})
+
+		telemetry.IncrCounter(float32(len(grouped)), "json_rpc", "call_batch", "calls")
+		writeJSON(w, out)
+	})
+}
+
+// groupCalls groups the eth_call requests of the batch by block, in chunks of at most the max
+// number of calls of a bundle. Only the blocks with several calls are grouped, and the calls with
+// state overrides are left out. It returns the groups and the group of each grouped request.
+func groupCalls(reqs []batchRequest) ([]*callGroup, map[int]*callGroup) {
+	byBlock := make(map[string][]int)
+	var blocks []string
+	for i, req := range reqs {
+		if req.Method != "eth_call" || len(req.ID) == 0 || len(req.Params) == 0 || len(req.Params) > 3 {
+			continue
+		}
+		if len(req.Params) == 3 && !isNull(req.Params[2]) {
+			continue
+		}
+		block := "\"latest\""
+		if len(req.Params) > 1 && !isNull(req.Params[1]) {
+			block = string(bytes.TrimSpace(req.Params[1]))
+		}
+		if _, found := byBlock[block]; !found {
+			blocks = append(blocks, block)
+		}
+		byBlock[block] = append(byBlock[block], i)
+	}
+
+	var groups []*callGroup
+	grouped := make(map[int]*callGroup)
+	for _, block := range blocks {
+		indexes := byBlock[block]
+		if len(indexes) < 2 {
+			continue
+		}
+		for start := 0; start < len(indexes); start += evmtypes.MaxBundleCalls {
+			end := start + evmtypes.MaxBundleCalls
+			if end > len(indexes) {
+				end = len(indexes)
+			}
+			group := &callGroup{
+				id:      fmt.Sprintf("%s%d", callBatchIDPrefix, len(groups)),
+				block:   json.RawMessage(block),
+				indexes: indexes[start:end],
+			}
+			for _, i := range group.indexes {
+				grouped[i] = group
+			}
+			groups = append(groups, group)
+		}
+	}
+	return groups, grouped
+}
+
+// rewriteBatch returns the batch with the grouped requests replaced by a ethermint_callBatch
+// request per group.
+func rewriteBatch(rawReqs []json.RawMessage, reqs []batchRequest, groups []*callGroup, grouped map[int]*callGroup) ([]byte, error) {
+	batch := make([]json.RawMessage, 0, len(rawReqs)-len(grouped)+len(groups))
+	for i, raw := range rawReqs {
+		if grouped[i] == nil {
+			batch = append(batch, raw)
+		}
+	}
+
+	for _, group := range groups {
+		calls := make([]json.RawMessage, len(group.indexes))
+		for j, i := range group.indexes {
+			calls[j] = reqs[i].Params[0]
+		}
+		bz, err := json.Marshal(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      group.id,
+			"method":  "ethermint_callBatch",
+			"params":  []interface{}{calls, group.block},
+		})
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, bz)
+	}
+	return json.Marshal(batch)
+}
+
+// dispatchResponses returns the responses of the original batch, in the order of the requests,
+// splitting the responses of the ethermint_callBatch requests into the responses of the calls. The
+// calls of a ethermint_callBatch request which failed as a whole are served by the given function,
+// rather than returning the error of the group for each of its calls.
+func dispatchResponses(
+	reqs []batchRequest, groups []*callGroup, grouped map[int]*callGroup, responses []batchResponse,
+	serveCall func(i int) batchResponse,
+) []interface{} {
+	byID := make(map[string][]batchResponse)
+	for _, res := range responses {
+		byID[string(res.ID)] = append(byID[string(res.ID)], res)
+	}
+
+	// the responses of the calls of each group
+	callResponses := make(map[int]batchResponse, len(grouped))
+	for _, group := range groups {
+		groupID, _ := json.Marshal(group.id)
+		queued := byID[string(groupID)]
+		delete(byID, string(groupID))
+
+		var results []rpctypes.CallBatchResult
+		if len(queued) == 0 || len(queued[0].Error) > 0 ||
+			json.Unmarshal(queued[0].Result, &results) != nil || len(results) != len(group.indexes) {
+			for _, i := range group.indexes {
+				callResponses[i] = serveCall(i)
+			}
+			continue
+		}
+
+		for j, i := range group.indexes {
+			res := batchResponse{Jsonrpc: "2.0", ID: reqs[i].ID}
+			if results[j].Error != nil {
+				res.Error, _ = json.Marshal(results[j].Error)
+			} else {
+				res.Result, _ = json.Marshal(results[j].Result)
+			}
+			callResponses[i] = res
+		}
+	}
+
+	out := make([]interface{}, 0, len(responses)+len(grouped)-len(groups))
+	for i, req := range reqs {
+		if res, found := callResponses[i]; found {
+			out = append(out, res)
+			continue
+		}
+		if queued := byID[string(req.ID)]; len(req.ID) > 0 && len(queued) > 0 {
+			out = append(out, queued[0])
+			byID[string(req.ID)] = queued[1:]
+		}
+	}
+	// the responses not matching a request, eg: the errors of the invalid requests
+	for _, res := range responses {
+		if queued := byID[string(res.ID)]; len(queued) > 0 {
+			out = append(out, queued[0])
+			byID[string(res.ID)] = queued[1:]
+		}
+	}
+	return out
+}
+
+// serveCall serves the eth_call request alone, returning an internal error response if the
+// response can't be decoded.
+func serveCall(next http.Handler, r *http.Request, raw, id json.RawMessage) batchResponse {
+	rec := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+	r2 := r.Clone(r.Context())
+	r2.Body = io.NopCloser(bytes.NewReader(raw))
+	r2.ContentLength = int64(len(raw))
+	next.ServeHTTP(rec, r2)
+
+	var res batchResponse
+	if rec.status != http.StatusOK || json.Unmarshal(rec.body.Bytes(), &res) != nil {
+		return batchResponse{
+			Jsonrpc: "2.0",
+			ID:      id,
+			Error:   json.RawMessage(`{"code":-32603,"message":"internal error"}`),
+		}
+	}
+	return res
+}
+
+// isNull returns true if the raw JSON value is null.
+func isNull(raw json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
+
+// writeJSON writes the value as a JSON response.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// bufferedResponse is a http.ResponseWriter buffering the response.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *bufferedResponse) Header() http.Header { return r.header }
+
+func (r *bufferedResponse) WriteHeader(status int) { r.status = status }
+
+func (r *bufferedResponse) Write(bz []byte) (int, error) { return r.body.Write(bz) }
+
+// writeTo writes the buffered response to the given writer.
+func (r *bufferedResponse) writeTo(w http.ResponseWriter) {
+	for key, values := range r.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.body.Bytes())
+}
@@ -0,0 +1,187 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestCallBatcher(t *testing.T) {
+	var received [][]batchRequest
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		// the single requests are received as a batch of one request, and answered as such
+		var reqs []batchRequest
+		single := !isBatch(body)
+		if single {
+			reqs = make([]batchRequest, 1)
+			require.NoError(t, json.Unmarshal(body, &reqs[0]))
+		} else {
+			require.NoError(t, json.Unmarshal(body, &reqs))
+		}
+		received = append(received, reqs)
+
+		responses := make([]batchResponse, 0, len(reqs))
+		for _, req := range reqs {
+			res := batchResponse{Jsonrpc: "2.0", ID: req.ID}
+			switch req.Method {
+			case "ethermint_callBatch":
+				var calls []json.RawMessage
+				require.NoError(t, json.Unmarshal(req.Params[0], &calls))
+				if string(req.Params[1]) == `"0xdead"` {
+					res.Error = json.RawMessage(`{"code":-32000,"message":"header not found"}`)
+					break
+				}
+				results := make([]json.RawMessage, len(calls))
+				for i, call := range calls {
+					results[i] = json.RawMessage(`{"result":"0x01"}`)
+					if string(call) == `{"to":"0xbad"}` {
+						results[i] = json.RawMessage(`{"error":{"code":-32000,"message":"invalid call"}}`)
+					}
+				}
+				results[len(calls)-1] = json.RawMessage(`{"error":{"code":3,"message":"execution reverted","data":"0x02"}}`)
+				res.Result, _ = json.Marshal(results)
+			case "eth_call":
+				if len(req.Params) > 1 && string(req.Params[1]) == `"0xdead"` {
+					res.Error = json.RawMessage(`{"code":-32000,"message":"header not found"}`)
+					break
+				}
+				res.Result = json.RawMessage(`"0x10"`)
+			default:
+				res.Result = json.RawMessage(`"0x10"`)
+			}
+			responses = append(responses, res)
+		}
+		if single {
+			_ = json.NewEncoder(w).Encode(responses[0])
+			return
+		}
+		_ = json.NewEncoder(w).Encode(responses)
+	})
+
+	handler := NewCallBatcher(log.NewNopLogger()).Handler(next)
+
+	serve := func(body string) []batchResponse {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body)))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var responses []batchResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responses))
+		return responses
+	}
+
+	// the calls at the same block are grouped, the other requests are forwarded
+	responses := serve(`[
+		{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x01"},"latest"]},
+		{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber","params":[]},
+		{"jsonrpc":"2.0","id":3,"method":"eth_call","params":[{"to":"0x02"}]},
+		{"jsonrpc":"2.0","id":4,"method":"eth_call","params":[{"to":"0x03"},"0x10"]},
+		{"jsonrpc":"2.0","id":5,"method":"eth_call","params":[{"to":"0x04"},"latest",{"0x05":{}}]}
+	]`)
+	require.Len(t, received, 1)
+	require.Len(t, received[0], 4)
+	require.Equal(t, "ethermint_callBatch", received[0][3].Method)
+
+	require.Len(t, responses, 5)
+	for i, res := range responses {
+		require.Equal(t, strconv.Itoa(i+1), string(res.ID))
+	}
+	require.Equal(t, `"0x01"`, string(responses[0].Result))
+	require.Equal(t, `"0x10"`, string(responses[1].Result))
+	require.JSONEq(t, `{"code":3,"message":"execution reverted","data":"0x02"}`, string(responses[2].Error))
+	require.Equal(t, `"0x10"`, string(responses[3].Result))
+	require.Equal(t, `"0x10"`, string(responses[4].Result))
+
+	// a failing call of a mixed batch only fails its own request
+	responses = serve(`[
+		{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x01"}]},
+		{"jsonrpc":"2.0","id":2,"method":"eth_call","params":[{"to":"0xbad"}]},
+		{"jsonrpc":"2.0","id":3,"method":"eth_getBalance","params":["0x01","latest"]},
+		{"jsonrpc":"2.0","id":4,"method":"eth_call","params":[{"to":"0x02"}]},
+		{"jsonrpc":"2.0","id":5,"method":"eth_call","params":[{"to":"0x03"}]}
+	]`)
+	require.Len(t, responses, 5)
+	for i, res := range responses {
+		require.Equal(t, strconv.Itoa(i+1), string(res.ID))
+	}
+	require.Equal(t, `"0x01"`, string(responses[0].Result))
+	require.JSONEq(t, `{"code":-32000,"message":"invalid call"}`, string(responses[1].Error))
+	require.Empty(t, responses[1].Result)
+	require.Equal(t, `"0x10"`, string(responses[2].Result))
+	require.Equal(t, `"0x01"`, string(responses[3].Result))
+	require.JSONEq(t, `{"code":3,"message":"execution reverted","data":"0x02"}`, string(responses[4].Error))
+
+	// the calls of a failed batch call are executed one by one, the other requests aren't served again
+	received = nil
+	responses = serve(`[
+		{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x01"},"0xdead"]},
+		{"jsonrpc":"2.0","id":2,"method":"eth_sendRawTransaction","params":["0x02"]},
+		{"jsonrpc":"2.0","id":3,"method":"eth_call","params":[{"to":"0x02"},"0xdead"]},
+		{"jsonrpc":"2.0","id":4,"method":"eth_call","params":[{"to":"0x03"}]},
+		{"jsonrpc":"2.0","id":5,"method":"eth_call","params":[{"to":"0x04"}]}
+	]`)
+	require.Len(t, received, 3)
+	require.Len(t, received[0], 3)
+	require.Equal(t, "eth_sendRawTransaction", received[0][0].Method)
+	require.Equal(t, "ethermint_callBatch", received[0][1].Method)
+	require.Equal(t, "ethermint_callBatch", received[0][2].Method)
+	for i, id := range []string{"1", "3"} {
+		require.Len(t, received[i+1], 1)
+		require.Equal(t, "eth_call", received[i+1][0].Method)
+		require.Equal(t, id, string(received[i+1][0].ID))
+	}
+	require.Len(t, responses, 5)
+	for i, res := range responses {
+		require.Equal(t, strconv.Itoa(i+1), string(res.ID))
+	}
+	require.JSONEq(t, `{"code":-32000,"message":"header not found"}`, string(responses[0].Error))
+	require.Equal(t, `"0x10"`, string(responses[1].Result))
+	require.JSONEq(t, `{"code":-32000,"message":"header not found"}`, string(responses[2].Error))
+	require.Equal(t, `"0x01"`, string(responses[3].Result))
+	require.JSONEq(t, `{"code":3,"message":"execution reverted","data":"0x02"}`, string(responses[4].Error))
+
+	// the batches without grouped calls are forwarded as-is
+	received = nil
+	responses = serve(`[
+		{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x01"},"latest"]},
+		{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber","params":[]}
+	]`)
+	require.Len(t, responses, 2)
+	require.Len(t, received[0], 2)
+	require.Equal(t, "eth_call", received[0][0].Method)
+}
+
+func TestCallBatcherRejectedBatch(t *testing.T) {
+	served := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		http.Error(w, "rejected", http.StatusServiceUnavailable)
+	})
+	handler := NewCallBatcher(log.NewNopLogger()).Handler(next)
+
+	// the rejection of the rewritten batch is returned, the requests aren't served again
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`[
+		{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x01"}]},
+		{"jsonrpc":"2.0","id":2,"method":"eth_sendRawTransaction","params":["0x02"]},
+		{"jsonrpc":"2.0","id":3,"method":"eth_call","params":[{"to":"0x02"}]}
+	]`)))
+	require.Equal(t, 1, served)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, "rejected\n", rec.Body.String())
+
+	// the oversized batches are rejected rather than truncated
+	rec = httptest.NewRecorder()
+	body := append([]byte(`[{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x01"}]}`), make([]byte, maxRequestContentLength)...)
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.Equal(t, 1, served)
+}
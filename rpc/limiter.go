@@ -0,0 +1,275 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+// ErrCodeLimitExceeded is the JSON-RPC error code returned when a request is
+// rejected because the server is at capacity, as defined by EIP-1474.
+const ErrCodeLimitExceeded = -32005
+
+// maxRequestContentLength is the max size of a request body inspected by the
+// load shedder. It matches the limit enforced by the go-ethereum RPC server.
+const maxRequestContentLength = 1024 * 1024 * 5
+
+// MethodClass groups JSON-RPC methods with a similar execution cost.
+type MethodClass string
+
+const (
+	// MethodClassDefault is the class of all the methods without a specific budget.
+	MethodClassDefault MethodClass = "default"
+	// MethodClassCall is the class of the methods executing the EVM against a state copy.
+	MethodClassCall MethodClass = "call"
+	// MethodClassTrace is the class of the methods replaying transactions with a tracer.
+	MethodClassTrace MethodClass = "trace"
+	// MethodClassLogs is the class of the methods filtering logs over block ranges.
+	MethodClassLogs MethodClass = "logs"
+)
+
+// ClassifyMethod returns the MethodClass of the given JSON-RPC method.
+func ClassifyMethod(method string) MethodClass {
+	switch {
+	// the trace jobs are bounded by their queue, their status and results are cheap
+	case method == "debug_traceStatus", method == "debug_traceResult":
+		return MethodClassDefault
+	case strings.HasPrefix(method, "debug_trace"):
+		return MethodClassTrace
+	case method == "eth_getLogs", method == "eth_getFilterLogs":
+		return MethodClassLogs
+	case method == "eth_call", method == "eth_estimateGas", method == "ethermint_estimateGas", method == "ethermint_callBatch",
+		method == "ethermint_estimateGasBulk":
+		return MethodClassCall
+	default:
+		return MethodClassDefault
+	}
+}
+
+// concurrencyLimiter bounds the number of requests served concurrently, letting
+// up to maxQueued requests wait for a free slot.
+type concurrencyLimiter struct {
+	slots     chan struct{}
+	queued    int64
+	maxQueued int64
+}
+
+func newConcurrencyLimiter(maxConcurrent, maxQueued int) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		slots:     make(chan struct{}, maxConcurrent),
+		maxQueued: int64(maxQueued),
+	}
+}
+
+// acquire blocks until a slot is available and returns false if the request
+// cannot be queued or is cancelled while waiting.
+func (l *concurrencyLimiter) acquire(done <-chan struct{}) bool {
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+	}
+
+	if atomic.AddInt64(&l.queued, 1) > l.maxQueued {
+		atomic.AddInt64(&l.queued, -1)
+		return false
+	}
+	defer atomic.AddInt64(&l.queued, -1)
+
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-done:
+		return false
+	}
+}
+
+func (l *concurrencyLimiter) release() {
+	<-l.slots
+}
+
+// LoadShedder is an HTTP middleware enforcing a concurrency budget per MethodClass
+// on the JSON-RPC server, so that expensive requests from a single consumer
+// cannot starve the node.
+type LoadShedder struct {
+	logger   log.Logger
+	limiters map[MethodClass]*concurrencyLimiter
+}
+
+// NewLoadShedder creates a new LoadShedder from the JSON-RPC configuration. Method
+// classes with a zero concurrency limit are not restricted.
+func NewLoadShedder(logger log.Logger, cfg config.JSONRPCConfig) *LoadShedder {
+	budgets := map[MethodClass]int{
+		MethodClassCall:  cfg.MaxConcurrentCalls,
+		MethodClassTrace: cfg.MaxConcurrentTraces,
+		MethodClassLogs:  cfg.MaxConcurrentLogs,
+	}
+
+	limiters := make(map[MethodClass]*concurrencyLimiter)
+	for class, maxConcurrent := range budgets {
+		if maxConcurrent > 0 {
+			limiters[class] = newConcurrencyLimiter(maxConcurrent, cfg.MaxQueuedRequests)
+		}
+	}
+
+	return &LoadShedder{
+		logger:   logger.With("module", "load-shedder"),
+		limiters: limiters,
+	}
+}
+
+// jsonrpcMessage contains the request fields inspected by the load shedder.
+type jsonrpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// Handler wraps the given handler, rejecting the requests that exceed the budget
+// of their method class with a JSON-RPC limit exceeded error.
+func (ls *LoadShedder) Handler(next http.Handler) http.Handler {
+	if len(ls.limiters) == 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readRequestBody(w, r)
+		if !ok {
+			return
+		}
+
+		msgs, batch := parseMessages(body)
+		class := requestClass(msgs)
+
+		limiter, ok := ls.limiters[class]
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !limiter.acquire(r.Context().Done()) {
+			ls.logger.Debug("rejecting request over budget", "class", class)
+			telemetry.IncrCounter(1, "json_rpc", "rejected", string(class))
+			writeLimitExceeded(w, msgs, batch, class)
+			return
+		}
+		defer limiter.release()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// readRequestBody reads the body of the request and replaces it by a copy for the next handlers. The
+// bodies larger than maxRequestContentLength are rejected with the status of the go-ethereum RPC
+// server instead of being truncated.
+func readRequestBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	if len(body) > maxRequestContentLength {
+		http.Error(w, fmt.Sprintf("content length too large (>%d)", maxRequestContentLength), http.StatusRequestEntityTooLarge)
+		return nil, false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, true
+}
+
+// parseMessages decodes the method and ID of each request in the body. Invalid
+// payloads are ignored so that the RPC server reports the decoding error.
+func parseMessages(body []byte) ([]jsonrpcMessage, bool) {
+	if isBatch(body) {
+		var msgs []jsonrpcMessage
+		_ = json.Unmarshal(body, &msgs)
+		return msgs, true
+	}
+
+	var msg jsonrpcMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, false
+	}
+	return []jsonrpcMessage{msg}, false
+}
+
+// requestClass returns the most expensive method class among the given
+// messages, so that a batch is accounted against its heaviest request.
+func requestClass(msgs []jsonrpcMessage) MethodClass {
+	class := MethodClassDefault
+	for _, msg := range msgs {
+		switch c := ClassifyMethod(msg.Method); c {
+		case MethodClassTrace:
+			return c
+		case MethodClassLogs:
+			class = c
+		case MethodClassCall:
+			if class == MethodClassDefault {
+				class = c
+			}
+		}
+	}
+	return class
+}
+
+// writeLimitExceeded replies to every request in msgs with a limit exceeded error.
+func writeLimitExceeded(w http.ResponseWriter, msgs []jsonrpcMessage, batch bool, class MethodClass) {
+	type errorObject struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	type errorResponse struct {
+		Jsonrpc string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Error   errorObject     `json:"error"`
+	}
+
+	responses := make([]errorResponse, len(msgs))
+	for i, msg := range msgs {
+		id := msg.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+
+		responses[i] = errorResponse{
+			Jsonrpc: "2.0",
+			ID:      id,
+			Error: errorObject{
+				Code:    ErrCodeLimitExceeded,
+				Message: "limit exceeded: too many concurrent " + string(class) + " requests, retry later",
+			},
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	if batch {
+		_ = json.NewEncoder(w).Encode(responses)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(responses[0])
+}
@@ -0,0 +1,104 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+func TestClassifyMethod(t *testing.T) {
+	testCases := []struct {
+		method string
+		class  MethodClass
+	}{
+		{"debug_traceTransaction", MethodClassTrace},
+		{"debug_traceBlockByNumber", MethodClassTrace},
+		{"debug_traceStatus", MethodClassDefault},
+		{"debug_queueTraceTransaction", MethodClassDefault},
+		{"eth_getLogs", MethodClassLogs},
+		{"eth_call", MethodClassCall},
+		{"eth_estimateGas", MethodClassCall},
+		{"ethermint_estimateGas", MethodClassCall},
+		{"ethermint_callBatch", MethodClassCall},
+		{"ethermint_estimateGasBulk", MethodClassCall},
+		{"eth_blockNumber", MethodClassDefault},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.class, ClassifyMethod(tc.method), tc.method)
+	}
+}
+
+func TestRequestClass(t *testing.T) {
+	msgs := []jsonrpcMessage{{Method: "eth_call"}, {Method: "eth_getLogs"}, {Method: "eth_chainId"}}
+	require.Equal(t, MethodClassLogs, requestClass(msgs))
+
+	msgs = append(msgs, jsonrpcMessage{Method: "debug_traceCall"})
+	require.Equal(t, MethodClassTrace, requestClass(msgs))
+
+	require.Equal(t, MethodClassDefault, requestClass(nil))
+}
+
+func TestLoadShedder(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.MaxConcurrentTraces = 1
+	cfg.MaxQueuedRequests = 0
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Block") != "" {
+			close(started)
+			<-release
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler := NewLoadShedder(log.NewNopLogger(), *cfg).Handler(next)
+	traceBody := []byte(`{"jsonrpc":"2.0","id":7,"method":"debug_traceTransaction","params":[]}`)
+
+	// occupy the only trace slot
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(traceBody))
+		req.Header.Set("X-Block", "true")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}()
+	<-started
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(traceBody)))
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	var res struct {
+		ID    int `json:"id"`
+		Error struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, 7, res.ID)
+	require.Equal(t, ErrCodeLimitExceeded, res.Error.Code)
+
+	// requests of other classes are not restricted
+	callBody := []byte(`{"jsonrpc":"2.0","id":8,"method":"eth_call","params":[]}`)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(callBody)))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// the slot is freed once the first request completes
+	close(release)
+	<-done
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(traceBody)))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/tendermint/tendermint/libs/log"
+
+	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/ethermint/rpc/types"
+)
+
+// backfillLogs returns the logs matching the criteria from its fromBlock to the latest block, along
+// with the latest block height. The backfill is limited by the logs and block range caps of the node,
+// like eth_getLogs.
+func backfillLogs(logger log.Logger, backend rpcfilters.Backend, crit filters.FilterCriteria) ([]*ethtypes.Log, int64, error) {
+	header, err := backend.HeaderByNumber(types.EthLatestBlockNumber)
+	if err != nil {
+		return nil, 0, err
+	}
+	if header == nil || header.Number == nil {
+		return nil, 0, errors.New("latest header not found")
+	}
+
+	head := header.Number.Int64()
+	if crit.FromBlock.Int64() > head {
+		return []*ethtypes.Log{}, head, nil
+	}
+
+	filter := rpcfilters.NewRangeFilter(logger, backend, crit.FromBlock.Int64(), head, crit.Addresses, crit.Topics)
+	logs, err := filter.Logs(context.Background(), int(backend.RPCLogsCap()), int64(backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, head, nil
+}
@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// backfillBackend serves a chain with one log per block.
+type backfillBackend struct {
+	rpcfilters.Backend
+	head       int64
+	blockRange int32
+}
+
+func (b backfillBackend) HeaderByNumber(types.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b backfillBackend) TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error) {
+	log := &evmtypes.Log{
+		Address:     common.HexToAddress("0x01").Hex(),
+		BlockNumber: uint64(*height),
+		BlockHash:   common.BigToHash(big.NewInt(*height)).Hex(),
+		TxHash:      common.Hash{}.Hex(),
+	}
+	bz, err := json.Marshal(log)
+	if err != nil {
+		return nil, err
+	}
+
+	event := abci.Event{
+		Type:       evmtypes.EventTypeTxLog,
+		Attributes: []abci.EventAttribute{{Key: []byte(evmtypes.AttributeKeyTxLog), Value: bz}},
+	}
+	return &coretypes.ResultBlockResults{
+		Height:     *height,
+		TxsResults: []*abci.ResponseDeliverTx{{Events: []abci.Event{event}}},
+	}, nil
+}
+
+func (b backfillBackend) BlockBloom(*coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	return ethtypes.BytesToBloom(ethtypes.LogsBloom([]*ethtypes.Log{{Address: common.HexToAddress("0x01")}})), nil
+}
+
+func (b backfillBackend) RPCLogsCap() int32 {
+	return 100
+}
+
+func (b backfillBackend) RPCBlockRangeCap() int32 {
+	return b.blockRange
+}
+
+func TestBackfillLogs(t *testing.T) {
+	backend := backfillBackend{head: 10, blockRange: 100}
+	crit := filters.FilterCriteria{FromBlock: big.NewInt(8), Addresses: []common.Address{common.HexToAddress("0x01")}}
+
+	logs, head, err := backfillLogs(log.NewNopLogger(), backend, crit)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), head)
+	require.Len(t, logs, 3)
+	for i, log := range logs {
+		require.Equal(t, uint64(8+i), log.BlockNumber)
+	}
+
+	// the logs of another contract
+	crit.Addresses = []common.Address{common.HexToAddress("0x02")}
+	logs, _, err = backfillLogs(log.NewNopLogger(), backend, crit)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+
+	// starting after the latest block
+	crit.FromBlock = big.NewInt(11)
+	logs, head, err = backfillLogs(log.NewNopLogger(), backend, crit)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), head)
+	require.Empty(t, logs)
+
+	// above the block range cap
+	backend.blockRange = 5
+	crit.FromBlock = big.NewInt(1)
+	_, _, err = backfillLogs(log.NewNopLogger(), backend, crit)
+	require.Error(t, err)
+}
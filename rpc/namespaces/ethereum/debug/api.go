@@ -17,6 +17,7 @@ package debug
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -41,6 +42,9 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/evmos/ethermint/rpc/backend"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/server/config"
+	srvflags "github.com/evmos/ethermint/server/flags"
+	srvlog "github.com/evmos/ethermint/server/log"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -55,10 +59,11 @@ type HandlerT struct {
 
 // API is the collection of tracing APIs exposed over the private debugging endpoint.
 type API struct {
-	ctx     *server.Context
-	logger  log.Logger
-	backend backend.EVMBackend
-	handler *HandlerT
+	ctx       *server.Context
+	logger    log.Logger
+	backend   backend.EVMBackend
+	handler   *HandlerT
+	traceJobs *traceQueue
 }
 
 // NewAPI creates a new API definition for the tracing methods of the Ethereum service.
@@ -66,24 +71,57 @@ func NewAPI(
 	ctx *server.Context,
 	backend backend.EVMBackend,
 ) *API {
+	workers := ctx.Viper.GetInt(srvflags.JSONRPCTraceJobWorkers)
+	if workers <= 0 {
+		workers = config.DefaultTraceJobWorkers
+	}
+	queueSize := ctx.Viper.GetInt(srvflags.JSONRPCTraceJobQueueSize)
+	if queueSize <= 0 {
+		queueSize = config.DefaultTraceJobQueueSize
+	}
+
+	logger := ctx.Logger.With("module", "debug")
+
+	retainBlocks := ctx.Viper.GetInt64(srvflags.JSONRPCTraceFileRetentionBlocks)
+	maxDiskSize := ctx.Viper.GetInt64(srvflags.JSONRPCTraceFileMaxDiskSize)
+	if retainBlocks > 0 || maxDiskSize > 0 {
+		dir, err := traceFileDir(ctx.Viper)
+		if err != nil {
+			panic(err)
+		}
+
+		pruner := &traceFilePruner{
+			logger:       logger,
+			dir:          dir,
+			retainBlocks: retainBlocks,
+			maxDiskSize:  maxDiskSize,
+			latestHeight: func() (int64, error) {
+				height, err := backend.BlockNumber()
+				return int64(height), err
+			},
+		}
+		go pruner.run()
+	}
+
 	return &API{
-		ctx:     ctx,
-		logger:  ctx.Logger.With("module", "debug"),
-		backend: backend,
-		handler: new(HandlerT),
+		ctx:       ctx,
+		logger:    logger,
+		backend:   backend,
+		handler:   new(HandlerT),
+		traceJobs: newTraceQueue(workers, queueSize),
 	}
 }
 
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+func (a *API) TraceTransaction(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
 	a.logger.Debug("debug_traceTransaction", "hash", hash)
-	return a.backend.TraceTransaction(hash, config)
+	return a.backend.TraceTransaction(ctx, hash, config)
 }
 
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) TraceBlockByNumber(ctx context.Context, height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByNumber", "height", height)
 	if height == 0 {
 		return nil, errors.New("genesis is not traceable")
@@ -95,12 +133,12 @@ func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.T
 		return nil, err
 	}
 
-	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
+	return a.backend.TraceBlock(ctx, rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) TraceBlockByHash(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByHash", "hash", hash)
 	// Get Tendermint Block
 	resBlock, err := a.backend.TendermintBlockByHash(hash)
@@ -114,7 +152,20 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 		return nil, errors.New("block not found")
 	}
 
-	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
+	return a.backend.TraceBlock(ctx, rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
+}
+
+// TraceCall lets you trace a given eth_call. It collects the structured logs created
+// during the execution of EVM if the given transaction was added on top of the
+// provided block and returns them as a JSON object.
+func (a *API) TraceCall(
+	ctx context.Context,
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+	return a.backend.TraceCall(ctx, args, blockNrOrHash, config)
 }
 
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
@@ -301,6 +352,23 @@ func (a *API) SetGCPercent(v int) int {
 	return debug.SetGCPercent(v)
 }
 
+// SetLogLevels sets the log levels of the modules at runtime, from a comma separated
+// list of `module:level` pairs where `*` matches all the other modules. It returns
+// the previous levels.
+func (a *API) SetLogLevels(levels string) (string, error) {
+	a.logger.Debug("debug_setLogLevels", "levels", levels)
+	logger, ok := a.ctx.Logger.(*srvlog.ModuleLevelLogger)
+	if !ok {
+		return "", errors.New("the node logger doesn't support module log levels")
+	}
+
+	previous := logger.Levels().String()
+	if err := logger.Levels().Set(levels); err != nil {
+		return "", err
+	}
+	return previous, nil
+}
+
 // GetHeaderRlp retrieves the RLP encoded for of a single header.
 func (a *API) GetHeaderRlp(number uint64) (hexutil.Bytes, error) {
 	header, err := a.backend.HeaderByNumber(rpctypes.BlockNumber(number))
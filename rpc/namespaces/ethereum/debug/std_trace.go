@@ -0,0 +1,184 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package debug
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// StdTraceConfig holds extra parameters to standard-json trace functions.
+type StdTraceConfig struct {
+	evmtypes.TraceConfig
+	// TxHash restricts the trace to a single transaction of the block.
+	TxHash common.Hash `json:"txHash"`
+}
+
+// stdTraceSummary is the last line of a standard-json trace file.
+type stdTraceSummary struct {
+	Output  string `json:"output"`
+	GasUsed string `json:"gasUsed"`
+	Failed  bool   `json:"failed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// StandardTraceBlockToFile dumps the structured logs created during the execution
+// of the EVM to the local file system and returns a list of files to the caller.
+// Each file contains one structured log per line followed by the execution
+// summary, so that heavy traces can be consumed without going through the RPC.
+func (a *API) StandardTraceBlockToFile(ctx context.Context, hash common.Hash, config *StdTraceConfig) ([]string, error) {
+	a.logger.Debug("debug_standardTraceBlockToFile", "hash", hash)
+
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		a.logger.Debug("block result not found", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
+	if config == nil {
+		config = &StdTraceConfig{}
+	}
+
+	// the files are written from the output of the default struct logger
+	traceConfig := config.TraceConfig
+	traceConfig.Tracer = ""
+
+	msgs := a.backend.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	if config.TxHash != (common.Hash{}) && !containsTx(msgs, config.TxHash) {
+		return nil, fmt.Errorf("transaction %s not found in block %s", config.TxHash.Hex(), hash.Hex())
+	}
+
+	results, err := a.backend.TraceBlock(ctx, rpctypes.BlockNumber(resBlock.Block.Height), &traceConfig, resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) != len(msgs) {
+		return nil, fmt.Errorf("trace results count mismatch, expected %d, got %d", len(msgs), len(results))
+	}
+
+	dir, err := traceFileDir(a.ctx.Viper)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for i, msg := range msgs {
+		txHash := common.HexToHash(msg.Hash)
+		if config.TxHash != (common.Hash{}) && txHash != config.TxHash {
+			continue
+		}
+
+		// the height prefix lets the pruner enforce the retention window
+		prefix := fmt.Sprintf("block_%d-%#x-%d-%#x-", resBlock.Block.Height, hash.Bytes()[:4], i, txHash.Bytes()[:4])
+		file, err := writeStdTraceFile(dir, prefix, results[i])
+		if err != nil {
+			return files, err
+		}
+
+		a.logger.Info("wrote standard trace", "file", file)
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// containsTx returns true if a message with the given hash is in msgs.
+func containsTx(msgs []*evmtypes.MsgEthereumTx, hash common.Hash) bool {
+	for _, msg := range msgs {
+		if common.HexToHash(msg.Hash) == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// writeStdTraceFile writes the trace result to a new file in dir with the given
+// name prefix, returning the file path.
+func writeStdTraceFile(dir, prefix string, result *evmtypes.TxTraceResult) (string, error) {
+	f, err := os.CreateTemp(dir, prefix)
+	if err != nil {
+		return "", err
+	}
+
+	w := bufio.NewWriter(f)
+	if err := encodeStdTrace(json.NewEncoder(w), result); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+
+	return f.Name(), f.Close()
+}
+
+// encodeStdTrace encodes one structured log per line followed by the execution
+// summary of the transaction.
+func encodeStdTrace(enc *json.Encoder, result *evmtypes.TxTraceResult) error {
+	if result.Error != "" {
+		return enc.Encode(&stdTraceSummary{Failed: true, Error: result.Error})
+	}
+
+	bz, err := json.Marshal(result.Result)
+	if err != nil {
+		return err
+	}
+
+	var execRes logger.ExecutionResult
+	if err := json.Unmarshal(bz, &execRes); err != nil {
+		return err
+	}
+
+	for i := range execRes.StructLogs {
+		if err := enc.Encode(&execRes.StructLogs[i]); err != nil {
+			return err
+		}
+	}
+
+	return enc.Encode(&stdTraceSummary{
+		Output:  "0x" + execRes.ReturnValue,
+		GasUsed: fmt.Sprintf("%#x", execRes.Gas),
+		Failed:  execRes.Failed,
+	})
+}
@@ -0,0 +1,60 @@
+package debug
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestWriteStdTraceFile(t *testing.T) {
+	result := &evmtypes.TxTraceResult{
+		Result: map[string]interface{}{
+			"gas":         21000,
+			"failed":      false,
+			"returnValue": "01",
+			"structLogs": []map[string]interface{}{
+				{"pc": 0, "op": "PUSH1", "gas": 100, "gasCost": 3, "depth": 1},
+				{"pc": 2, "op": "STOP", "gas": 97, "gasCost": 0, "depth": 1},
+			},
+		},
+	}
+
+	file, err := writeStdTraceFile(t.TempDir(), "block_1-0x01-0-0x02-", result)
+	require.NoError(t, err)
+
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+
+	require.Len(t, lines, 3)
+	require.Equal(t, "PUSH1", lines[0]["op"])
+	require.Equal(t, "STOP", lines[1]["op"])
+	require.Equal(t, "0x01", lines[2]["output"])
+	require.Equal(t, "0x5208", lines[2]["gasUsed"])
+}
+
+func TestWriteStdTraceFileError(t *testing.T) {
+	file, err := writeStdTraceFile(t.TempDir(), "block_1-0x01-0-0x02-", &evmtypes.TxTraceResult{Error: "execution reverted"})
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	var summary stdTraceSummary
+	require.NoError(t, json.Unmarshal(bz, &summary))
+	require.True(t, summary.Failed)
+	require.Equal(t, "execution reverted", summary.Error)
+}
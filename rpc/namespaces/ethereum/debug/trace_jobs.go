@@ -0,0 +1,221 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package debug
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// TraceJobState is the execution state of a trace job.
+type TraceJobState string
+
+const (
+	TraceJobQueued  TraceJobState = "queued"
+	TraceJobRunning TraceJobState = "running"
+	TraceJobDone    TraceJobState = "done"
+	TraceJobFailed  TraceJobState = "failed"
+)
+
+// TraceJobStatus is the status of a trace job returned by `debug_traceStatus`.
+type TraceJobStatus struct {
+	ID     rpc.ID        `json:"id"`
+	Method string        `json:"method"`
+	State  TraceJobState `json:"state"`
+	// Position is the number of jobs to be executed before a queued job.
+	Position int    `json:"position"`
+	Error    string `json:"error,omitempty"`
+}
+
+type traceJob struct {
+	status TraceJobStatus
+	run    func() (interface{}, error)
+	result interface{}
+}
+
+// traceQueue executes the trace jobs with a bounded number of workers, so that the heavy traces
+// are serialized instead of replaying blocks concurrently. The results of the last completed jobs
+// are kept until they are evicted by newer ones.
+type traceQueue struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	jobs     map[rpc.ID]*traceJob
+	queued   []*traceJob
+	finished []rpc.ID
+	size     int
+}
+
+// newTraceQueue creates a queue of the given size and starts its workers.
+func newTraceQueue(workers, size int) *traceQueue {
+	q := &traceQueue{
+		jobs: make(map[rpc.ID]*traceJob),
+		size: size,
+	}
+	q.cond = sync.NewCond(&q.mu)
+	for i := 0; i < workers; i++ {
+		go q.work()
+	}
+	return q
+}
+
+// Submit queues a job, it fails if the queue is full.
+func (q *traceQueue) Submit(method string, run func() (interface{}, error)) (rpc.ID, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.queued) >= q.size {
+		return "", fmt.Errorf("trace job queue is full, %d jobs waiting", len(q.queued))
+	}
+
+	job := &traceJob{
+		status: TraceJobStatus{ID: rpc.NewID(), Method: method, State: TraceJobQueued},
+		run:    run,
+	}
+	q.jobs[job.status.ID] = job
+	q.queued = append(q.queued, job)
+	q.cond.Signal()
+	return job.status.ID, nil
+}
+
+// Status returns the status of a job.
+func (q *traceQueue) Status(id rpc.ID) (*TraceJobStatus, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.jobs[id]
+	if !ok {
+		return nil, fmt.Errorf("trace job %s not found", id)
+	}
+
+	status := job.status
+	if status.State == TraceJobQueued {
+		for i, queued := range q.queued {
+			if queued == job {
+				status.Position = i
+				break
+			}
+		}
+	}
+	return &status, nil
+}
+
+// Result returns the result of a completed job, or its error if it failed.
+func (q *traceQueue) Result(id rpc.ID) (interface{}, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job, ok := q.jobs[id]
+	if !ok {
+		return nil, fmt.Errorf("trace job %s not found", id)
+	}
+
+	switch job.status.State {
+	case TraceJobDone:
+		return job.result, nil
+	case TraceJobFailed:
+		return nil, fmt.Errorf("trace job %s failed: %s", id, job.status.Error)
+	default:
+		return nil, fmt.Errorf("trace job %s is %s", id, job.status.State)
+	}
+}
+
+// work executes the queued jobs in order.
+func (q *traceQueue) work() {
+	for {
+		q.mu.Lock()
+		for len(q.queued) == 0 {
+			q.cond.Wait()
+		}
+		job := q.queued[0]
+		q.queued = q.queued[1:]
+		job.status.State = TraceJobRunning
+		q.mu.Unlock()
+
+		result, err := job.run()
+
+		q.mu.Lock()
+		if err != nil {
+			job.status.State = TraceJobFailed
+			job.status.Error = err.Error()
+		} else {
+			job.status.State = TraceJobDone
+			job.result = result
+		}
+		job.run = nil
+
+		q.finished = append(q.finished, job.status.ID)
+		if len(q.finished) > q.size {
+			delete(q.jobs, q.finished[0])
+			q.finished = q.finished[1:]
+		}
+		q.mu.Unlock()
+	}
+}
+
+// QueueTraceTransaction queues the trace of a transaction, returning the id of the job whose
+// result is returned by `debug_traceResult`.
+func (a *API) QueueTraceTransaction(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) (rpc.ID, error) {
+	a.logger.Debug("debug_queueTraceTransaction", "hash", hash)
+	jobCtx := jobContext(ctx)
+	return a.traceJobs.Submit("debug_traceTransaction", func() (interface{}, error) {
+		return a.TraceTransaction(jobCtx, hash, config)
+	})
+}
+
+// QueueTraceBlockByNumber queues the trace of a block, returning the id of the job whose result
+// is returned by `debug_traceResult`.
+func (a *API) QueueTraceBlockByNumber(ctx context.Context, height rpctypes.BlockNumber, config *evmtypes.TraceConfig) (rpc.ID, error) {
+	a.logger.Debug("debug_queueTraceBlockByNumber", "height", height)
+	jobCtx := jobContext(ctx)
+	return a.traceJobs.Submit("debug_traceBlockByNumber", func() (interface{}, error) {
+		return a.TraceBlockByNumber(jobCtx, height, config)
+	})
+}
+
+// QueueTraceBlockByHash queues the trace of a block, returning the id of the job whose result is
+// returned by `debug_traceResult`.
+func (a *API) QueueTraceBlockByHash(ctx context.Context, hash common.Hash, config *evmtypes.TraceConfig) (rpc.ID, error) {
+	a.logger.Debug("debug_queueTraceBlockByHash", "hash", hash)
+	jobCtx := jobContext(ctx)
+	return a.traceJobs.Submit("debug_traceBlockByHash", func() (interface{}, error) {
+		return a.TraceBlockByHash(jobCtx, hash, config)
+	})
+}
+
+// TraceStatus returns the status of a queued trace job.
+func (a *API) TraceStatus(id rpc.ID) (*TraceJobStatus, error) {
+	a.logger.Debug("debug_traceStatus", "id", id)
+	return a.traceJobs.Status(id)
+}
+
+// TraceResult returns the result of a completed trace job.
+func (a *API) TraceResult(id rpc.ID) (interface{}, error) {
+	a.logger.Debug("debug_traceResult", "id", id)
+	return a.traceJobs.Result(id)
+}
+
+// jobContext returns the context of a trace job, which outlives the request queuing it but keeps
+// its ID.
+func jobContext(ctx context.Context) context.Context {
+	return rpctypes.ContextWithRequestID(context.Background(), rpctypes.RequestIDFromContext(ctx))
+}
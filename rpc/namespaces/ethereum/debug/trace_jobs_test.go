@@ -0,0 +1,70 @@
+package debug
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func waitTraceJob(t *testing.T, q *traceQueue, id rpc.ID, state TraceJobState) {
+	require.Eventually(t, func() bool {
+		status, err := q.Status(id)
+		require.NoError(t, err)
+		return status.State == state
+	}, time.Second, time.Millisecond)
+}
+
+func TestTraceQueue(t *testing.T) {
+	q := newTraceQueue(1, 2)
+	release := make(chan struct{})
+
+	blocking, err := q.Submit("debug_traceBlockByNumber", func() (interface{}, error) {
+		<-release
+		return "block", nil
+	})
+	require.NoError(t, err)
+	waitTraceJob(t, q, blocking, TraceJobRunning)
+
+	// the jobs are serialized by the single worker
+	failing, err := q.Submit("debug_traceTransaction", func() (interface{}, error) {
+		return nil, errors.New("tx not found")
+	})
+	require.NoError(t, err)
+	last, err := q.Submit("debug_traceTransaction", func() (interface{}, error) {
+		return "tx", nil
+	})
+	require.NoError(t, err)
+	_, err = q.Submit("debug_traceTransaction", func() (interface{}, error) {
+		return nil, nil
+	})
+	require.Error(t, err, "queue full")
+
+	status, err := q.Status(last)
+	require.NoError(t, err)
+	require.Equal(t, TraceJobStatus{ID: last, Method: "debug_traceTransaction", State: TraceJobQueued, Position: 1}, *status)
+	_, err = q.Result(last)
+	require.Error(t, err)
+
+	close(release)
+	waitTraceJob(t, q, last, TraceJobDone)
+
+	result, err := q.Result(last)
+	require.NoError(t, err)
+	require.Equal(t, "tx", result)
+
+	status, err = q.Status(failing)
+	require.NoError(t, err)
+	require.Equal(t, TraceJobFailed, status.State)
+	require.Equal(t, "tx not found", status.Error)
+	_, err = q.Result(failing)
+	require.ErrorContains(t, err, "tx not found")
+
+	// the oldest result is evicted, the queue keeping as many results as queued jobs
+	_, err = q.Status(blocking)
+	require.Error(t, err)
+	_, err = q.Result("unknown")
+	require.Error(t, err)
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package debug
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	srvflags "github.com/evmos/ethermint/server/flags"
+)
+
+// traceFilePruneInterval is the period between two runs of the trace file pruner.
+const traceFilePruneInterval = time.Minute
+
+// traceFileRegexp matches the names of the files written by `debug_standardTraceBlockToFile`,
+// capturing the block height.
+var traceFileRegexp = regexp.MustCompile(`^block_(\d+)-0x[0-9a-f]+-\d+-0x[0-9a-f]+-\d+$`)
+
+// traceFile is a trace file found by the pruner.
+type traceFile struct {
+	path   string
+	height int64
+	size   int64
+}
+
+// traceFilePruner deletes the trace files of the blocks out of the retention window and the
+// oldest trace files exceeding the disk budget. Only the files named after the trace file
+// pattern are considered, so that it is safe to share the directory with other files.
+type traceFilePruner struct {
+	logger       log.Logger
+	dir          string
+	retainBlocks int64
+	maxDiskSize  int64
+	latestHeight func() (int64, error)
+}
+
+// traceFileDir returns the directory where the trace files are written.
+func traceFileDir(v *viper.Viper) (string, error) {
+	dir := v.GetString(srvflags.JSONRPCTraceFileDir)
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return ExpandHome(dir)
+}
+
+// run prunes the trace files periodically, it never returns.
+func (p *traceFilePruner) run() {
+	ticker := time.NewTicker(traceFilePruneInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := p.prune(); err != nil {
+			p.logger.Error("failed to prune the trace files", "dir", p.dir, "error", err.Error())
+		}
+	}
+}
+
+// prune deletes the trace files out of the retention policy and reports the disk usage metrics.
+func (p *traceFilePruner) prune() error {
+	files, err := p.listFiles()
+	if err != nil {
+		return err
+	}
+
+	var minHeight int64
+	if p.retainBlocks > 0 {
+		latest, err := p.latestHeight()
+		if err != nil {
+			return err
+		}
+		minHeight = latest - p.retainBlocks + 1
+	}
+
+	// oldest blocks first, so that the budget is enforced by deleting them
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].height < files[j].height
+	})
+
+	var total int64
+	for _, f := range files {
+		total += f.size
+	}
+
+	var prunedFiles, prunedBytes int64
+	for _, f := range files {
+		expired := f.height < minHeight
+		overBudget := p.maxDiskSize > 0 && total > p.maxDiskSize
+		if !expired && !overBudget {
+			break
+		}
+
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		total -= f.size
+		prunedFiles++
+		prunedBytes += f.size
+	}
+
+	telemetry.SetGauge(float32(total), "json_rpc", "trace_files", "bytes")
+	if prunedFiles > 0 {
+		telemetry.IncrCounter(float32(prunedFiles), "json_rpc", "trace_files", "pruned")
+		telemetry.IncrCounter(float32(prunedBytes), "json_rpc", "trace_files", "pruned_bytes")
+		p.logger.Info("pruned trace files", "dir", p.dir, "files", prunedFiles, "bytes", prunedBytes)
+	}
+
+	return nil
+}
+
+// listFiles returns the trace files of the directory.
+func (p *traceFilePruner) listFiles() ([]traceFile, error) {
+	entries, err := os.ReadDir(p.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var files []traceFile
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		match := traceFileRegexp.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		height, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			// deleted concurrently
+			continue
+		}
+
+		files = append(files, traceFile{
+			path:   filepath.Join(p.dir, entry.Name()),
+			height: height,
+			size:   info.Size(),
+		})
+	}
+
+	return files, nil
+}
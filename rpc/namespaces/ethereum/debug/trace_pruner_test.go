@@ -0,0 +1,79 @@
+package debug
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func writeTraceFiles(t *testing.T, dir string, heights ...int64) {
+	for i, height := range heights {
+		name := fmt.Sprintf("block_%d-0x01020304-%d-0x05060708-123456", height, i)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), make([]byte, 100), 0o600))
+	}
+}
+
+func listTraceHeights(t *testing.T, dir string) []int64 {
+	p := &traceFilePruner{dir: dir}
+	files, err := p.listFiles()
+	require.NoError(t, err)
+
+	heights := make([]int64, 0, len(files))
+	for _, f := range files {
+		heights = append(heights, f.height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights
+}
+
+func TestTraceFilePruner(t *testing.T) {
+	testCases := []struct {
+		name         string
+		retainBlocks int64
+		maxDiskSize  int64
+		expHeights   []int64
+	}{
+		{"no retention", 0, 0, []int64{5, 8, 9, 10, 10}},
+		{"retention window", 3, 0, []int64{8, 9, 10, 10}},
+		{"disk budget", 0, 250, []int64{10, 10}},
+		{"window and budget", 3, 300, []int64{9, 10, 10}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeTraceFiles(t, dir, 10, 5, 9, 8, 10)
+			other := filepath.Join(dir, "block_profile.out")
+			require.NoError(t, os.WriteFile(other, make([]byte, 1000), 0o600))
+
+			p := &traceFilePruner{
+				logger:       log.NewNopLogger(),
+				dir:          dir,
+				retainBlocks: tc.retainBlocks,
+				maxDiskSize:  tc.maxDiskSize,
+				latestHeight: func() (int64, error) { return 10, nil },
+			}
+			require.NoError(t, p.prune())
+			require.Equal(t, tc.expHeights, listTraceHeights(t, dir))
+
+			// the files not written by the tracer are never pruned
+			_, err := os.Stat(other)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTraceFilePrunerMissingDir(t *testing.T) {
+	p := &traceFilePruner{
+		logger:       log.NewNopLogger(),
+		dir:          filepath.Join(t.TempDir(), "missing"),
+		retainBlocks: 1,
+		latestHeight: func() (int64, error) { return 10, nil },
+	}
+	require.NoError(t, p.prune())
+}
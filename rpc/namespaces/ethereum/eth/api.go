@@ -46,8 +46,8 @@ type EthereumAPI interface {
 	//
 	// Retrieves information from a particular block in the blockchain.
 	BlockNumber() (hexutil.Uint64, error)
-	GetBlockByNumber(ethBlockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
-	GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error)
+	GetBlockByNumber(ethBlockNum rpctypes.BlockNumber, fullTx bool, opts *rpctypes.BlockOptions) (map[string]interface{}, error)
+	GetBlockByHash(hash common.Hash, fullTx bool, opts *rpctypes.BlockOptions) (map[string]interface{}, error)
 	GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint
 	GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) *hexutil.Uint
 
@@ -56,8 +56,8 @@ type EthereumAPI interface {
 	// Retrieves information on the state data for addresses regardless of whether
 	// it is a user or a smart contract.
 	GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error)
-	GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
-	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetTransactionCount(address common.Address, blockNrOrHash *rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
+	GetTransactionReceipt(hash common.Hash, opts *rpctypes.ReceiptOptions) (map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	// eth_getBlockReceipts
@@ -75,23 +75,23 @@ type EthereumAPI interface {
 	//
 	// Returns information regarding an address's stored on-chain data.
 	Accounts() ([]common.Address, error)
-	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
-	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
-	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
-	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
+	GetBalance(address common.Address, blockNrOrHash *rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
+	GetStorageAt(address common.Address, key string, blockNrOrHash *rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetCode(address common.Address, blockNrOrHash *rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetProof(address common.Address, storageKeys []string, blockNrOrHash *rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
 
 	// EVM/Smart Contract Execution
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
-	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error)
+	Call(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash, overrides *rpctypes.StateOverride) (hexutil.Bytes, error)
 
 	// Chain Information
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
@@ -108,6 +108,15 @@ type EthereumAPI interface {
 	Hashrate() hexutil.Uint64
 	Mining() bool
 
+	// Compilers
+	//
+	// Legacy methods removed from the Ethereum clients, recognized so that the
+	// tooling can detect that no compiler is available.
+	GetCompilers() []string
+	CompileSolidity(source string) (map[string]interface{}, error)
+	CompileLLL(source string) (hexutil.Bytes, error)
+	CompileSerpent(source string) (hexutil.Bytes, error)
+
 	// Other
 	Syncing() (interface{}, error)
 	Coinbase() (string, error)
@@ -118,10 +127,6 @@ type EthereumAPI interface {
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	GetPendingTransactions() ([]*rpctypes.RPCTransaction, error)
 	// eth_signTransaction (on Ethereum.org)
-	// eth_getCompilers (on Ethereum.org)
-	// eth_compileSolidity (on Ethereum.org)
-	// eth_compileLLL (on Ethereum.org)
-	// eth_compileSerpent (on Ethereum.org)
 	// eth_getWork (on Ethereum.org)
 	// eth_submitWork (on Ethereum.org)
 	// eth_submitHashrate (on Ethereum.org)
@@ -157,16 +162,46 @@ func (e *PublicAPI) BlockNumber() (hexutil.Uint64, error) {
 	return e.backend.BlockNumber()
 }
 
-// GetBlockByNumber returns the block identified by number.
-func (e *PublicAPI) GetBlockByNumber(ethBlockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+// GetBlockByNumber returns the block identified by number. The optional
+// ethermint specific options add the Tendermint metadata of the block.
+func (e *PublicAPI) GetBlockByNumber(ethBlockNum rpctypes.BlockNumber, fullTx bool, opts *rpctypes.BlockOptions) (map[string]interface{}, error) {
 	e.logger.Debug("eth_getBlockByNumber", "number", ethBlockNum, "full", fullTx)
-	return e.backend.GetBlockByNumber(ethBlockNum, fullTx)
+	block, err := e.backend.GetBlockByNumber(ethBlockNum, fullTx)
+	if err != nil {
+		return nil, err
+	}
+	return e.addTendermintMetadata(block, opts)
 }
 
-// GetBlockByHash returns the block identified by hash.
-func (e *PublicAPI) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+// GetBlockByHash returns the block identified by hash. The optional ethermint
+// specific options add the Tendermint metadata of the block.
+func (e *PublicAPI) GetBlockByHash(hash common.Hash, fullTx bool, opts *rpctypes.BlockOptions) (map[string]interface{}, error) {
 	e.logger.Debug("eth_getBlockByHash", "hash", hash.Hex(), "full", fullTx)
-	return e.backend.GetBlockByHash(hash, fullTx)
+	block, err := e.backend.GetBlockByHash(hash, fullTx)
+	if err != nil {
+		return nil, err
+	}
+	return e.addTendermintMetadata(block, opts)
+}
+
+// addTendermintMetadata adds the Tendermint metadata to the block under the
+// `tendermint` key if requested.
+func (e *PublicAPI) addTendermintMetadata(block map[string]interface{}, opts *rpctypes.BlockOptions) (map[string]interface{}, error) {
+	if block == nil || opts == nil || !opts.Tendermint {
+		return block, nil
+	}
+
+	height, ok := block["number"].(hexutil.Uint64)
+	if !ok {
+		return block, nil
+	}
+
+	metadata, err := e.backend.TendermintMetadata(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	block["tendermint"] = metadata
+	return block, nil
 }
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -180,20 +215,36 @@ func (e *PublicAPI) GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransac
 }
 
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
-func (e *PublicAPI) GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error) {
+func (e *PublicAPI) GetTransactionCount(address common.Address, blockNrOrHash *rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error) {
 	e.logger.Debug("eth_getTransactionCount", "address", address.Hex(), "block number or hash", blockNrOrHash)
-	blockNum, err := e.backend.BlockNumberFromTendermint(blockNrOrHash)
+	blockNum, err := e.backend.BlockNumberFromTendermint(blockNrOrHash.OrLatest())
 	if err != nil {
 		return nil, err
 	}
 	return e.backend.GetTransactionCount(address, blockNum)
 }
 
-// GetTransactionReceipt returns the transaction receipt identified by hash.
-func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+// GetTransactionReceipt returns the transaction receipt identified by hash. The
+// optional ethermint specific options add the Tendermint finality of its block.
+func (e *PublicAPI) GetTransactionReceipt(hash common.Hash, opts *rpctypes.ReceiptOptions) (map[string]interface{}, error) {
 	hexTx := hash.Hex()
 	e.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
-	return e.backend.GetTransactionReceipt(hash)
+	receipt, err := e.backend.GetTransactionReceipt(hash)
+	if err != nil || receipt == nil || opts == nil || !opts.Tendermint {
+		return receipt, err
+	}
+
+	height, ok := receipt["blockNumber"].(hexutil.Uint64)
+	if !ok {
+		return receipt, nil
+	}
+
+	finality, err := e.backend.TxFinality(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	receipt["tendermint"] = finality
+	return receipt, nil
 }
 
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
@@ -247,30 +298,30 @@ func (e *PublicAPI) Accounts() ([]common.Address, error) {
 }
 
 // GetBalance returns the provided account's balance up to the provided block number.
-func (e *PublicAPI) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
+func (e *PublicAPI) GetBalance(address common.Address, blockNrOrHash *rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
 	e.logger.Debug("eth_getBalance", "address", address.String(), "block number or hash", blockNrOrHash)
-	return e.backend.GetBalance(address, blockNrOrHash)
+	return e.backend.GetBalance(address, blockNrOrHash.OrLatest())
 }
 
 // GetStorageAt returns the contract storage at the given address, block number, and key.
-func (e *PublicAPI) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+func (e *PublicAPI) GetStorageAt(address common.Address, key string, blockNrOrHash *rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_getStorageAt", "address", address.Hex(), "key", key, "block number or hash", blockNrOrHash)
-	return e.backend.GetStorageAt(address, key, blockNrOrHash)
+	return e.backend.GetStorageAt(address, key, blockNrOrHash.OrLatest())
 }
 
 // GetCode returns the contract code at the given address and block number.
-func (e *PublicAPI) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+func (e *PublicAPI) GetCode(address common.Address, blockNrOrHash *rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_getCode", "address", address.Hex(), "block number or hash", blockNrOrHash)
-	return e.backend.GetCode(address, blockNrOrHash)
+	return e.backend.GetCode(address, blockNrOrHash.OrLatest())
 }
 
 // GetProof returns an account object with proof and any storage proofs
 func (e *PublicAPI) GetProof(address common.Address,
 	storageKeys []string,
-	blockNrOrHash rpctypes.BlockNumberOrHash,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
 ) (*rpctypes.AccountResult, error) {
 	e.logger.Debug("eth_getProof", "address", address.Hex(), "keys", storageKeys, "block number or hash", blockNrOrHash)
-	return e.backend.GetProof(address, storageKeys, blockNrOrHash)
+	return e.backend.GetProof(address, storageKeys, blockNrOrHash.OrLatest())
 }
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -278,17 +329,17 @@ func (e *PublicAPI) GetProof(address common.Address,
 ///////////////////////////////////////////////////////////////////////////////
 
 // Call performs a raw contract call.
-func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
-	blockNrOrHash rpctypes.BlockNumberOrHash,
-	_ *rpctypes.StateOverride,
+func (e *PublicAPI) Call(ctx context.Context, args evmtypes.TransactionArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+	overrides *rpctypes.StateOverride,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
-	blockNum, err := e.backend.BlockNumberFromTendermint(blockNrOrHash)
+	blockNum, err := e.backend.BlockNumberFromTendermint(blockNrOrHash.OrLatest())
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(ctx, args, blockNum, overrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -318,9 +369,9 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (e *PublicAPI) EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(ctx, args, blockNrOptional)
 }
 
 func (e *PublicAPI) FeeHistory(blockCount rpc.DecimalOrHex,
@@ -388,6 +439,37 @@ func (e *PublicAPI) Mining() bool {
 	return false
 }
 
+///////////////////////////////////////////////////////////////////////////////
+///                           Compilers                                     ///
+///////////////////////////////////////////////////////////////////////////////
+
+// GetCompilers returns the compilers available to compile the contracts. Always empty.
+func (e *PublicAPI) GetCompilers() []string {
+	e.logger.Debug("eth_getCompilers")
+	return []string{}
+}
+
+// CompileSolidity compiles a Solidity contract.
+// Unsupported in Ethermint
+func (e *PublicAPI) CompileSolidity(_ string) (map[string]interface{}, error) {
+	e.logger.Debug("eth_compileSolidity")
+	return nil, rpctypes.NewUnsupportedMethodError("eth_compileSolidity")
+}
+
+// CompileLLL compiles a LLL contract.
+// Unsupported in Ethermint
+func (e *PublicAPI) CompileLLL(_ string) (hexutil.Bytes, error) {
+	e.logger.Debug("eth_compileLLL")
+	return nil, rpctypes.NewUnsupportedMethodError("eth_compileLLL")
+}
+
+// CompileSerpent compiles a Serpent contract.
+// Unsupported in Ethermint
+func (e *PublicAPI) CompileSerpent(_ string) (hexutil.Bytes, error) {
+	e.logger.Debug("eth_compileSerpent")
+	return nil, rpctypes.NewUnsupportedMethodError("eth_compileSerpent")
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Other 															          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -65,6 +65,7 @@ type Backend interface {
 	RPCFilterCap() int32
 	RPCLogsCap() int32
 	RPCBlockRangeCap() int32
+	RPCConfirmationDepth() uint64
 }
 
 // consider a filter inactive if it has not been polled for within deadline
@@ -100,7 +101,7 @@ func NewPublicAPI(logger log.Logger, clientCtx client.Context, tmWSClient *rpccl
 		clientCtx: clientCtx,
 		backend:   backend,
 		filters:   make(map[rpc.ID]*filter),
-		events:    NewEventSystem(logger, tmWSClient),
+		events:    NewEventSystem(logger, tmWSClient, clientCtx.Client),
 	}
 
 	go api.timeoutLoop()
@@ -287,6 +288,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 	}
 
 	api.filters[headerSub.ID()] = &filter{typ: filters.BlocksSubscription, deadline: time.NewTimer(deadline), hashes: []common.Hash{}, s: headerSub}
+	headersCh := ConfirmHeads(headerSub.eventCh, api.backend.RPCConfirmationDepth())
 
 	go func(headersCh <-chan coretypes.ResultEvent, errCh <-chan error) {
 		defer cancelSubs()
@@ -319,7 +321,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 				return
 			}
 		}
-	}(headerSub.eventCh, headerSub.Err())
+	}(headersCh, headerSub.Err())
 
 	return headerSub.ID()
 }
@@ -369,7 +371,7 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 				return
 			}
 		}
-	}(headersSub.eventCh)
+	}(ConfirmHeads(headersSub.eventCh, api.backend.RPCConfirmationDepth()))
 
 	return rpcSub, err
 }
@@ -460,6 +462,10 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 		return rpc.ID(""), fmt.Errorf("error creating filter: max limit reached")
 	}
 
+	if depth := api.backend.RPCConfirmationDepth(); depth > 0 {
+		return api.newConfirmedLogsFilter(criteria, depth)
+	}
+
 	var (
 		filterID = rpc.ID("")
 		err      error
@@ -523,27 +529,83 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 	return filterID, err
 }
 
+// newConfirmedLogsFilter creates a logs filter whose logs are fetched once their block is confirmed
+// by depth blocks. The filters lock must be held.
+func (api *PublicFilterAPI) newConfirmedLogsFilter(criteria filters.FilterCriteria, depth uint64) (rpc.ID, error) {
+	if err := ValidateLogsRange(criteria); err != nil {
+		return rpc.ID(""), err
+	}
+
+	header, err := api.backend.HeaderByNumber(types.EthLatestBlockNumber)
+	if err != nil {
+		return rpc.ID(""), err
+	}
+	if header == nil || header.Number == nil {
+		return rpc.ID(""), fmt.Errorf("latest header not found")
+	}
+	last := header.Number.Int64()
+
+	headerSub, cancelSubs, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		return rpc.ID(""), err
+	}
+
+	filterID := headerSub.ID()
+	api.filters[filterID] = &filter{
+		typ:      filters.LogsSubscription,
+		crit:     criteria,
+		deadline: time.NewTimer(deadline),
+		hashes:   []common.Hash{},
+		s:        headerSub,
+	}
+
+	go func(headersCh <-chan coretypes.ResultEvent) {
+		defer cancelSubs()
+
+		for {
+			select {
+			case ev, ok := <-headersCh:
+				if !ok {
+					api.filtersMu.Lock()
+					delete(api.filters, filterID)
+					api.filtersMu.Unlock()
+					return
+				}
+				data, ok := ev.Data.(tmtypes.EventDataNewBlockHeader)
+				if !ok {
+					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+					continue
+				}
+
+				var logs []*ethtypes.Log
+				logs, last, err = ConfirmedLogs(api.logger, api.backend, criteria, last, data.Header.Height, depth)
+				if err != nil {
+					api.logger.Error("failed to fetch the confirmed logs", "height", data.Header.Height, "error", err.Error())
+					continue
+				}
+
+				api.filtersMu.Lock()
+				if f, found := api.filters[filterID]; found {
+					f.logs = append(f.logs, logs...)
+				}
+				api.filtersMu.Unlock()
+			case <-headerSub.Err():
+				api.filtersMu.Lock()
+				delete(api.filters, filterID)
+				api.filtersMu.Unlock()
+				return
+			}
+		}
+	}(headerSub.eventCh)
+
+	return filterID, nil
+}
+
 // GetLogs returns logs matching the given argument that are stored within the state.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
 func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
-	var filter *Filter
-	if crit.BlockHash != nil {
-		// Block filter requested, construct a single-shot filter
-		filter = NewBlockFilter(api.logger, api.backend, crit)
-	} else {
-		// Convert the RPC block numbers into internal representations
-		begin := rpc.LatestBlockNumber.Int64()
-		if crit.FromBlock != nil {
-			begin = crit.FromBlock.Int64()
-		}
-		end := rpc.LatestBlockNumber.Int64()
-		if crit.ToBlock != nil {
-			end = crit.ToBlock.Int64()
-		}
-		// Construct the range filter
-		filter = NewRangeFilter(api.logger, api.backend, begin, end, crit.Addresses, crit.Topics)
-	}
+	filter := NewFilterFromCriteria(api.logger, api.backend, crit)
 
 	// Run the filter and return all the logs
 	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
@@ -0,0 +1,123 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"context"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// The confirmation depth is the number of blocks on top of a block before its headers and logs are
+// emitted by the filters and the subscriptions, as a safety margin against the state-sync restores
+// and the rollbacks shifting the visible heights.
+
+// ConfirmHeads forwards the new block header events of the channel once their block is confirmed by
+// depth blocks. The pending headers of the rolled back blocks are dropped. The headers are forwarded as
+// they come if depth is zero. The returned channel is closed with the headers one.
+func ConfirmHeads(headsCh <-chan coretypes.ResultEvent, depth uint64) <-chan coretypes.ResultEvent {
+	if depth == 0 {
+		return headsCh
+	}
+
+	confirmedCh := make(chan coretypes.ResultEvent)
+	go func() {
+		defer close(confirmedCh)
+
+		queue := newConfirmationQueue(depth)
+		for event := range headsCh {
+			data, ok := event.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+			for _, confirmed := range queue.Push(data.Header.Height, event) {
+				confirmedCh <- confirmed
+			}
+		}
+	}()
+	return confirmedCh
+}
+
+// confirmationQueue holds the header events until their block is confirmed.
+type confirmationQueue struct {
+	depth   int64
+	head    int64
+	pending []pendingHeader
+}
+
+type pendingHeader struct {
+	height int64
+	event  coretypes.ResultEvent
+}
+
+func newConfirmationQueue(depth uint64) *confirmationQueue {
+	return &confirmationQueue{depth: int64(depth)}
+}
+
+// Push queues the header of the new head and returns the headers it confirms. A head lower than or
+// equal to the previous one replaces the rolled back blocks, whose pending headers are dropped.
+func (q *confirmationQueue) Push(head int64, event coretypes.ResultEvent) []coretypes.ResultEvent {
+	if head <= q.head {
+		kept := q.pending[:0]
+		for _, pending := range q.pending {
+			if pending.height < head {
+				kept = append(kept, pending)
+			}
+		}
+		q.pending = kept
+	}
+	q.head = head
+	q.pending = append(q.pending, pendingHeader{height: head, event: event})
+
+	var confirmed []coretypes.ResultEvent
+	for len(q.pending) > 0 && q.pending[0].height <= head-q.depth {
+		confirmed = append(confirmed, q.pending[0].event)
+		q.pending = q.pending[1:]
+	}
+	return confirmed
+}
+
+// ConfirmedLogs returns the logs matching the criteria of the blocks following the last one returned,
+// up to the last block confirmed by depth blocks at the given head, and the height of this block. The
+// logs are fetched from the backend, the confirmed blocks being the canonical ones.
+func ConfirmedLogs(
+	logger log.Logger, backend Backend, crit filters.FilterCriteria, last, head int64, depth uint64,
+) ([]*ethtypes.Log, int64, error) {
+	from, to := last+1, head-int64(depth)
+	if crit.FromBlock != nil && crit.FromBlock.Int64() > from {
+		from = crit.FromBlock.Int64()
+	}
+	if crit.ToBlock != nil && crit.ToBlock.Sign() >= 0 && crit.ToBlock.Int64() < to {
+		to = crit.ToBlock.Int64()
+	}
+	if to < from {
+		if to > last {
+			return []*ethtypes.Log{}, to, nil
+		}
+		return []*ethtypes.Log{}, last, nil
+	}
+
+	filter := NewRangeFilter(logger, backend, from, to, crit.Addresses, crit.Topics)
+	logs, err := filter.Logs(context.Background(), int(backend.RPCLogsCap()), int64(backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, last, err
+	}
+	return logs, to, nil
+}
@@ -0,0 +1,103 @@
+package filters
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func headerEvent(height int64, round int) coretypes.ResultEvent {
+	return coretypes.ResultEvent{
+		Query: headerEvents,
+		Data: tmtypes.EventDataNewBlockHeader{
+			Header: tmtypes.Header{Height: height},
+			// tells apart the headers of the blocks replacing the rolled back ones
+			NumTxs: int64(round),
+		},
+	}
+}
+
+func eventsKeys(events []coretypes.ResultEvent) [][2]int64 {
+	keys := [][2]int64{}
+	for _, event := range events {
+		data := event.Data.(tmtypes.EventDataNewBlockHeader)
+		keys = append(keys, [2]int64{data.Header.Height, data.NumTxs})
+	}
+	return keys
+}
+
+func TestConfirmationQueue(t *testing.T) {
+	queue := newConfirmationQueue(2)
+
+	require.Empty(t, queue.Push(1, headerEvent(1, 0)))
+	require.Empty(t, queue.Push(2, headerEvent(2, 0)))
+	require.Equal(t, [][2]int64{{1, 0}}, eventsKeys(queue.Push(3, headerEvent(3, 0))))
+	require.Equal(t, [][2]int64{{2, 0}}, eventsKeys(queue.Push(4, headerEvent(4, 0))))
+
+	// the pending headers of the rolled back blocks are dropped
+	require.Empty(t, queue.Push(4, headerEvent(4, 1)))
+	require.Equal(t, [][2]int64{{3, 0}}, eventsKeys(queue.Push(5, headerEvent(5, 1))))
+	require.Equal(t, [][2]int64{{4, 1}}, eventsKeys(queue.Push(6, headerEvent(6, 1))))
+
+	// a rollback below the confirmed blocks doesn't notify them again
+	require.Empty(t, queue.Push(3, headerEvent(3, 2)))
+	require.Equal(t, [][2]int64{{3, 2}}, eventsKeys(queue.Push(5, headerEvent(5, 2))))
+}
+
+func TestConfirmHeads(t *testing.T) {
+	headsCh := make(chan coretypes.ResultEvent)
+	require.Equal(t, (<-chan coretypes.ResultEvent)(headsCh), ConfirmHeads(headsCh, 0))
+
+	confirmedCh := ConfirmHeads(headsCh, 1)
+	go func() {
+		for height := int64(1); height <= 3; height++ {
+			headsCh <- headerEvent(height, 0)
+		}
+		close(headsCh)
+	}()
+
+	var confirmed []coretypes.ResultEvent
+	for event := range confirmedCh {
+		confirmed = append(confirmed, event)
+	}
+	require.Equal(t, [][2]int64{{1, 0}, {2, 0}}, eventsKeys(confirmed))
+}
+
+func TestConfirmedLogs(t *testing.T) {
+	backend := &logsBackend{blockLogs: []int{1, 1, 0, 1, 1}}
+	logger := log.NewNopLogger()
+
+	blockNumbers := func(crit filters.FilterCriteria, last, head int64) ([]uint64, int64) {
+		logs, last, err := ConfirmedLogs(logger, backend, crit, last, head, 2)
+		require.NoError(t, err)
+		numbers := []uint64{}
+		for _, log := range logs {
+			numbers = append(numbers, log.BlockNumber)
+		}
+		return numbers, last
+	}
+
+	numbers, last := blockNumbers(filters.FilterCriteria{}, 0, 4)
+	require.Equal(t, []uint64{1, 2}, numbers)
+	require.Equal(t, int64(2), last)
+
+	// the head didn't confirm a new block
+	numbers, last = blockNumbers(filters.FilterCriteria{}, 2, 4)
+	require.Empty(t, numbers)
+	require.Equal(t, int64(2), last)
+
+	numbers, last = blockNumbers(filters.FilterCriteria{}, 2, 5)
+	require.Equal(t, []uint64{}, numbers)
+	require.Equal(t, int64(3), last)
+
+	// the block range of the criteria bounds the fetched blocks
+	crit := filters.FilterCriteria{FromBlock: big.NewInt(2), ToBlock: big.NewInt(4)}
+	numbers, last = blockNumbers(crit, 0, 7)
+	require.Equal(t, []uint64{2, 4}, numbers)
+	require.Equal(t, int64(4), last)
+}
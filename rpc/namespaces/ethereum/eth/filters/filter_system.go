@@ -26,6 +26,7 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -57,6 +58,9 @@ type EventSystem struct {
 	logger     log.Logger
 	ctx        context.Context
 	tmWSClient *rpcclient.WSClient
+	// tmClient fetches the blocks whose events are replayed after a reconnection, no event is replayed
+	// if it's nil
+	tmClient tmrpcclient.SignClient
 
 	// light client mode
 	lightMode bool
@@ -69,6 +73,11 @@ type EventSystem struct {
 	install   chan *Subscription // install filter for event notification
 	uninstall chan *Subscription // remove filter for event notification
 	eventBus  pubsub.EventBus
+
+	// positions of the last events forwarded to each topic, and of the last replayed ones, only
+	// accessed by the events consumer
+	seen     map[string]eventPosition
+	replayed map[string]eventPosition
 }
 
 // NewEventSystem creates a new manager that listens for event on the given mux,
@@ -76,8 +85,9 @@ type EventSystem struct {
 // work loop holds its own index that is used to forward events to filters.
 //
 // The returned manager has a loop that needs to be stopped with the Stop function
-// or by stopping the given mux.
-func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSystem {
+// or by stopping the given mux. The subscriptions are recovered once the Tendermint WS client
+// reconnects, replaying the missed events of the blocks fetched with the tmClient.
+func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient, tmClient tmrpcclient.SignClient) *EventSystem {
 	index := make(filterIndex)
 	for i := filters.UnknownSubscription; i < filters.LastIndexSubscription; i++ {
 		index[i] = make(map[rpc.ID]*Subscription)
@@ -87,6 +97,7 @@ func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSys
 		logger:     logger,
 		ctx:        context.Background(),
 		tmWSClient: tmWSClient,
+		tmClient:   tmClient,
 		lightMode:  false,
 		index:      index,
 		topicChans: make(map[string]chan<- coretypes.ResultEvent, len(index)),
@@ -94,6 +105,8 @@ func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSys
 		install:    make(chan *Subscription),
 		uninstall:  make(chan *Subscription),
 		eventBus:   pubsub.NewEventBus(),
+		seen:       make(map[string]eventPosition),
+		replayed:   make(map[string]eventPosition),
 	}
 
 	go es.eventLoop()
@@ -165,6 +178,14 @@ func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.Unsub
 // given criteria to the given logs channel. Default value for the from and to
 // block is "latest". If the fromBlock > toBlock an error is returned.
 func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription, pubsub.UnsubscribeFunc, error) {
+	if err := ValidateLogsRange(crit); err != nil {
+		return nil, nil, err
+	}
+	return es.subscribeLogs(crit)
+}
+
+// ValidateLogsRange returns an error if the block range of the logs subscription criteria is invalid.
+func ValidateLogsRange(crit filters.FilterCriteria) error {
 	var from, to rpc.BlockNumber
 	if crit.FromBlock == nil {
 		from = rpc.LatestBlockNumber
@@ -183,10 +204,10 @@ func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription
 	case (from == rpc.LatestBlockNumber && to == rpc.LatestBlockNumber),
 		(from >= 0 && to >= 0 && to >= from),
 		(from >= 0 && to == rpc.LatestBlockNumber):
-		return es.subscribeLogs(crit)
+		return nil
 
 	default:
-		return nil, nil, fmt.Errorf("invalid from and to block combination: from > to (%d > %d)", from, to)
+		return fmt.Errorf("invalid from and to block combination: from > to (%d > %d)", from, to)
 	}
 }
 
@@ -282,11 +303,26 @@ func (es *EventSystem) eventLoop() {
 	}
 }
 
+// consumeEvents forwards the events received from Tendermint to the channels of their topics, and
+// recovers the subscriptions once the Tendermint WS client reconnects.
 func (es *EventSystem) consumeEvents() {
+	reconnects := make(chan *rpcclient.WSClient, 1)
+	reconnectsSub := tmWSReconnects.Subscribe(reconnects)
+	defer reconnectsSub.Unsubscribe()
+
 	for {
-		for rpcResp := range es.tmWSClient.ResponsesCh {
-			var ev coretypes.ResultEvent
+		select {
+		case client := <-reconnects:
+			if client == es.tmWSClient {
+				es.recoverSubscriptions()
+			}
+		case rpcResp, ok := <-es.tmWSClient.ResponsesCh:
+			if !ok {
+				time.Sleep(time.Second)
+				continue
+			}
 
+			var ev coretypes.ResultEvent
 			if rpcResp.Error != nil {
 				time.Sleep(5 * time.Second)
 				continue
@@ -300,24 +336,36 @@ func (es *EventSystem) consumeEvents() {
 				continue
 			}
 
-			es.indexMux.RLock()
-			ch, ok := es.topicChans[ev.Query]
-			es.indexMux.RUnlock()
-			if !ok {
-				es.logger.Debug("channel for subscription not found", "topic", ev.Query)
-				es.logger.Debug("list of available channels", "channels", es.eventBus.Topics())
-				continue
-			}
-
-			// gracefully handle lagging subscribers
-			t := time.NewTimer(time.Second)
-			select {
-			case <-t.C:
-				es.logger.Debug("dropped event during lagging subscription", "topic", ev.Query)
-			case ch <- ev:
-			}
+			es.forward(ev, false)
 		}
+	}
+}
+
+// forward forwards the live or replayed event to the channel of its topic, it returns false if the
+// event isn't forwarded.
+func (es *EventSystem) forward(ev coretypes.ResultEvent, replayed bool) bool {
+	es.indexMux.RLock()
+	ch, ok := es.topicChans[ev.Query]
+	es.indexMux.RUnlock()
+	if !ok {
+		es.logger.Debug("channel for subscription not found", "topic", ev.Query)
+		es.logger.Debug("list of available channels", "channels", es.eventBus.Topics())
+		return false
+	}
+
+	if !es.track(ev, replayed) {
+		es.logger.Debug("skipped event already forwarded", "topic", ev.Query)
+		return false
+	}
 
-		time.Sleep(time.Second)
+	// gracefully handle lagging subscribers
+	t := time.NewTimer(time.Second)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		es.logger.Debug("dropped event during lagging subscription", "topic", ev.Query)
+		return false
+	case ch <- ev:
+		return true
 	}
 }
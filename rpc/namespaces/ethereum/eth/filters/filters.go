@@ -29,9 +29,11 @@ import (
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // BloomIV represents the bit indexes and value inside the bloom filter that belong
@@ -91,6 +93,26 @@ func NewRangeFilter(logger log.Logger, backend Backend, begin, end int64, addres
 	return newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
 }
 
+// NewFilterFromCriteria creates the block filter or the range filter of the logs query criteria.
+func NewFilterFromCriteria(logger log.Logger, backend Backend, crit filters.FilterCriteria) *Filter {
+	if crit.BlockHash != nil {
+		// Block filter requested, construct a single-shot filter
+		return NewBlockFilter(logger, backend, crit)
+	}
+
+	// Convert the RPC block numbers into internal representations
+	begin := rpc.LatestBlockNumber.Int64()
+	if crit.FromBlock != nil {
+		begin = crit.FromBlock.Int64()
+	}
+	end := rpc.LatestBlockNumber.Int64()
+	if crit.ToBlock != nil {
+		end = crit.ToBlock.Int64()
+	}
+	// Construct the range filter
+	return NewRangeFilter(logger, backend, begin, end, crit.Addresses, crit.Topics)
+}
+
 // newFilter returns a new Filter
 func newFilter(logger log.Logger, backend Backend, criteria filters.FilterCriteria, bloomFilters [][]BloomIV) *Filter {
 	return &Filter{
@@ -107,7 +129,7 @@ const (
 
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
-func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
+func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
 	logs := []*ethtypes.Log{}
 	var err error
 
@@ -144,19 +166,14 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	}
 
 	head := header.Number.Int64()
-	if f.criteria.FromBlock.Int64() < 0 {
-		f.criteria.FromBlock = big.NewInt(head)
-	} else if f.criteria.FromBlock.Int64() == 0 {
-		f.criteria.FromBlock = big.NewInt(1)
-	}
-	if f.criteria.ToBlock.Int64() < 0 {
-		f.criteria.ToBlock = big.NewInt(head)
-	} else if f.criteria.ToBlock.Int64() == 0 {
-		f.criteria.ToBlock = big.NewInt(1)
-	}
+	f.resolveRange(head)
 
 	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
-		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+		from := f.criteria.FromBlock.Uint64()
+		return nil, types.NewLogsLimitError(
+			fmt.Sprintf("maximum [from, to] blocks distance: %d", blockLimit),
+			&types.LogsRange{FromBlock: hexutil.Uint64(from), ToBlock: hexutil.Uint64(from + uint64(blockLimit))},
+		)
 	}
 
 	// check bounds
@@ -170,6 +187,11 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	to := f.criteria.ToBlock.Int64()
 
 	for height := from; height <= to; height++ {
+		// the search is aborted once the request deadline is exceeded
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
@@ -186,15 +208,134 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 			return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
 		}
 
-		// check logs limit
+		// check logs limit, hinting the range of the blocks already processed
 		if len(logs)+len(filtered) > logLimit {
-			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+			var hint *types.LogsRange
+			if height > from {
+				hint = &types.LogsRange{FromBlock: hexutil.Uint64(from), ToBlock: hexutil.Uint64(height - 1)}
+			}
+			return nil, types.NewLogsLimitError(fmt.Sprintf("query returned more than %d results", logLimit), hint)
 		}
 		logs = append(logs, filtered...)
 	}
 	return logs, nil
 }
 
+// LogsPage searches the logs matching the filter from the cursor position, or from the start of the
+// range without cursor. It returns at most logLimit logs found in at most blockLimit blocks, and the
+// cursor of the next page, nil once the logs of the whole range are returned. The blocks above the
+// latest block are not searched, the returned cursor allows to resume once they are produced.
+func (f *Filter) LogsPage(
+	ctx context.Context, cursor *types.LogsCursor, logLimit int, blockLimit int64,
+) ([]*ethtypes.Log, *types.LogsCursor, error) {
+	var head int64
+	if f.criteria.BlockHash != nil && *f.criteria.BlockHash != (common.Hash{}) {
+		resBlock, err := f.backend.TendermintBlockByHash(*f.criteria.BlockHash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch header by hash %s: %w", f.criteria.BlockHash, err)
+		}
+		if resBlock == nil || resBlock.Block == nil {
+			return nil, nil, fmt.Errorf("block not found for hash %s", f.criteria.BlockHash)
+		}
+		head = resBlock.Block.Height
+		f.criteria.FromBlock = big.NewInt(head)
+		f.criteria.ToBlock = big.NewInt(head)
+	} else {
+		header, err := f.backend.HeaderByNumber(types.EthLatestBlockNumber)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch header by number (latest): %w", err)
+		}
+		if header == nil || header.Number == nil {
+			return nil, nil, errors.New("latest header not found")
+		}
+		head = header.Number.Int64()
+		f.resolveRange(head)
+	}
+
+	from := f.criteria.FromBlock.Int64()
+	to := f.criteria.ToBlock.Int64()
+	var skip uint
+	if cursor != nil {
+		if cursor.Height < from || cursor.Height-1 > to {
+			return nil, nil, fmt.Errorf("cursor height %d out of the [%d, %d] blocks range", cursor.Height, from, to)
+		}
+		from, skip = cursor.Height, cursor.LogIndex
+	}
+
+	if logLimit < 1 {
+		logLimit = 1
+	}
+	if blockLimit < 1 {
+		blockLimit = 1
+	}
+	end := to
+	if end > head {
+		end = head
+	}
+	if end >= from+blockLimit {
+		end = from + blockLimit - 1
+	}
+
+	logs := []*ethtypes.Log{}
+	if from > end {
+		if from > to {
+			return logs, nil, nil
+		}
+		// the next block isn't produced yet
+		return logs, &types.LogsCursor{Height: from, LogIndex: skip}, nil
+	}
+
+	for height := from; height <= end; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to fetch block result %d", height)
+		}
+
+		bloom, err := f.backend.BlockBloom(blockRes)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		filtered, err := f.blockLogs(blockRes, bloom)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
+		}
+
+		for _, log := range filtered {
+			if height == from && log.Index < skip {
+				continue
+			}
+			if len(logs) == logLimit {
+				return logs, &types.LogsCursor{Height: height, LogIndex: log.Index}, nil
+			}
+			logs = append(logs, log)
+		}
+	}
+
+	if end >= to {
+		return logs, nil, nil
+	}
+	return logs, &types.LogsCursor{Height: end + 1}, nil
+}
+
+// resolveRange resolves the latest and zero block numbers of the filter range.
+func (f *Filter) resolveRange(head int64) {
+	if f.criteria.FromBlock.Int64() < 0 {
+		f.criteria.FromBlock = big.NewInt(head)
+	} else if f.criteria.FromBlock.Int64() == 0 {
+		f.criteria.FromBlock = big.NewInt(1)
+	}
+	if f.criteria.ToBlock.Int64() < 0 {
+		f.criteria.ToBlock = big.NewInt(head)
+	} else if f.criteria.ToBlock.Int64() == 0 {
+		f.criteria.ToBlock = big.NewInt(1)
+	}
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
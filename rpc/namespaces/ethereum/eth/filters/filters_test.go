@@ -0,0 +1,171 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var _ Backend = &logsBackend{}
+
+// logsBackend serves the logs of a chain whose blocks contain the given number of logs.
+type logsBackend struct {
+	Backend
+	mu        sync.Mutex
+	blockLogs []int
+}
+
+func (b *logsBackend) addBlock(logs int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.blockLogs = append(b.blockLogs, logs)
+}
+
+func (b *logsBackend) HeaderByNumber(types.BlockNumber) (*ethtypes.Header, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return &ethtypes.Header{Number: big.NewInt(int64(len(b.blockLogs)))}, nil
+}
+
+func (b *logsBackend) TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error) {
+	b.mu.Lock()
+	count := b.blockLogs[*height-1]
+	b.mu.Unlock()
+
+	event := abci.Event{Type: evmtypes.EventTypeTxLog}
+	for i := 0; i < count; i++ {
+		bz, err := json.Marshal(&evmtypes.Log{
+			Address:     common.BigToAddress(big.NewInt(1)).Hex(),
+			BlockNumber: uint64(*height),
+			Index:       uint64(i),
+		})
+		if err != nil {
+			return nil, err
+		}
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: []byte(evmtypes.AttributeKeyTxLog), Value: bz})
+	}
+	return &coretypes.ResultBlockResults{
+		Height:     *height,
+		TxsResults: []*abci.ResponseDeliverTx{{Events: []abci.Event{event}}},
+	}, nil
+}
+
+func (b *logsBackend) BlockBloom(*coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	return ethtypes.Bloom{}, nil
+}
+
+func (b *logsBackend) RPCLogsCap() int32 {
+	return 2
+}
+
+func (b *logsBackend) RPCBlockRangeCap() int32 {
+	return 2
+}
+
+func newTestRangeFilter(backend Backend, from, to int64) *Filter {
+	return NewRangeFilter(log.NewNopLogger(), backend, from, to, nil, nil)
+}
+
+func TestLogsLimitHints(t *testing.T) {
+	backend := &logsBackend{blockLogs: []int{1, 2, 3, 4, 5}}
+
+	testCases := []struct {
+		name       string
+		from, to   int64
+		logLimit   int
+		blockLimit int64
+		expHint    *types.LogsRange
+	}{
+		{"block range exceeded", 1, 5, 100, 2, &types.LogsRange{FromBlock: 1, ToBlock: 3}},
+		{"logs limit exceeded", 2, 5, 6, 10, &types.LogsRange{FromBlock: 2, ToBlock: 3}},
+		{"first block exceeds the logs limit", 4, 5, 3, 10, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newTestRangeFilter(backend, tc.from, tc.to).Logs(context.Background(), tc.logLimit, tc.blockLimit)
+			require.Error(t, err)
+			limitErr, ok := err.(*types.LogsLimitError)
+			require.True(t, ok)
+			require.Equal(t, types.ErrCodeLimitExceeded, limitErr.ErrorCode())
+			require.Equal(t, tc.expHint, limitErr.Hint)
+		})
+	}
+}
+
+func TestLogsPage(t *testing.T) {
+	backend := &logsBackend{blockLogs: []int{1, 0, 3, 0, 0, 0, 2, 4}}
+
+	testCases := []struct {
+		name       string
+		from, to   int64
+		logLimit   int
+		blockLimit int64
+		expPages   [][]int64 // block number of the logs of each page
+	}{
+		{"single page", 1, 8, 100, 100, [][]int64{{1, 3, 3, 3, 7, 7, 8, 8, 8, 8}}},
+		{
+			"logs limit within blocks", 1, 8, 3, 100,
+			[][]int64{{1, 3, 3}, {3, 7, 7}, {8, 8, 8}, {8}},
+		},
+		{
+			"block range limit", 2, 8, 100, 2,
+			[][]int64{{3, 3, 3}, {}, {7, 7}, {8, 8, 8, 8}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cursor *types.LogsCursor
+			for i, expPage := range tc.expPages {
+				logs, next, err := newTestRangeFilter(backend, tc.from, tc.to).LogsPage(context.Background(), cursor, tc.logLimit, tc.blockLimit)
+				require.NoError(t, err)
+
+				heights := make([]int64, len(logs))
+				for j, log := range logs {
+					heights[j] = int64(log.BlockNumber)
+				}
+				require.Equal(t, expPage, heights, "page %d", i)
+
+				if i == len(tc.expPages)-1 {
+					require.Nil(t, next)
+				} else {
+					require.NotNil(t, next)
+					// the cursor is passed through its encoding
+					parsed, err := types.ParseLogsCursor(next.String())
+					require.NoError(t, err)
+					cursor = &parsed
+				}
+			}
+		})
+	}
+}
+
+func TestLogsPageAboveLatestBlock(t *testing.T) {
+	backend := &logsBackend{blockLogs: []int{1, 1}}
+
+	logs, next, err := newTestRangeFilter(backend, 1, 10).LogsPage(context.Background(), nil, 100, 100)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	// the query resumes once the next block is produced
+	require.Equal(t, &types.LogsCursor{Height: 3}, next)
+
+	_, _, err = newTestRangeFilter(backend, 1, 10).LogsPage(context.Background(), &types.LogsCursor{Height: 12}, 100, 100)
+	require.Error(t, err)
+
+	hint, err := json.Marshal(types.NewLogsLimitError("", &types.LogsRange{FromBlock: 1, ToBlock: hexutil.Uint64(2)}).ErrorData())
+	require.NoError(t, err)
+	require.JSONEq(t, `{"fromBlock":"0x1","toBlock":"0x2"}`, string(hint))
+}
@@ -0,0 +1,268 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/ethereum/go-ethereum/event"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// MaxReplayBlocks is the maximum number of blocks whose events are replayed after a reconnection to
+// Tendermint, the events of the older missed blocks are lost.
+const MaxReplayBlocks = 100
+
+// tmWSReconnects notifies the reconnections of the Tendermint WS clients to the event systems, the
+// queries subscribed through a client being lost with its connection.
+var tmWSReconnects event.Feed
+
+// NotifyTmWSReconnect notifies the event systems using the client that it reconnected to Tendermint.
+// It's meant to be called from the OnReconnect callback of the client.
+func NotifyTmWSReconnect(client *rpcclient.WSClient) {
+	tmWSReconnects.Send(client)
+}
+
+// eventPosition is the position of an event in the chain, the tx events of a block being ordered by
+// their index.
+type eventPosition struct {
+	height int64
+	index  int64
+}
+
+// after returns true if the position is after the other one.
+func (p eventPosition) after(other eventPosition) bool {
+	return p.height > other.height || p.height == other.height && p.index > other.index
+}
+
+// positionOf returns the position of the header or tx event, it returns false for the other events.
+func positionOf(ev coretypes.ResultEvent) (eventPosition, bool) {
+	switch data := ev.Data.(type) {
+	case tmtypes.EventDataNewBlockHeader:
+		return eventPosition{height: data.Header.Height}, true
+	case tmtypes.EventDataTx:
+		return eventPosition{height: data.Height, index: int64(data.Index)}, true
+	default:
+		return eventPosition{}, false
+	}
+}
+
+// track records the position of the event forwarded to its topic, it returns false if the event is
+// a live event already forwarded by the replay.
+func (es *EventSystem) track(ev coretypes.ResultEvent, replayed bool) bool {
+	pos, ok := positionOf(ev)
+	if !ok {
+		return true
+	}
+
+	if replayed {
+		if seen, ok := es.seen[ev.Query]; ok && !pos.after(seen) {
+			return false
+		}
+		es.replayed[ev.Query] = pos
+	} else if last, ok := es.replayed[ev.Query]; ok {
+		// the live events buffered during the replay are duplicates of the replayed ones
+		if !pos.after(last) {
+			return false
+		}
+		delete(es.replayed, ev.Query)
+	}
+
+	es.seen[ev.Query] = pos
+	return true
+}
+
+// recoverSubscriptions subscribes again to the topics once the Tendermint WS client reconnected, and
+// replays the events of the blocks committed since the last event forwarded to each topic, so that
+// the subscriptions don't miss the events emitted while the client was disconnected.
+func (es *EventSystem) recoverSubscriptions() {
+	es.indexMux.RLock()
+	topics := make([]string, 0, len(es.topicChans))
+	for topic := range es.topicChans {
+		topics = append(topics, topic)
+	}
+	es.indexMux.RUnlock()
+
+	es.logger.Info("resubscribing to the Tendermint events after reconnection", "topics", len(topics))
+	for _, topic := range topics {
+		if err := es.tmWSClient.Subscribe(context.Background(), topic); err != nil {
+			es.logger.Error("failed to resubscribe to query", "query", topic, "error", err.Error())
+		}
+	}
+
+	if es.tmClient == nil {
+		return
+	}
+
+	replayed, err := es.replayEvents(topics)
+	if err != nil {
+		es.logger.Error("failed to replay the missed Tendermint events", "error", err.Error())
+	}
+	if replayed > 0 {
+		es.logger.Info("replayed the missed Tendermint events", "events", replayed)
+		telemetry.IncrCounter(float32(replayed), "json_rpc", "tm_ws", "replayed_events")
+	}
+}
+
+// replayEvents forwards the events of the topics from the block of their last forwarded event to the
+// latest block, it returns the number of events replayed.
+func (es *EventSystem) replayEvents(topics []string) (int, error) {
+	queries := make(map[string]*tmquery.Query, len(topics))
+	for _, topic := range topics {
+		query, err := tmquery.New(topic)
+		if err != nil {
+			es.logger.Error("failed to parse the query of the topic", "query", topic, "error", err.Error())
+			continue
+		}
+		queries[topic] = query
+	}
+
+	// the topics without forwarded event are replayed from the last block forwarded to any topic
+	var from, lastHeight int64
+	for topic, pos := range es.seen {
+		if _, ok := queries[topic]; !ok {
+			delete(es.seen, topic)
+			continue
+		}
+		if from == 0 || pos.height < from {
+			from = pos.height
+		}
+		if pos.height > lastHeight {
+			lastHeight = pos.height
+		}
+	}
+	// nothing was forwarded yet, there's no known block to replay from
+	if lastHeight == 0 {
+		return 0, nil
+	}
+	if len(es.seen) < len(queries) {
+		from = lastHeight
+	}
+
+	ctx := context.Background()
+	latest, err := es.tmClient.Block(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	to := latest.Block.Height
+	if to-from >= MaxReplayBlocks {
+		es.logger.Error("too many missed blocks, the events of the older ones are lost", "from", from, "to", to)
+		from = to - MaxReplayBlocks + 1
+	}
+
+	replayed := 0
+	for height := from; height <= to; height++ {
+		events, err := es.blockEvents(ctx, height)
+		if err != nil {
+			return replayed, err
+		}
+
+		for _, ev := range events {
+			for topic, query := range queries {
+				matches, err := query.Matches(ev.Events)
+				if err != nil || !matches {
+					continue
+				}
+
+				ev.Query = topic
+				if es.forward(ev, true) {
+					replayed++
+				}
+			}
+		}
+	}
+	return replayed, nil
+}
+
+// blockEvents returns the header and tx events emitted by Tendermint for the block.
+func (es *EventSystem) blockEvents(ctx context.Context, height int64) ([]coretypes.ResultEvent, error) {
+	block, err := es.tmClient.Block(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	results, err := es.tmClient.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.TxsResults) != len(block.Block.Txs) {
+		return nil, fmt.Errorf("block %d has %d txs but %d tx results", height, len(block.Block.Txs), len(results.TxsResults))
+	}
+
+	blockEvents := make([]abci.Event, 0, len(results.BeginBlockEvents)+len(results.EndBlockEvents))
+	blockEvents = append(blockEvents, results.BeginBlockEvents...)
+	blockEvents = append(blockEvents, results.EndBlockEvents...)
+	headerEvents := stringifyEvents(blockEvents)
+	headerEvents[tmtypes.EventTypeKey] = append(headerEvents[tmtypes.EventTypeKey], tmtypes.EventNewBlockHeader)
+
+	events := make([]coretypes.ResultEvent, 0, len(block.Block.Txs)+1)
+	events = append(events, coretypes.ResultEvent{
+		Data: tmtypes.EventDataNewBlockHeader{
+			Header:           block.Block.Header,
+			NumTxs:           int64(len(block.Block.Txs)),
+			ResultBeginBlock: abci.ResponseBeginBlock{Events: results.BeginBlockEvents},
+			ResultEndBlock: abci.ResponseEndBlock{
+				ValidatorUpdates:      results.ValidatorUpdates,
+				ConsensusParamUpdates: results.ConsensusParamUpdates,
+				Events:                results.EndBlockEvents,
+			},
+		},
+		Events: headerEvents,
+	})
+
+	for i, tx := range block.Block.Txs {
+		result := results.TxsResults[i]
+		txEvents := stringifyEvents(result.Events)
+		txEvents[tmtypes.EventTypeKey] = append(txEvents[tmtypes.EventTypeKey], tmtypes.EventTx)
+		txEvents[tmtypes.TxHashKey] = append(txEvents[tmtypes.TxHashKey], fmt.Sprintf("%X", tx.Hash()))
+		txEvents[tmtypes.TxHeightKey] = append(txEvents[tmtypes.TxHeightKey], fmt.Sprintf("%d", height))
+
+		events = append(events, coretypes.ResultEvent{
+			Data: tmtypes.EventDataTx{TxResult: abci.TxResult{
+				Height: height,
+				Index:  uint32(i),
+				Tx:     tx,
+				Result: *result,
+			}},
+			Events: txEvents,
+		})
+	}
+	return events, nil
+}
+
+// stringifyEvents returns the composite keys of the events attributes mapped to their values, like the
+// Tendermint event bus matching the queries.
+func stringifyEvents(events []abci.Event) map[string][]string {
+	result := make(map[string][]string)
+	for _, event := range events {
+		if len(event.Type) == 0 {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+			key := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+			result[key] = append(result[key], string(attr.Value))
+		}
+	}
+	return result
+}
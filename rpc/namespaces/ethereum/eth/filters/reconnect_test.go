@@ -0,0 +1,105 @@
+package filters
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// blocksClient serves blocks containing one evm tx and one non-evm tx.
+type blocksClient struct {
+	tmrpcclient.SignClient
+	latest int64
+}
+
+func (c *blocksClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	h := c.latest
+	if height != nil {
+		h = *height
+	}
+	return &coretypes.ResultBlock{Block: &tmtypes.Block{
+		Header: tmtypes.Header{Height: h},
+		Data:   tmtypes.Data{Txs: tmtypes.Txs{tmtypes.Tx("evm"), tmtypes.Tx("bank")}},
+	}}, nil
+}
+
+func (c *blocksClient) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	moduleEvent := func(module string) abci.Event {
+		return abci.Event{Type: "message", Attributes: []abci.EventAttribute{{Key: []byte("module"), Value: []byte(module)}}}
+	}
+	return &coretypes.ResultBlockResults{
+		Height: *height,
+		TxsResults: []*abci.ResponseDeliverTx{
+			{Events: []abci.Event{moduleEvent(evmtypes.ModuleName)}},
+			{Events: []abci.Event{moduleEvent("bank")}},
+		},
+	}, nil
+}
+
+func TestReplayEvents(t *testing.T) {
+	headersCh := make(chan coretypes.ResultEvent, 100)
+	logsCh := make(chan coretypes.ResultEvent, 100)
+	es := &EventSystem{
+		logger:   log.NewNopLogger(),
+		tmClient: &blocksClient{latest: 5},
+		topicChans: map[string]chan<- coretypes.ResultEvent{
+			headerEvents: headersCh,
+			evmEvents:    logsCh,
+		},
+		indexMux: new(sync.RWMutex),
+		seen: map[string]eventPosition{
+			headerEvents: {height: 2},
+			evmEvents:    {height: 3},
+		},
+		replayed: make(map[string]eventPosition),
+	}
+
+	replayed, err := es.replayEvents([]string{headerEvents, evmEvents})
+	require.NoError(t, err)
+	// headers 3 to 5 and the evm txs of the blocks 4 and 5
+	require.Equal(t, 5, replayed)
+	require.Len(t, headersCh, 3)
+	require.Len(t, logsCh, 2)
+	for i := int64(3); i <= 5; i++ {
+		ev := <-headersCh
+		require.Equal(t, i, ev.Data.(tmtypes.EventDataNewBlockHeader).Header.Height)
+	}
+	for i := int64(4); i <= 5; i++ {
+		ev := <-logsCh
+		data := ev.Data.(tmtypes.EventDataTx)
+		require.Equal(t, i, data.Height)
+		require.Equal(t, uint32(0), data.Index)
+	}
+
+	// the live events buffered during the replay aren't forwarded twice
+	liveTx := func(height int64) coretypes.ResultEvent {
+		return coretypes.ResultEvent{Query: evmEvents, Data: tmtypes.EventDataTx{TxResult: abci.TxResult{Height: height}}}
+	}
+	require.False(t, es.forward(liveTx(5), false))
+	require.True(t, es.forward(liveTx(6), false))
+	require.Len(t, logsCh, 1)
+}
+
+func TestReplayEventsNothingForwarded(t *testing.T) {
+	es := &EventSystem{
+		logger:     log.NewNopLogger(),
+		tmClient:   &blocksClient{latest: 5},
+		topicChans: map[string]chan<- coretypes.ResultEvent{headerEvents: make(chan coretypes.ResultEvent)},
+		indexMux:   new(sync.RWMutex),
+		seen:       make(map[string]eventPosition),
+		replayed:   make(map[string]eventPosition),
+	}
+
+	replayed, err := es.replayEvents([]string{headerEvents})
+	require.NoError(t, err)
+	require.Zero(t, replayed)
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// streamPollInterval is the interval at which the log streams check for the new blocks once they
+// reached the latest block.
+const streamPollInterval = time.Second
+
+var _ evmtypes.LogStreamServer = &LogStreamServer{}
+
+// LogStreamServer implements the gRPC streaming of the logs, for the services preferring gRPC to the
+// websocket subscriptions. The streams replay the logs of the past blocks from the requested height,
+// then follow the new blocks.
+type LogStreamServer struct {
+	logger       log.Logger
+	backend      Backend
+	pollInterval time.Duration
+}
+
+// NewLogStreamServer creates a new LogStreamServer.
+func NewLogStreamServer(logger log.Logger, backend Backend) *LogStreamServer {
+	return &LogStreamServer{
+		logger:       logger.With("server", "log-stream"),
+		backend:      backend,
+		pollInterval: streamPollInterval,
+	}
+}
+
+// StreamLogs streams the logs matching the request filter until the client cancels the stream.
+func (s *LogStreamServer) StreamLogs(req *evmtypes.StreamLogsRequest, stream evmtypes.LogStream_StreamLogsServer) error {
+	addresses, topics, err := streamCriteria(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.FromHeight < 0 {
+		return status.Error(codes.InvalidArgument, "from height cannot be negative")
+	}
+
+	ctx := stream.Context()
+	head, err := s.latestHeight()
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	cursor := &types.LogsCursor{Height: head + 1}
+	if req.FromHeight > 0 {
+		cursor.Height = req.FromHeight
+	}
+
+	for {
+		// the range is open ended, the page stops at the latest block and returns the next cursor
+		filter := NewRangeFilter(s.logger, s.backend, cursor.Height, math.MaxInt64, addresses, topics)
+		logs, next, err := filter.LogsPage(ctx, cursor, int(s.backend.RPCLogsCap()), int64(s.backend.RPCBlockRangeCap()))
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		for _, log := range logs {
+			if err := stream.Send(&evmtypes.StreamLogsResponse{Log: evmtypes.NewLogFromEth(log)}); err != nil {
+				return err
+			}
+		}
+		cursor = next
+
+		head, err = s.latestHeight()
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		if cursor.Height <= head {
+			// catching up with the past blocks
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// latestHeight returns the height of the latest block.
+func (s *LogStreamServer) latestHeight() (int64, error) {
+	header, err := s.backend.HeaderByNumber(types.EthLatestBlockNumber)
+	if err != nil {
+		return 0, err
+	}
+	if header == nil || header.Number == nil {
+		return 0, errors.New("latest header not found")
+	}
+	return header.Number.Int64(), nil
+}
+
+// streamCriteria returns the addresses and topics of the stream filter.
+func streamCriteria(req *evmtypes.StreamLogsRequest) ([]common.Address, [][]common.Hash, error) {
+	addresses := make([]common.Address, len(req.Addresses))
+	for i, address := range req.Addresses {
+		if !common.IsHexAddress(address) {
+			return nil, nil, fmt.Errorf("invalid address %s", address)
+		}
+		addresses[i] = common.HexToAddress(address)
+	}
+
+	topics := make([][]common.Hash, len(req.Topics))
+	for i, position := range req.Topics {
+		for _, topic := range position.Topics {
+			bz, err := hexutil.Decode(topic)
+			if err != nil || len(bz) != common.HashLength {
+				return nil, nil, fmt.Errorf("invalid topic %s", topic)
+			}
+			topics[i] = append(topics[i], common.BytesToHash(bz))
+		}
+	}
+	return addresses, topics, nil
+}
@@ -0,0 +1,92 @@
+package filters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// logStream collects the streamed logs.
+type logStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	logs chan *evmtypes.Log
+}
+
+func (s *logStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *logStream) Send(res *evmtypes.StreamLogsResponse) error {
+	s.logs <- res.Log
+	return nil
+}
+
+func TestStreamLogs(t *testing.T) {
+	backend := &logsBackend{blockLogs: []int{1, 0, 3, 2}}
+	server := NewLogStreamServer(log.NewNopLogger(), backend)
+	server.pollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &logStream{ctx: ctx, logs: make(chan *evmtypes.Log, 100)}
+	done := make(chan error)
+	go func() {
+		done <- server.StreamLogs(&evmtypes.StreamLogsRequest{FromHeight: 2}, stream)
+	}()
+
+	receive := func(height, index uint64) {
+		select {
+		case log := <-stream.logs:
+			require.Equal(t, height, log.BlockNumber)
+			require.Equal(t, index, log.Index)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("log %d of block %d not streamed", index, height)
+		}
+	}
+
+	// the past blocks are replayed from the requested height, across several pages
+	for _, expected := range [][2]uint64{{3, 0}, {3, 1}, {3, 2}, {4, 0}, {4, 1}} {
+		receive(expected[0], expected[1])
+	}
+
+	// then the new blocks are followed
+	backend.addBlock(0)
+	backend.addBlock(2)
+	receive(6, 0)
+	receive(6, 1)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+	require.Empty(t, stream.logs)
+}
+
+func TestStreamLogsInvalidRequest(t *testing.T) {
+	server := NewLogStreamServer(log.NewNopLogger(), &logsBackend{blockLogs: []int{1}})
+	stream := &logStream{ctx: context.Background()}
+
+	for _, req := range []*evmtypes.StreamLogsRequest{
+		{Addresses: []string{"invalid"}},
+		{Topics: []evmtypes.TopicsFilter{{Topics: []string{"0x1234"}}}},
+		{FromHeight: -1},
+	} {
+		err := server.StreamLogs(req, stream)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	addresses, topics, err := streamCriteria(&evmtypes.StreamLogsRequest{
+		Addresses: []string{common.BigToAddress(common.Big1).Hex()},
+		Topics:    []evmtypes.TopicsFilter{{}, {Topics: []string{common.BigToHash(common.Big2).Hex()}}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{common.BigToAddress(common.Big1)}, addresses)
+	require.Equal(t, [][]common.Hash{nil, {common.BigToHash(common.Big2)}}, topics)
+}
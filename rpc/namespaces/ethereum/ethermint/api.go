@@ -0,0 +1,215 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ethermint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethfilters "github.com/ethereum/go-ethereum/eth/filters"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// API is the ethermint prefixed set of APIs, exposing the chain specific
+// queries not covered by the Ethereum JSON-RPC spec.
+type API struct {
+	logger      log.Logger
+	backend     backend.EVMBackend
+	methods     []string
+	submissions *submissions
+}
+
+// NewAPI creates an instance of the Ethermint API.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		logger:      ctx.Logger.With("api", "ethermint"),
+		backend:     backend,
+		submissions: newSubmissions(),
+	}
+}
+
+// GetChainStats returns the aggregated statistics (tx count, gas used, average
+// gas price and failure ratio) of the ethereum transactions executed in the
+// given block range.
+func (api *API) GetChainStats(fromBlock, toBlock rpctypes.BlockNumber) (*rpctypes.ChainStats, error) {
+	api.logger.Debug("ethermint_getChainStats", "from", fromBlock, "to", toBlock)
+	return api.backend.ChainStats(fromBlock, toBlock)
+}
+
+// GetValidatorAccount returns the hex account, operator and consensus addresses
+// of the validator identified by any of them.
+func (api *API) GetValidatorAccount(address string) (*rpctypes.ValidatorAccount, error) {
+	api.logger.Debug("ethermint_getValidatorAccount", "address", address)
+	return api.backend.ValidatorAccount(address)
+}
+
+// GetAccountProofForHeight returns the account proof, the proofs of the given storage keys and the
+// app hash they are verified against in a single response, so that the bridge relayers don't need to
+// match the proofs with the right block header.
+func (api *API) GetAccountProofForHeight(
+	address common.Address, storageKeys []string, blockNum rpctypes.BlockNumber,
+) (*rpctypes.AccountProofBundle, error) {
+	api.logger.Debug("ethermint_getAccountProofForHeight", "address", address.Hex(), "keys", storageKeys, "height", blockNum)
+	return api.backend.AccountProofForHeight(address, storageKeys, blockNum)
+}
+
+// GetBalances returns the balances of all the denoms held by the address at the given block, keyed by
+// denom. The balances of the denoms other than the evm denom aren't exposed by eth_getBalance.
+func (api *API) GetBalances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (map[string]*hexutil.Big, error) {
+	api.logger.Debug("ethermint_getBalances", "address", address.Hex(), "block number or hash", blockNrOrHash)
+	return api.backend.Balances(address, blockNrOrHash)
+}
+
+// SimulateBundle executes the calls in order on the state of the given block, each call seeing the
+// state changes of the previous ones, and returns the result, gas used and logs of each call.
+func (api *API) SimulateBundle(
+	calls []evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *rpctypes.StateOverride,
+) ([]*rpctypes.BundleCallResult, error) {
+	api.logger.Debug("ethermint_simulateBundle", "calls", len(calls), "block number or hash", blockNrOrHash)
+
+	if len(calls) > evmtypes.MaxBundleCalls {
+		return nil, fmt.Errorf("bundle of %d calls exceeds the maximum of %d", len(calls), evmtypes.MaxBundleCalls)
+	}
+
+	blockNum, err := api.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.SimulateBundle(calls, blockNum, overrides)
+}
+
+// CallBatch executes the calls independently on the state of the given block, sharing the state
+// reads, and returns the result or the error eth_call would return for each call. The JSON-RPC
+// batches of eth_call at the same block are served by it.
+func (api *API) CallBatch(calls []evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.CallBatchResult, error) {
+	api.logger.Debug("ethermint_callBatch", "calls", len(calls), "block number or hash", blockNrOrHash)
+
+	if len(calls) > evmtypes.MaxBundleCalls {
+		return nil, fmt.Errorf("batch of %d calls exceeds the maximum of %d", len(calls), evmtypes.MaxBundleCalls)
+	}
+
+	blockNum, err := api.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.CallBatch(calls, blockNum)
+}
+
+// DryRunTransaction executes the call on the state of the given block without applying it, and
+// returns its result with the state changes it would apply: the balances, nonces, code and storage
+// of the changed accounts, and the contracts created or destroyed. It powers the transaction
+// previews of the wallets. The fees and the nonce increment of the sender aren't included.
+func (api *API) DryRunTransaction(
+	args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *rpctypes.StateOverride,
+) (*rpctypes.DryRunResult, error) {
+	api.logger.Debug("ethermint_dryRunTransaction", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum, err := api.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return api.backend.DryRunTransaction(args, blockNum, overrides)
+}
+
+// EstimateGas returns the gas estimation of the call adjusted by the estimate gas multiplier of the
+// node, as returned by `eth_estimateGas`, along with the raw estimation.
+func (api *API) EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (*rpctypes.GasEstimate, error) {
+	api.logger.Debug("ethermint_estimateGas")
+	return api.backend.EstimateGasDetailed(ctx, args, blockNrOptional)
+}
+
+// EstimateGasBulk returns the gas estimation of each call, or the error eth_estimateGas would
+// return, like EstimateGas. The calls are estimated independently on the state of the given block,
+// sharing the state reads, e.g. to estimate many similar mints or transfers at once.
+func (api *API) EstimateGasBulk(calls []evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) ([]*rpctypes.GasEstimateBulkResult, error) {
+	api.logger.Debug("ethermint_estimateGasBulk", "calls", len(calls))
+
+	if len(calls) > rpctypes.MaxEstimateGasBulkCalls {
+		return nil, fmt.Errorf("bulk of %d calls exceeds the maximum of %d", len(calls), rpctypes.MaxEstimateGasBulkCalls)
+	}
+
+	blockNr := rpctypes.EthPendingBlockNumber
+	if blockNrOptional != nil {
+		blockNr = *blockNrOptional
+	}
+	return api.backend.EstimateGasBulk(calls, blockNr)
+}
+
+// GetLogsPaged returns a page of the logs matching the filter criteria, starting at the cursor
+// returned by the previous page, or at the start of the range without cursor. The pages are limited
+// by the logs and block range caps of the node, and the returned cursor is nil once the logs of the
+// whole range are returned.
+func (api *API) GetLogsPaged(ctx context.Context, crit gethfilters.FilterCriteria, cursor *string) (*rpctypes.LogsPage, error) {
+	api.logger.Debug("ethermint_getLogsPaged", "cursor", cursor)
+
+	var start *rpctypes.LogsCursor
+	if cursor != nil {
+		parsed, err := rpctypes.ParseLogsCursor(*cursor)
+		if err != nil {
+			return nil, err
+		}
+		start = &parsed
+	}
+
+	filter := filters.NewFilterFromCriteria(api.logger, api.backend, crit)
+	logs, next, err := filter.LogsPage(ctx, start, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, err
+	}
+
+	page := &rpctypes.LogsPage{Logs: logs}
+	if next != nil {
+		encoded := next.String()
+		page.Cursor = &encoded
+	}
+	return page, nil
+}
+
+// SetMethods sets the JSON-RPC methods served by the node, returned by
+// `ethermint_capabilities`. It isn't a method of the API so that it isn't
+// exposed by the RPC server.
+func SetMethods(api *API, methods []string) {
+	api.methods = methods
+}
+
+// Capabilities returns the JSON-RPC methods implemented by the node and the
+// limits set in its config, so that the tooling can feature-detect.
+func (api *API) Capabilities() *rpctypes.Capabilities {
+	api.logger.Debug("ethermint_capabilities")
+
+	methods := api.methods
+	if methods == nil {
+		methods = []string{}
+	}
+
+	return &rpctypes.Capabilities{
+		Methods:     methods,
+		Unsupported: rpctypes.UnsupportedMethods,
+		Limits:      api.backend.RPCLimits(),
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ethermint
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var _ evmtypes.IndexerQueryServer = &IndexerQueryServer{}
+
+// IndexerQueryServer implements the gRPC queries of the data aggregated by the evm indexer, served by
+// the backend like the corresponding ethermint namespace methods.
+type IndexerQueryServer struct {
+	backend backend.EVMBackend
+}
+
+// NewIndexerQueryServer creates a new IndexerQueryServer, the backend must be created with the evm
+// indexer.
+func NewIndexerQueryServer(backend backend.EVMBackend) *IndexerQueryServer {
+	return &IndexerQueryServer{backend: backend}
+}
+
+// ChainStats implements the IndexerQuery/ChainStats gRPC method, the equivalent of
+// `ethermint_getChainStats`.
+func (s *IndexerQueryServer) ChainStats(_ context.Context, req *evmtypes.QueryChainStatsRequest) (*evmtypes.QueryChainStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.FromBlock < 0 || req.ToBlock < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block range [%d, %d]", req.FromBlock, req.ToBlock)
+	}
+
+	toBlock := rpctypes.BlockNumber(req.ToBlock)
+	if req.ToBlock == 0 {
+		toBlock = rpctypes.EthLatestBlockNumber
+	}
+	stats, err := s.backend.ChainStats(rpctypes.BlockNumber(req.FromBlock), toBlock)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the failure ratio is computed from the counts rather than converted from its float value
+	failureRatio := sdk.ZeroDec()
+	if stats.TxCount > 0 {
+		failureRatio = sdk.NewDec(int64(stats.FailedTxCount)).QuoInt64(int64(stats.TxCount))
+	}
+
+	return &evmtypes.QueryChainStatsResponse{
+		FromBlock:       int64(stats.FromBlock),
+		ToBlock:         int64(stats.ToBlock),
+		BlockCount:      uint64(stats.BlockCount),
+		TxCount:         uint64(stats.TxCount),
+		FailedTxCount:   uint64(stats.FailedTxCount),
+		GasUsed:         uint64(stats.GasUsed),
+		AverageGasPrice: sdkmath.NewIntFromBigInt(stats.AverageGasPrice.ToInt()),
+		FailureRatio:    failureRatio,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package ethermint
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// statsBackend is a fake backend returning the statistics of the requested range.
+type statsBackend struct {
+	backend.EVMBackend
+	from, to rpctypes.BlockNumber
+}
+
+func (b *statsBackend) ChainStats(fromBlock, toBlock rpctypes.BlockNumber) (*rpctypes.ChainStats, error) {
+	b.from, b.to = fromBlock, toBlock
+	if fromBlock > 100 {
+		return nil, errors.New("invalid block range")
+	}
+	return &rpctypes.ChainStats{
+		FromBlock:       hexutil.Uint64(fromBlock),
+		ToBlock:         10,
+		BlockCount:      2,
+		TxCount:         3,
+		FailedTxCount:   1,
+		GasUsed:         63000,
+		AverageGasPrice: (*hexutil.Big)(big.NewInt(1_000_000_000)),
+		FailureRatio:    1.0 / 3,
+	}, nil
+}
+
+func TestIndexerQueryChainStats(t *testing.T) {
+	b := &statsBackend{}
+	server := NewIndexerQueryServer(b)
+
+	res, err := server.ChainStats(context.Background(), &evmtypes.QueryChainStatsRequest{FromBlock: 1})
+	require.NoError(t, err)
+	// the omitted last block is the latest one
+	require.Equal(t, rpctypes.BlockNumber(1), b.from)
+	require.Equal(t, rpctypes.EthLatestBlockNumber, b.to)
+	require.Equal(t, &evmtypes.QueryChainStatsResponse{
+		FromBlock:       1,
+		ToBlock:         10,
+		BlockCount:      2,
+		TxCount:         3,
+		FailedTxCount:   1,
+		GasUsed:         63000,
+		AverageGasPrice: sdkmath.NewInt(1_000_000_000),
+		FailureRatio:    sdk.NewDec(1).QuoInt64(3),
+	}, res)
+
+	_, err = server.ChainStats(context.Background(), &evmtypes.QueryChainStatsRequest{FromBlock: 2, ToBlock: 5})
+	require.NoError(t, err)
+	require.Equal(t, rpctypes.BlockNumber(5), b.to)
+
+	for _, req := range []*evmtypes.QueryChainStatsRequest{
+		nil,
+		{FromBlock: -1},
+		{FromBlock: 1, ToBlock: -1},
+		{FromBlock: 101},
+	} {
+		_, err = server.ChainStats(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
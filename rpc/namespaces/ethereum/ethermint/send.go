@@ -0,0 +1,194 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ethermint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+const (
+	// idempotencyCacheSize is the number of idempotency keys remembered by the node
+	idempotencyCacheSize = 10000
+	// inclusionTimeout is the max duration waited for the inclusion of a transaction
+	inclusionTimeout = 30 * time.Second
+	// inclusionPollInterval is the interval between the receipt queries of a waited transaction
+	inclusionPollInterval = 500 * time.Millisecond
+)
+
+// submission is the broadcast of a transaction identified by an idempotency key. The done channel
+// is closed once the CheckTx result is known.
+type submission struct {
+	txHash common.Hash
+	done   chan struct{}
+	err    error
+}
+
+// submissions remembers the broadcasts by idempotency key, the failed ones are forgotten so that
+// they can be retried.
+type submissions struct {
+	mtx  sync.Mutex
+	keys *lru.Cache
+}
+
+func newSubmissions() *submissions {
+	keys, err := lru.New(idempotencyCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &submissions{keys: keys}
+}
+
+// start returns the submission of the key, and true if the caller must broadcast the transaction.
+func (s *submissions) start(key string, txHash common.Hash) (*submission, bool, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if value, ok := s.keys.Get(key); ok {
+		sub := value.(*submission)
+		if sub.txHash != txHash {
+			return nil, false, fmt.Errorf("idempotency key %s already used by the transaction %s", key, sub.txHash.Hex())
+		}
+		return sub, false, nil
+	}
+
+	sub := &submission{txHash: txHash, done: make(chan struct{})}
+	s.keys.Add(key, sub)
+	return sub, true, nil
+}
+
+// finish records the CheckTx result of the submission.
+func (s *submissions) finish(key string, sub *submission, err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	sub.err = err
+	if err != nil {
+		if value, ok := s.keys.Peek(key); ok && value == sub {
+			s.keys.Remove(key)
+		}
+	}
+	close(sub.done)
+}
+
+// SendRawTransactionSync broadcasts the signed transaction and returns once it's accepted by
+// CheckTx, or once it's included in a block if the inclusion is waited for. The retries with the
+// same idempotency key don't broadcast the transaction again, they wait for the result of the first
+// submission instead, so that the clients can safely retry after a timeout.
+func (api *API) SendRawTransactionSync(ctx context.Context, data hexutil.Bytes, opts *rpctypes.SendTxOptions) (*rpctypes.SendTxResult, error) {
+	api.logger.Debug("ethermint_sendRawTransactionSync", "length", len(data))
+
+	if opts == nil {
+		opts = &rpctypes.SendTxOptions{}
+	}
+
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	txHash := tx.Hash()
+
+	if err := api.broadcastOnce(ctx, opts.IdempotencyKey, txHash, data); err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.SendTxResult{Hash: txHash}
+	if !opts.WaitForInclusion {
+		return result, nil
+	}
+
+	receipt, err := api.waitForReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	result.Receipt = receipt
+	return result, nil
+}
+
+// SendPendingTransaction broadcasts the eth_sendTransaction transaction waiting for the signature of
+// the signing request of an asynchronous signer, e.g. an MPC/TSS provider. It returns a pending
+// signature error, with the request id as data, while the signature isn't produced yet.
+func (api *API) SendPendingTransaction(requestID string) (common.Hash, error) {
+	api.logger.Debug("ethermint_sendPendingTransaction", "request", requestID)
+	return api.backend.SendPendingTransaction(requestID)
+}
+
+// broadcastOnce broadcasts the transaction, unless it was already submitted with the idempotency
+// key in which case the result of the first submission is returned.
+func (api *API) broadcastOnce(ctx context.Context, key string, txHash common.Hash, data hexutil.Bytes) error {
+	if key == "" {
+		_, err := api.backend.SendRawTransaction(data)
+		return err
+	}
+
+	sub, broadcast, err := api.submissions.start(key, txHash)
+	if err != nil {
+		return err
+	}
+
+	if broadcast {
+		_, err := api.backend.SendRawTransaction(data)
+		api.submissions.finish(key, sub, err)
+		return err
+	}
+
+	select {
+	case <-sub.done:
+		return sub.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// waitForReceipt polls the receipt of the transaction until it's included, it returns a nil receipt
+// after the inclusion timeout.
+func (api *API) waitForReceipt(ctx context.Context, txHash common.Hash) (map[string]interface{}, error) {
+	timer := time.NewTimer(inclusionTimeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(inclusionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := api.backend.GetTransactionReceipt(txHash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			return nil, nil
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, nil
+			}
+			return nil, ctx.Err()
+		}
+	}
+}
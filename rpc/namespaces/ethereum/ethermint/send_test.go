@@ -0,0 +1,175 @@
+package ethermint
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// sendBackend is a fake backend counting the broadcasts of the transactions.
+type sendBackend struct {
+	backend.EVMBackend
+	mu       sync.Mutex
+	sent     int
+	sendErr  error
+	release  chan struct{}
+	included map[common.Hash]bool
+}
+
+func (b *sendBackend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	if b.release != nil {
+		<-b.release
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sent++
+
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), b.sendErr
+}
+
+func (b *sendBackend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.included[hash] {
+		return nil, nil
+	}
+	return map[string]interface{}{"transactionHash": hash}, nil
+}
+
+func (b *sendBackend) sentCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.sent
+}
+
+func signedRawTx(t *testing.T, nonce uint64) hexutil.Bytes {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chainID := big.NewInt(9000)
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.LegacyTx{
+		Nonce:    nonce,
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+		To:       &common.Address{},
+	})
+	require.NoError(t, err)
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return bz
+}
+
+func newSendAPI(b *sendBackend) *API {
+	return &API{
+		logger:      log.NewNopLogger(),
+		backend:     b,
+		submissions: newSubmissions(),
+	}
+}
+
+func TestSendRawTransactionSync(t *testing.T) {
+	ctx := context.Background()
+	tx0, tx1 := signedRawTx(t, 0), signedRawTx(t, 1)
+
+	t.Run("no idempotency key", func(t *testing.T) {
+		b := &sendBackend{}
+		api := newSendAPI(b)
+		for i := 0; i < 2; i++ {
+			_, err := api.SendRawTransactionSync(ctx, tx0, nil)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 2, b.sentCount())
+	})
+
+	t.Run("retries with the same key broadcast once", func(t *testing.T) {
+		b := &sendBackend{}
+		api := newSendAPI(b)
+		opts := &rpctypes.SendTxOptions{IdempotencyKey: "key"}
+		res1, err := api.SendRawTransactionSync(ctx, tx0, opts)
+		require.NoError(t, err)
+		res2, err := api.SendRawTransactionSync(ctx, tx0, opts)
+		require.NoError(t, err)
+		require.Equal(t, res1.Hash, res2.Hash)
+		require.Equal(t, 1, b.sentCount())
+
+		// the key can't be used by another tx
+		_, err = api.SendRawTransactionSync(ctx, tx1, opts)
+		require.ErrorContains(t, err, "already used")
+		require.Equal(t, 1, b.sentCount())
+	})
+
+	t.Run("failed broadcast can be retried", func(t *testing.T) {
+		b := &sendBackend{sendErr: errors.New("mempool is full")}
+		api := newSendAPI(b)
+		opts := &rpctypes.SendTxOptions{IdempotencyKey: "key"}
+		_, err := api.SendRawTransactionSync(ctx, tx0, opts)
+		require.Error(t, err)
+
+		b.sendErr = nil
+		_, err = api.SendRawTransactionSync(ctx, tx0, opts)
+		require.NoError(t, err)
+		require.Equal(t, 2, b.sentCount())
+	})
+
+	t.Run("concurrent retry waits for the first submission", func(t *testing.T) {
+		b := &sendBackend{release: make(chan struct{})}
+		api := newSendAPI(b)
+		opts := &rpctypes.SendTxOptions{IdempotencyKey: "key"}
+
+		var wg sync.WaitGroup
+		errs := make([]error, 3)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = api.SendRawTransactionSync(ctx, tx0, opts)
+			}(i)
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(b.release)
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		require.Equal(t, 1, b.sentCount())
+	})
+
+	t.Run("wait for inclusion", func(t *testing.T) {
+		tx := &ethtypes.Transaction{}
+		require.NoError(t, tx.UnmarshalBinary(tx0))
+		b := &sendBackend{included: map[common.Hash]bool{tx.Hash(): true}}
+		api := newSendAPI(b)
+
+		res, err := api.SendRawTransactionSync(ctx, tx0, &rpctypes.SendTxOptions{WaitForInclusion: true})
+		require.NoError(t, err)
+		require.Equal(t, tx.Hash(), res.Receipt["transactionHash"])
+	})
+
+	t.Run("inclusion not waited beyond the deadline", func(t *testing.T) {
+		api := newSendAPI(&sendBackend{})
+		deadlineCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+
+		res, err := api.SendRawTransactionSync(deadlineCtx, tx0, &rpctypes.SendTxOptions{WaitForInclusion: true})
+		require.NoError(t, err)
+		require.Nil(t, res.Receipt)
+	})
+}
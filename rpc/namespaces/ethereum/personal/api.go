@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
 
 	"github.com/evmos/ethermint/crypto/hd"
 	ethermint "github.com/evmos/ethermint/types"
@@ -82,6 +83,13 @@ func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 	return api.backend.ListAccounts()
 }
 
+// ListKeyringAccounts returns the accounts this node manages with their metadata (name, type,
+// derivation path and public key), including the hidden accounts.
+func (api *PrivateAccountAPI) ListKeyringAccounts() ([]rpctypes.KeyringAccount, error) {
+	api.logger.Debug("personal_listKeyringAccounts")
+	return api.backend.KeyringAccounts()
+}
+
 // LockAccount will lock the account associated with the given address when it's unlocked.
 // It removes the key corresponding to the given address from the API's local keys.
 func (api *PrivateAccountAPI) LockAccount(address common.Address) bool {
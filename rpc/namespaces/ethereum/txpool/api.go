@@ -16,23 +16,33 @@
 package txpool
 
 import (
+	"fmt"
+
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
+// The pending transactions are the ethereum transactions of the Tendermint mempool, which has no queue
+// of future transactions.
 // NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
@@ -43,24 +53,97 @@ func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTran
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+
+	msgs, err := api.pendingMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msg := range msgs {
+		rpcTx, err := types.NewTransactionFromMsg(msg, common.Hash{}, 0, 0, nil, api.backend.ChainConfig().ChainID)
+		if err != nil {
+			return nil, err
+		}
+		from, nonce := senderAndNonce(msg)
+		if content["pending"][from] == nil {
+			content["pending"][from] = make(map[string]*types.RPCTransaction)
+		}
+		content["pending"][from][nonce] = rpcTx
+	}
 	return content, nil
 }
 
-// Inspect returns the content of the transaction pool and flattens it into an
+// Inspect returns the content of the transaction pool and flattens it into an easily inspectable list,
+// the call data being annotated with the signature of the called function if the decoding is enabled.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+
+	msgs, err := api.pendingMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msg := range msgs {
+		from, nonce := senderAndNonce(msg)
+		if content["pending"][from] == nil {
+			content["pending"][from] = make(map[string]string)
+		}
+		content["pending"][from][nonce] = api.format(msg.AsTransaction())
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (api *PublicAPI) Status() map[string]hexutil.Uint {
 	api.logger.Debug("txpool_status")
+	msgs, err := api.pendingMsgs()
+	if err != nil {
+		api.logger.Debug("failed to get the pending transactions", "error", err.Error())
+	}
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
+		"pending": hexutil.Uint(len(msgs)),
 		"queued":  hexutil.Uint(0),
 	}
 }
+
+// pendingMsgs returns the ethereum transactions of the mempool.
+func (api *PublicAPI) pendingMsgs() ([]*evmtypes.MsgEthereumTx, error) {
+	txs, err := api.backend.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []*evmtypes.MsgEthereumTx
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+			msgs = append(msgs, ethMsg)
+		}
+	}
+	return msgs, nil
+}
+
+// format returns the summary of the transaction, in the same format as geth.
+func (api *PublicAPI) format(tx *ethtypes.Transaction) string {
+	summary := fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+	if to := tx.To(); to != nil {
+		summary = fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+		if signature, ok := api.backend.MethodSignature(tx.Data()); ok {
+			summary += " calling " + signature
+		}
+	}
+	return summary
+}
+
+// senderAndNonce returns the checksummed sender and the nonce of the transaction, keying the pool content.
+func senderAndNonce(msg *evmtypes.MsgEthereumTx) (string, string) {
+	return common.HexToAddress(msg.From).Hex(), fmt.Sprint(msg.AsTransaction().Nonce())
+}
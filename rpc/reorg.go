@@ -0,0 +1,68 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// reorgTrackedBlocks is the number of recent blocks whose delivered logs are kept by a logs
+// subscription, to be notified as removed if the chain is rolled back.
+const reorgTrackedBlocks = 128
+
+// headTracker detects the chain rollbacks, e.g. after a `rollback` or a replay following a crash,
+// from the headers of a newHeads subscription.
+type headTracker struct {
+	height int64
+	hash   common.Hash
+}
+
+// Track records a new header, it returns true if the header replaces a header already notified,
+// i.e. if the chain was rolled back to its height.
+func (t *headTracker) Track(height int64, hash common.Hash) bool {
+	rolledBack := t.height > 0 && (height < t.height || (height == t.height && hash != t.hash))
+	t.height, t.hash = height, hash
+	return rolledBack
+}
+
+// logsTracker keeps the logs delivered to a logs subscription in the recent blocks, to notify them
+// again with removed=true if the chain is rolled back below their block.
+type logsTracker struct {
+	logs []*ethtypes.Log
+}
+
+// Track records the logs delivered for a transaction executed in the block of the given height and
+// hash, an empty hash if unknown. It returns the previously delivered logs of the rolled back blocks,
+// the blocks above height and the block at height with a different hash, marked as removed.
+func (t *logsTracker) Track(height uint64, hash common.Hash, logs []*ethtypes.Log) []*ethtypes.Log {
+	var removed []*ethtypes.Log
+	kept := t.logs[:0]
+	for _, log := range t.logs {
+		switch {
+		case log.BlockNumber > height,
+			log.BlockNumber == height && hash != (common.Hash{}) && log.BlockHash != hash:
+			removedLog := *log
+			removedLog.Removed = true
+			removed = append(removed, &removedLog)
+		case log.BlockNumber+reorgTrackedBlocks > height:
+			kept = append(kept, log)
+		}
+	}
+
+	t.logs = append(kept, logs...)
+	return removed
+}
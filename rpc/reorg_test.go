@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadTracker(t *testing.T) {
+	var tracker headTracker
+	require.False(t, tracker.Track(10, common.HexToHash("0x0a")))
+	require.False(t, tracker.Track(11, common.HexToHash("0x0b")))
+	require.False(t, tracker.Track(11, common.HexToHash("0x0b")))
+	// replayed with a different result
+	require.True(t, tracker.Track(11, common.HexToHash("0x1b")))
+	// rolled back
+	require.True(t, tracker.Track(10, common.HexToHash("0x1a")))
+	require.False(t, tracker.Track(11, common.HexToHash("0x2b")))
+}
+
+func TestLogsTracker(t *testing.T) {
+	hash10, hash11, hash11b := common.HexToHash("0x0a"), common.HexToHash("0x0b"), common.HexToHash("0x1b")
+	log10 := &ethtypes.Log{BlockNumber: 10, BlockHash: hash10, Index: 0}
+	log11 := &ethtypes.Log{BlockNumber: 11, BlockHash: hash11, Index: 0}
+	log11b := &ethtypes.Log{BlockNumber: 11, BlockHash: hash11, Index: 1}
+
+	var tracker logsTracker
+	require.Empty(t, tracker.Track(10, hash10, []*ethtypes.Log{log10}))
+	require.Empty(t, tracker.Track(11, hash11, []*ethtypes.Log{log11}))
+	// another tx of the same block
+	require.Empty(t, tracker.Track(11, hash11, []*ethtypes.Log{log11b}))
+	// a tx without logs can't be compared to the block hash
+	require.Empty(t, tracker.Track(11, common.Hash{}, nil))
+
+	// the block 11 is replayed with a different result
+	removed := tracker.Track(11, hash11b, nil)
+	require.Len(t, removed, 2)
+	for i, log := range removed {
+		require.True(t, log.Removed)
+		require.Equal(t, uint(i), log.Index)
+	}
+	// the delivered logs are left untouched
+	require.False(t, log11.Removed)
+
+	// rolled back to the block 9
+	removed = tracker.Track(9, common.Hash{}, nil)
+	require.Len(t, removed, 1)
+	require.Equal(t, uint64(10), removed[0].BlockNumber)
+	require.Empty(t, tracker.Track(9, common.Hash{}, nil))
+
+	// the logs of the old blocks are pruned
+	require.Empty(t, tracker.Track(12, hash11, []*ethtypes.Log{{BlockNumber: 12}}))
+	require.Empty(t, tracker.Track(12+reorgTrackedBlocks, common.Hash{}, nil))
+	require.Empty(t, tracker.logs)
+}
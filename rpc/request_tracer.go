@@ -0,0 +1,182 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/libs/log"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// RequestIDHeader is the HTTP header of the ID of a JSON-RPC request. The ID set by the client, e.g.
+// by a gateway, is kept if valid, otherwise one is generated.
+const RequestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength is the max length of the request IDs set by the clients.
+const maxRequestIDLength = 64
+
+// RequestTracer is an HTTP middleware assigning an ID to each JSON-RPC request, so that the failures
+// reported by the users can be correlated with the node logs. The ID is passed to the API methods
+// through the request context and forwarded to the evm queries, which tag their logs with it. It is
+// returned in the response header and in the error objects of the failed calls, the calls of a batch
+// sharing the ID of the HTTP request.
+type RequestTracer struct {
+	logger log.Logger
+}
+
+// NewRequestTracer creates a new RequestTracer.
+func NewRequestTracer(logger log.Logger) *RequestTracer {
+	return &RequestTracer{
+		logger: logger.With("module", "request-tracer"),
+	}
+}
+
+// Handler wraps the given handler, tagging the requests with their ID and logging the failed calls.
+func (rt *RequestTracer) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !isValidRequestID(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+
+		body, ok := readRequestBody(w, r)
+		if !ok {
+			return
+		}
+		r = r.WithContext(rpctypes.ContextWithRequestID(r.Context(), id))
+
+		start := time.Now()
+		rec := &bufferedResponse{header: w.Header(), status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		res := rec.body.Bytes()
+		if rec.status == http.StatusOK {
+			msgs, _ := parseMessages(body)
+			if traced := rt.traceErrors(id, msgs, res, time.Since(start)); !bytes.Equal(traced, res) {
+				w.Header().Del("Content-Length")
+				res = traced
+			}
+		}
+
+		w.WriteHeader(rec.status)
+		_, _ = w.Write(res)
+	})
+}
+
+// traceErrors logs the failed calls of the response and adds the request ID to their error objects.
+// The responses which can't be decoded are returned as-is.
+func (rt *RequestTracer) traceErrors(id string, msgs []jsonrpcMessage, res []byte, elapsed time.Duration) []byte {
+	batch := isBatch(res)
+	var responses []json.RawMessage
+	if batch {
+		if err := json.Unmarshal(res, &responses); err != nil {
+			return res
+		}
+	} else {
+		responses = []json.RawMessage{res}
+	}
+
+	methods := make(map[string]string, len(msgs))
+	for _, msg := range msgs {
+		methods[string(bytes.TrimSpace(msg.ID))] = msg.Method
+	}
+
+	traced := false
+	for i, raw := range responses {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil || len(fields["error"]) == 0 || isNull(fields["error"]) {
+			continue
+		}
+		var errObj map[string]json.RawMessage
+		if err := json.Unmarshal(fields["error"], &errObj); err != nil {
+			continue
+		}
+
+		method := methods[string(bytes.TrimSpace(fields["id"]))]
+		var message string
+		_ = json.Unmarshal(errObj["message"], &message)
+		rt.logger.Info(
+			"request failed",
+			"request_id", id,
+			"method", method,
+			"code", string(errObj["code"]),
+			"error", message,
+			"elapsed", elapsed,
+		)
+		telemetry.IncrCounterWithLabels(
+			[]string{"json_rpc", "errors"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("class", string(ClassifyMethod(method)))},
+		)
+
+		errObj["requestId"], _ = json.Marshal(id)
+		bz, err := json.Marshal(errObj)
+		if err != nil {
+			continue
+		}
+		fields["error"] = bz
+		if bz, err = json.Marshal(fields); err == nil {
+			responses[i] = bz
+			traced = true
+		}
+	}
+
+	if !traced {
+		return res
+	}
+	if !batch {
+		return append(responses[0], '\n')
+	}
+	bz, err := json.Marshal(responses)
+	if err != nil {
+		return res
+	}
+	return append(bz, '\n')
+}
+
+// newRequestID generates a random request ID.
+func newRequestID() string {
+	bz := make([]byte, 16)
+	if _, err := rand.Read(bz); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(bz)
+}
+
+// isValidRequestID returns true if the request ID set by the client is safe to be logged and echoed.
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
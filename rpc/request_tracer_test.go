@@ -0,0 +1,118 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+type tracedService struct{}
+
+func (tracedService) RequestID(ctx context.Context) string {
+	return rpctypes.RequestIDFromContext(ctx)
+}
+
+func (tracedService) Fail() error {
+	return errors.New("failed")
+}
+
+func newTracedHandler(t *testing.T) http.Handler {
+	server := ethrpc.NewServer()
+	require.NoError(t, server.RegisterName("test", tracedService{}))
+	return NewRequestTracer(log.NewNopLogger()).Handler(server)
+}
+
+func serveTraced(handler http.Handler, body, id string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if id != "" {
+		req.Header.Set(RequestIDHeader, id)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRequestTracerID(t *testing.T) {
+	handler := newTracedHandler(t)
+	body := `{"jsonrpc":"2.0","id":1,"method":"test_requestID","params":[]}`
+
+	testCases := []struct {
+		name     string
+		clientID string
+		expID    string
+	}{
+		{"generated", "", ""},
+		{"set by the client", "gw-1234.5", "gw-1234.5"},
+		{"invalid client id", "bad id\n", ""},
+		{"too long client id", strings.Repeat("a", maxRequestIDLength+1), ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := serveTraced(handler, body, tc.clientID)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			id := rec.Header().Get(RequestIDHeader)
+			if tc.expID != "" {
+				require.Equal(t, tc.expID, id)
+			} else {
+				require.Len(t, id, 32)
+			}
+
+			// the id is passed to the api methods
+			var res struct {
+				Result string `json:"result"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Equal(t, id, res.Result)
+		})
+	}
+}
+
+func TestRequestTracerErrors(t *testing.T) {
+	handler := newTracedHandler(t)
+
+	type response struct {
+		ID     int             `json:"id"`
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code      int    `json:"code"`
+			Message   string `json:"message"`
+			RequestID string `json:"requestId"`
+		} `json:"error"`
+	}
+
+	rec := serveTraced(handler, `{"jsonrpc":"2.0","id":1,"method":"test_fail","params":[]}`, "req-1")
+	var res response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.NotNil(t, res.Error)
+	require.Equal(t, "failed", res.Error.Message)
+	require.Equal(t, "req-1", res.Error.RequestID)
+
+	// the failed calls of a batch share the id of the request
+	batch := `[{"jsonrpc":"2.0","id":1,"method":"test_requestID","params":[]},{"jsonrpc":"2.0","id":2,"method":"test_fail","params":[]}]`
+	rec = serveTraced(handler, batch, "req-2")
+	var responses []response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &responses))
+	require.Len(t, responses, 2)
+	require.Nil(t, responses[0].Error)
+	require.Equal(t, `"req-2"`, string(responses[0].Result))
+	require.NotNil(t, responses[1].Error)
+	require.Equal(t, "req-2", responses[1].Error.RequestID)
+
+	// the successful responses are forwarded as-is
+	rec = serveTraced(handler, `{"jsonrpc":"2.0","id":1,"method":"test_requestID","params":[]}`, "req-3")
+	require.False(t, bytes.Contains(rec.Body.Bytes(), []byte("requestId")))
+}
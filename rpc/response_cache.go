@@ -0,0 +1,274 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+// cacheableMethods defines the JSON-RPC methods whose responses never change once
+// the queried block or transaction has been committed. Tendermint provides
+// instant finality, so any included block or transaction is final. The value is
+// the field of the result object that is only set once the result is in a block,
+// e.g. the transactions served from the mempool have a null blockHash, empty for
+// the results that aren't objects.
+var cacheableMethods = map[string]string{
+	"eth_getBlockByHash":                      "hash",
+	"eth_getBlockByNumber":                    "hash",
+	"eth_getBlockTransactionCountByHash":      "",
+	"eth_getBlockTransactionCountByNumber":    "",
+	"eth_getTransactionByHash":                "blockHash",
+	"eth_getTransactionByBlockHashAndIndex":   "blockHash",
+	"eth_getTransactionByBlockNumberAndIndex": "blockHash",
+	"eth_getTransactionReceipt":               "blockHash",
+}
+
+// mutableBlockTags defines the block tags resolving to a different block over time.
+var mutableBlockTags = map[string]bool{
+	"latest":    true,
+	"pending":   true,
+	"safe":      true,
+	"finalized": true,
+}
+
+// ResponseCache defines the storage backend of the JSON-RPC response cache.
+type ResponseCache interface {
+	// Get returns the cached result for the given key, if any.
+	Get(key string) ([]byte, bool)
+	// Set stores the result for the given key.
+	Set(key string, value []byte)
+}
+
+var _ ResponseCache = (*lruResponseCache)(nil)
+
+// lruResponseCache is an in-process ResponseCache evicting the least recently
+// used entries.
+type lruResponseCache struct {
+	cache *lru.Cache
+}
+
+// NewLRUResponseCache creates an in-process ResponseCache holding up to size entries.
+func NewLRUResponseCache(size int) (ResponseCache, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lruResponseCache{cache: cache}, nil
+}
+
+// Get implements ResponseCache
+func (c *lruResponseCache) Get(key string) ([]byte, bool) {
+	value, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	return value.([]byte), true
+}
+
+// Set implements ResponseCache
+func (c *lruResponseCache) Set(key string, value []byte) {
+	c.cache.Add(key, value)
+}
+
+// NewResponseCacheFromConfig returns the ResponseCache defined by the JSON-RPC
+// configuration. It returns nil if the response cache is disabled.
+func NewResponseCacheFromConfig(cfg config.JSONRPCConfig) (ResponseCache, error) {
+	if cfg.ResponseCacheRedisURL != "" {
+		return NewRedisResponseCache(cfg.ResponseCacheRedisURL, cfg.ResponseCacheTTL)
+	}
+
+	if cfg.ResponseCacheSize > 0 {
+		return NewLRUResponseCache(cfg.ResponseCacheSize)
+	}
+
+	return nil, nil
+}
+
+// ResponseCacher is an HTTP middleware serving the responses of immutable
+// JSON-RPC queries from a ResponseCache.
+type ResponseCacher struct {
+	logger log.Logger
+	cache  ResponseCache
+}
+
+// NewResponseCacher creates a new ResponseCacher backed by the given cache.
+func NewResponseCacher(logger log.Logger, cache ResponseCache) *ResponseCacher {
+	return &ResponseCacher{
+		logger: logger.With("module", "response-cache"),
+		cache:  cache,
+	}
+}
+
+// cacheRequest contains the request fields used to build the cache key.
+type cacheRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// cacheResponse contains the response fields stored in the cache.
+type cacheResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   json.RawMessage `json:"error,omitempty"`
+}
+
+// Handler wraps the given handler, serving the cacheable requests from the
+// cache and storing the successful results returned by next that are in a block.
+func (rc *ResponseCacher) Handler(next http.Handler) http.Handler {
+	if rc.cache == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readRequestBody(w, r)
+		if !ok {
+			return
+		}
+
+		// batches are forwarded as-is
+		if isBatch(body) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var req cacheRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key, ok := responseCacheKey(req)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if result, found := rc.cache.Get(key); found {
+			telemetry.IncrCounter(1, "json_rpc", "response_cache", "hit")
+			writeCachedResponse(w, req.ID, result)
+			return
+		}
+		telemetry.IncrCounter(1, "json_rpc", "response_cache", "miss")
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		if rec.status != http.StatusOK {
+			return
+		}
+
+		var res cacheResponse
+		if err := json.Unmarshal(rec.body.Bytes(), &res); err != nil {
+			rc.logger.Debug("failed to decode response", "method", req.Method, "error", err.Error())
+			return
+		}
+
+		// only cache the queries that resolved to a committed block or transaction
+		if len(res.Error) > 0 || !inBlock(cacheableMethods[req.Method], res.Result) {
+			return
+		}
+
+		rc.cache.Set(key, res.Result)
+	})
+}
+
+// responseCacheKey returns the cache key of the request and false if its response
+// can change over time.
+func responseCacheKey(req cacheRequest) (string, bool) {
+	if _, ok := cacheableMethods[req.Method]; !ok {
+		return "", false
+	}
+
+	var params []interface{}
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return "", false
+		}
+	}
+
+	for _, param := range params {
+		if tag, ok := param.(string); ok && mutableBlockTags[tag] {
+			return "", false
+		}
+	}
+
+	// re-encode the parameters to ignore formatting differences between requests
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+
+	return req.Method + ":" + string(bz), true
+}
+
+// inBlock returns true if the result is non-null and, for the result objects, the
+// given field is set.
+func inBlock(field string, result json.RawMessage) bool {
+	if len(result) == 0 || bytes.Equal(result, []byte("null")) {
+		return false
+	}
+	if field == "" {
+		return true
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(result, &fields); err != nil {
+		return false
+	}
+	value, ok := fields[field]
+	return ok && !bytes.Equal(value, []byte("null"))
+}
+
+// writeCachedResponse writes the cached result with the ID of the current request.
+func writeCachedResponse(w http.ResponseWriter, id json.RawMessage, result []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&cacheResponse{
+		Jsonrpc: "2.0",
+		ID:      id,
+		Result:  result,
+	})
+}
+
+// responseRecorder forwards the response to the wrapped writer while keeping a
+// copy of its status and body.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(bz []byte) (int, error) {
+	r.body.Write(bz)
+	return r.ResponseWriter.Write(bz)
+}
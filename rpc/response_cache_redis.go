@@ -0,0 +1,75 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisKeyPrefix namespaces the JSON-RPC responses stored in Redis.
+const redisKeyPrefix = "ethermint:json-rpc:"
+
+// redisTimeout bounds the duration of the Redis operations so that an unavailable
+// cache falls back to serving the request from the node.
+const redisTimeout = 500 * time.Millisecond
+
+var _ ResponseCache = (*redisResponseCache)(nil)
+
+// redisResponseCache is a ResponseCache backed by a Redis server, which can be
+// shared by several RPC nodes.
+type redisResponseCache struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewRedisResponseCache creates a ResponseCache connected to the Redis server at
+// the given URL (eg: redis://localhost:6379/0). Entries expire after ttl, or never
+// if ttl is zero.
+func NewRedisResponseCache(url string, ttl time.Duration) (ResponseCache, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisResponseCache{
+		client: redis.NewClient(opts),
+		ttl:    ttl,
+	}, nil
+}
+
+// Get implements ResponseCache
+func (c *redisResponseCache) Get(key string) ([]byte, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	value, err := c.client.Get(ctx, redisKeyPrefix+key).Bytes()
+	if err != nil {
+		return nil, false
+	}
+
+	return value, true
+}
+
+// Set implements ResponseCache
+func (c *redisResponseCache) Set(key string, value []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	_ = c.client.Set(ctx, redisKeyPrefix+key, value, c.ttl).Err()
+}
@@ -0,0 +1,148 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestResponseCacheKey(t *testing.T) {
+	testCases := []struct {
+		name      string
+		req       cacheRequest
+		cacheable bool
+	}{
+		{
+			"receipt by hash",
+			cacheRequest{Method: "eth_getTransactionReceipt", Params: json.RawMessage(`["0x01"]`)},
+			true,
+		},
+		{
+			"block by number",
+			cacheRequest{Method: "eth_getBlockByNumber", Params: json.RawMessage(`["0x10", true]`)},
+			true,
+		},
+		{
+			"latest block",
+			cacheRequest{Method: "eth_getBlockByNumber", Params: json.RawMessage(`["latest", true]`)},
+			false,
+		},
+		{
+			"mutable method",
+			cacheRequest{Method: "eth_blockNumber"},
+			false,
+		},
+		{
+			"invalid params",
+			cacheRequest{Method: "eth_getTransactionByHash", Params: json.RawMessage(`{}`)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, ok := responseCacheKey(tc.req)
+		require.Equal(t, tc.cacheable, ok, tc.name)
+	}
+
+	// formatting differences resolve to the same key
+	key1, _ := responseCacheKey(cacheRequest{Method: "eth_getBlockByNumber", Params: json.RawMessage(`["0x10",true]`)})
+	key2, _ := responseCacheKey(cacheRequest{Method: "eth_getBlockByNumber", Params: json.RawMessage(`[ "0x10", true ]`)})
+	require.Equal(t, key1, key2)
+}
+
+func TestResponseCacher(t *testing.T) {
+	cache, err := NewLRUResponseCache(10)
+	require.NoError(t, err)
+
+	calls := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		var req cacheRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result json.RawMessage
+		switch req.Method {
+		case "eth_getTransactionReceipt":
+			result = json.RawMessage(`{"blockHash":"0x02","status":"0x1"}`)
+		case "eth_getTransactionByHash":
+			// a pending tx served from the mempool
+			result = json.RawMessage(`{"blockHash":null,"hash":"0x01"}`)
+		case "eth_getBlockTransactionCountByNumber":
+			result = json.RawMessage(`"0x1"`)
+		default:
+			result = json.RawMessage(`null`)
+		}
+		_ = json.NewEncoder(w).Encode(&cacheResponse{Jsonrpc: "2.0", ID: req.ID, Result: result})
+	})
+
+	handler := NewResponseCacher(log.NewNopLogger(), cache).Handler(next)
+
+	serve := func(body string) cacheResponse {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body)))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res cacheResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res
+	}
+
+	res := serve(`{"jsonrpc":"2.0","id":1,"method":"eth_getTransactionReceipt","params":["0x01"]}`)
+	require.Equal(t, json.RawMessage(`1`), res.ID)
+	require.Equal(t, 1, calls)
+
+	// served from the cache with the ID of the new request
+	res = serve(`{"jsonrpc":"2.0","id":2,"method":"eth_getTransactionReceipt","params":["0x01"]}`)
+	require.Equal(t, json.RawMessage(`2`), res.ID)
+	require.JSONEq(t, `{"blockHash":"0x02","status":"0x1"}`, string(res.Result))
+	require.Equal(t, 1, calls)
+
+	// the results that aren't in a block yet are not cached
+	serve(`{"jsonrpc":"2.0","id":3,"method":"eth_getTransactionByHash","params":["0x01"]}`)
+	res = serve(`{"jsonrpc":"2.0","id":4,"method":"eth_getTransactionByHash","params":["0x01"]}`)
+	require.JSONEq(t, `{"blockHash":null,"hash":"0x01"}`, string(res.Result))
+	require.Equal(t, 3, calls)
+
+	// null results are not cached
+	serve(`{"jsonrpc":"2.0","id":5,"method":"eth_getBlockByNumber","params":["0x10",false]}`)
+	serve(`{"jsonrpc":"2.0","id":6,"method":"eth_getBlockByNumber","params":["0x10",false]}`)
+	require.Equal(t, 5, calls)
+
+	// the results that aren't objects are cached once non-null
+	serve(`{"jsonrpc":"2.0","id":7,"method":"eth_getBlockTransactionCountByNumber","params":["0x10"]}`)
+	serve(`{"jsonrpc":"2.0","id":8,"method":"eth_getBlockTransactionCountByNumber","params":["0x10"]}`)
+	require.Equal(t, 6, calls)
+
+	// the oversized requests are rejected rather than truncated
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(make([]byte, maxRequestContentLength+1))))
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.Equal(t, 6, calls)
+}
+
+func TestInBlock(t *testing.T) {
+	testCases := []struct {
+		name    string
+		field   string
+		result  string
+		inBlock bool
+	}{
+		{"null", "blockHash", `null`, false},
+		{"empty", "", ``, false},
+		{"included tx", "blockHash", `{"blockHash":"0x01"}`, true},
+		{"pending tx", "blockHash", `{"blockHash":null}`, false},
+		{"missing field", "hash", `{"number":"0x1"}`, false},
+		{"not an object", "hash", `"0x1"`, false},
+		{"count", "", `"0x1"`, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.inBlock, inBlock(tc.field, json.RawMessage(tc.result)), tc.name)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+// RequestTimeouts is an HTTP middleware setting the execution deadline of the JSON-RPC requests from
+// the timeout of their method, namespace or method class. The deadline is set on the request context
+// passed to the API methods, which forward it to the evm queries so that the EVM executions and the
+// store iterations are aborted once it's exceeded. A batch is given the longest timeout of its calls.
+type RequestTimeouts struct {
+	logger         log.Logger
+	defaultTimeout time.Duration
+	classes        map[MethodClass]time.Duration
+	overrides      map[string]time.Duration
+}
+
+// NewRequestTimeouts creates a new RequestTimeouts from the JSON-RPC configuration.
+func NewRequestTimeouts(logger log.Logger, cfg config.JSONRPCConfig) (*RequestTimeouts, error) {
+	overrides, err := cfg.MethodTimeoutOverrides()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RequestTimeouts{
+		logger:         logger.With("module", "request-timeouts"),
+		defaultTimeout: cfg.DefaultTimeout,
+		classes: map[MethodClass]time.Duration{
+			MethodClassCall:  cfg.CallTimeout,
+			MethodClassTrace: cfg.TraceTimeout,
+			MethodClassLogs:  cfg.LogsTimeout,
+		},
+		overrides: overrides,
+	}, nil
+}
+
+// Timeout returns the execution timeout of the method, 0 if it has none. The timeout of the method
+// takes precedence over the one of its namespace, then over the one of its method class.
+func (rt *RequestTimeouts) Timeout(method string) time.Duration {
+	if timeout, ok := rt.overrides[method]; ok {
+		return timeout
+	}
+	if namespace, _, ok := strings.Cut(method, "_"); ok {
+		if timeout, ok := rt.overrides[namespace]; ok {
+			return timeout
+		}
+	}
+	if timeout := rt.classes[ClassifyMethod(method)]; timeout > 0 {
+		return timeout
+	}
+	return rt.defaultTimeout
+}
+
+// requestTimeout returns the longest timeout of the messages, 0 if any of them has no timeout.
+func (rt *RequestTimeouts) requestTimeout(msgs []jsonrpcMessage) time.Duration {
+	var timeout time.Duration
+	for _, msg := range msgs {
+		t := rt.Timeout(msg.Method)
+		if t == 0 {
+			return 0
+		}
+		if t > timeout {
+			timeout = t
+		}
+	}
+	return timeout
+}
+
+// Handler wraps the given handler, serving the requests with a context expiring after their timeout.
+func (rt *RequestTimeouts) Handler(next http.Handler) http.Handler {
+	if rt.defaultTimeout == 0 && len(rt.overrides) == 0 &&
+		rt.classes[MethodClassCall] == 0 && rt.classes[MethodClassTrace] == 0 && rt.classes[MethodClassLogs] == 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readRequestBody(w, r)
+		if !ok {
+			return
+		}
+
+		msgs, _ := parseMessages(body)
+		timeout := rt.requestTimeout(msgs)
+		if timeout == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			class := requestClass(msgs)
+			rt.logger.Debug("request timed out", "class", class, "timeout", timeout)
+			telemetry.IncrCounter(1, "json_rpc", "timeouts", string(class))
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.DefaultTimeout = time.Second
+	cfg.CallTimeout = 2 * time.Second
+	cfg.MethodTimeouts = []string{"debug=1m", "debug_traceCall=3s", "eth_estimateGas=0s"}
+	timeouts, err := NewRequestTimeouts(log.NewNopLogger(), *cfg)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		method  string
+		timeout time.Duration
+	}{
+		{"eth_blockNumber", time.Second},
+		{"eth_getLogs", time.Second},
+		{"eth_call", 2 * time.Second},
+		{"eth_estimateGas", 0},
+		{"debug_traceTransaction", time.Minute},
+		{"debug_traceCall", 3 * time.Second},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.timeout, timeouts.Timeout(tc.method), tc.method)
+	}
+
+	// a batch is given the longest timeout of its calls
+	require.Equal(t, 2*time.Second, timeouts.requestTimeout([]jsonrpcMessage{{Method: "eth_chainId"}, {Method: "eth_call"}}))
+	require.Zero(t, timeouts.requestTimeout([]jsonrpcMessage{{Method: "eth_call"}, {Method: "eth_estimateGas"}}))
+
+	cfg.MethodTimeouts = []string{"debug"}
+	_, err = NewRequestTimeouts(log.NewNopLogger(), *cfg)
+	require.Error(t, err)
+}
+
+func TestRequestTimeoutsHandler(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadline, hasDeadline = r.Context().Deadline()
+	})
+
+	cfg := config.DefaultJSONRPCConfig()
+	timeouts, err := NewRequestTimeouts(log.NewNopLogger(), *cfg)
+	require.NoError(t, err)
+	handler := timeouts.Handler(next)
+
+	serve := func(body string) {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body))
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	// no timeout by default
+	serve(`{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[]}`)
+	require.False(t, hasDeadline)
+
+	cfg.LogsTimeout = time.Minute
+	timeouts, err = NewRequestTimeouts(log.NewNopLogger(), *cfg)
+	require.NoError(t, err)
+	handler = timeouts.Handler(next)
+
+	start := time.Now()
+	serve(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`)
+	require.True(t, hasDeadline)
+	require.WithinDuration(t, start.Add(time.Minute), deadline, 5*time.Second)
+
+	serve(`{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[]}`)
+	require.False(t, hasDeadline)
+}
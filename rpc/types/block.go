@@ -22,9 +22,9 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
 	"strings"
 
-	"github.com/spf13/cast"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -33,6 +33,7 @@ import (
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 
 	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // BlockNumber represents decoding hex string to block values
@@ -73,6 +74,39 @@ func ContextWithHeight(height int64) context.Context {
 	return metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, fmt.Sprintf("%d", height))
 }
 
+// QueryContext returns the context of a gRPC query at the given height like ContextWithHeight,
+// which also forwards the ID and the deadline of the JSON-RPC request served by ctx to the query
+// execution.
+func QueryContext(ctx context.Context, height int64) context.Context {
+	queryCtx := context.Background()
+	if ctx != nil {
+		queryCtx = ctx
+	}
+	if height != 0 {
+		queryCtx = metadata.AppendToOutgoingContext(queryCtx, grpctypes.GRPCBlockHeightHeader, fmt.Sprintf("%d", height))
+	}
+	if id := RequestIDFromContext(ctx); id != "" {
+		queryCtx = metadata.AppendToOutgoingContext(queryCtx, evmtypes.GRPCRequestIDHeader, id)
+	}
+	return queryCtx
+}
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of the context of a JSON-RPC request carrying its ID.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the ID of the JSON-RPC request served by the context, empty if unknown.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
 // UnmarshalJSON parses the given JSON fragment into a BlockNumber. It supports:
 // - "latest", "finalized", "earliest" or "pending" as string arguments
 // - the block number
@@ -97,10 +131,8 @@ func (bn *BlockNumber) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	blckNum, err := hexutil.DecodeUint64(input)
-	if errors.Is(err, hexutil.ErrMissingPrefix) {
-		blckNum = cast.ToUint64(input)
-	} else if err != nil {
+	blckNum, err := decodeBlockHeight(input)
+	if err != nil {
 		return err
 	}
 
@@ -141,6 +173,19 @@ type BlockNumberOrHash struct {
 	BlockHash   *common.Hash `json:"blockHash,omitempty"`
 }
 
+// OrLatest returns the block number or hash, defaulting to the latest block
+// when the optional parameter was omitted by the client.
+func (bnh *BlockNumberOrHash) OrLatest() BlockNumberOrHash {
+	if bnh == nil || (bnh.BlockNumber == nil && bnh.BlockHash == nil) {
+		bn := EthLatestBlockNumber
+		return BlockNumberOrHash{BlockNumber: &bn}
+	}
+	return *bnh
+}
+
+// UnmarshalJSON parses the given JSON fragment into a BlockNumberOrHash. On top of
+// the EIP-1898 object and the hex string forms, it accepts the decimal numbers and
+// strings sent by legacy web3.js clients.
 func (bnh *BlockNumberOrHash) UnmarshalJSON(data []byte) error {
 	type erased BlockNumberOrHash
 	e := erased{}
@@ -148,6 +193,10 @@ func (bnh *BlockNumberOrHash) UnmarshalJSON(data []byte) error {
 	if err == nil {
 		return bnh.checkUnmarshal(BlockNumberOrHash(e))
 	}
+	var number json.Number
+	if json.Unmarshal(data, &number) == nil {
+		return bnh.decodeFromString(number.String())
+	}
 	var input string
 	err = json.Unmarshal(data, &input)
 	if err != nil {
@@ -175,7 +224,7 @@ func (bnh *BlockNumberOrHash) decodeFromString(input string) error {
 	case BlockParamEarliest:
 		bn := EthEarliestBlockNumber
 		bnh.BlockNumber = &bn
-	case BlockParamLatest, BlockParamFinalized:
+	case BlockParamLatest, BlockParamFinalized, BlockParamSafe:
 		bn := EthLatestBlockNumber
 		bnh.BlockNumber = &bn
 	case BlockParamPending:
@@ -192,8 +241,8 @@ func (bnh *BlockNumberOrHash) decodeFromString(input string) error {
 			bnh.BlockHash = &hash
 			break
 		}
-		// otherwise take the hex string has int64 value
-		blockNumber, err := hexutil.DecodeUint64(input)
+		// otherwise take the hex or decimal string has int64 value
+		blockNumber, err := decodeBlockHeight(input)
 		if err != nil {
 			return err
 		}
@@ -208,3 +257,13 @@ func (bnh *BlockNumberOrHash) decodeFromString(input string) error {
 	}
 	return nil
 }
+
+// decodeBlockHeight decodes a hex encoded block height, falling back to a decimal
+// one when the 0x prefix is missing.
+func decodeBlockHeight(input string) (uint64, error) {
+	height, err := hexutil.DecodeUint64(input)
+	if errors.Is(err, hexutil.ErrMissingPrefix) {
+		return strconv.ParseUint(input, 10, 64)
+	}
+	return height, err
+}
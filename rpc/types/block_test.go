@@ -1,11 +1,17 @@
 package types
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 func TestUnmarshalBlockNumberOrHash(t *testing.T) {
@@ -78,6 +84,47 @@ func TestUnmarshalBlockNumberOrHash(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"String input with block number safe",
+			[]byte("\"safe\""),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthLatestBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
+		{
+			"String input with decimal block number",
+			[]byte("\"53\""),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, BlockNumber(53))
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
+		{
+			"Number input with block number",
+			[]byte("53"),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, BlockNumber(53))
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
+		{
+			"Number input with negative block number",
+			[]byte("-1"),
+			func() {
+			},
+			false,
+		},
+		{
+			"String input with invalid block number",
+			[]byte("\"block\""),
+			func() {
+			},
+			false,
+		},
 		{
 			"String input with block number overflow",
 			[]byte("\"0xffffffffffffffffffffffffffffffffffffff\""),
@@ -100,3 +147,62 @@ func TestUnmarshalBlockNumberOrHash(t *testing.T) {
 		}
 	}
 }
+
+func TestUnmarshalBlockNumber(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		input   string
+		exp     BlockNumber
+		expPass bool
+	}{
+		{"hex string", "\"0x35\"", BlockNumber(0x35), true},
+		{"decimal string", "\"53\"", BlockNumber(53), true},
+		{"number", "53", BlockNumber(53), true},
+		{"latest", "\"latest\"", EthLatestBlockNumber, true},
+		{"safe", "\"safe\"", EthLatestBlockNumber, true},
+		{"pending", "\"pending\"", EthPendingBlockNumber, true},
+		{"earliest", "\"earliest\"", EthEarliestBlockNumber, true},
+		{"invalid string", "\"block\"", 0, false},
+		{"invalid hex", "\"0xzz\"", 0, false},
+	}
+
+	for _, tc := range testCases {
+		var bn BlockNumber
+		err := json.Unmarshal([]byte(tc.input), &bn)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+			require.Equal(t, tc.exp, bn, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestBlockNumberOrHashOrLatest(t *testing.T) {
+	var omitted *BlockNumberOrHash
+	require.Equal(t, EthLatestBlockNumber, *omitted.OrLatest().BlockNumber)
+	require.Equal(t, EthLatestBlockNumber, *(&BlockNumberOrHash{}).OrLatest().BlockNumber)
+
+	hash := common.HexToHash("0x579917054e325746fda5c3ee431d73d26255bc4e10b51163862368629ae19739")
+	bnh := BlockNumberOrHash{BlockHash: &hash}
+	require.Equal(t, bnh, (&bnh).OrLatest())
+}
+
+func TestQueryContext(t *testing.T) {
+	// no request id
+	md, _ := metadata.FromOutgoingContext(QueryContext(context.Background(), 5))
+	require.Equal(t, []string{"5"}, md.Get(grpctypes.GRPCBlockHeightHeader))
+	require.Empty(t, md.Get(evmtypes.GRPCRequestIDHeader))
+
+	ctx := ContextWithRequestID(context.Background(), "req-1")
+	require.Equal(t, "req-1", RequestIDFromContext(ctx))
+
+	md, _ = metadata.FromOutgoingContext(QueryContext(ctx, 5))
+	require.Equal(t, []string{"5"}, md.Get(grpctypes.GRPCBlockHeightHeader))
+	require.Equal(t, []string{"req-1"}, md.Get(evmtypes.GRPCRequestIDHeader))
+
+	// latest height
+	md, _ = metadata.FromOutgoingContext(QueryContext(ctx, 0))
+	require.Empty(t, md.Get(grpctypes.GRPCBlockHeightHeader))
+	require.Equal(t, []string{"req-1"}, md.Get(evmtypes.GRPCRequestIDHeader))
+}
@@ -0,0 +1,187 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// ErrCodeMethodNotSupported is the JSON-RPC error code returned by the methods
+// which are part of the Ethereum JSON-RPC spec but not implemented by the node,
+// as defined by EIP-1474.
+const ErrCodeMethodNotSupported = -32004
+
+// ErrCodeLimitExceeded is the JSON-RPC error code returned by the queries
+// exceeding a limit of the node, as defined by EIP-1474.
+const ErrCodeLimitExceeded = -32005
+
+// UnsupportedMethods are the legacy methods recognized by the node, which return
+// an UnsupportedMethodError instead of a method not found error.
+var UnsupportedMethods = []string{
+	"eth_compileSolidity",
+	"eth_compileLLL",
+	"eth_compileSerpent",
+}
+
+// UnsupportedMethodError is returned by the legacy methods not implemented by
+// the node, so that clients can tell them apart from the unknown methods.
+type UnsupportedMethodError struct {
+	Method string
+}
+
+// NewUnsupportedMethodError returns an UnsupportedMethodError for the given method.
+func NewUnsupportedMethodError(method string) *UnsupportedMethodError {
+	return &UnsupportedMethodError{Method: method}
+}
+
+// Error implements the error interface.
+func (e *UnsupportedMethodError) Error() string {
+	return fmt.Sprintf("the method %s is not supported", e.Method)
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *UnsupportedMethodError) ErrorCode() int {
+	return ErrCodeMethodNotSupported
+}
+
+// LogsRange defines the block range of a logs query.
+type LogsRange struct {
+	FromBlock hexutil.Uint64 `json:"fromBlock"`
+	ToBlock   hexutil.Uint64 `json:"toBlock"`
+}
+
+// LogsLimitError is returned by the logs queries exceeding the block range or
+// the logs limit of the node. Its data hints the largest block range from the
+// start of the query which can be queried instead, so that the clients can
+// split the query and resume from there. There is no hint when the first block
+// alone exceeds the logs limit, its logs can be queried with
+// `ethermint_getLogsPaged`.
+type LogsLimitError struct {
+	Message string
+	Hint    *LogsRange
+}
+
+// NewLogsLimitError returns a LogsLimitError with the hinted block range.
+func NewLogsLimitError(message string, hint *LogsRange) *LogsLimitError {
+	return &LogsLimitError{Message: message, Hint: hint}
+}
+
+// Error implements the error interface.
+func (e *LogsLimitError) Error() string {
+	return e.Message
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *LogsLimitError) ErrorCode() int {
+	return ErrCodeLimitExceeded
+}
+
+// ErrorData returns the hinted block range, included in the JSON-RPC error.
+func (e *LogsLimitError) ErrorData() interface{} {
+	if e.Hint == nil {
+		return nil
+	}
+	return e.Hint
+}
+
+// invalidNonceRegexp matches the nonces of the ante handler ErrInvalidSequence errors.
+var invalidNonceRegexp = regexp.MustCompile(`invalid nonce; got (\d+), expected (\d+)`)
+
+// TxError is a transaction submission error prefixed with the message of the equivalent
+// go-ethereum error, so that the wallets (eg: MetaMask) matching the go-ethereum messages handle it.
+type TxError struct {
+	// Reason is the go-ethereum error
+	Reason error
+	// Err is the original error
+	Err error
+}
+
+// Error implements the error interface.
+func (e *TxError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Reason, e.Err)
+}
+
+// ErrorCode returns the JSON-RPC error code, the one of go-ethereum for the transactions errors.
+func (e *TxError) ErrorCode() int {
+	return DefaultErrorCode
+}
+
+// Unwrap returns the original error.
+func (e *TxError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches both the go-ethereum and the original errors.
+func (e *TxError) Is(target error) bool {
+	return errors.Is(e.Reason, target)
+}
+
+// TranslateTxError translates the errors of the transactions submission to the errors of
+// go-ethereum the wallets pattern-match on: nonce too low or too high, transaction already known
+// or underpriced, insufficient funds and intrinsic gas too low. The other errors are returned
+// unchanged.
+func TranslateTxError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var txErr *TxError
+	if errors.As(err, &txErr) {
+		return err
+	}
+
+	var reason error
+	switch {
+	case errorsmod.IsOf(err, errortypes.ErrInvalidSequence):
+		reason = core.ErrNonceTooLow
+		if got, expected, ok := parseInvalidNonce(err); ok && got > expected {
+			reason = core.ErrNonceTooHigh
+		}
+	case errorsmod.IsOf(err, errortypes.ErrTxInMempoolCache):
+		reason = core.ErrAlreadyKnown
+	case errorsmod.IsOf(err, evmtypes.ErrTxUnderpriced, errortypes.ErrInsufficientFee):
+		reason = core.ErrUnderpriced
+	case errorsmod.IsOf(err, errortypes.ErrInsufficientFunds):
+		reason = core.ErrInsufficientFunds
+	case errorsmod.IsOf(err, errortypes.ErrOutOfGas, core.ErrIntrinsicGas):
+		reason = core.ErrIntrinsicGas
+	default:
+		return err
+	}
+	return &TxError{Reason: reason, Err: err}
+}
+
+// parseInvalidNonce returns the nonce of the transaction and the one expected by the ante handler
+// from an ErrInvalidSequence error.
+func parseInvalidNonce(err error) (got, expected uint64, ok bool) {
+	matches := invalidNonceRegexp.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return 0, 0, false
+	}
+	got, gotErr := strconv.ParseUint(matches[1], 10, 64)
+	expected, expectedErr := strconv.ParseUint(matches[2], 10, 64)
+	return got, expected, gotErr == nil && expectedErr == nil
+}
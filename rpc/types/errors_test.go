@@ -0,0 +1,84 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestTranslateTxError(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		expReason error
+	}{
+		{
+			"nonce too low",
+			errorsmod.Wrapf(errortypes.ErrInvalidSequence, "invalid nonce; got %d, expected %d", 1, 2),
+			core.ErrNonceTooLow,
+		},
+		{
+			"nonce too high",
+			errorsmod.Wrapf(errortypes.ErrInvalidSequence, "invalid nonce; got %d, expected %d", 3, 2),
+			core.ErrNonceTooHigh,
+		},
+		{
+			"nonce error from the abci response",
+			errorsmod.ABCIError(errortypes.ErrInvalidSequence.Codespace(), errortypes.ErrInvalidSequence.ABCICode(), "invalid nonce; got 1, expected 2"),
+			core.ErrNonceTooLow,
+		},
+		{
+			"already known",
+			errortypes.ErrTxInMempoolCache,
+			core.ErrAlreadyKnown,
+		},
+		{
+			"underpriced",
+			errorsmod.Wrap(evmtypes.ErrTxUnderpriced, "gas price 1 < minimum gas price 2"),
+			core.ErrUnderpriced,
+		},
+		{
+			"insufficient funds",
+			errorsmod.Wrap(errortypes.ErrInsufficientFunds, "failed to deduct full gas cost"),
+			core.ErrInsufficientFunds,
+		},
+		{
+			"intrinsic gas too low",
+			errorsmod.Wrap(errortypes.ErrOutOfGas, "gas limit too low: 1 (gas limit) < 21000 (intrinsic gas)"),
+			core.ErrIntrinsicGas,
+		},
+		{
+			"unknown error",
+			errortypes.ErrInvalidRequest,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := TranslateTxError(tc.err)
+		if tc.expReason == nil {
+			require.Equal(t, tc.err, err, tc.name)
+			continue
+		}
+
+		var txErr *TxError
+		require.True(t, errors.As(err, &txErr), tc.name)
+		require.Equal(t, tc.expReason, txErr.Reason, tc.name)
+		require.ErrorIs(t, err, tc.expReason, tc.name)
+		require.Equal(t, DefaultErrorCode, txErr.ErrorCode(), tc.name)
+		// the wallets match the go-ethereum message prefix
+		require.True(t, strings.HasPrefix(err.Error(), tc.expReason.Error()+": "), tc.name)
+
+		// already translated errors are unchanged
+		require.Equal(t, err, TranslateTxError(err), tc.name)
+	}
+
+	require.NoError(t, TranslateTxError(nil))
+}
@@ -17,10 +17,12 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -72,6 +74,12 @@ type ParsedTx struct {
 	EthTxIndex int32
 	GasUsed    uint64
 	Failed     bool
+	// revert data of a reverted tx, nil if not available
+	RevertReason []byte
+	// tip per gas actually paid, nil if not available
+	EffectiveTip *big.Int
+	// gas price actually paid, nil if not available
+	EffectiveGasPrice *big.Int
 }
 
 // NewParsedTx initialize a ParsedTx
@@ -171,6 +179,7 @@ func ParseTxIndexerResult(txResult *tmrpctypes.ResultTx, tx sdk.Tx, getter func(
 		Failed:            parsedTx.Failed,
 		GasUsed:           parsedTx.GasUsed,
 		CumulativeGasUsed: txs.AccumulativeGasUsed(parsedTx.MsgIndex),
+		EffectiveTip:      parsedTx.EffectiveTipString(),
 	}, nil
 }
 
@@ -204,6 +213,14 @@ func (p *ParsedTxs) updateTx(eventIndex int, attrs []abci.EventAttribute) error
 	return nil
 }
 
+// EffectiveTipString returns the effective tip as stored by the indexer, empty if not available.
+func (tx ParsedTx) EffectiveTipString() string {
+	if tx.EffectiveTip == nil {
+		return ""
+	}
+	return tx.EffectiveTip.String()
+}
+
 // GetTxByHash find ParsedTx by tx hash, returns nil if not exists.
 func (p *ParsedTxs) GetTxByHash(hash common.Hash) *ParsedTx {
 	if idx, ok := p.TxHashes[hash]; ok {
@@ -260,6 +277,24 @@ func fillTxAttribute(tx *ParsedTx, key []byte, value []byte) error {
 		tx.GasUsed = gasUsed
 	case evmtypes.AttributeKeyEthereumTxFailed:
 		tx.Failed = len(value) > 0
+	case evmtypes.AttributeKeyEthereumTxRevertReason:
+		revertReason, err := hexutil.Decode(string(value))
+		if err != nil {
+			return err
+		}
+		tx.RevertReason = revertReason
+	case evmtypes.AttributeKeyEffectiveTip:
+		tip, ok := new(big.Int).SetString(string(value), 10)
+		if !ok {
+			return fmt.Errorf("invalid effective tip: %s", value)
+		}
+		tx.EffectiveTip = tip
+	case evmtypes.AttributeKeyEffectiveGasPrice:
+		gasPrice, ok := new(big.Int).SetString(string(value), 10)
+		if !ok {
+			return fmt.Errorf("invalid effective gas price: %s", value)
+		}
+		tx.EffectiveGasPrice = gasPrice
 	}
 	return nil
 }
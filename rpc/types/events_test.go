@@ -119,6 +119,96 @@ func TestParseTxResult(t *testing.T) {
 				},
 			},
 		},
+		{
+			"format 1 events, reverted with reason",
+			abci.ResponseDeliverTx{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+						{Key: []byte("txIndex"), Value: []byte("10")},
+						{Key: []byte("amount"), Value: []byte("1000")},
+						{Key: []byte("txGasUsed"), Value: []byte("21000")},
+						{Key: []byte("txHash"), Value: []byte("14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57")},
+						{Key: []byte("recipient"), Value: []byte("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")},
+						{Key: []byte("ethereumTxFailed"), Value: []byte("execution reverted")},
+						{Key: []byte("ethereumTxRevertReason"), Value: []byte("0x08c379a0")},
+					}},
+					{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{}},
+				},
+			},
+			[]*ParsedTx{
+				{
+					MsgIndex:     0,
+					Hash:         txHash,
+					EthTxIndex:   10,
+					GasUsed:      21000,
+					Failed:       true,
+					RevertReason: []byte{0x08, 0xc3, 0x79, 0xa0},
+				},
+			},
+		},
+		{
+			"format 1 events, invalid revert reason",
+			abci.ResponseDeliverTx{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+						{Key: []byte("txIndex"), Value: []byte("10")},
+						{Key: []byte("amount"), Value: []byte("1000")},
+						{Key: []byte("txGasUsed"), Value: []byte("21000")},
+						{Key: []byte("ethereumTxFailed"), Value: []byte("execution reverted")},
+						{Key: []byte("ethereumTxRevertReason"), Value: []byte("reverted")},
+					}},
+				},
+			},
+			nil,
+		},
+		{
+			"format 2 events, effective tip",
+			abci.ResponseDeliverTx{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+						{Key: []byte("txIndex"), Value: []byte("0")},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: []byte("1000")},
+						{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+						{Key: []byte("txIndex"), Value: []byte("0")},
+						{Key: []byte("txGasUsed"), Value: []byte("21000")},
+						{Key: []byte("effectiveTip"), Value: []byte("1500000000")},
+					}},
+				},
+			},
+			[]*ParsedTx{
+				{
+					MsgIndex:     0,
+					Hash:         txHash,
+					EthTxIndex:   0,
+					GasUsed:      21000,
+					Failed:       false,
+					EffectiveTip: big.NewInt(1500000000),
+				},
+			},
+		},
+		{
+			"format 1 events, invalid effective tip",
+			abci.ResponseDeliverTx{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+						{Key: []byte("txIndex"), Value: []byte("10")},
+						{Key: []byte("txGasUsed"), Value: []byte("21000")},
+						{Key: []byte("effectiveTip"), Value: []byte("0x01")},
+					}},
+				},
+			},
+			nil,
+		},
 		{
 			"format 1 events, failed",
 			abci.ResponseDeliverTx{
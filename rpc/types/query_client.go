@@ -24,6 +24,8 @@ import (
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
@@ -33,10 +35,14 @@ import (
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - Staking module queries
+//   - Bank module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	Staking   stakingtypes.QueryClient
+	Bank      banktypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
@@ -45,6 +51,8 @@ func NewQueryClient(clientCtx client.Context) *QueryClient {
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		Staking:       stakingtypes.NewQueryClient(clientCtx),
+		Bank:          banktypes.NewQueryClient(clientCtx),
 	}
 }
 
@@ -0,0 +1,173 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// builtinSignatures are the signatures of the common token and router methods, always
+// known by the signature databases.
+var builtinSignatures = []string{
+	// ERC-20
+	"totalSupply()",
+	"balanceOf(address)",
+	"transfer(address,uint256)",
+	"transferFrom(address,address,uint256)",
+	"approve(address,uint256)",
+	"allowance(address,address)",
+	"name()",
+	"symbol()",
+	"decimals()",
+	"increaseAllowance(address,uint256)",
+	"decreaseAllowance(address,uint256)",
+	"permit(address,address,uint256,uint256,uint8,bytes32,bytes32)",
+	// WETH
+	"deposit()",
+	"withdraw(uint256)",
+	// ERC-721
+	"ownerOf(uint256)",
+	"safeTransferFrom(address,address,uint256)",
+	"safeTransferFrom(address,address,uint256,bytes)",
+	"setApprovalForAll(address,bool)",
+	"getApproved(uint256)",
+	"isApprovedForAll(address,address)",
+	"tokenURI(uint256)",
+	// ERC-1155
+	"balanceOf(address,uint256)",
+	"balanceOfBatch(address[],uint256[])",
+	"safeTransferFrom(address,address,uint256,uint256,bytes)",
+	"safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)",
+	"uri(uint256)",
+	// ERC-165
+	"supportsInterface(bytes4)",
+	// Ownable
+	"owner()",
+	"transferOwnership(address)",
+	"renounceOwnership()",
+	// Multicall
+	"multicall(bytes[])",
+	"aggregate((address,bytes)[])",
+	"tryAggregate(bool,(address,bytes)[])",
+	// Uniswap V2 router
+	"swapExactTokensForTokens(uint256,uint256,address[],address,uint256)",
+	"swapTokensForExactTokens(uint256,uint256,address[],address,uint256)",
+	"swapExactETHForTokens(uint256,address[],address,uint256)",
+	"swapExactTokensForETH(uint256,uint256,address[],address,uint256)",
+	"addLiquidity(address,address,uint256,uint256,uint256,uint256,address,uint256)",
+	"removeLiquidity(address,address,uint256,uint256,uint256,address,uint256)",
+}
+
+var (
+	signatureDBsMu sync.Mutex
+	signatureDBs   = make(map[string]*SignatureDB)
+)
+
+// SignatureDB maps the 4-byte function selectors to their human-readable signatures.
+type SignatureDB struct {
+	signatures map[[4]byte]string
+}
+
+// NewSignatureDB creates a SignatureDB of the built-in signatures and the given ones.
+func NewSignatureDB(signatures ...string) *SignatureDB {
+	db := &SignatureDB{signatures: make(map[[4]byte]string, len(builtinSignatures)+len(signatures))}
+	for _, signature := range append(builtinSignatures, signatures...) {
+		var selector [4]byte
+		copy(selector[:], crypto.Keccak256([]byte(signature))[:4])
+		db.signatures[selector] = signature
+	}
+	return db
+}
+
+// LoadSignatureDB returns the SignatureDB of the built-in signatures and the ones of the
+// 4byte database file, if any. The file uses the 4byte.json format of geth, a JSON object
+// of the signatures by hex selector. The databases are loaded once per file and shared.
+func LoadSignatureDB(path string) (*SignatureDB, error) {
+	signatureDBsMu.Lock()
+	defer signatureDBsMu.Unlock()
+
+	if db, ok := signatureDBs[path]; ok {
+		return db, nil
+	}
+
+	db := NewSignatureDB()
+	if path != "" {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the 4byte database: %w", err)
+		}
+
+		var signatures map[string]string
+		if err := json.Unmarshal(bz, &signatures); err != nil {
+			return nil, fmt.Errorf("invalid 4byte database %s: %w", path, err)
+		}
+		for hexSelector, signature := range signatures {
+			selector, err := hexutil.Decode("0x" + strings.TrimPrefix(hexSelector, "0x"))
+			if err != nil || len(selector) != 4 {
+				return nil, fmt.Errorf("invalid selector %s in the 4byte database %s", hexSelector, path)
+			}
+			db.signatures[*(*[4]byte)(selector)] = signature
+		}
+	}
+
+	signatureDBs[path] = db
+	return db, nil
+}
+
+// Lookup returns the signature of the function called by the call data.
+func (db *SignatureDB) Lookup(input []byte) (string, bool) {
+	if len(input) < 4 {
+		return "", false
+	}
+	signature, ok := db.signatures[*(*[4]byte)(input[:4])]
+	return signature, ok
+}
+
+// AnnotateCallFrames sets the signature of the called function as the `method` of the call
+// frames, found in the decoded JSON output of the call tracers, e.g. `callTracer` and
+// `flatCallTracer`.
+func (db *SignatureDB) AnnotateCallFrames(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, child := range v {
+			db.AnnotateCallFrames(child)
+		}
+
+		input, ok := v["input"].(string)
+		if !ok {
+			return
+		}
+		if frameType, _ := v["type"].(string); strings.HasPrefix(strings.ToUpper(frameType), "CREATE") {
+			return
+		}
+		if bz, err := hexutil.Decode(input); err == nil {
+			if signature, ok := db.Lookup(bz); ok {
+				v["method"] = signature
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			db.AnnotateCallFrames(child)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureDB(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "4byte.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"d0e30db0":"deposit()","0x12345678":"custom(uint256)"}`), 0o600))
+
+	db, err := LoadSignatureDB(path)
+	require.NoError(t, err)
+
+	// loaded once per path
+	cached, err := LoadSignatureDB(path)
+	require.NoError(t, err)
+	require.Same(t, db, cached)
+
+	testCases := []struct {
+		input     string
+		signature string
+	}{
+		{"0xa9059cbb0000000000000000000000000000000000000000000000000000000000000001", "transfer(address,uint256)"},
+		{"0x095ea7b3", "approve(address,uint256)"},
+		{"0xd0e30db0", "deposit()"},
+		{"0x12345678", "custom(uint256)"},
+		{"0x87654321", ""},
+		{"0xa9059c", ""},
+	}
+	for _, tc := range testCases {
+		signature, ok := db.Lookup(common.FromHex(tc.input))
+		require.Equal(t, tc.signature != "", ok, tc.input)
+		require.Equal(t, tc.signature, signature, tc.input)
+	}
+
+	_, ok := NewSignatureDB().Lookup(common.FromHex("0x12345678"))
+	require.False(t, ok)
+	signature, ok := NewSignatureDB("custom(uint256)").Lookup(crypto.Keccak256([]byte("custom(uint256)")))
+	require.True(t, ok)
+	require.Equal(t, "custom(uint256)", signature)
+}
+
+func TestLoadSignatureDBErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadSignatureDB(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+
+	invalidSelector := filepath.Join(dir, "invalid_selector.json")
+	require.NoError(t, os.WriteFile(invalidSelector, []byte(`{"a9059c":"transfer(address,uint256)"}`), 0o600))
+	_, err = LoadSignatureDB(invalidSelector)
+	require.ErrorContains(t, err, "invalid selector")
+
+	invalidJSON := filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(invalidJSON, []byte(`["transfer(address,uint256)"]`), 0o600))
+	_, err = LoadSignatureDB(invalidJSON)
+	require.ErrorContains(t, err, "invalid 4byte database")
+}
+
+func TestAnnotateCallFrames(t *testing.T) {
+	trace := []interface{}{
+		map[string]interface{}{
+			"action": map[string]interface{}{"callType": "call", "input": "0x70a08231"},
+			"type":   "call",
+		},
+		map[string]interface{}{
+			"action": map[string]interface{}{"init": "0x6080"},
+			"type":   "create",
+		},
+		map[string]interface{}{"type": "CALL", "input": "invalid"},
+	}
+
+	NewSignatureDB().AnnotateCallFrames(trace)
+	require.Equal(t, "balanceOf(address)", trace[0].(map[string]interface{})["action"].(map[string]interface{})["method"])
+	require.NotContains(t, trace[1].(map[string]interface{})["action"], "method")
+	require.NotContains(t, trace[2], "method")
+}
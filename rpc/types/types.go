@@ -16,11 +16,16 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -68,21 +73,11 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
+type OverrideAccount = evmtypes.OverrideAccount
 
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
@@ -91,6 +86,38 @@ type FeeHistoryResult struct {
 	GasUsedRatio []float64        `json:"gasUsedRatio"`
 }
 
+// ChainStats defines the aggregated statistics of the ethereum transactions
+// executed in a block range, returned by `ethermint_getChainStats`.
+type ChainStats struct {
+	FromBlock       hexutil.Uint64 `json:"fromBlock"`
+	ToBlock         hexutil.Uint64 `json:"toBlock"`
+	BlockCount      hexutil.Uint64 `json:"blockCount"`
+	TxCount         hexutil.Uint64 `json:"txCount"`
+	FailedTxCount   hexutil.Uint64 `json:"failedTxCount"`
+	GasUsed         hexutil.Uint64 `json:"gasUsed"`
+	AverageGasPrice *hexutil.Big   `json:"averageGasPrice"`
+	FailureRatio    float64        `json:"failureRatio"`
+}
+
+// KeyringAccount defines an account of the node keyring, returned by `personal_listKeyringAccounts`.
+type KeyringAccount struct {
+	Name    string         `json:"name"`
+	Address common.Address `json:"address"`
+	// Type is the keyring record type: local, ledger, offline or multi
+	Type string `json:"type"`
+	// DerivationPath is only known for the ledger accounts
+	DerivationPath string        `json:"derivationPath,omitempty"`
+	PublicKey      hexutil.Bytes `json:"publicKey"`
+	Hidden         bool          `json:"hidden"`
+}
+
+// TraceCallConfig is the config for the `debug_traceCall` api, extending the
+// trace config with the state overrides.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride `json:"stateOverrides"`
+}
+
 // SignTransactionResult represents a RLP encoded signed transaction.
 type SignTransactionResult struct {
 	Raw hexutil.Bytes         `json:"raw"`
@@ -102,3 +129,317 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// BlockFees defines the fee data of a block backing `eth_feeHistory`, persisted
+// by the indexer so that it outlives the node restarts and the block pruning.
+type BlockFees struct {
+	BaseFee      *hexutil.Big   `json:"baseFee"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	GasUsedRatio float64        `json:"gasUsedRatio"`
+	// Txs are the gas used and effective tips of the eth txs, sorted by tip
+	Txs []TxFees `json:"txs"`
+}
+
+// TxFees defines the gas used and the effective tip paid by an eth tx.
+type TxFees struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Reward  *hexutil.Big   `json:"reward"`
+}
+
+// Rewards returns the effective tips paid at the given percentiles of the block
+// gas used, zero if the block has no eth txs.
+func (f BlockFees) Rewards(percentiles []float64) []*big.Int {
+	rewards := make([]*big.Int, len(percentiles))
+	for i := range rewards {
+		rewards[i] = big.NewInt(0)
+	}
+
+	txCount := len(f.Txs)
+	if txCount == 0 {
+		return rewards
+	}
+
+	var txIndex int
+	sumGasUsed := uint64(f.Txs[0].GasUsed)
+
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(f.GasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < txCount-1 {
+			txIndex++
+			sumGasUsed += uint64(f.Txs[txIndex].GasUsed)
+		}
+		rewards[i] = f.Txs[txIndex].Reward.ToInt()
+	}
+
+	return rewards
+}
+
+// SyncingResult is the payload of the syncing subscription notification emitted
+// when the node starts catching up with the network.
+type SyncingResult struct {
+	Syncing bool       `json:"syncing"`
+	Status  SyncStatus `json:"status"`
+}
+
+// SyncStatus defines the sync progress of the node as reported by Tendermint. The
+// highestBlock field is omitted, as Tendermint doesn't expose the heights of the peers.
+type SyncStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+}
+
+// Capabilities defines the JSON-RPC methods implemented by the node and its
+// limits, returned by `ethermint_capabilities` for the clients to feature-detect.
+type Capabilities struct {
+	// Methods are the methods served by the node
+	Methods []string `json:"methods"`
+	// Unsupported are the methods recognized by the node which always return an
+	// unsupported method error
+	Unsupported []string  `json:"unsupported"`
+	Limits      RPCLimits `json:"limits"`
+}
+
+// RPCLimits defines the limits of the JSON-RPC server set in the node config.
+// A zero value means no limit.
+type RPCLimits struct {
+	GasCap        hexutil.Uint64 `json:"gasCap"`
+	EVMTimeout    string         `json:"evmTimeout"`
+	TxFeeCap      float64        `json:"txFeeCap"`
+	FilterCap     int32          `json:"filterCap"`
+	FeeHistoryCap int32          `json:"feeHistoryCap"`
+	LogsCap       int32          `json:"logsCap"`
+	BlockRangeCap int32          `json:"blockRangeCap"`
+	// ConfirmationDepth is the number of blocks on top of a block before its events are emitted by the
+	// filters and the subscriptions
+	ConfirmationDepth hexutil.Uint64 `json:"confirmationDepth"`
+}
+
+// BlockOptions defines the optional ethermint specific flags of the
+// `eth_getBlockByNumber` and `eth_getBlockByHash` queries.
+type BlockOptions struct {
+	// Tendermint adds the TendermintMetadata of the block to the response
+	Tendermint bool `json:"tendermint"`
+}
+
+// TendermintMetadata defines the consensus details of a block, returned under
+// the `tendermint` key of the Ethereum formatted blocks on request.
+type TendermintMetadata struct {
+	// ProposerAddress is the bech32 consensus address of the block proposer
+	ProposerAddress string `json:"proposerAddress"`
+	// Round is the consensus round in which the block was committed
+	Round hexutil.Uint64 `json:"round"`
+	// EvidenceCount is the number of misbehavior evidences included in the block
+	EvidenceCount hexutil.Uint64 `json:"evidenceCount"`
+	// AppHash is the application state hash after the previous block
+	AppHash hexutil.Bytes `json:"appHash"`
+}
+
+// ReceiptOptions defines the optional ethermint specific flags of the
+// `eth_getTransactionReceipt` query.
+type ReceiptOptions struct {
+	// Tendermint adds the TxFinality of the transaction block to the response
+	Tendermint bool `json:"tendermint"`
+}
+
+// TxFinality defines the Tendermint finality of the block including a transaction,
+// returned under the `tendermint` key of the receipts on request. The blocks
+// committed by more than 2/3 of the voting power are final, they can't be reverted.
+type TxFinality struct {
+	// Round is the consensus round in which the block was committed
+	Round hexutil.Uint64 `json:"round"`
+	// Timestamp is the unix time of the block in seconds
+	Timestamp hexutil.Uint64 `json:"timestamp"`
+	// SignedPower is the voting power of the precommits for the block
+	SignedPower hexutil.Uint64 `json:"signedPower"`
+	// TotalPower is the voting power of the validator set of the block
+	TotalPower hexutil.Uint64 `json:"totalPower"`
+	// Final is true when the precommits of more than 2/3 of the voting power are observed
+	Final bool `json:"final"`
+}
+
+// AccountProofBundle defines the account and storage proofs of an address at a height along with
+// the app hash they are verified against, returned by `ethermint_getAccountProofForHeight`.
+type AccountProofBundle struct {
+	AccountResult
+	// Height is the height of the state the proofs are generated for
+	Height hexutil.Uint64 `json:"height"`
+	// CommitHeight is the height of the block whose header commits the app hash
+	CommitHeight hexutil.Uint64 `json:"commitHeight"`
+	// AppHash is the multistore root hash the proofs are verified against
+	AppHash hexutil.Bytes `json:"appHash"`
+}
+
+// ValidatorAccount defines the addresses of a validator, returned by
+// `ethermint_getValidatorAccount`.
+type ValidatorAccount struct {
+	// Address is the hex address of the validator account
+	Address common.Address `json:"address"`
+	// OperatorAddress is the bech32 validator operator address
+	OperatorAddress string `json:"operatorAddress"`
+	// ConsensusAddress is the bech32 validator consensus address
+	ConsensusAddress string `json:"consensusAddress"`
+}
+
+// LogsPage defines a page of the logs returned by `ethermint_getLogsPaged`.
+type LogsPage struct {
+	Logs []*ethtypes.Log `json:"logs"`
+	// Cursor is the position of the next page, to pass to the next query with the same filter
+	// criteria. It is nil once all the logs of the block range are returned.
+	Cursor *string `json:"cursor"`
+}
+
+// LogsCursor defines the position of the next log of a `ethermint_getLogsPaged` query, as the block
+// height and the index of the log within the block.
+type LogsCursor struct {
+	Height   int64
+	LogIndex uint
+}
+
+// String returns the opaque hex encoding of the cursor.
+func (c LogsCursor) String() string {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(c.Height))
+	binary.BigEndian.PutUint64(bz[8:], uint64(c.LogIndex))
+	return hexutil.Encode(bz)
+}
+
+// ParseLogsCursor decodes a cursor returned by `ethermint_getLogsPaged`.
+func ParseLogsCursor(cursor string) (LogsCursor, error) {
+	bz, err := hexutil.Decode(cursor)
+	if err != nil || len(bz) != 16 {
+		return LogsCursor{}, fmt.Errorf("invalid logs cursor %s", cursor)
+	}
+	height := binary.BigEndian.Uint64(bz)
+	if height > math.MaxInt64 {
+		return LogsCursor{}, fmt.Errorf("invalid logs cursor height %d", height)
+	}
+	return LogsCursor{
+		Height:   int64(height),
+		LogIndex: uint(binary.BigEndian.Uint64(bz[8:])),
+	}, nil
+}
+
+// BundleCallResult defines the result of a call of `ethermint_simulateBundle`.
+type BundleCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	// Error is the execution error of the call, including the revert reason, empty on success
+	Error string `json:"error,omitempty"`
+}
+
+// CallBatchResult defines the outcome of a call of `ethermint_callBatch`, holding either the return
+// data or the error eth_call would return.
+type CallBatchResult struct {
+	Result *hexutil.Bytes  `json:"result,omitempty"`
+	Error  *CallBatchError `json:"error,omitempty"`
+}
+
+// DefaultErrorCode is the JSON-RPC error code the go-ethereum rpc server returns for the errors
+// without a specific code.
+const DefaultErrorCode = -32000
+
+// CallBatchError defines the JSON-RPC error of a failed call of `ethermint_callBatch`.
+type CallBatchError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// GasEstimate defines the result of `ethermint_estimateGas`, the gas estimation adjusted by the
+// estimate gas multiplier of the node and the raw one.
+type GasEstimate struct {
+	// Gas is the adjusted estimation, returned by `eth_estimateGas`
+	Gas hexutil.Uint64 `json:"gas"`
+	// RawGas is the estimation before the adjustment
+	RawGas     hexutil.Uint64 `json:"rawGas"`
+	Multiplier float64        `json:"multiplier"`
+}
+
+// MaxEstimateGasBulkCalls is the max number of calls of an `ethermint_estimateGasBulk` request.
+const MaxEstimateGasBulkCalls = 5000
+
+// GasEstimateBulkResult defines the outcome of a call of `ethermint_estimateGasBulk`, holding either
+// the gas estimation or the error eth_estimateGas would return.
+type GasEstimateBulkResult struct {
+	*GasEstimate
+	Error *CallBatchError `json:"error,omitempty"`
+}
+
+// DryRunResult defines the result of `ethermint_dryRunTransaction`, the result of the call with
+// the state changes it would apply.
+type DryRunResult struct {
+	BundleCallResult
+	// StateDiff are the changed accounts
+	StateDiff map[common.Address]*AccountDiff `json:"stateDiff"`
+}
+
+// AccountDiff defines the changes of an account by a dry run.
+type AccountDiff struct {
+	BalanceBefore  *hexutil.Big   `json:"balanceBefore"`
+	BalanceAfter   *hexutil.Big   `json:"balanceAfter"`
+	NonceBefore    hexutil.Uint64 `json:"nonceBefore"`
+	NonceAfter     hexutil.Uint64 `json:"nonceAfter"`
+	CodeHashBefore common.Hash    `json:"codeHashBefore"`
+	CodeHashAfter  common.Hash    `json:"codeHashAfter"`
+	// Created is true if a contract is deployed at the address
+	Created bool `json:"created"`
+	// Destroyed is true if the contract self destructed
+	Destroyed bool                        `json:"destroyed"`
+	Storage   map[common.Hash]StorageDiff `json:"storage"`
+}
+
+// StorageDiff defines the change of a storage slot by a dry run.
+type StorageDiff struct {
+	Before common.Hash `json:"before"`
+	After  common.Hash `json:"after"`
+}
+
+// NewAccountDiff converts the account diff returned by the evm StateDiff query.
+func NewAccountDiff(diff evmtypes.AccountDiff) (*AccountDiff, error) {
+	balanceBefore, ok := new(big.Int).SetString(diff.BalanceBefore, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance %s of %s", diff.BalanceBefore, diff.Address)
+	}
+	balanceAfter, ok := new(big.Int).SetString(diff.BalanceAfter, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance %s of %s", diff.BalanceAfter, diff.Address)
+	}
+
+	account := &AccountDiff{
+		BalanceBefore:  (*hexutil.Big)(balanceBefore),
+		BalanceAfter:   (*hexutil.Big)(balanceAfter),
+		NonceBefore:    hexutil.Uint64(diff.NonceBefore),
+		NonceAfter:     hexutil.Uint64(diff.NonceAfter),
+		CodeHashBefore: common.HexToHash(diff.CodeHashBefore),
+		CodeHashAfter:  common.HexToHash(diff.CodeHashAfter),
+		Created:        diff.Created,
+		Destroyed:      diff.Destroyed,
+		Storage:        make(map[common.Hash]StorageDiff, len(diff.Storage)),
+	}
+	for _, slot := range diff.Storage {
+		account.Storage[common.HexToHash(slot.Key)] = StorageDiff{
+			Before: common.HexToHash(slot.Before),
+			After:  common.HexToHash(slot.After),
+		}
+	}
+	return account, nil
+}
+
+// SendTxOptions defines the options of `ethermint_sendRawTransactionSync`.
+type SendTxOptions struct {
+	// IdempotencyKey identifies the submission, the retries with the same key return the result of
+	// the first submission instead of broadcasting the transaction again.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+	// WaitForInclusion waits for the transaction to be included in a block and returns its receipt.
+	WaitForInclusion bool `json:"waitForInclusion,omitempty"`
+}
+
+// SendTxResult defines the result of `ethermint_sendRawTransactionSync`, once the transaction is
+// accepted by CheckTx.
+type SendTxResult struct {
+	Hash common.Hash `json:"hash"`
+	// Receipt is the receipt of the transaction if its inclusion is waited for, nil if the
+	// transaction isn't included before the timeout.
+	Receipt map[string]interface{} `json:"receipt,omitempty"`
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockFeesRewards(t *testing.T) {
+	txFees := func(gasUsed uint64, reward int64) TxFees {
+		return TxFees{GasUsed: hexutil.Uint64(gasUsed), Reward: (*hexutil.Big)(big.NewInt(reward))}
+	}
+	percentiles := []float64{10, 50, 90, 100}
+
+	testCases := []struct {
+		name       string
+		fees       BlockFees
+		expRewards []*big.Int
+	}{
+		{
+			"no txs",
+			BlockFees{GasUsed: 0},
+			[]*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0)},
+		},
+		{
+			"single tx",
+			BlockFees{GasUsed: 21000, Txs: []TxFees{txFees(21000, 5)}},
+			[]*big.Int{big.NewInt(5), big.NewInt(5), big.NewInt(5), big.NewInt(5)},
+		},
+		{
+			"weighted by gas used",
+			BlockFees{GasUsed: 100, Txs: []TxFees{txFees(20, 1), txFees(60, 2), txFees(20, 3)}},
+			[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(3)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRewards, tc.fees.Rewards(percentiles))
+		})
+	}
+}
+
+func TestLogsCursor(t *testing.T) {
+	cursor := LogsCursor{Height: 120, LogIndex: 7}
+	parsed, err := ParseLogsCursor(cursor.String())
+	require.NoError(t, err)
+	require.Equal(t, cursor, parsed)
+
+	for _, invalid := range []string{"", "0x", "0x1234", "not hex", "0xffffffffffffffff0000000000000000"} {
+		_, err := ParseLogsCursor(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -32,12 +33,15 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -52,6 +56,19 @@ type WebsocketsServer interface {
 	Start()
 }
 
+// MempoolEvictions is implemented by the apps evicting the unconfirmed ethereum transactions from
+// the mempool after a TTL.
+type MempoolEvictions interface {
+	SubscribeMempoolEvictions(ch chan<- common.Hash) event.Subscription
+}
+
+// EvictedTx is the newPendingTransactions notification of a transaction evicted from the mempool
+// after its TTL.
+type EvictedTx struct {
+	Hash    common.Hash `json:"hash"`
+	Evicted bool        `json:"evicted"`
+}
+
 type SubscriptionResponseJSON struct {
 	Jsonrpc string      `json:"jsonrpc"`
 	Result  interface{} `json:"result"`
@@ -89,7 +106,14 @@ type websocketsServer struct {
 	logger   log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+// The evictions are optional, the evicted transactions are notified to the newPendingTransactions
+// subscriptions if set. The logs backend is optional too, the logs subscriptions with a fromBlock
+// are backfilled from it if set. It's required by the logs subscriptions if a confirmation depth is
+// configured, the confirmed logs being fetched from it.
+func NewWebsocketsServer(
+	clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config,
+	evictions MempoolEvictions, logsBackend rpcfilters.Backend,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
@@ -98,7 +122,7 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      newPubSubAPI(clientCtx, logger, tmWSClient, evictions, logsBackend, cfg.JSONRPC.ConfirmationDepth),
 		logger:   logger,
 	}
 }
@@ -242,8 +266,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			}
 
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			// closed once the subscription ID is sent, before any notification
+			subscribed := make(chan struct{})
+			unsubFn, err := s.api.subscribe(wsConn, subID, params, subscribed)
 			if err != nil {
+				close(subscribed)
 				s.sendErrResponse(wsConn, err.Error())
 				continue
 			}
@@ -255,7 +282,9 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				Result:  subID,
 			}
 
-			if err := wsConn.WriteJSON(res); err != nil {
+			err = wsConn.WriteJSON(res)
+			close(subscribed)
+			if err != nil {
 				break
 			}
 		case "eth_unsubscribe":
@@ -344,22 +373,36 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
-	events    *rpcfilters.EventSystem
-	logger    log.Logger
-	clientCtx client.Context
+	events      *rpcfilters.EventSystem
+	evictions   MempoolEvictions
+	logsBackend rpcfilters.Backend
+	// number of blocks on top of a block before its headers and logs are notified
+	confirmationDepth uint64
+	logger            log.Logger
+	clientCtx         client.Context
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(
+	clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient,
+	evictions MempoolEvictions, logsBackend rpcfilters.Backend, confirmationDepth uint64,
+) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
-		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:            rpcfilters.NewEventSystem(logger, tmWSClient, clientCtx.Client),
+		evictions:         evictions,
+		logsBackend:       logsBackend,
+		confirmationDepth: confirmationDepth,
+		logger:            logger,
+		clientCtx:         clientCtx,
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribe creates the subscription, the subscribed channel is closed once its ID is sent to the
+// client or once the subscription failed.
+func (api *pubSubAPI) subscribe(
+	wsConn *wsConn, subID rpc.ID, params []interface{}, subscribed <-chan struct{},
+) (pubsub.UnsubscribeFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -371,9 +414,9 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		return api.subscribeNewHeads(wsConn, subID)
 	case "logs":
 		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+			return api.subscribeLogs(wsConn, subID, params[1], subscribed)
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
+		return api.subscribeLogs(wsConn, subID, nil, subscribed)
 	case "newPendingTransactions":
 		return api.subscribePendingTransactions(wsConn, subID)
 	case "syncing":
@@ -393,8 +436,9 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 	baseFee := big.NewInt(params.InitialBaseFee)
 
 	go func() {
-		headersCh := sub.Event()
+		headersCh := rpcfilters.ConfirmHeads(sub.Event(), api.confirmationDepth)
 		errCh := sub.Err()
+		var tracker headTracker
 		for {
 			select {
 			case event, ok := <-headersCh:
@@ -408,6 +452,11 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					continue
 				}
 
+				// the header replacing a rolled back block is the correction of the chain head
+				if tracker.Track(data.Header.Height, common.BytesToHash(data.Header.Hash())) {
+					api.logger.Info("chain rolled back, notifying the new head", "height", data.Header.Height, "subscription-id", subID)
+				}
+
 				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
 
 				// write to ws conn
@@ -459,7 +508,12 @@ func try(fn func(), l log.Logger, desc string) {
 	fn()
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribeLogs subscribes to the logs matching the criteria. As an ethermint extension, the logs
+// from the fromBlock of the criteria to the latest block are backfilled: they're notified before the
+// live logs, without gap nor duplicate between the two.
+func (api *pubSubAPI) subscribeLogs(
+	wsConn *wsConn, subID rpc.ID, extra interface{}, subscribed <-chan struct{},
+) (pubsub.UnsubscribeFunc, error) {
 	crit := filters.FilterCriteria{}
 
 	if extra != nil {
@@ -557,6 +611,29 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				crit.Topics[topicIdx] = subtopicsCollect
 			}
 		}
+
+		if params["fromBlock"] != nil {
+			fromBlock, ok := params["fromBlock"].(string)
+			if !ok {
+				err := errors.New("invalid fromBlock")
+				api.logger.Debug("invalid fromBlock", "type", fmt.Sprintf("%T", params["fromBlock"]))
+				return nil, err
+			}
+
+			var blockNum types.BlockNumber
+			if err := blockNum.UnmarshalJSON([]byte(fromBlock)); err != nil {
+				return nil, errors.Wrap(err, "invalid fromBlock")
+			}
+
+			// latest and pending only subscribe to the new logs
+			if blockNum >= 0 {
+				crit.FromBlock = big.NewInt(int64(blockNum))
+			}
+		}
+	}
+
+	if api.confirmationDepth > 0 {
+		return api.subscribeConfirmedLogs(wsConn, subID, crit, subscribed)
 	}
 
 	sub, unsubFn, err := api.events.SubscribeLogs(crit)
@@ -565,52 +642,174 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		return nil, err
 	}
 
+	// the live events are buffered until the backfill is done
+	var (
+		backfill   []*ethtypes.Log
+		backfilled int64
+		pending    []coretypes.ResultEvent
+	)
+	ch := sub.Event()
+	buffered := make(chan struct{})
 	go func() {
-		ch := sub.Event()
-		errCh := sub.Err()
+		defer close(buffered)
 		for {
 			select {
 			case event, ok := <-ch:
 				if !ok {
 					return
 				}
+				pending = append(pending, event)
+			case <-subscribed:
+				return
+			}
+		}
+	}()
+
+	if crit.FromBlock != nil && api.logsBackend != nil {
+		backfill, backfilled, err = backfillLogs(api.logger, api.logsBackend, crit)
+		if err != nil {
+			unsubFn()
+			api.logger.Debug("failed to backfill logs", "from", crit.FromBlock, "error", err.Error())
+			return nil, err
+		}
+	}
+
+	notify := func(logs []*ethtypes.Log) {
+		api.notifyLogs(wsConn, subID, logs)
+	}
+
+	var tracker logsTracker
+	// notifyEvent notifies the logs of the event, it returns false if the event can't be decoded
+	notifyEvent := func(event coretypes.ResultEvent) bool {
+		dataTx, ok := event.Data.(tmtypes.EventDataTx)
+		if !ok {
+			api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", event.Data))
+			return true
+		}
+
+		// the logs of the backfilled blocks are already notified
+		if dataTx.Height <= backfilled {
+			return true
+		}
+
+		txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
+		if err != nil {
+			api.logger.Error("failed to decode tx response", "error", err.Error())
+			return false
+		}
+
+		txLogs := evmtypes.LogsToEthereum(txResponse.Logs)
+		var blockHash common.Hash
+		if len(txLogs) > 0 {
+			blockHash = txLogs[0].BlockHash
+		}
+
+		// the logs delivered for the rolled back blocks are notified again as removed
+		logs := rpcfilters.FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
+		removed := tracker.Track(uint64(dataTx.Height), blockHash, logs)
+		if len(removed) > 0 {
+			api.logger.Info("chain rolled back, notifying removed logs", "height", dataTx.Height, "removed", len(removed), "subscription-id", subID)
+		}
+		notify(append(removed, logs...))
+		return true
+	}
 
-				dataTx, ok := event.Data.(tmtypes.EventDataTx)
+	go func() {
+		<-buffered
+		notify(backfill)
+		for _, event := range pending {
+			if !notifyEvent(event) {
+				return
+			}
+		}
+
+		errCh := sub.Err()
+		for {
+			select {
+			case event, ok := <-ch:
 				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", event.Data))
-					continue
+					return
 				}
-
-				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
-				if err != nil {
-					api.logger.Error("failed to decode tx response", "error", err.Error())
+				if !notifyEvent(event) {
+					return
+				}
+			case err, ok := <-errCh:
+				if !ok {
 					return
 				}
+				api.logger.Debug("dropping Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
 
-				logs := rpcfilters.FilterLogs(evmtypes.LogsToEthereum(txResponse.Logs), crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-				if len(logs) == 0 {
+	return unsubFn, nil
+}
+
+// subscribeConfirmedLogs subscribes to the logs matching the criteria, fetched from the logs backend
+// once their block is confirmed by the confirmation depth. The logs from the fromBlock of the criteria
+// to the latest confirmed block are backfilled.
+func (api *pubSubAPI) subscribeConfirmedLogs(
+	wsConn *wsConn, subID rpc.ID, crit filters.FilterCriteria, subscribed <-chan struct{},
+) (pubsub.UnsubscribeFunc, error) {
+	if api.logsBackend == nil {
+		return nil, errors.New("the confirmed logs subscriptions require a logs backend")
+	}
+	if err := rpcfilters.ValidateLogsRange(crit); err != nil {
+		return nil, err
+	}
+
+	header, err := api.logsBackend.HeaderByNumber(types.EthLatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil || header.Number == nil {
+		return nil, errors.New("latest header not found")
+	}
+	head := header.Number.Int64()
+
+	// without fromBlock, only the logs of the new blocks are notified
+	last := head
+	var backfill []*ethtypes.Log
+	if crit.FromBlock != nil {
+		backfill, last, err = rpcfilters.ConfirmedLogs(api.logger, api.logsBackend, crit, crit.FromBlock.Int64()-1, head, api.confirmationDepth)
+		if err != nil {
+			api.logger.Debug("failed to backfill logs", "from", crit.FromBlock, "error", err.Error())
+			return nil, err
+		}
+	}
+
+	sub, unsubFn, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating block filter")
+	}
+
+	go func() {
+		// the logs are notified once the subscription ID is sent, the logs of the heads received
+		// meanwhile are fetched with the next ones
+		<-subscribed
+		api.notifyLogs(wsConn, subID, backfill)
+
+		headersCh := sub.Event()
+		errCh := sub.Err()
+		for {
+			select {
+			case event, ok := <-headersCh:
+				if !ok {
+					return
+				}
+				data, ok := event.Data.(tmtypes.EventDataNewBlockHeader)
+				if !ok {
+					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", event.Data))
 					continue
 				}
 
-				for _, ethLog := range logs {
-					res := &SubscriptionNotification{
-						Jsonrpc: "2.0",
-						Method:  "eth_subscription",
-						Params: &SubscriptionResult{
-							Subscription: subID,
-							Result:       ethLog,
-						},
-					}
-
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close()
-							}
-						}, api.logger, "closing websocket peer sub")
-					}
+				var logs []*ethtypes.Log
+				logs, last, err = rpcfilters.ConfirmedLogs(api.logger, api.logsBackend, crit, last, data.Header.Height, api.confirmationDepth)
+				if err != nil {
+					api.logger.Error("failed to fetch the confirmed logs", "height", data.Header.Height, "error", err.Error())
+					continue
 				}
+				api.notifyLogs(wsConn, subID, logs)
 			case err, ok := <-errCh:
 				if !ok {
 					return
@@ -623,17 +822,71 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
+// notifyLogs writes the logs notifications of the subscription, closing the connection on failure.
+func (api *pubSubAPI) notifyLogs(wsConn *wsConn, subID rpc.ID, logs []*ethtypes.Log) {
+	for _, ethLog := range logs {
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       ethLog,
+			},
+		}
+
+		err := wsConn.WriteJSON(res)
+		if err != nil {
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close()
+				}
+			}, api.logger, "closing websocket peer sub")
+		}
+	}
+}
+
 func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
 	}
 
+	// the evicted txs are notified if the app evicts them after a ttl
+	var evictedCh chan common.Hash
+	if api.evictions != nil {
+		evictedCh = make(chan common.Hash, 16)
+		evictionSub := api.evictions.SubscribeMempoolEvictions(evictedCh)
+		cancelSub := unsubFn
+		unsubFn = func() {
+			evictionSub.Unsubscribe()
+			cancelSub()
+		}
+	}
+
 	go func() {
 		txsCh := sub.Event()
 		errCh := sub.Err()
 		for {
 			select {
+			case hash := <-evictedCh:
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       EvictedTx{Hash: hash, Evicted: true},
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing evicted tx, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close()
+						}
+					}, api.logger, "closing websocket peer sub")
+				}
 			case ev := <-txsCh:
 				data, ok := ev.Data.(tmtypes.EventDataTx)
 				if !ok {
@@ -681,8 +934,79 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// syncingPollInterval defines how often the Tendermint node status is polled to
+// detect sync transitions for the syncing subscription.
+const syncingPollInterval = time.Second
+
+// subscribeSyncing notifies the subscriber every time the node starts or stops
+// catching up with the network. A SyncingResult is sent when the node starts
+// syncing and false once it has caught up, matching the geth behavior.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a Tendermint RPC client")
+	}
+
+	status, err := api.clientCtx.Client.Status(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query node status")
+	}
+
+	quit := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
+
+		catchingUp := false
+		for {
+			if status != nil && status.SyncInfo.CatchingUp != catchingUp {
+				catchingUp = status.SyncInfo.CatchingUp
+
+				var result interface{} = false
+				if catchingUp {
+					result = &types.SyncingResult{
+						Syncing: true,
+						Status: types.SyncStatus{
+							StartingBlock: hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
+							CurrentBlock:  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+						},
+					}
+				}
+
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       result,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close()
+						}
+					}, api.logger, "closing websocket peer sub")
+					return
+				}
+			}
+
+			select {
+			case <-quit:
+				return
+			case <-ticker.C:
+				status, err = api.clientCtx.Client.Status(context.Background())
+				if err != nil {
+					api.logger.Debug("failed to query node status", "subscription-id", subID, "error", err.Error())
+				}
+			}
+		}
+	}()
+
+	return func() { close(quit) }, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// syncingClient returns the scripted sync statuses of the node, the last one once all returned.
+type syncingClient struct {
+	tmrpcclient.Client
+	mu       sync.Mutex
+	statuses []coretypes.SyncInfo
+}
+
+func (c *syncingClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	info := c.statuses[0]
+	if len(c.statuses) > 1 {
+		c.statuses = c.statuses[1:]
+	}
+	return &coretypes.ResultStatus{SyncInfo: info}, nil
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	tmClient := &syncingClient{statuses: []coretypes.SyncInfo{
+		{CatchingUp: true, EarliestBlockHeight: 1, LatestBlockHeight: 10},
+		{CatchingUp: false, EarliestBlockHeight: 1, LatestBlockHeight: 20},
+	}}
+	api := &pubSubAPI{logger: log.NewNopLogger(), clientCtx: client.Context{}.WithClient(tmClient)}
+
+	// the subscription writes to the server side of a websocket connection
+	unsubscribed := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		require.NoError(t, err)
+		unsubscribe, err := api.subscribeSyncing(&wsConn{conn: conn, mux: new(sync.Mutex)}, rpc.ID("0x1"))
+		require.NoError(t, err)
+		go func() {
+			<-unsubscribed
+			unsubscribe()
+		}()
+	}))
+	defer srv.Close()
+	defer close(unsubscribed)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	read := func() string {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		var notification struct {
+			Method string `json:"method"`
+			Params struct {
+				Subscription string          `json:"subscription"`
+				Result       json.RawMessage `json:"result"`
+			} `json:"params"`
+		}
+		require.NoError(t, conn.ReadJSON(&notification))
+		require.Equal(t, "eth_subscription", notification.Method)
+		require.Equal(t, "0x1", notification.Params.Subscription)
+		return string(notification.Params.Result)
+	}
+
+	// the node starts catching up, the height of the peers isn't known
+	require.JSONEq(t, `{"syncing":true,"status":{"startingBlock":"0x1","currentBlock":"0xa"}}`, read())
+	// then it catches up, the unchanged statuses are not notified
+	require.Equal(t, "false", read())
+}
+
+func TestSubscribeSyncingWithoutClient(t *testing.T) {
+	api := &pubSubAPI{logger: log.NewNopLogger()}
+	_, err := api.subscribeSyncing(nil, rpc.ID("0x1"))
+	require.ErrorContains(t, err, "requires a Tendermint RPC client")
+}
@@ -18,9 +18,12 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
+	stdstrings "strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 
 	"github.com/tendermint/tendermint/libs/strings"
@@ -51,12 +54,50 @@ const (
 
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultSpeculativeCacheSize is the default number of cached CheckTx speculative execution
+	// results, the speculative execution is disabled by default
+	DefaultSpeculativeCacheSize = 0
+
+	// DefaultStateDBCacheBudget is the default memory budget in bytes of the state cached by an evm
+	// execution, the cache is unbounded by default
+	DefaultStateDBCacheBudget uint64 = 0
+
+	// DefaultDenomMetadataDecimals is the default decimals of the evm denom display unit validated at
+	// genesis, the validation is disabled by default
+	DefaultDenomMetadataDecimals uint32 = 0
+
+	// DefaultStorageHashMaxSlots is the default max number of storage slots of the accounts whose
+	// storage hash is computed by the queries
+	DefaultStorageHashMaxSlots uint64 = 100_000
+
+	// DefaultMempoolTTLBlocks is the default number of blocks after which the unconfirmed eth txs are
+	// evicted from the mempool, the eviction is disabled by default
+	DefaultMempoolTTLBlocks int64 = 0
+
+	// DefaultMempoolTTLDuration is the default duration after which the unconfirmed eth txs are
+	// evicted from the mempool, the eviction is disabled by default
+	DefaultMempoolTTLDuration time.Duration = 0
+
+	// DefaultEVMInterpreter is the default EVM implementation, the go-ethereum one
+	DefaultEVMInterpreter = "geth"
+
+	// DefaultAdaptiveGasPriceMaxMultiplier is the default max multiplier of the node min gas price
+	// adjusted with the block fullness, the adjustment is disabled by default
+	DefaultAdaptiveGasPriceMaxMultiplier float64 = 0
+
+	// DefaultAdaptiveGasPriceTargetFullness is the default block fullness above which the node min gas
+	// price is raised
+	DefaultAdaptiveGasPriceTargetFullness float64 = 0.5
+
 	DefaultGasCap uint64 = 25000000
 
 	DefaultFilterCap int32 = 200
 
 	DefaultFeeHistoryCap int32 = 100
 
+	// DefaultFeeHistoryRetention is the number of recent blocks whose fee data is kept by the indexer
+	DefaultFeeHistoryRetention uint64 = 10000
+
 	DefaultLogsCap int32 = 10000
 
 	DefaultBlockRangeCap int32 = 10000
@@ -66,6 +107,9 @@ const (
 	// default 1.0 eth
 	DefaultTxFeeCap float64 = 1.0
 
+	// DefaultEstimateGasMultiplier is the multiplier applied to the eth_estimateGas results (unchanged = 1.0)
+	DefaultEstimateGasMultiplier float64 = 1.0
+
 	DefaultHTTPTimeout = 30 * time.Second
 
 	DefaultHTTPIdleTimeout = 120 * time.Second
@@ -75,6 +119,39 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultMaxConcurrentRequests represents the amount of concurrent requests per method class (unlimited = 0)
+	DefaultMaxConcurrentRequests = 0
+
+	// DefaultMaxQueuedRequests represents the amount of requests per method class waiting for a free slot
+	DefaultMaxQueuedRequests = 100
+
+	// DefaultResponseCacheSize represents the amount of cached immutable responses (disabled = 0)
+	DefaultResponseCacheSize = 0
+
+	DefaultResponseCacheTTL = time.Hour
+
+	// DefaultBlockCacheSize is the default number of recent heights whose Ethereum formatted blocks are
+	// cached by the backend
+	DefaultBlockCacheSize = 16
+
+	// DefaultTraceJobWorkers is the number of trace jobs executed concurrently
+	DefaultTraceJobWorkers = 1
+
+	// DefaultTraceJobQueueSize is the max number of trace jobs waiting for a worker
+	DefaultTraceJobQueueSize = 16
+
+	// DefaultJSTracerTimeout is the max execution time of the custom JavaScript tracers
+	DefaultJSTracerTimeout = 5 * time.Second
+
+	// DefaultVerifierSolcPath is the default solc binary used to verify the contracts
+	DefaultVerifierSolcPath = "solc"
+
+	// DefaultVerifierCompileTimeout is the max execution time of a contract verification compilation
+	DefaultVerifierCompileTimeout = 20 * time.Second
+
+	// DefaultVerifierMaxCompilations is the max number of contract verification compilations run concurrently
+	DefaultVerifierMaxCompilations = 2
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -96,6 +173,34 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// SpeculativeCacheSize defines the number of eth txs whose CheckTx speculative execution results
+	// are cached to be reused in DeliverTx, 0 disables the speculative execution.
+	SpeculativeCacheSize int `mapstructure:"speculative-cache-size"`
+	// StateDBCacheBudget defines the approximate memory budget in bytes of the accounts and storage
+	// cached by each evm execution, above which the state that isn't modified is evicted, 0 for an
+	// unbounded cache.
+	StateDBCacheBudget uint64 `mapstructure:"statedb-cache-budget"`
+	// DenomMetadataDecimals defines the decimals of the display unit of the evm denom, whose bank
+	// metadata is validated at genesis. 0 disables the validation.
+	DenomMetadataDecimals uint32 `mapstructure:"denom-metadata-decimals"`
+	// StorageHashMaxSlots defines the max number of storage slots of the accounts whose storage hash
+	// is computed by the queries, which iterate the whole storage. 0 for unbounded.
+	StorageHashMaxSlots uint64 `mapstructure:"storage-hash-max-slots"`
+	// MempoolTTLBlocks defines the number of blocks after which the unconfirmed eth txs are evicted
+	// from the mempool on recheck. 0 disables the limit.
+	MempoolTTLBlocks int64 `mapstructure:"mempool-ttl-blocks"`
+	// MempoolTTLDuration defines the duration, measured with the block times, after which the
+	// unconfirmed eth txs are evicted from the mempool on recheck. 0 disables the limit.
+	MempoolTTLDuration time.Duration `mapstructure:"mempool-ttl-duration"`
+	// Interpreter defines the name of the registered EVM implementation that executes the eth txs.
+	// Default: 'geth'.
+	Interpreter string `mapstructure:"interpreter"`
+	// AdaptiveGasPriceMaxMultiplier defines the max multiplier of the node min gas price, raised while
+	// the blocks are fuller than the target and lowered back while they're emptier. 0 disables it.
+	AdaptiveGasPriceMaxMultiplier float64 `mapstructure:"adaptive-gas-price-max-multiplier"`
+	// AdaptiveGasPriceTargetFullness defines the ratio of the block gas limit consumed above which the
+	// node min gas price is raised.
+	AdaptiveGasPriceTargetFullness float64 `mapstructure:"adaptive-gas-price-target-fullness"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -112,10 +217,16 @@ type JSONRPCConfig struct {
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
+	// EstimateGasMultiplier defines the multiplier applied to the `eth_estimateGas` results, as a buffer
+	// for the calls whose gas usage depends on the gas limit, eg: deep call stacks and the 63/64 rule.
+	EstimateGasMultiplier float64 `mapstructure:"estimate-gas-multiplier"`
 	// FilterCap is the global cap for total number of filters that can be created.
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
 	FeeHistoryCap int32 `mapstructure:"feehistory-cap"`
+	// FeeHistoryRetention defines the number of recent blocks whose fee data, backing `eth_feeHistory`, is
+	// persisted by the custom indexer.
+	FeeHistoryRetention uint64 `mapstructure:"feehistory-retention"`
 	// Enable defines if the EVM RPC server should be enabled.
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
@@ -138,6 +249,161 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// MaxConcurrentCalls defines the max number of `eth_call` and `eth_estimateGas` requests served concurrently.
+	MaxConcurrentCalls int `mapstructure:"max-concurrent-calls"`
+	// MaxConcurrentTraces defines the max number of `debug_trace*` requests served concurrently.
+	MaxConcurrentTraces int `mapstructure:"max-concurrent-traces"`
+	// MaxConcurrentLogs defines the max number of `eth_getLogs` requests served concurrently.
+	MaxConcurrentLogs int `mapstructure:"max-concurrent-logs"`
+	// MaxQueuedRequests defines the max number of requests per method class waiting for a
+	// free slot before new ones are rejected.
+	MaxQueuedRequests int `mapstructure:"max-queued-requests"`
+	// DefaultTimeout defines the execution timeout of the requests whose method class has no timeout, 0
+	// meaning no timeout.
+	DefaultTimeout time.Duration `mapstructure:"default-timeout"`
+	// CallTimeout defines the execution timeout of the `eth_call` and `eth_estimateGas` requests.
+	CallTimeout time.Duration `mapstructure:"call-timeout"`
+	// TraceTimeout defines the execution timeout of the `debug_trace*` requests.
+	TraceTimeout time.Duration `mapstructure:"trace-timeout"`
+	// LogsTimeout defines the execution timeout of the `eth_getLogs` requests.
+	LogsTimeout time.Duration `mapstructure:"logs-timeout"`
+	// MethodTimeouts overrides the execution timeout of namespaces or methods, as
+	// "<namespace|method>=<duration>" entries, the method ones taking precedence.
+	MethodTimeouts []string `mapstructure:"method-timeouts"`
+	// ResponseCacheSize defines the max number of immutable query responses kept in the in-memory cache.
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
+	// ResponseCacheRedisURL defines the Redis server used to cache immutable query responses instead of
+	// the in-memory cache.
+	ResponseCacheRedisURL string `mapstructure:"response-cache-redis-url"`
+	// ResponseCacheTTL defines the expiration of the responses cached in Redis.
+	ResponseCacheTTL time.Duration `mapstructure:"response-cache-ttl"`
+	// BlockCacheSize defines the number of most recent heights whose assembled Ethereum blocks are
+	// cached by the backend, 0 disables the cache.
+	BlockCacheSize int `mapstructure:"block-cache-size"`
+	// TraceFileDir defines the directory where `debug_standardTraceBlockToFile` writes the trace files.
+	TraceFileDir string `mapstructure:"trace-file-dir"`
+	// TraceFileRetentionBlocks defines the number of latest blocks whose trace files are kept, the
+	// older ones being pruned in the background.
+	TraceFileRetentionBlocks uint64 `mapstructure:"trace-file-retention-blocks"`
+	// TraceFileMaxDiskSize defines the max number of bytes used by the trace files, the files of the
+	// oldest blocks being pruned in the background when it is exceeded.
+	TraceFileMaxDiskSize uint64 `mapstructure:"trace-file-max-disk-size"`
+	// TraceMaxMemorySize defines the max number of memory bytes captured per step by the struct logger.
+	TraceMaxMemorySize uint64 `mapstructure:"trace-max-memory-size"`
+	// TraceMaxStackSize defines the max number of stack items captured per step by the struct logger.
+	TraceMaxStackSize uint64 `mapstructure:"trace-max-stack-size"`
+	// TraceMaxStorageSize defines the max number of storage slots captured per step by the struct logger.
+	TraceMaxStorageSize uint64 `mapstructure:"trace-max-storage-size"`
+	// TraceMaxReturnDataSize defines the max number of return data bytes captured per step by the struct logger.
+	TraceMaxReturnDataSize uint64 `mapstructure:"trace-max-return-data-size"`
+	// TraceJobWorkers defines the number of trace jobs, queued with `debug_queueTrace*`, executed concurrently.
+	TraceJobWorkers int `mapstructure:"trace-job-workers"`
+	// TraceJobQueueSize defines the max number of trace jobs waiting for a worker, and the number of
+	// completed jobs whose results are kept.
+	TraceJobQueueSize int `mapstructure:"trace-job-queue-size"`
+	// EnableUnsafeJSTracers defines if user supplied JavaScript tracers can be run by the `debug` namespace.
+	EnableUnsafeJSTracers bool `mapstructure:"enable-unsafe-js-tracers"`
+	// JSTracerTimeout defines the max execution time of a user supplied JavaScript tracer per transaction.
+	JSTracerTimeout time.Duration `mapstructure:"js-tracer-timeout"`
+	// HiddenAccounts defines the keyring accounts, by key name or hex address, not returned by `eth_accounts`
+	// and `personal_listAccounts`.
+	HiddenAccounts []string `mapstructure:"hidden-accounts"`
+	// EnableVerifier defines if the contract verification API is served by the `verifier` namespace and the
+	// `/verifier` REST routes of the JSON-RPC server.
+	EnableVerifier bool `mapstructure:"enable-verifier"`
+	// VerifierSolcPath defines the solc binary used to compile the sources of the verified contracts.
+	VerifierSolcPath string `mapstructure:"verifier-solc-path"`
+	// VerifierCompileTimeout defines the max execution time of a verification compilation.
+	VerifierCompileTimeout time.Duration `mapstructure:"verifier-compile-timeout"`
+	// VerifierMaxCompilations defines the max number of verification compilations run concurrently, the
+	// other requests waiting for a free slot.
+	VerifierMaxCompilations int `mapstructure:"verifier-max-compilations"`
+	// DecodeSignatures defines if the call data of the call tracer outputs and of `txpool_inspect` is annotated
+	// with the human-readable signatures of the called functions.
+	DecodeSignatures bool `mapstructure:"decode-signatures"`
+	// FourByteDBPath defines the 4byte database file of the function signatures, completing the built-in ones.
+	FourByteDBPath string `mapstructure:"4byte-db-path"`
+	// EpochArchives defines the JSON-RPC endpoints serving the blocks of the previous chain epochs, as
+	// "<chain-id>=<url>" entries, to which the block queries prior to the current chain-id are forwarded.
+	EpochArchives []string `mapstructure:"epoch-archives"`
+	// ExternalSigners defines the web3signer-compatible JSON-RPC endpoints signing for the accounts, as
+	// "<address>=<url>" entries, to which `eth_sendTransaction`, `eth_sign` and `eth_signTypedData` of
+	// these accounts delegate the signature instead of using the node keyring.
+	ExternalSigners []string `mapstructure:"external-signers"`
+	// TxSigner defines the name of the signer registered by the application, e.g. an MPC/TSS provider,
+	// signing the `eth_sendTransaction` transactions of the accounts instead of the node keyring.
+	TxSigner string `mapstructure:"tx-signer"`
+	// NonceGapTolerance defines the max number of future-nonce transactions per sender held in the node
+	// local queue by `eth_sendRawTransaction`, and broadcasted once the nonce gap is filled.
+	NonceGapTolerance uint64 `mapstructure:"nonce-gap-tolerance"`
+	// ConfirmationDepth defines the number of blocks on top of a block before its headers and logs are
+	// emitted by the filters and the subscriptions, as a safety margin against the rollbacks.
+	ConfirmationDepth uint64 `mapstructure:"confirmation-depth"`
+}
+
+// EpochArchiveURLs parses the epoch archives, returning the JSON-RPC endpoints by chain-id.
+func (c JSONRPCConfig) EpochArchiveURLs() (map[string]string, error) {
+	archives := make(map[string]string, len(c.EpochArchives))
+	for _, archive := range c.EpochArchives {
+		chainID, rawURL, ok := stdstrings.Cut(archive, "=")
+		chainID, rawURL = stdstrings.TrimSpace(chainID), stdstrings.TrimSpace(rawURL)
+		if !ok || chainID == "" || rawURL == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC epoch archive '%s', expected <chain-id>=<url>", archive)
+		}
+		if _, err := url.ParseRequestURI(rawURL); err != nil {
+			return nil, fmt.Errorf("invalid JSON-RPC epoch archive url of chain-id %s: %w", chainID, err)
+		}
+		if _, found := archives[chainID]; found {
+			return nil, fmt.Errorf("repeated JSON-RPC epoch archive for chain-id %s", chainID)
+		}
+		archives[chainID] = rawURL
+	}
+	return archives, nil
+}
+
+// ExternalSignerURLs parses the external signers, returning the JSON-RPC endpoints by account address.
+func (c JSONRPCConfig) ExternalSignerURLs() (map[common.Address]string, error) {
+	signers := make(map[common.Address]string, len(c.ExternalSigners))
+	for _, signer := range c.ExternalSigners {
+		address, rawURL, ok := stdstrings.Cut(signer, "=")
+		address, rawURL = stdstrings.TrimSpace(address), stdstrings.TrimSpace(rawURL)
+		if !ok || !common.IsHexAddress(address) || rawURL == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC external signer '%s', expected <address>=<url>", signer)
+		}
+		if _, err := url.ParseRequestURI(rawURL); err != nil {
+			return nil, fmt.Errorf("invalid JSON-RPC external signer url of address %s: %w", address, err)
+		}
+		addr := common.HexToAddress(address)
+		if _, found := signers[addr]; found {
+			return nil, fmt.Errorf("repeated JSON-RPC external signer for address %s", addr.Hex())
+		}
+		signers[addr] = rawURL
+	}
+	return signers, nil
+}
+
+// MethodTimeoutOverrides parses the method timeouts, returning the timeouts by namespace or method.
+func (c JSONRPCConfig) MethodTimeoutOverrides() (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration, len(c.MethodTimeouts))
+	for _, override := range c.MethodTimeouts {
+		name, rawTimeout, ok := stdstrings.Cut(override, "=")
+		name, rawTimeout = stdstrings.TrimSpace(name), stdstrings.TrimSpace(rawTimeout)
+		if !ok || name == "" || rawTimeout == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC method timeout '%s', expected <namespace|method>=<duration>", override)
+		}
+		timeout, err := time.ParseDuration(rawTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON-RPC timeout of %s: %w", name, err)
+		}
+		if timeout < 0 {
+			return nil, fmt.Errorf("JSON-RPC timeout of %s cannot be negative", name)
+		}
+		if _, found := timeouts[name]; found {
+			return nil, fmt.Errorf("repeated JSON-RPC timeout for %s", name)
+		}
+		timeouts[name] = timeout
+	}
+	return timeouts, nil
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -196,8 +462,18 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:                DefaultEVMTracer,
+		MaxTxGasWanted:        DefaultMaxTxGasWanted,
+		SpeculativeCacheSize:  DefaultSpeculativeCacheSize,
+		StateDBCacheBudget:    DefaultStateDBCacheBudget,
+		DenomMetadataDecimals: DefaultDenomMetadataDecimals,
+		StorageHashMaxSlots:   DefaultStorageHashMaxSlots,
+		MempoolTTLBlocks:      DefaultMempoolTTLBlocks,
+		MempoolTTLDuration:    DefaultMempoolTTLDuration,
+		Interpreter:           DefaultEVMInterpreter,
+
+		AdaptiveGasPriceMaxMultiplier:  DefaultAdaptiveGasPriceMaxMultiplier,
+		AdaptiveGasPriceTargetFullness: DefaultAdaptiveGasPriceTargetFullness,
 	}
 }
 
@@ -207,6 +483,26 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.SpeculativeCacheSize < 0 {
+		return errors.New("speculative cache size cannot be negative")
+	}
+
+	if c.MempoolTTLBlocks < 0 {
+		return errors.New("mempool ttl blocks cannot be negative")
+	}
+
+	if c.MempoolTTLDuration < 0 {
+		return errors.New("mempool ttl duration cannot be negative")
+	}
+
+	if c.AdaptiveGasPriceMaxMultiplier != 0 && c.AdaptiveGasPriceMaxMultiplier <= 1 {
+		return errors.New("adaptive gas price max multiplier must be 0 or greater than 1")
+	}
+
+	if c.AdaptiveGasPriceTargetFullness <= 0 || c.AdaptiveGasPriceTargetFullness > 1 {
+		return errors.New("adaptive gas price target fullness must be within (0, 1]")
+	}
+
 	return nil
 }
 
@@ -217,7 +513,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "ethermint"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -230,8 +526,10 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		GasCap:                   DefaultGasCap,
 		EVMTimeout:               DefaultEVMTimeout,
 		TxFeeCap:                 DefaultTxFeeCap,
+		EstimateGasMultiplier:    DefaultEstimateGasMultiplier,
 		FilterCap:                DefaultFilterCap,
 		FeeHistoryCap:            DefaultFeeHistoryCap,
+		FeeHistoryRetention:      DefaultFeeHistoryRetention,
 		BlockRangeCap:            DefaultBlockRangeCap,
 		LogsCap:                  DefaultLogsCap,
 		HTTPTimeout:              DefaultHTTPTimeout,
@@ -241,6 +539,31 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		MaxConcurrentCalls:       DefaultMaxConcurrentRequests,
+		MaxConcurrentTraces:      DefaultMaxConcurrentRequests,
+		MaxConcurrentLogs:        DefaultMaxConcurrentRequests,
+		MaxQueuedRequests:        DefaultMaxQueuedRequests,
+		ResponseCacheSize:        DefaultResponseCacheSize,
+		ResponseCacheRedisURL:    "",
+		ResponseCacheTTL:         DefaultResponseCacheTTL,
+		BlockCacheSize:           DefaultBlockCacheSize,
+		TraceFileDir:             "",
+		TraceFileRetentionBlocks: 0,
+		TraceFileMaxDiskSize:     0,
+		TraceMaxMemorySize:       0,
+		TraceMaxStackSize:        0,
+		TraceMaxStorageSize:      0,
+		TraceMaxReturnDataSize:   0,
+		TraceJobWorkers:          DefaultTraceJobWorkers,
+		TraceJobQueueSize:        DefaultTraceJobQueueSize,
+		EnableUnsafeJSTracers:    false,
+		JSTracerTimeout:          DefaultJSTracerTimeout,
+		EnableVerifier:           false,
+		VerifierSolcPath:         DefaultVerifierSolcPath,
+		VerifierCompileTimeout:   DefaultVerifierCompileTimeout,
+		VerifierMaxCompilations:  DefaultVerifierMaxCompilations,
+		DecodeSignatures:         false,
+		FourByteDBPath:           "",
 	}
 }
 
@@ -262,6 +585,11 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC tx fee cap cannot be negative")
 	}
 
+	// 0 falls back to the default for the configs predating the option
+	if c.EstimateGasMultiplier < 0 || (c.EstimateGasMultiplier > 0 && c.EstimateGasMultiplier < 1) {
+		return errors.New("JSON-RPC estimate gas multiplier cannot be lower than 1")
+	}
+
 	if c.EVMTimeout < 0 {
 		return errors.New("JSON-RPC EVM timeout duration cannot be negative")
 	}
@@ -282,6 +610,62 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.MaxConcurrentCalls < 0 || c.MaxConcurrentTraces < 0 || c.MaxConcurrentLogs < 0 {
+		return errors.New("JSON-RPC max concurrent requests cannot be negative")
+	}
+
+	if c.MaxQueuedRequests < 0 {
+		return errors.New("JSON-RPC max queued requests cannot be negative")
+	}
+
+	if c.DefaultTimeout < 0 || c.CallTimeout < 0 || c.TraceTimeout < 0 || c.LogsTimeout < 0 {
+		return errors.New("JSON-RPC request timeouts cannot be negative")
+	}
+
+	if _, err := c.MethodTimeoutOverrides(); err != nil {
+		return err
+	}
+
+	if c.ResponseCacheSize < 0 {
+		return errors.New("JSON-RPC response cache size cannot be negative")
+	}
+
+	if c.ResponseCacheTTL < 0 {
+		return errors.New("JSON-RPC response cache TTL cannot be negative")
+	}
+
+	if c.BlockCacheSize < 0 {
+		return errors.New("JSON-RPC block cache size cannot be negative")
+	}
+
+	if c.TraceJobWorkers < 0 || c.TraceJobQueueSize < 0 {
+		return errors.New("JSON-RPC trace job workers and queue size cannot be negative")
+	}
+
+	if c.JSTracerTimeout < 0 {
+		return errors.New("JSON-RPC JavaScript tracer timeout cannot be negative")
+	}
+
+	if c.EnableVerifier && c.VerifierSolcPath == "" {
+		return errors.New("JSON-RPC verifier solc path cannot be empty when the verifier is enabled")
+	}
+
+	if c.EnableVerifier && c.VerifierCompileTimeout <= 0 {
+		return errors.New("JSON-RPC verifier compile timeout must be positive when the verifier is enabled")
+	}
+
+	if c.EnableVerifier && c.VerifierMaxCompilations <= 0 {
+		return errors.New("JSON-RPC verifier max compilations must be positive when the verifier is enabled")
+	}
+
+	if _, err := c.EpochArchiveURLs(); err != nil {
+		return err
+	}
+
+	if _, err := c.ExternalSignerURLs(); err != nil {
+		return err
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -330,8 +714,18 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			Tracer:                v.GetString("evm.tracer"),
+			MaxTxGasWanted:        v.GetUint64("evm.max-tx-gas-wanted"),
+			SpeculativeCacheSize:  v.GetInt("evm.speculative-cache-size"),
+			StateDBCacheBudget:    v.GetUint64("evm.statedb-cache-budget"),
+			DenomMetadataDecimals: v.GetUint32("evm.denom-metadata-decimals"),
+			StorageHashMaxSlots:   v.GetUint64("evm.storage-hash-max-slots"),
+			MempoolTTLBlocks:      v.GetInt64("evm.mempool-ttl-blocks"),
+			MempoolTTLDuration:    v.GetDuration("evm.mempool-ttl-duration"),
+			Interpreter:           v.GetString("evm.interpreter"),
+
+			AdaptiveGasPriceMaxMultiplier:  v.GetFloat64("evm.adaptive-gas-price-max-multiplier"),
+			AdaptiveGasPriceTargetFullness: v.GetFloat64("evm.adaptive-gas-price-target-fullness"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -341,7 +735,9 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			GasCap:                   v.GetUint64("json-rpc.gas-cap"),
 			FilterCap:                v.GetInt32("json-rpc.filter-cap"),
 			FeeHistoryCap:            v.GetInt32("json-rpc.feehistory-cap"),
+			FeeHistoryRetention:      v.GetUint64("json-rpc.feehistory-retention"),
 			TxFeeCap:                 v.GetFloat64("json-rpc.txfee-cap"),
+			EstimateGasMultiplier:    v.GetFloat64("json-rpc.estimate-gas-multiplier"),
 			EVMTimeout:               v.GetDuration("json-rpc.evm-timeout"),
 			LogsCap:                  v.GetInt32("json-rpc.logs-cap"),
 			BlockRangeCap:            v.GetInt32("json-rpc.block-range-cap"),
@@ -351,6 +747,41 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
+			MaxConcurrentCalls:       v.GetInt("json-rpc.max-concurrent-calls"),
+			MaxConcurrentTraces:      v.GetInt("json-rpc.max-concurrent-traces"),
+			MaxConcurrentLogs:        v.GetInt("json-rpc.max-concurrent-logs"),
+			MaxQueuedRequests:        v.GetInt("json-rpc.max-queued-requests"),
+			DefaultTimeout:           v.GetDuration("json-rpc.default-timeout"),
+			CallTimeout:              v.GetDuration("json-rpc.call-timeout"),
+			TraceTimeout:             v.GetDuration("json-rpc.trace-timeout"),
+			LogsTimeout:              v.GetDuration("json-rpc.logs-timeout"),
+			MethodTimeouts:           v.GetStringSlice("json-rpc.method-timeouts"),
+			ResponseCacheSize:        v.GetInt("json-rpc.response-cache-size"),
+			ResponseCacheRedisURL:    v.GetString("json-rpc.response-cache-redis-url"),
+			ResponseCacheTTL:         v.GetDuration("json-rpc.response-cache-ttl"),
+			BlockCacheSize:           v.GetInt("json-rpc.block-cache-size"),
+			TraceFileDir:             v.GetString("json-rpc.trace-file-dir"),
+			TraceFileRetentionBlocks: v.GetUint64("json-rpc.trace-file-retention-blocks"),
+			TraceFileMaxDiskSize:     v.GetUint64("json-rpc.trace-file-max-disk-size"),
+			TraceMaxMemorySize:       v.GetUint64("json-rpc.trace-max-memory-size"),
+			TraceMaxStackSize:        v.GetUint64("json-rpc.trace-max-stack-size"),
+			TraceMaxStorageSize:      v.GetUint64("json-rpc.trace-max-storage-size"),
+			TraceMaxReturnDataSize:   v.GetUint64("json-rpc.trace-max-return-data-size"),
+			TraceJobWorkers:          v.GetInt("json-rpc.trace-job-workers"),
+			TraceJobQueueSize:        v.GetInt("json-rpc.trace-job-queue-size"),
+			EnableUnsafeJSTracers:    v.GetBool("json-rpc.enable-unsafe-js-tracers"),
+			JSTracerTimeout:          v.GetDuration("json-rpc.js-tracer-timeout"),
+			HiddenAccounts:           v.GetStringSlice("json-rpc.hidden-accounts"),
+			EnableVerifier:           v.GetBool("json-rpc.enable-verifier"),
+			VerifierSolcPath:         v.GetString("json-rpc.verifier-solc-path"),
+			VerifierCompileTimeout:   v.GetDuration("json-rpc.verifier-compile-timeout"),
+			DecodeSignatures:         v.GetBool("json-rpc.decode-signatures"),
+			FourByteDBPath:           v.GetString("json-rpc.4byte-db-path"),
+			EpochArchives:            v.GetStringSlice("json-rpc.epoch-archives"),
+			ExternalSigners:          v.GetStringSlice("json-rpc.external-signers"),
+			TxSigner:                 v.GetString("json-rpc.tx-signer"),
+			NonceGapTolerance:        v.GetUint64("json-rpc.nonce-gap-tolerance"),
+			ConfirmationDepth:        v.GetUint64("json-rpc.confirmation-depth"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -1,8 +1,11 @@
 package config
 
 import (
+	stdstrings "strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -12,3 +15,105 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestEpochArchiveURLs(t *testing.T) {
+	cfg := DefaultConfig()
+	archives, err := cfg.JSONRPC.EpochArchiveURLs()
+	require.NoError(t, err)
+	require.Empty(t, archives)
+
+	cfg.JSONRPC.EpochArchives = []string{"ethermint_9000-1=http://archive-1:8545", " ethermint_9000-2 = http://archive-2:8545 "}
+	archives, err = cfg.JSONRPC.EpochArchiveURLs()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"ethermint_9000-1": "http://archive-1:8545",
+		"ethermint_9000-2": "http://archive-2:8545",
+	}, archives)
+	require.NoError(t, cfg.JSONRPC.Validate())
+
+	for _, archive := range []string{
+		"ethermint_9000-1",
+		"=http://archive-1:8545",
+		"ethermint_9000-1=archive",
+	} {
+		cfg.JSONRPC.EpochArchives = []string{archive}
+		require.Error(t, cfg.JSONRPC.Validate(), archive)
+	}
+
+	cfg.JSONRPC.EpochArchives = []string{"ethermint_9000-1=http://a:8545", "ethermint_9000-1=http://b:8545"}
+	require.Error(t, cfg.JSONRPC.Validate())
+}
+
+func TestExternalSignerURLs(t *testing.T) {
+	cfg := DefaultConfig()
+	signers, err := cfg.JSONRPC.ExternalSignerURLs()
+	require.NoError(t, err)
+	require.Empty(t, signers)
+
+	cfg.JSONRPC.ExternalSigners = []string{" 0x7cb61d4117ae31a12e393a1cfa3bac666481d02e = http://signer:9000 "}
+	signers, err = cfg.JSONRPC.ExternalSignerURLs()
+	require.NoError(t, err)
+	require.Equal(t, map[common.Address]string{
+		common.HexToAddress("0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E"): "http://signer:9000",
+	}, signers)
+	require.NoError(t, cfg.JSONRPC.Validate())
+
+	for _, signer := range []string{
+		"0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E",
+		"signer=http://signer:9000",
+		"0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E=signer",
+		"0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E=http://a:9000,0x7cb61d4117ae31a12e393a1cfa3bac666481d02e=http://b:9000",
+	} {
+		cfg.JSONRPC.ExternalSigners = stdstrings.Split(signer, ",")
+		require.Error(t, cfg.JSONRPC.Validate(), signer)
+	}
+}
+
+func TestEstimateGasMultiplier(t *testing.T) {
+	cfg := DefaultConfig()
+	require.Equal(t, DefaultEstimateGasMultiplier, cfg.JSONRPC.EstimateGasMultiplier)
+
+	for _, multiplier := range []float64{0, 1, 1.2} {
+		cfg.JSONRPC.EstimateGasMultiplier = multiplier
+		require.NoError(t, cfg.JSONRPC.Validate(), multiplier)
+	}
+	for _, multiplier := range []float64{-1, 0.5} {
+		cfg.JSONRPC.EstimateGasMultiplier = multiplier
+		require.Error(t, cfg.JSONRPC.Validate(), multiplier)
+	}
+}
+
+func TestVerifierLimits(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.JSONRPC.EnableVerifier = true
+	require.NoError(t, cfg.JSONRPC.Validate())
+
+	cfg.JSONRPC.VerifierCompileTimeout = 0
+	require.Error(t, cfg.JSONRPC.Validate())
+	cfg.JSONRPC.VerifierCompileTimeout = DefaultVerifierCompileTimeout
+
+	cfg.JSONRPC.VerifierMaxCompilations = 0
+	require.Error(t, cfg.JSONRPC.Validate())
+
+	// the limits are unused when the verifier is disabled
+	cfg.JSONRPC.EnableVerifier = false
+	require.NoError(t, cfg.JSONRPC.Validate())
+}
+
+func TestMethodTimeoutOverrides(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.JSONRPC.MethodTimeouts = []string{"debug=1m", " eth_call = 5s "}
+	timeouts, err := cfg.JSONRPC.MethodTimeoutOverrides()
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Duration{"debug": time.Minute, "eth_call": 5 * time.Second}, timeouts)
+	require.NoError(t, cfg.JSONRPC.Validate())
+
+	for _, timeout := range []string{"debug", "=1m", "debug=", "debug=1", "debug=-1s", "debug=1m,debug=2m"} {
+		cfg.JSONRPC.MethodTimeouts = stdstrings.Split(timeout, ",")
+		require.Error(t, cfg.JSONRPC.Validate(), timeout)
+	}
+
+	cfg.JSONRPC.MethodTimeouts = nil
+	cfg.JSONRPC.CallTimeout = -time.Second
+	require.Error(t, cfg.JSONRPC.Validate())
+}
@@ -31,6 +31,44 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# SpeculativeCacheSize defines the number of eth txs whose CheckTx speculative execution results are
+# cached to be reused in DeliverTx when the state they read is unchanged. 0 disables the speculative execution.
+speculative-cache-size = {{ .EVM.SpeculativeCacheSize }}
+
+# StateDBCacheBudget defines the approximate memory budget in bytes of the accounts and storage cached by each
+# evm execution (txs, eth_call, traces), above which the state that isn't modified is evicted. 0 for unbounded.
+statedb-cache-budget = {{ .EVM.StateDBCacheBudget }}
+
+# DenomMetadataDecimals defines the decimals of the display unit of the evm denom, whose bank metadata is validated
+# at genesis, the chain failing to start when it's missing, invalid or conflicting with another denom. 0 disables it.
+denom-metadata-decimals = {{ .EVM.DenomMetadataDecimals }}
+
+# StorageHashMaxSlots defines the max number of storage slots of the accounts whose storage hash is computed by the
+# account queries and eth_getProof, which iterate the whole storage of the account. 0 for unbounded.
+storage-hash-max-slots = {{ .EVM.StorageHashMaxSlots }}
+
+# MempoolTTLBlocks defines the number of blocks after which the unconfirmed eth txs are evicted from the mempool
+# on recheck, the eviction being notified on the newPendingTransactions subscriptions. 0 disables the limit.
+mempool-ttl-blocks = {{ .EVM.MempoolTTLBlocks }}
+
+# MempoolTTLDuration defines the duration, measured with the block times, after which the unconfirmed eth txs are
+# evicted from the mempool on recheck. 0 disables the limit.
+mempool-ttl-duration = "{{ .EVM.MempoolTTLDuration }}"
+
+# Interpreter defines the name of the registered EVM implementation that executes the eth txs. The interpreter is
+# part of the state machine, all the validators must run the same one. Default: geth
+interpreter = "{{ .EVM.Interpreter }}"
+
+# AdaptiveGasPriceMaxMultiplier defines the max multiplier of the node min gas price, the min-gas-prices before the
+# London hard fork and the base fee after it. The multiplier is raised while the blocks are fuller than the target and
+# lowered back while they're emptier, by at most 1/8 per block, and applies to the txs accepted in the mempool and to
+# the eth_gasPrice suggestion. 0 disables it.
+adaptive-gas-price-max-multiplier = {{ .EVM.AdaptiveGasPriceMaxMultiplier }}
+
+# AdaptiveGasPriceTargetFullness defines the ratio of the block gas limit consumed above which the node min gas price
+# is raised.
+adaptive-gas-price-target-fullness = {{ .EVM.AdaptiveGasPriceTargetFullness }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -59,12 +97,22 @@ evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 # TxFeeCap is the global tx-fee cap for send transaction. Default: 1eth.
 txfee-cap = {{ .JSONRPC.TxFeeCap }}
 
+# EstimateGasMultiplier is the multiplier applied to the eth_estimateGas results, as a buffer for the calls
+# whose gas usage depends on the gas limit (eg: deep call stacks and the 63/64 rule). Default: 1.0.
+# The raw and adjusted estimates are both returned by ethermint_estimateGas.
+estimate-gas-multiplier = {{ .JSONRPC.EstimateGasMultiplier }}
+
 # FilterCap sets the global cap for total number of filters that can be created
 filter-cap = {{ .JSONRPC.FilterCap }}
 
 # FeeHistoryCap sets the global cap for total number of blocks that can be fetched
 feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 
+# FeeHistoryRetention defines the number of recent blocks whose fee data, backing eth_feeHistory, is
+# persisted by the custom indexer so that it survives the restarts and the block pruning (0=disabled).
+# Requires enable-indexer.
+feehistory-retention = {{ .JSONRPC.FeeHistoryRetention }}
+
 # LogsCap defines the max number of results can be returned from single 'eth_getLogs' query.
 logs-cap = {{ .JSONRPC.LogsCap }}
 
@@ -95,6 +143,151 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# MaxConcurrentCalls defines the max number of 'eth_call' and 'eth_estimateGas' requests served
+# concurrently (0=unlimited).
+max-concurrent-calls = {{ .JSONRPC.MaxConcurrentCalls }}
+
+# MaxConcurrentTraces defines the max number of 'debug_trace*' requests served concurrently (0=unlimited).
+max-concurrent-traces = {{ .JSONRPC.MaxConcurrentTraces }}
+
+# MaxConcurrentLogs defines the max number of 'eth_getLogs' requests served concurrently (0=unlimited).
+max-concurrent-logs = {{ .JSONRPC.MaxConcurrentLogs }}
+
+# MaxQueuedRequests defines the max number of requests per method class waiting for a free slot.
+# Requests exceeding it are rejected with a "limit exceeded" error.
+max-queued-requests = {{ .JSONRPC.MaxQueuedRequests }}
+
+# DefaultTimeout defines the execution timeout of the requests whose method class has no timeout (0=none).
+# The timeouts are enforced through the request contexts, their deadlines aborting the EVM executions and
+# the store iterations of the queries.
+default-timeout = "{{ .JSONRPC.DefaultTimeout }}"
+
+# CallTimeout defines the execution timeout of the 'eth_call' and 'eth_estimateGas' requests (0=default-timeout).
+call-timeout = "{{ .JSONRPC.CallTimeout }}"
+
+# TraceTimeout defines the execution timeout of the 'debug_trace*' requests (0=default-timeout).
+trace-timeout = "{{ .JSONRPC.TraceTimeout }}"
+
+# LogsTimeout defines the execution timeout of the 'eth_getLogs' requests (0=default-timeout).
+logs-timeout = "{{ .JSONRPC.LogsTimeout }}"
+
+# MethodTimeouts overrides the execution timeout of namespaces or methods, as "<namespace|method>=<duration>"
+# entries (eg: "debug=1m,eth_getLogs=10s"), the method ones taking precedence over the namespace ones.
+method-timeouts = "{{range $index, $elmt := .JSONRPC.MethodTimeouts}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# ResponseCacheSize defines the max number of responses to immutable queries (blocks, transactions
+# and receipts already committed) kept in the in-memory cache (0=disabled).
+response-cache-size = {{ .JSONRPC.ResponseCacheSize }}
+
+# ResponseCacheRedisURL defines the Redis server used to cache the responses to immutable queries
+# instead of the in-memory cache. Example: "redis://localhost:6379/0"
+response-cache-redis-url = "{{ .JSONRPC.ResponseCacheRedisURL }}"
+
+# ResponseCacheTTL defines the expiration of the responses cached in Redis (0=never).
+response-cache-ttl = "{{ .JSONRPC.ResponseCacheTTL }}"
+
+# BlockCacheSize defines the number of most recent heights whose assembled Ethereum blocks are cached
+# for the polling clients, the cache following the new commits (0=disabled).
+block-cache-size = {{ .JSONRPC.BlockCacheSize }}
+
+# TraceFileDir defines the directory where 'debug_standardTraceBlockToFile' writes the trace files.
+# Defaults to the temporary directory of the operating system if empty.
+trace-file-dir = "{{ .JSONRPC.TraceFileDir }}"
+
+# TraceFileRetentionBlocks defines the number of latest blocks whose trace files are kept in the trace
+# file directory, the files of the older blocks being pruned in the background (0=unlimited).
+trace-file-retention-blocks = {{ .JSONRPC.TraceFileRetentionBlocks }}
+
+# TraceFileMaxDiskSize defines the max number of bytes used by the trace files, the files of the oldest
+# blocks being pruned in the background when it is exceeded (0=unlimited).
+trace-file-max-disk-size = {{ .JSONRPC.TraceFileMaxDiskSize }}
+
+# TraceMaxMemorySize defines the max number of memory bytes captured per step in the struct logs,
+# capping the limit requested in the trace config (0=unlimited).
+trace-max-memory-size = {{ .JSONRPC.TraceMaxMemorySize }}
+
+# TraceMaxStackSize defines the max number of stack items captured per step in the struct logs,
+# capping the limit requested in the trace config (0=unlimited).
+trace-max-stack-size = {{ .JSONRPC.TraceMaxStackSize }}
+
+# TraceMaxStorageSize defines the max number of storage slots captured per step in the struct logs,
+# capping the limit requested in the trace config (0=unlimited).
+trace-max-storage-size = {{ .JSONRPC.TraceMaxStorageSize }}
+
+# TraceMaxReturnDataSize defines the max number of return data bytes captured per step in the struct
+# logs, capping the limit requested in the trace config (0=unlimited).
+trace-max-return-data-size = {{ .JSONRPC.TraceMaxReturnDataSize }}
+
+# TraceJobWorkers defines the number of trace jobs, queued with the 'debug_queueTrace*' methods, executed
+# concurrently, the other jobs waiting in the queue (0=default of 1).
+trace-job-workers = {{ .JSONRPC.TraceJobWorkers }}
+
+# TraceJobQueueSize defines the max number of trace jobs waiting for a worker, new jobs being rejected
+# when the queue is full. The results of as many completed jobs are kept for 'debug_traceResult'
+# (0=default of 16).
+trace-job-queue-size = {{ .JSONRPC.TraceJobQueueSize }}
+
+# EnableUnsafeJSTracers defines if user supplied JavaScript tracers can be run by the 'debug' namespace.
+# Tracers run arbitrary code on the node, only enable them for trusted users.
+enable-unsafe-js-tracers = {{ .JSONRPC.EnableUnsafeJSTracers }}
+
+# JSTracerTimeout defines the max execution time of a user supplied JavaScript tracer per transaction,
+# capping the timeout requested in the trace config (0=uncapped).
+js-tracer-timeout = "{{ .JSONRPC.JSTracerTimeout }}"
+
+# HiddenAccounts defines the keyring accounts, by key name or hex address, not returned by eth_accounts
+# and personal_listAccounts, they are still listed by personal_listKeyringAccounts.
+hidden-accounts = "{{range $index, $elmt := .JSONRPC.HiddenAccounts}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# EpochArchives defines the JSON-RPC endpoints serving the blocks of the previous chain epochs, as
+# "<chain-id>=<url>" entries (eg: "ethermint_9000-1=http://archive-1:8545"). The eth_getBlockByNumber
+# queries of the heights produced under a previous chain-id are forwarded to the matching endpoint.
+epoch-archives = "{{range $index, $elmt := .JSONRPC.EpochArchives}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# ExternalSigners defines the web3signer-compatible JSON-RPC endpoints signing for the accounts, as
+# "<address>=<url>" entries (eg: "0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E=http://web3signer:9000").
+# eth_sendTransaction, eth_sign and eth_signTypedData of these accounts delegate the signature to the
+# endpoint, their private keys don't need to be loaded in the node keyring.
+external-signers = "{{range $index, $elmt := .JSONRPC.ExternalSigners}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# TxSigner defines the name of the signer registered by the application (eg: an MPC/TSS provider) signing
+# the eth_sendTransaction transactions instead of the node keyring. The asynchronous signers leave the
+# transactions pending until ethermint_sendPendingTransaction is called once they're signed.
+tx-signer = "{{ .JSONRPC.TxSigner }}"
+
+# NonceGapTolerance defines the max number of future-nonce transactions per sender held in the node local
+# queue by eth_sendRawTransaction, and broadcasted once the nonce gap is filled through this node (0=disabled).
+# The queue isn't part of the consensus rules, the transactions are still executed in nonce order.
+nonce-gap-tolerance = {{ .JSONRPC.NonceGapTolerance }}
+
+# ConfirmationDepth defines the number of blocks on top of a block before its headers and logs are emitted
+# by the filters and the subscriptions (0=emitted at once). The pending events of the blocks rolled back,
+# eg. after a state-sync restore or a rollback, are dropped instead of being emitted.
+confirmation-depth = {{ .JSONRPC.ConfirmationDepth }}
+
+# EnableVerifier defines if the contract verification API is served by the 'verifier' namespace and the
+# '/verifier' REST routes of the JSON-RPC server. The verified sources and ABIs are stored in the node data dir.
+enable-verifier = {{ .JSONRPC.EnableVerifier }}
+
+# VerifierSolcPath defines the solc binary used to compile the sources of the verified contracts.
+verifier-solc-path = "{{ .JSONRPC.VerifierSolcPath }}"
+
+# VerifierCompileTimeout defines the max execution time of a verification compilation, the requests
+# being also bounded by the http-timeout.
+verifier-compile-timeout = "{{ .JSONRPC.VerifierCompileTimeout }}"
+
+# VerifierMaxCompilations defines the max number of verification compilations run concurrently, the
+# other requests waiting for a free slot until their http-timeout.
+verifier-max-compilations = {{ .JSONRPC.VerifierMaxCompilations }}
+
+# DecodeSignatures defines if the call data of the call tracer outputs and of txpool_inspect is annotated
+# with the human-readable signatures of the called functions, the common token methods being built in.
+decode-signatures = {{ .JSONRPC.DecodeSignatures }}
+
+# FourByteDBPath defines the 4byte database file completing the built-in function signatures, using the
+# 4byte.json format of geth: a JSON object of the signatures by hex selector.
+4byte-db-path = "{{ .JSONRPC.FourByteDBPath }}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
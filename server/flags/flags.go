@@ -42,6 +42,15 @@ const (
 	GRPCWebAddress = "grpc-web.address"
 )
 
+// Read replica flags
+const (
+	// ReplicaStreamDir enables the read replica mode, replaying the state changes streamed to the directory
+	// by the file streaming service of an upstream node.
+	ReplicaStreamDir          = "replica.stream-dir"
+	ReplicaStreamPrefix       = "replica.stream-prefix"
+	ReplicaStreamPollInterval = "replica.stream-poll-interval"
+)
+
 // Cosmos API flags
 const (
 	RPCEnable         = "api.enable"
@@ -70,12 +79,33 @@ const (
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCTraceFileDir             = "json-rpc.trace-file-dir"
+	JSONRPCTraceFileRetentionBlocks = "json-rpc.trace-file-retention-blocks"
+	JSONRPCTraceFileMaxDiskSize     = "json-rpc.trace-file-max-disk-size"
+	JSONRPCTraceJobWorkers          = "json-rpc.trace-job-workers"
+	JSONRPCTraceJobQueueSize        = "json-rpc.trace-job-queue-size"
 )
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer                = "evm.tracer"
+	EVMMaxTxGasWanted        = "evm.max-tx-gas-wanted"
+	EVMSpeculativeCacheSize  = "evm.speculative-cache-size"
+	EVMStateDBCacheBudget    = "evm.statedb-cache-budget"
+	EVMDenomMetadataDecimals = "evm.denom-metadata-decimals"
+	EVMStorageHashMaxSlots   = "evm.storage-hash-max-slots"
+	EVMMempoolTTLBlocks      = "evm.mempool-ttl-blocks"
+	EVMMempoolTTLDuration    = "evm.mempool-ttl-duration"
+	EVMInterpreter           = "evm.interpreter"
+
+	EVMAdaptiveGasPriceMaxMultiplier  = "evm.adaptive-gas-price-max-multiplier"
+	EVMAdaptiveGasPriceTargetFullness = "evm.adaptive-gas-price-target-fullness"
+)
+
+// Logging flags
+const (
+	// LogModuleLevels sets the log level of the modules, e.g. `evm:debug,p2p:error`
+	LogModuleLevels = "log_module_levels"
 )
 
 // TLS flags
@@ -17,17 +17,27 @@ package server
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/evmos/ethermint/indexer"
+	"github.com/tendermint/tendermint/libs/log"
 	tmnode "github.com/tendermint/tendermint/node"
 	sm "github.com/tendermint/tendermint/state"
 	tmstore "github.com/tendermint/tendermint/store"
 )
 
+const (
+	flagOverwrite = "overwrite"
+	flagFrom      = "from"
+	flagTo        = "to"
+)
+
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "index-eth-tx [backward|forward]",
@@ -52,46 +62,12 @@ func NewIndexTxCmd() *cobra.Command {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
-			cfg := serverCtx.Config
-			home := cfg.RootDir
-			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
-			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
-				return err
-			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
-
-			// open local tendermint db, because the local rpc won't be available.
-			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+			bi, err := newBlockIndexer(serverCtx, clientCtx)
 			if err != nil {
 				return err
 			}
-			blockStore := tmstore.NewBlockStore(tmdb)
-
-			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
-			if err != nil {
-				return err
-			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
-
-			indexBlock := func(height int64) error {
-				blk := blockStore.LoadBlock(height)
-				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
-				}
-				resBlk, err := stateStore.LoadABCIResponses(height)
-				if err != nil {
-					return err
-				}
-				if err := idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
-					return err
-				}
-				fmt.Println(height)
-				return nil
-			}
+			defer bi.Close()
+			idxer, blockStore := bi.idxer, bi.blockStore
 
 			switch args[0] {
 			case "backward":
@@ -104,7 +80,7 @@ func NewIndexTxCmd() *cobra.Command {
 					first = blockStore.Height()
 				}
 				for i := first - 1; i > 0; i-- {
-					if err := indexBlock(i); err != nil {
+					if err := bi.indexBlock(i); err != nil {
 						return err
 					}
 				}
@@ -118,7 +94,7 @@ func NewIndexTxCmd() *cobra.Command {
 					latest = 0
 				}
 				for i := latest + 1; i <= blockStore.Height(); i++ {
-					if err := indexBlock(i); err != nil {
+					if err := bi.indexBlock(i); err != nil {
 						return err
 					}
 				}
@@ -131,3 +107,212 @@ func NewIndexTxCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewIndexCmd returns the command group of the eth tx indexer.
+func NewIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index",
+		Short: "Manage the eth tx indexer db",
+	}
+	cmd.AddCommand(NewIndexBackfillCmd())
+	return cmd
+}
+
+// NewIndexBackfillCmd returns a command indexing the eth txs of a range of stored blocks.
+func NewIndexBackfillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Index the eth txs of a range of stored blocks",
+		Long: `Index the eth txs of the blocks in the [from, to] range, replaying the blocks and their results stored by the node,
+for nodes synced before the indexer was enabled. The range defaults to all the stored blocks. The blocks already indexed
+are indexed again, and the node must be stopped while backfilling.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			from, err := cmd.Flags().GetInt64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagTo)
+			if err != nil {
+				return err
+			}
+
+			bi, err := newBlockIndexer(serverCtx, clientCtx)
+			if err != nil {
+				return err
+			}
+			defer bi.Close()
+
+			base, height := bi.blockStore.Base(), bi.blockStore.Height()
+			if from == 0 {
+				from = base
+			}
+			if to == 0 {
+				to = height
+			}
+			if from < base || to > height || from > to {
+				return fmt.Errorf("invalid block range [%d, %d], the stored blocks are [%d, %d]", from, to, base, height)
+			}
+
+			for i := from; i <= to; i++ {
+				if err := bi.indexBlock(i); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagFrom, 0, "The first block to index, defaults to the earliest stored block")
+	cmd.Flags().Int64(flagTo, 0, "The last block to index, defaults to the latest stored block")
+	return cmd
+}
+
+// blockIndexer indexes the eth txs of the blocks stored by the node.
+type blockIndexer struct {
+	idxer      *indexer.KVIndexer
+	blockStore *tmstore.BlockStore
+	stateStore sm.Store
+	dbs        []io.Closer
+	logger     log.Logger
+}
+
+func newBlockIndexer(serverCtx *server.Context, clientCtx client.Context) (*blockIndexer, error) {
+	cfg := serverCtx.Config
+	home := cfg.RootDir
+	bi := &blockIndexer{logger: serverCtx.Logger}
+
+	idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		bi.logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return nil, err
+	}
+	bi.dbs = append(bi.dbs, idxDB)
+	bi.idxer = indexer.NewKVIndexer(idxDB, bi.logger.With("module", "evmindex"), clientCtx)
+
+	// open local tendermint db, because the local rpc won't be available.
+	tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		bi.Close()
+		return nil, err
+	}
+	bi.dbs = append(bi.dbs, tmdb)
+	bi.blockStore = tmstore.NewBlockStore(tmdb)
+
+	stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		bi.Close()
+		return nil, err
+	}
+	bi.dbs = append(bi.dbs, stateDB)
+	bi.stateStore = sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	return bi, nil
+}
+
+// Close closes the dbs opened by the block indexer.
+func (bi *blockIndexer) Close() {
+	for _, db := range bi.dbs {
+		if err := db.Close(); err != nil {
+			bi.logger.Error("failed to close db", "error", err.Error())
+		}
+	}
+}
+
+func (bi *blockIndexer) indexBlock(height int64) error {
+	blk := bi.blockStore.LoadBlock(height)
+	if blk == nil {
+		return fmt.Errorf("block not found %d", height)
+	}
+	resBlk, err := bi.stateStore.LoadABCIResponses(height)
+	if err != nil {
+		return err
+	}
+	if err := bi.idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
+		return err
+	}
+	bi.logger.Info("indexed block", "height", height)
+	return nil
+}
+
+// NewIndexExportCmd returns a command exporting the eth tx indexer db to a file.
+func NewIndexExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-export [file]",
+		Short: "Export the eth tx indexer db to a file",
+		Long: `Export the eth tx indexer db to a file, so it can be imported with index-import on a fresh RPC replica
+without re-indexing the full chain. The node must be stopped while exporting.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			idxDB, err := OpenIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer idxDB.Close()
+
+			f, err := os.OpenFile(filepath.Clean(args[0]), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			count, err := indexer.ExportDB(idxDB, f)
+			if err != nil {
+				return fmt.Errorf("failed to export the indexer db: %w", err)
+			}
+			if err := f.Sync(); err != nil {
+				return err
+			}
+
+			serverCtx.Logger.Info("exported indexer db", "entries", count, "file", args[0])
+			return nil
+		},
+	}
+	return cmd
+}
+
+// NewIndexImportCmd returns a command importing the eth tx indexer db exported by index-export.
+func NewIndexImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-import [file]",
+		Short: "Import the eth tx indexer db from a file",
+		Long: `Import the eth tx indexer db exported by index-export, the indexer db must be empty unless --overwrite is set.
+The node must be stopped while importing, once started it resumes indexing from the latest imported block.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			overwrite, err := cmd.Flags().GetBool(flagOverwrite)
+			if err != nil {
+				return err
+			}
+
+			idxDB, err := OpenIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer idxDB.Close()
+
+			f, err := os.Open(filepath.Clean(args[0]))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			count, err := indexer.ImportDB(idxDB, f, overwrite)
+			if err != nil {
+				return fmt.Errorf("failed to import the indexer db: %w", err)
+			}
+
+			serverCtx.Logger.Info("imported indexer db", "entries", count, "file", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().Bool(flagOverwrite, false, "Import into a non-empty indexer db, replacing the existing entries with the same keys")
+	return cmd
+}
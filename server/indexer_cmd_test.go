@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmnode "github.com/tendermint/tendermint/node"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/evmos/ethermint/indexer"
+)
+
+// newStoredBlocksContext returns the server context of a node home storing the blocks [1, height]
+// and their results.
+func newStoredBlocksContext(t *testing.T, height int64) *server.Context {
+	cfg := tmcfg.TestConfig()
+	cfg.SetRoot(t.TempDir())
+	cfg.DBBackend = "goleveldb"
+
+	blockStoreDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	require.NoError(t, err)
+	defer blockStoreDB.Close()
+	stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+	require.NoError(t, err)
+	defer stateDB.Close()
+
+	blockStore := tmstore.NewBlockStore(blockStoreDB)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	for h := int64(1); h <= height; h++ {
+		block := tmtypes.MakeBlock(h, nil, &tmtypes.Commit{}, nil)
+		block.ProposerAddress = make([]byte, 20)
+		blockStore.SaveBlock(block, block.MakePartSet(tmtypes.BlockPartSizeBytes), &tmtypes.Commit{Height: h})
+		require.NoError(t, stateStore.SaveABCIResponses(h, &tmstate.ABCIResponses{
+			DeliverTxs: []*abci.ResponseDeliverTx{},
+			EndBlock:   &abci.ResponseEndBlock{},
+			BeginBlock: &abci.ResponseBeginBlock{},
+		}))
+	}
+
+	return server.NewContext(viper.New(), cfg, log.NewNopLogger())
+}
+
+func TestIndexBackfillCmd(t *testing.T) {
+	testCases := []struct {
+		name       string
+		args       []string
+		expIndexed []int64
+		expErr     string
+	}{
+		{"all the stored blocks", []string{}, []int64{1, 2, 3}, ""},
+		{"from", []string{"--from", "2"}, []int64{2, 3}, ""},
+		{"from and to", []string{"--from", "2", "--to", "2"}, []int64{2}, ""},
+		{"invalid from", []string{"--from", "abc"}, nil, "invalid argument \"abc\" for \"--from\""},
+		{"to above the stored blocks", []string{"--to", "4"}, nil, "invalid block range [1, 4], the stored blocks are [1, 3]"},
+		{"from above to", []string{"--from", "3", "--to", "2"}, nil, "invalid block range [3, 2]"},
+		{"unexpected arg", []string{"1"}, nil, "unknown command \"1\""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serverCtx := newStoredBlocksContext(t, 3)
+			clientCtx := client.Context{}.WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+
+			ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+
+			// the backfill command is served by the index command group
+			cmd := NewIndexCmd()
+			cmd.SetArgs(append([]string{"backfill"}, tc.args...))
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// the dbs are closed by the command, so the indexer db can be opened again
+			idxDB, err := OpenIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			require.NoError(t, err)
+			defer idxDB.Close()
+			idxer := indexer.NewKVIndexer(idxDB, log.NewNopLogger(), clientCtx)
+
+			var indexed []int64
+			for height := int64(1); height <= 3; height++ {
+				stats, err := idxer.GetBlockStats(height)
+				require.NoError(t, err)
+				if stats != nil {
+					indexed = append(indexed, height)
+				}
+			}
+			require.Equal(t, tc.expIndexed, indexed)
+		})
+	}
+}
+
+func TestIndexCmdWiring(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "ethermintd"}
+	AddCommands(rootCmd, NewDefaultStartOptions(nil, t.TempDir()), nil, func(*cobra.Command) {})
+
+	cmd, _, err := rootCmd.Find([]string{"index", "backfill"})
+	require.NoError(t, err)
+	require.Equal(t, "backfill", cmd.Name())
+	for _, flag := range []string{flagFrom, flagTo} {
+		require.NotNil(t, cmd.Flags().Lookup(flag), flag)
+	}
+}
@@ -28,18 +28,28 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/rpc/backend"
+	tmstrings "github.com/tendermint/tendermint/libs/strings"
 
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/verifier"
 )
 
-// StartJSONRPC starts the JSON-RPC server
+// JSONRPCMiddleware wraps the JSON-RPC handler, eg. to authenticate or bill the requests or to
+// inject response headers.
+type JSONRPCMiddleware func(http.Handler) http.Handler
+
+// StartJSONRPC starts the JSON-RPC server, wrapping its handler in the given middlewares, the first
+// one being the outermost.
 func StartJSONRPC(ctx *server.Context,
 	clientCtx client.Context,
 	tmRPCAddr,
 	tmEndpoint string,
 	config *config.Config,
 	indexer ethermint.EVMTxIndexer,
+	evictions rpc.MempoolEvictions,
+	middlewares ...JSONRPCMiddleware,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
@@ -63,6 +73,34 @@ func StartJSONRPC(ctx *server.Context,
 
 	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
 
+	var contractVerifier *verifier.Verifier
+	if config.JSONRPC.EnableVerifier {
+		verifierDB, err := OpenVerifierDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open contract verifier DB", "error", err.Error())
+			return nil, nil, err
+		}
+
+		contractVerifier = verifier.NewVerifier(
+			ctx.Logger.With("module", "verifier"),
+			verifierDB,
+			verifier.NewSolcCompiler(
+				config.JSONRPC.VerifierSolcPath,
+				config.JSONRPC.VerifierCompileTimeout,
+				config.JSONRPC.VerifierMaxCompilations,
+			),
+			verifier.NewEVMCodeFetcher(clientCtx),
+		)
+		apis = append(apis, ethrpc.API{
+			Namespace: verifier.Namespace,
+			Version:   "1.0",
+			Service:   verifier.NewAPI(contractVerifier),
+			Public:    true,
+		})
+	}
+
+	rpc.SetCapabilities(apis)
+
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
 			ctx.Logger.Error(
@@ -74,8 +112,35 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	responseCache, err := rpc.NewResponseCacheFromConfig(config.JSONRPC)
+	if err != nil {
+		ctx.Logger.Error("failed to create JSON-RPC response cache", "error", err.Error())
+		return nil, nil, err
+	}
+
+	timeouts, err := rpc.NewRequestTimeouts(ctx.Logger, config.JSONRPC)
+	if err != nil {
+		ctx.Logger.Error("failed to create JSON-RPC request timeouts", "error", err.Error())
+		return nil, nil, err
+	}
+
+	handler := rpc.NewLoadShedder(ctx.Logger, config.JSONRPC).Handler(timeouts.Handler(rpcServer))
+	handler = rpc.NewResponseCacher(ctx.Logger, responseCache).Handler(handler)
+	// the eth_call groups are served by ethermint_callBatch
+	if tmstrings.StringInSlice(rpc.EthermintNamespace, rpcAPIArr) {
+		handler = rpc.NewCallBatcher(ctx.Logger).Handler(handler)
+	}
+	handler = rpc.NewRequestTracer(ctx.Logger).Handler(handler)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", handler).Methods("POST")
+
+	if contractVerifier != nil {
+		verifier.RegisterRoutes(r.PathPrefix("/verifier").Subrouter(), contractVerifier)
+	}
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -122,7 +187,8 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	logsBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, evictions, logsBackend)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/server/config"
+)
+
+func TestStartJSONRPCMiddlewares(t *testing.T) {
+	cfg := tmcfg.TestConfig()
+	cfg.SetRoot(t.TempDir())
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	appCfg := config.DefaultConfig()
+	appCfg.JSONRPC.Address = "127.0.0.1:0"
+	appCfg.JSONRPC.WsAddress = "127.0.0.1:0"
+
+	var calls []string
+	record := func(name string) JSONRPCMiddleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, name)
+				w.Header().Set("X-"+name, "1")
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+	auth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	// the tendermint node is not running, the JSON-RPC server is still served
+	httpSrv, _, err := StartJSONRPC(
+		serverCtx, client.Context{}.WithChainID("ethermint_9000-1"), "tcp://127.0.0.1:1", "/websocket",
+		appCfg, nil, nil, record("Outer"), record("Inner"), auth,
+	)
+	require.NoError(t, err)
+	defer httpSrv.Close()
+
+	do := func(authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion"}`))
+		req.Header.Set("Content-Type", "application/json")
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		httpSrv.Handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// the first middleware is the outermost one
+	rec := do("Bearer token")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"result"`)
+	require.Equal(t, []string{"Outer", "Inner"}, calls)
+	require.Equal(t, "1", rec.Header().Get("X-Outer"))
+	require.Equal(t, "1", rec.Header().Get("X-Inner"))
+
+	// a middleware can reject the requests before they are served
+	calls = nil
+	rec = do("")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Equal(t, []string{"Outer", "Inner"}, calls)
+}
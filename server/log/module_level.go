@@ -0,0 +1,219 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package log
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+// Level is the minimum level of the log lines written by a module.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelError
+	LevelNone
+)
+
+// defaultModule is the module matching all the modules without a level of their own.
+const defaultModule = "*"
+
+// ParseLevel parses a log level name.
+func ParseLevel(level string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "trace", "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn", "error":
+		return LevelError, nil
+	case "none", "disabled", "fatal", "panic":
+		return LevelNone, nil
+	default:
+		return 0, fmt.Errorf("unknown log level %q", level)
+	}
+}
+
+// ModuleLevels holds the minimum log level of every module, it is safe for
+// concurrent use so the levels can be updated at runtime.
+type ModuleLevels struct {
+	mtx          sync.RWMutex
+	defaultLevel Level
+	modules      map[string]Level
+}
+
+// NewModuleLevels creates the module levels from a comma separated list of
+// `module:level` pairs, with `*` matching the modules without a level of their
+// own, e.g. `*:info,evm:debug,p2p:error`. A single level is applied to all the
+// modules.
+func NewModuleLevels(levels string) (*ModuleLevels, error) {
+	ml := &ModuleLevels{}
+	if err := ml.Set(levels); err != nil {
+		return nil, err
+	}
+	return ml, nil
+}
+
+// Set replaces the module levels with the ones of the given list, see NewModuleLevels
+// for the format.
+func (ml *ModuleLevels) Set(levels string) error {
+	defaultLevel := LevelInfo
+	modules := make(map[string]Level)
+
+	for _, item := range strings.Split(levels, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		module, levelName := defaultModule, item
+		if i := strings.LastIndex(item, ":"); i >= 0 {
+			module, levelName = strings.TrimSpace(item[:i]), item[i+1:]
+		}
+		if module == "" {
+			return fmt.Errorf("empty module name in %q", item)
+		}
+
+		level, err := ParseLevel(levelName)
+		if err != nil {
+			return err
+		}
+
+		if module == defaultModule {
+			defaultLevel = level
+		} else {
+			modules[module] = level
+		}
+	}
+
+	ml.mtx.Lock()
+	defer ml.mtx.Unlock()
+	ml.defaultLevel = defaultLevel
+	ml.modules = modules
+	return nil
+}
+
+// String returns the module levels in the format accepted by Set.
+func (ml *ModuleLevels) String() string {
+	ml.mtx.RLock()
+	defer ml.mtx.RUnlock()
+
+	modules := make([]string, 0, len(ml.modules))
+	for module := range ml.modules {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	items := []string{defaultModule + ":" + ml.defaultLevel.String()}
+	for _, module := range modules {
+		items = append(items, module+":"+ml.modules[module].String())
+	}
+	return strings.Join(items, ",")
+}
+
+// Allowed returns true if the log lines of the given level are written for the module.
+func (ml *ModuleLevels) Allowed(module string, level Level) bool {
+	ml.mtx.RLock()
+	defer ml.mtx.RUnlock()
+
+	minLevel, ok := ml.modules[module]
+	if !ok {
+		minLevel = ml.defaultLevel
+	}
+	return level >= minLevel
+}
+
+// String returns the name of the level.
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelError:
+		return "error"
+	default:
+		return "none"
+	}
+}
+
+var _ tmlog.Logger = (*ModuleLevelLogger)(nil)
+
+// ModuleLevelLogger is a logger writing the log lines of a module according to
+// the minimum level configured for it. The module of a logger is set with the
+// `module` key value passed to With.
+type ModuleLevelLogger struct {
+	logger tmlog.Logger
+	module string
+	levels *ModuleLevels
+}
+
+// NewModuleLevelLogger wraps the logger to filter the log lines with the module levels.
+// The wrapped logger must write all the log lines, including the debug ones.
+func NewModuleLevelLogger(logger tmlog.Logger, levels *ModuleLevels) *ModuleLevelLogger {
+	return &ModuleLevelLogger{
+		logger: logger,
+		levels: levels,
+	}
+}
+
+// Levels returns the module levels of the logger, shared with all the loggers derived from it.
+func (l *ModuleLevelLogger) Levels() *ModuleLevels {
+	return l.levels
+}
+
+// Debug implements tmlog.Logger
+func (l *ModuleLevelLogger) Debug(msg string, keyVals ...interface{}) {
+	if l.levels.Allowed(l.module, LevelDebug) {
+		l.logger.Debug(msg, keyVals...)
+	}
+}
+
+// Info implements tmlog.Logger
+func (l *ModuleLevelLogger) Info(msg string, keyVals ...interface{}) {
+	if l.levels.Allowed(l.module, LevelInfo) {
+		l.logger.Info(msg, keyVals...)
+	}
+}
+
+// Error implements tmlog.Logger
+func (l *ModuleLevelLogger) Error(msg string, keyVals ...interface{}) {
+	if l.levels.Allowed(l.module, LevelError) {
+		l.logger.Error(msg, keyVals...)
+	}
+}
+
+// With implements tmlog.Logger, the last `module` key value sets the module of the returned logger.
+func (l *ModuleLevelLogger) With(keyVals ...interface{}) tmlog.Logger {
+	module := l.module
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		if key, ok := keyVals[i].(string); ok && key == "module" {
+			module = fmt.Sprint(keyVals[i+1])
+		}
+	}
+
+	return &ModuleLevelLogger{
+		logger: l.logger.With(keyVals...),
+		module: module,
+		levels: l.levels,
+	}
+}
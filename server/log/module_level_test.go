@@ -0,0 +1,74 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+func TestModuleLevels(t *testing.T) {
+	testCases := []struct {
+		name    string
+		levels  string
+		expPass bool
+		expStr  string
+	}{
+		{"empty", "", true, "*:info"},
+		{"single level", "debug", true, "*:debug"},
+		{"default and modules", "*:error,evm:debug,p2p:none", true, "*:error,evm:debug,p2p:none"},
+		{"module only", "evm:debug", true, "*:info,evm:debug"},
+		{"later level wins", "info,error", true, "*:error"},
+		{"zerolog aliases", "warn,evm:trace", true, "*:error,evm:debug"},
+		{"unknown level", "evm:verbose", false, ""},
+		{"empty module", ":debug", false, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			levels, err := NewModuleLevels(tc.levels)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expStr, levels.String())
+		})
+	}
+}
+
+func TestModuleLevelsAllowed(t *testing.T) {
+	levels, err := NewModuleLevels("*:info,evm:debug,p2p:error")
+	require.NoError(t, err)
+
+	require.True(t, levels.Allowed("evm", LevelDebug))
+	require.False(t, levels.Allowed("p2p", LevelInfo))
+	require.True(t, levels.Allowed("p2p", LevelError))
+	require.False(t, levels.Allowed("consensus", LevelDebug))
+	require.True(t, levels.Allowed("consensus", LevelInfo))
+}
+
+func TestModuleLevelLogger(t *testing.T) {
+	var buf bytes.Buffer
+	levels, err := NewModuleLevels("*:error,evm:debug")
+	require.NoError(t, err)
+
+	logger := NewModuleLevelLogger(tmlog.NewTMLogger(&buf), levels)
+
+	logger.Info("filtered")
+	require.Empty(t, buf.String())
+
+	// the module is tracked through With
+	evmLogger := logger.With("module", "evm")
+	evmLogger.Debug("evm debug")
+	require.Contains(t, buf.String(), "evm debug")
+	buf.Reset()
+
+	// the levels are updated at runtime for all the derived loggers
+	require.NoError(t, logger.Levels().Set("*:info,evm:none"))
+	evmLogger.Error("evm error")
+	require.Empty(t, buf.String())
+	logger.Info("default info")
+	require.Contains(t, buf.String(), "default info")
+}
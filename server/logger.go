@@ -0,0 +1,61 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package server
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+
+	srvflags "github.com/evmos/ethermint/server/flags"
+	srvlog "github.com/evmos/ethermint/server/log"
+)
+
+// InterceptModuleLogLevels replaces the logger of the server context with one
+// writing the log lines of every module according to its own level. The levels
+// are set by the log_level flag, overridden per module by the log_module_levels
+// flag, and can be updated at runtime. It must be called after the server
+// context has been set up by InterceptConfigsPreRunHandler.
+func InterceptModuleLogLevels(cmd *cobra.Command) error {
+	serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+
+	levels, err := srvlog.NewModuleLevels(
+		serverCtx.Viper.GetString(flags.FlagLogLevel) + "," + serverCtx.Viper.GetString(srvflags.LogModuleLevels),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to parse the module log levels: %w", err)
+	}
+
+	var logWriter io.Writer
+	if strings.ToLower(serverCtx.Viper.GetString(flags.FlagLogFormat)) == tmcfg.LogFormatPlain {
+		logWriter = zerolog.ConsoleWriter{Out: os.Stderr}
+	} else {
+		logWriter = os.Stderr
+	}
+
+	// the levels are enforced by the module level logger, so all the lines are written
+	logger := sdkserver.ZeroLogWrapper{Logger: zerolog.New(logWriter).Level(zerolog.DebugLevel).With().Timestamp().Logger()}
+	serverCtx.Logger = srvlog.NewModuleLevelLogger(logger, levels)
+
+	return sdkserver.SetCmdServerContext(cmd, serverCtx)
+}
@@ -0,0 +1,203 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+// Package replica implements the read replica mode, in which a node without consensus keeps its
+// state in sync with an upstream node by replaying the state changes streamed by it.
+package replica
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// errBlockNotReady is returned when the files of a block are missing or not completely written yet.
+var errBlockNotReady = errors.New("block files not ready")
+
+// storeKeysByName is implemented by the root multi store.
+type storeKeysByName interface {
+	StoreKeysByName() map[string]storetypes.StoreKey
+}
+
+// FileStreamReplayer replays the state changes written by the file streaming service of an upstream
+// node into the local multi store, committing a new version for every block. The upstream node must
+// stream all the stores (`streamers.file.keys = ["*"]`), and the local store must be at the same
+// height as the upstream one when the streaming started, e.g. restored from a snapshot.
+type FileStreamReplayer struct {
+	cms          storetypes.CommitMultiStore
+	keys         map[string]storetypes.StoreKey
+	dir          string
+	prefix       string
+	pollInterval time.Duration
+	logger       log.Logger
+
+	// height of the last replayed block, safe to read while replaying
+	lastHeight atomic.Int64
+}
+
+// NewFileStreamReplayer creates a replayer of the files written to dir by the file streaming service,
+// prefix is the optional `streamers.file.prefix` of the upstream node.
+func NewFileStreamReplayer(
+	cms storetypes.CommitMultiStore,
+	dir, prefix string,
+	pollInterval time.Duration,
+	logger log.Logger,
+) (*FileStreamReplayer, error) {
+	rs, ok := cms.(storeKeysByName)
+	if !ok {
+		return nil, fmt.Errorf("unsupported multi store type %T", cms)
+	}
+	if pollInterval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s", pollInterval)
+	}
+
+	return &FileStreamReplayer{
+		cms:          cms,
+		keys:         rs.StoreKeysByName(),
+		dir:          dir,
+		prefix:       prefix,
+		pollInterval: pollInterval,
+		logger:       logger.With("module", "replica"),
+	}, nil
+}
+
+// Run replays the streamed blocks as they are written, until the context is canceled or a block
+// fails to be replayed.
+func (r *FileStreamReplayer) Run(ctx context.Context) error {
+	for {
+		height := r.cms.LastCommitID().Version + 1
+		err := r.ReplayBlock(height)
+		switch {
+		case err == nil:
+			r.logger.Debug("replayed block", "height", height)
+			r.lastHeight.Store(height)
+			continue
+		case !errors.Is(err, errBlockNotReady):
+			return fmt.Errorf("failed to replay block %d: %w", height, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.pollInterval):
+		}
+	}
+}
+
+// LastHeight returns the height of the last block replayed by Run.
+func (r *FileStreamReplayer) LastHeight() int64 {
+	return r.lastHeight.Load()
+}
+
+// ReplayBlock applies the state changes of the block at the given height and commits them. If the
+// upstream node outputs the block metadata, the resulting app hash is checked against the upstream one.
+func (r *FileStreamReplayer) ReplayBlock(height int64) error {
+	if expected := r.cms.LastCommitID().Version + 1; height != expected {
+		return fmt.Errorf("invalid block height %d, expected %d", height, expected)
+	}
+
+	data, err := r.readFile(fmt.Sprintf("block-%d-data", height))
+	if err != nil {
+		return err
+	}
+
+	var expAppHash []byte
+	meta, err := r.readFile(fmt.Sprintf("block-%d-meta", height))
+	switch {
+	case err == nil:
+		var metadata storetypes.BlockMetadata
+		if err := metadata.Unmarshal(meta); err != nil {
+			return fmt.Errorf("invalid block metadata: %w", err)
+		}
+		if metadata.ResponseCommit != nil {
+			expAppHash = metadata.ResponseCommit.Data
+		}
+	case !errors.Is(err, errBlockNotReady):
+		return err
+	}
+
+	pairs, err := decodeKVPairs(data)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		key, ok := r.keys[pair.StoreKey]
+		if !ok {
+			return fmt.Errorf("unknown store %s", pair.StoreKey)
+		}
+		store := r.cms.GetCommitKVStore(key)
+		if pair.Delete {
+			store.Delete(pair.Key)
+		} else {
+			store.Set(pair.Key, pair.Value)
+		}
+	}
+
+	commitID := r.cms.Commit()
+	if expAppHash != nil && !bytes.Equal(commitID.Hash, expAppHash) {
+		return fmt.Errorf("app hash mismatch at height %d, expected %X, got %X", height, expAppHash, commitID.Hash)
+	}
+	return nil
+}
+
+// readFile reads a file written by the file streaming service, which is prefixed with the big endian
+// size of its content to detect the incomplete files.
+func (r *FileStreamReplayer) readFile(name string) ([]byte, error) {
+	if r.prefix != "" {
+		name = fmt.Sprintf("%s-%s", r.prefix, name)
+	}
+
+	bz, err := os.ReadFile(filepath.Join(r.dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errBlockNotReady
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) < 8 || sdk.BigEndianToUint64(bz[:8]) != uint64(len(bz)-8) {
+		return nil, errBlockNotReady
+	}
+	return bz[8:], nil
+}
+
+// decodeKVPairs decodes the length prefixed store kv pairs of a data file.
+func decodeKVPairs(bz []byte) ([]storetypes.StoreKVPair, error) {
+	var pairs []storetypes.StoreKVPair
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < size {
+			return nil, errors.New("invalid block data: malformed kv pair")
+		}
+
+		var pair storetypes.StoreKVPair
+		if err := pair.Unmarshal(bz[n : n+int(size)]); err != nil {
+			return nil, fmt.Errorf("invalid block data: %w", err)
+		}
+		pairs = append(pairs, pair)
+		bz = bz[n+int(size):]
+	}
+	return pairs, nil
+}
@@ -0,0 +1,146 @@
+package replica
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	keyA = storetypes.NewKVStoreKey("a")
+	keyB = storetypes.NewKVStoreKey("b")
+	cdc  = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+func newStore(t *testing.T) *rootmulti.Store {
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	store.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	return store
+}
+
+// writeBlock applies the pairs to the upstream store and writes the files of the file streaming service.
+func writeBlock(t *testing.T, upstream *rootmulti.Store, dir string, pairs []storetypes.StoreKVPair, withMeta bool) {
+	var data []byte
+	for i := range pairs {
+		store := upstream.GetCommitKVStore(upstream.StoreKeysByName()[pairs[i].StoreKey])
+		if pairs[i].Delete {
+			store.Delete(pairs[i].Key)
+		} else {
+			store.Set(pairs[i].Key, pairs[i].Value)
+		}
+
+		bz, err := cdc.MarshalLengthPrefixed(&pairs[i])
+		require.NoError(t, err)
+		data = append(data, bz...)
+	}
+	commitID := upstream.Commit()
+
+	if withMeta {
+		meta, err := cdc.Marshal(&storetypes.BlockMetadata{
+			ResponseCommit: &abci.ResponseCommit{Data: commitID.Hash},
+		})
+		require.NoError(t, err)
+		writeFile(t, filepath.Join(dir, fmt.Sprintf("block-%d-meta", commitID.Version)), meta)
+	}
+	writeFile(t, filepath.Join(dir, fmt.Sprintf("block-%d-data", commitID.Version)), data)
+}
+
+func writeFile(t *testing.T, path string, bz []byte) {
+	require.NoError(t, os.WriteFile(path, append(sdk.Uint64ToBigEndian(uint64(len(bz))), bz...), 0o600))
+}
+
+func TestReplayBlock(t *testing.T) {
+	dir := t.TempDir()
+	upstream, replica := newStore(t), newStore(t)
+
+	replayer, err := NewFileStreamReplayer(replica, dir, "", time.Millisecond, log.NewNopLogger())
+	require.NoError(t, err)
+
+	// the block files are not written yet
+	require.ErrorIs(t, replayer.ReplayBlock(1), errBlockNotReady)
+
+	writeBlock(t, upstream, dir, []storetypes.StoreKVPair{
+		{StoreKey: "a", Key: []byte("k1"), Value: []byte("v1")},
+		{StoreKey: "b", Key: []byte("k2"), Value: []byte("v2")},
+	}, true)
+	writeBlock(t, upstream, dir, []storetypes.StoreKVPair{
+		{StoreKey: "a", Key: []byte("k1"), Delete: true},
+		{StoreKey: "b", Key: []byte("k2"), Value: []byte("v3")},
+	}, false)
+
+	require.Error(t, replayer.ReplayBlock(2), "blocks are replayed in order")
+	require.NoError(t, replayer.ReplayBlock(1))
+	require.Equal(t, []byte("v1"), replica.GetCommitKVStore(keyA).Get([]byte("k1")))
+	require.NoError(t, replayer.ReplayBlock(2))
+	require.Nil(t, replica.GetCommitKVStore(keyA).Get([]byte("k1")))
+	require.Equal(t, []byte("v3"), replica.GetCommitKVStore(keyB).Get([]byte("k2")))
+	require.Equal(t, upstream.LastCommitID(), replica.LastCommitID())
+
+	// incomplete file
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "block-3-data"), sdk.Uint64ToBigEndian(10), 0o600))
+	require.ErrorIs(t, replayer.ReplayBlock(3), errBlockNotReady)
+}
+
+func TestReplayBlockAppHashMismatch(t *testing.T) {
+	dir := t.TempDir()
+	upstream, replica := newStore(t), newStore(t)
+	replica.GetCommitKVStore(keyA).Set([]byte("diverged"), []byte{1})
+
+	replayer, err := NewFileStreamReplayer(replica, dir, "", time.Millisecond, log.NewNopLogger())
+	require.NoError(t, err)
+
+	writeBlock(t, upstream, dir, []storetypes.StoreKVPair{
+		{StoreKey: "a", Key: []byte("k1"), Value: []byte("v1")},
+	}, true)
+	require.ErrorContains(t, replayer.ReplayBlock(1), "app hash mismatch")
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	upstream, replica := newStore(t), newStore(t)
+
+	replayer, err := NewFileStreamReplayer(replica, dir, "", time.Millisecond, log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- replayer.Run(ctx)
+	}()
+
+	for i := 0; i < 3; i++ {
+		writeBlock(t, upstream, dir, []storetypes.StoreKVPair{
+			{StoreKey: "a", Key: []byte{byte(i)}, Value: []byte{byte(i)}},
+		}, true)
+	}
+	require.Eventually(t, func() bool {
+		return replayer.LastHeight() == 3
+	}, 5*time.Second, time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+	require.Equal(t, upstream.LastCommitID(), replica.LastCommitID())
+
+	// unknown store fails the replay
+	writeFile(t, filepath.Join(dir, "block-4-data"), func() []byte {
+		bz, err := cdc.MarshalLengthPrefixed(&storetypes.StoreKVPair{StoreKey: "unknown", Key: []byte{1}})
+		require.NoError(t, err)
+		return bz
+	}())
+	require.ErrorContains(t, replayer.Run(context.Background()), "unknown store")
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/spf13/cobra"
 
 	"google.golang.org/grpc"
@@ -62,10 +63,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/rpc/backend"
 	ethdebug "github.com/evmos/ethermint/rpc/namespaces/ethereum/debug"
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	ethermintapi "github.com/evmos/ethermint/rpc/namespaces/ethereum/ethermint"
 	"github.com/evmos/ethermint/server/config"
 	srvflags "github.com/evmos/ethermint/server/flags"
+	"github.com/evmos/ethermint/server/replica"
 	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // DBOpener is a function to open `application.db`, potentially with customized options.
@@ -76,6 +83,8 @@ type StartOptions struct {
 	AppCreator      types.AppCreator
 	DefaultNodeHome string
 	DBOpener        DBOpener
+	// JSONRPCMiddlewares wrap the JSON-RPC handler, the first one being the outermost.
+	JSONRPCMiddlewares []JSONRPCMiddleware
 }
 
 // NewDefaultStartOptions use the default db opener provided in tm-db.
@@ -191,6 +200,11 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.GRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled.)")
 	cmd.Flags().String(srvflags.GRPCWebAddress, serverconfig.DefaultGRPCWebAddress, "The gRPC-Web server address to listen on")
 
+	cmd.Flags().String(srvflags.ReplicaStreamDir, "", "Start the node as a read replica, replaying the state changes streamed to the directory by the file streaming service of an upstream node") //nolint:lll
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "The tendermint RPC of the upstream node in read replica mode")
+	cmd.Flags().String(srvflags.ReplicaStreamPrefix, "", "The file prefix of the upstream file streaming service")
+	cmd.Flags().Duration(srvflags.ReplicaStreamPollInterval, time.Second, "The interval at which the read replica polls the stream directory for new blocks")
+
 	cmd.Flags().Bool(srvflags.RPCEnable, false, "Defines if Cosmos-sdk REST server should be enabled")
 	cmd.Flags().Bool(srvflags.EnabledUnsafeCors, false, "Defines if CORS should be enabled (unsafe - use it at your own risk)")
 
@@ -211,8 +225,17 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
-	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
-	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")          //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                          //nolint:lll
+	cmd.Flags().Int(srvflags.EVMSpeculativeCacheSize, config.DefaultSpeculativeCacheSize, "the number of eth txs whose CheckTx speculative execution results are reused in DeliverTx, 0 disables it") //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMStateDBCacheBudget, config.DefaultStateDBCacheBudget, "the memory budget in bytes of the state cached by each evm execution, 0 for unbounded")                     //nolint:lll
+	cmd.Flags().Uint32(srvflags.EVMDenomMetadataDecimals, config.DefaultDenomMetadataDecimals, "the decimals of the evm denom display unit validated at genesis, 0 disables the validation")          //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMStorageHashMaxSlots, config.DefaultStorageHashMaxSlots, "the max number of storage slots of the accounts whose storage hash is queried, 0 for unbounded")          //nolint:lll
+	cmd.Flags().Int64(srvflags.EVMMempoolTTLBlocks, config.DefaultMempoolTTLBlocks, "the number of blocks after which the unconfirmed eth txs are evicted from the mempool, 0 disables it")           //nolint:lll
+	cmd.Flags().Duration(srvflags.EVMMempoolTTLDuration, config.DefaultMempoolTTLDuration, "the duration after which the unconfirmed eth txs are evicted from the mempool, 0 disables it")            //nolint:lll
+	cmd.Flags().String(srvflags.EVMInterpreter, config.DefaultEVMInterpreter, "the name of the registered EVM implementation that executes the eth txs")                                              //nolint:lll
+	cmd.Flags().Float64(srvflags.EVMAdaptiveGasPriceMaxMultiplier, config.DefaultAdaptiveGasPriceMaxMultiplier, "the max multiplier of the node min gas price, 0 disables it")                        //nolint:lll
+	cmd.Flags().Float64(srvflags.EVMAdaptiveGasPriceTargetFullness, config.DefaultAdaptiveGasPriceTargetFullness, "the block fullness raising the min gas price")                                     //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -360,10 +383,23 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
 
 	var (
-		tmNode   *node.Node
-		gRPCOnly = ctx.Viper.GetBool(srvflags.GRPCOnly)
+		tmNode           *node.Node
+		gRPCOnly         = ctx.Viper.GetBool(srvflags.GRPCOnly)
+		replicaStreamDir = ctx.Viper.GetString(srvflags.ReplicaStreamDir)
 	)
 
+	if replicaStreamDir != "" {
+		logger.Info("starting node in read replica mode; Tendermint is disabled", "stream-dir", replicaStreamDir)
+		gRPCOnly = true
+
+		stopReplica, err := startReplica(ctx, app, replicaStreamDir)
+		if err != nil {
+			logger.Error("failed to start the read replica", "error", err.Error())
+			return err
+		}
+		defer stopReplica()
+	}
+
 	if gRPCOnly {
 		logger.Info("starting node in query only mode; Tendermint is disabled")
 		config.GRPC.Enable = true
@@ -401,8 +437,11 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	// Add the tx service to the gRPC router. We only need to register this
 	// service if API or gRPC or JSONRPC is enabled, and avoid doing so in the general
 	// case, because it spawns a new local tendermint RPC client.
-	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || config.JSONRPC.EnableIndexer) && tmNode != nil {
-		clientCtx = clientCtx.WithClient(local.New(tmNode))
+	// In read replica mode the services forward the txs and the tendermint queries to the upstream node.
+	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || config.JSONRPC.EnableIndexer) && (tmNode != nil || replicaStreamDir != "") {
+		if tmNode != nil {
+			clientCtx = clientCtx.WithClient(local.New(tmNode))
+		}
 
 		app.RegisterTxService(clientCtx)
 		app.RegisterTendermintService(clientCtx)
@@ -530,7 +569,23 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	)
 
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC)
+		grpcApp := app
+		if clientCtx.Client != nil {
+			// stream the ethereum logs of the blocks served by the tendermint client, and serve the
+			// queries of the indexer if enabled
+			genDoc, err := genDocProvider()
+			if err != nil {
+				return err
+			}
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx.WithChainID(genDoc.ChainID), config.JSONRPC.AllowUnprotectedTxs, idxer)
+			nodeApp := nodeServicesApp{Application: app, logStream: filters.NewLogStreamServer(ctx.Logger, evmBackend)}
+			if idxer != nil {
+				nodeApp.indexerQuery = ethermintapi.NewIndexerQueryServer(evmBackend)
+			}
+			grpcApp = nodeApp
+		}
+
+		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, grpcApp, config.GRPC)
 		if err != nil {
 			return err
 		}
@@ -565,7 +620,15 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer)
+		if replicaStreamDir != "" {
+			// subscribe to the events of the upstream node
+			tmRPCAddr = clientCtx.NodeURI
+		}
+		// notify the txs evicted from the mempool after their ttl
+		evictions, _ := app.(rpc.MempoolEvictions)
+		httpSrv, httpSrvDone, err = StartJSONRPC(
+			ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer, evictions, opts.JSONRPCMiddlewares...,
+		)
 		if err != nil {
 			return err
 		}
@@ -644,6 +707,38 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	return server.WaitForQuitSignals()
 }
 
+// startReplica starts replaying the state changes streamed by the upstream node into the app store,
+// it returns a function stopping the replay.
+func startReplica(ctx *server.Context, app types.Application, streamDir string) (func(), error) {
+	cmsApp, ok := app.(interface {
+		CommitMultiStore() sdk.CommitMultiStore
+	})
+	if !ok {
+		return nil, fmt.Errorf("app %T doesn't expose its commit multi store", app)
+	}
+
+	replayer, err := replica.NewFileStreamReplayer(
+		cmsApp.CommitMultiStore(),
+		streamDir,
+		ctx.Viper.GetString(srvflags.ReplicaStreamPrefix),
+		ctx.Viper.GetDuration(srvflags.ReplicaStreamPollInterval),
+		ctx.Logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		// stop replaying on failure, the replica keeps serving the last replayed state
+		if err := replayer.Run(runCtx); err != nil {
+			ctx.Logger.Error("read replica stopped", "error", err.Error())
+		}
+	}()
+
+	return cancel, nil
+}
+
 func openDB(_ types.AppOptions, rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
@@ -655,6 +750,12 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenVerifierDB opens the contract verifier db, using the same db backend as the main app
+func OpenVerifierDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("verifier", backendType, dataDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
@@ -674,3 +775,20 @@ func startTelemetry(cfg config.Config) (*telemetry.Metrics, error) {
 	}
 	return telemetry.New(cfg.Telemetry)
 }
+
+// nodeServicesApp registers the gRPC services served by the node, the streaming of the ethereum logs
+// and the indexer queries, along with the app gRPC services.
+type nodeServicesApp struct {
+	types.Application
+	logStream    *filters.LogStreamServer
+	indexerQuery *ethermintapi.IndexerQueryServer
+}
+
+// RegisterGRPCServer implements the Application interface.
+func (a nodeServicesApp) RegisterGRPCServer(server gogogrpc.Server) {
+	a.Application.RegisterGRPCServer(server)
+	evmtypes.RegisterLogStreamServer(server, a.logStream)
+	if a.indexerQuery != nil {
+		evmtypes.RegisterIndexerQueryServer(server, a.indexerQuery)
+	}
+}
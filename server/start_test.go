@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+
+	"github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	ethermintapi "github.com/evmos/ethermint/rpc/namespaces/ethereum/ethermint"
+	"github.com/evmos/ethermint/server/config"
+	srvflags "github.com/evmos/ethermint/server/flags"
+)
+
+func TestStartCmdJSONRPCFlags(t *testing.T) {
+	cmd := StartCmd(NewDefaultStartOptions(nil, t.TempDir()))
+	require.NoError(t, cmd.ParseFlags([]string{
+		"--" + srvflags.JSONRPCAddress, "127.0.0.1:9545",
+		"--" + srvflags.JSONRPCAPI, "eth,net",
+		"--" + srvflags.JSONRPCEnableIndexer,
+	}))
+
+	// the flags override the JSON-RPC config the server is started with
+	v := viper.New()
+	require.NoError(t, v.BindPFlags(cmd.Flags()))
+	cfg, err := config.GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:9545", cfg.JSONRPC.Address)
+	require.Equal(t, []string{"eth", "net"}, cfg.JSONRPC.API)
+	require.True(t, cfg.JSONRPC.EnableIndexer)
+
+	require.Error(t, cmd.ParseFlags([]string{"--" + srvflags.JSONRPCHTTPTimeout, "abc"}))
+}
+
+// grpcApp is an application without gRPC services.
+type grpcApp struct {
+	types.Application
+}
+
+func (grpcApp) RegisterGRPCServer(gogogrpc.Server) {}
+
+func TestNodeServicesAppRegisterGRPCServer(t *testing.T) {
+	services := func(app nodeServicesApp) []string {
+		server := grpc.NewServer()
+		app.RegisterGRPCServer(server)
+		var names []string
+		for name := range server.GetServiceInfo() {
+			names = append(names, name)
+		}
+		return names
+	}
+
+	app := nodeServicesApp{Application: grpcApp{}, logStream: filters.NewLogStreamServer(log.NewNopLogger(), nil)}
+	require.ElementsMatch(t, []string{"ethermint.evm.v1.LogStream"}, services(app))
+
+	// the indexer queries are only served with the indexer
+	app.indexerQuery = ethermintapi.NewIndexerQueryServer(nil)
+	require.ElementsMatch(t, []string{"ethermint.evm.v1.LogStream", "ethermint.evm.v1.IndexerQuery"}, services(app))
+}
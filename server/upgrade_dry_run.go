@@ -0,0 +1,298 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmnode "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+)
+
+const (
+	flagHeightRange = "height-range"
+	flagWorkDir     = "work-dir"
+	flagKeepWorkDir = "keep-work-dir"
+)
+
+// NewUpgradeDryRunCmd returns a command replaying a range of stored blocks with the current binary
+// against a copy of the data dir, to check that it computes the same app hashes as the binary which
+// committed them.
+func NewUpgradeDryRunCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-dry-run",
+		Short: "Replay recent blocks with this binary and compare the app hashes",
+		Long: `Replay the stored blocks of the --height-range with this binary and compare the resulting app hashes with
+the ones committed by the chain, catching the consensus breaking changes of a candidate binary before the validators
+switch to it. The range is formatted as <from>:<to>, the latest stored block being replayed if <to> is omitted.
+
+The data dir is copied to the --work-dir and the application state of the copy is rolled back to the block preceding
+the range, so the node data is left untouched. The node should be stopped while copying its data dir, and the
+application state at <from> - 1 must not be pruned.`,
+		Example: "ethermintd upgrade-dry-run --height-range 1000:1100",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			heightRange, err := cmd.Flags().GetString(flagHeightRange)
+			if err != nil {
+				return err
+			}
+			from, to, err := parseHeightRange(heightRange)
+			if err != nil {
+				return err
+			}
+			workDir, err := cmd.Flags().GetString(flagWorkDir)
+			if err != nil {
+				return err
+			}
+			keepWorkDir, err := cmd.Flags().GetBool(flagKeepWorkDir)
+			if err != nil {
+				return err
+			}
+
+			if workDir == "" {
+				if workDir, err = os.MkdirTemp("", "upgrade-dry-run"); err != nil {
+					return err
+				}
+			} else if entries, err := os.ReadDir(workDir); err == nil && len(entries) > 0 {
+				return fmt.Errorf("work dir %s is not empty", workDir)
+			}
+			if !keepWorkDir {
+				defer os.RemoveAll(workDir)
+			}
+
+			// copy the app and tendermint dbs, which are both in the data dir by default
+			cfg := *serverCtx.Config
+			dataDir := filepath.Join(workDir, "data")
+			if err := copyDir(filepath.Join(cfg.RootDir, "data"), dataDir); err != nil {
+				return fmt.Errorf("failed to copy the data dir: %w", err)
+			}
+			if cfg.DBDir() != filepath.Join(cfg.RootDir, "data") {
+				if err := copyDir(cfg.DBDir(), dataDir); err != nil {
+					return fmt.Errorf("failed to copy the tendermint db dir: %w", err)
+				}
+			}
+			cfg.RootDir = workDir
+			cfg.DBPath = "data"
+			serverCtx.Viper.Set(flags.FlagHome, workDir)
+			serverCtx.Logger.Info("copied the data dir", "dir", dataDir)
+
+			r, err := newBlockReplayer(serverCtx, &cfg, appCreator)
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+
+			if to, err = r.replay(from, to); err != nil {
+				return err
+			}
+
+			cmd.Printf("replayed the blocks [%d, %d], the app hashes match\n", from, to)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagHeightRange, "", "The range of blocks to replay, formatted as <from>:<to>")
+	cmd.Flags().String(flagWorkDir, "", "The empty dir the data dir is copied to, defaults to a temporary dir")
+	cmd.Flags().Bool(flagKeepWorkDir, false, "Keep the work dir after the replay, e.g. to inspect the diverging state")
+	_ = cmd.MarkFlagRequired(flagHeightRange)
+	return cmd
+}
+
+// blockReplayer executes the stored blocks on the application, the way the tendermint handshake
+// replays the blocks the application is missing.
+type blockReplayer struct {
+	app        types.Application
+	conn       proxy.AppConnConsensus
+	blockStore *tmstore.BlockStore
+	stateStore sm.Store
+	state      sm.State
+	dbs        []io.Closer
+	logger     log.Logger
+}
+
+func newBlockReplayer(serverCtx *server.Context, cfg *tmcfg.Config, appCreator types.AppCreator) (*blockReplayer, error) {
+	r := &blockReplayer{logger: serverCtx.Logger}
+
+	blockStoreDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	r.dbs = append(r.dbs, blockStoreDB)
+	r.blockStore = tmstore.NewBlockStore(blockStoreDB)
+
+	stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	r.dbs = append(r.dbs, stateDB)
+	r.stateStore = sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	if r.state, err = r.stateStore.Load(); err != nil {
+		r.Close()
+		return nil, err
+	}
+
+	appDB, err := openDB(serverCtx.Viper, cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	r.dbs = append(r.dbs, appDB)
+	r.app = appCreator(serverCtx.Logger, appDB, nil, serverCtx.Viper)
+	r.conn = proxy.NewAppConnConsensus(abcicli.NewLocalClient(nil, r.app))
+	return r, nil
+}
+
+// replay rolls the application state back to the block preceding from, then replays the blocks up
+// to the given height, 0 for the latest committed block, and checks their app hashes.
+func (r *blockReplayer) replay(from, to int64) (int64, error) {
+	if to == 0 {
+		to = r.state.LastBlockHeight
+	}
+	first := r.blockStore.Base()
+	if first <= r.state.InitialHeight {
+		// the genesis block can't be replayed without the genesis state
+		first = r.state.InitialHeight + 1
+	}
+	if from < first || to > r.state.LastBlockHeight || from > to {
+		return 0, fmt.Errorf("invalid block range [%d, %d], the blocks which can be replayed are [%d, %d]", from, to, first, r.state.LastBlockHeight)
+	}
+
+	if err := r.app.CommitMultiStore().RollbackToVersion(from - 1); err != nil {
+		return 0, fmt.Errorf("failed to roll back the app state to height %d: %w", from-1, err)
+	}
+
+	for height := from; height <= to; height++ {
+		block := r.blockStore.LoadBlock(height)
+		if block == nil {
+			return 0, fmt.Errorf("block not found %d", height)
+		}
+		appHash, err := sm.ExecCommitBlock(r.conn, block, r.logger, r.stateStore, r.state.InitialHeight)
+		if err != nil {
+			return 0, fmt.Errorf("failed to replay block %d: %w", height, err)
+		}
+
+		expAppHash, err := r.committedAppHash(height)
+		if err != nil {
+			return 0, err
+		}
+		if !bytes.Equal(appHash, expAppHash) {
+			return 0, fmt.Errorf("app hash mismatch at height %d, expected %X, got %X", height, expAppHash, appHash)
+		}
+		r.logger.Info("replayed block", "height", height, "app-hash", fmt.Sprintf("%X", appHash))
+	}
+	return to, nil
+}
+
+// committedAppHash returns the app hash committed by the chain after executing the block at the
+// given height, which is recorded in the header of the next block.
+func (r *blockReplayer) committedAppHash(height int64) ([]byte, error) {
+	if height == r.state.LastBlockHeight {
+		return r.state.AppHash, nil
+	}
+	meta := r.blockStore.LoadBlockMeta(height + 1)
+	if meta == nil {
+		return nil, fmt.Errorf("block not found %d", height+1)
+	}
+	return meta.Header.AppHash, nil
+}
+
+// Close closes the dbs opened by the replayer.
+func (r *blockReplayer) Close() {
+	for _, db := range r.dbs {
+		if err := db.Close(); err != nil {
+			r.logger.Error("failed to close db", "error", err.Error())
+		}
+	}
+}
+
+// parseHeightRange parses a <from>:<to> block range, to is 0 if omitted.
+func parseHeightRange(heightRange string) (from, to int64, err error) {
+	fromStr, toStr, ok := strings.Cut(heightRange, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid height range %q, expected <from>:<to>", heightRange)
+	}
+	if from, err = strconv.ParseInt(fromStr, 10, 64); err != nil || from <= 0 {
+		return 0, 0, fmt.Errorf("invalid height range %q, expected a positive <from> height", heightRange)
+	}
+	if toStr == "" {
+		return from, 0, nil
+	}
+	if to, err = strconv.ParseInt(toStr, 10, 64); err != nil || to < from {
+		return 0, 0, fmt.Errorf("invalid height range %q, expected a <to> height greater than <from>", heightRange)
+	}
+	return from, to, nil
+}
+
+// copyDir copies the regular files of the src dir to the dst dir, creating it if needed.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, 0o700)
+		case d.Type().IsRegular():
+			return copyFile(path, target)
+		default:
+			return nil
+		}
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(filepath.Clean(src))
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(filepath.Clean(dst), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
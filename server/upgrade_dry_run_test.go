@@ -0,0 +1,144 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmnode "github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestParseHeightRange(t *testing.T) {
+	testCases := []struct {
+		heightRange string
+		from, to    int64
+		expPass     bool
+	}{
+		{"5:10", 5, 10, true},
+		{"5:5", 5, 5, true},
+		{"5:", 5, 0, true},
+		{"0:3", 0, 0, false},
+		{"-1:3", 0, 0, false},
+		{"5:4", 0, 0, false},
+		{"5:x", 0, 0, false},
+		{"5", 0, 0, false},
+		{"abc", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.heightRange, func(t *testing.T) {
+			from, to, err := parseHeightRange(tc.heightRange)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.from, from)
+			require.Equal(t, tc.to, to)
+		})
+	}
+}
+
+func TestCopyDir(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "application.db", "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "priv_validator_state.json"), []byte("state"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "application.db", "sub", "000001.log"), []byte("log"), 0o600))
+	require.NoError(t, os.Symlink(filepath.Join(src, "priv_validator_state.json"), filepath.Join(src, "link")))
+
+	dst := filepath.Join(t.TempDir(), "data")
+	require.NoError(t, copyDir(src, dst))
+
+	bz, err := os.ReadFile(filepath.Join(dst, "priv_validator_state.json"))
+	require.NoError(t, err)
+	require.Equal(t, "state", string(bz))
+	bz, err = os.ReadFile(filepath.Join(dst, "application.db", "sub", "000001.log"))
+	require.NoError(t, err)
+	require.Equal(t, "log", string(bz))
+
+	// the non-regular files are skipped
+	_, err = os.Lstat(filepath.Join(dst, "link"))
+	require.True(t, os.IsNotExist(err))
+
+	// the copy is independent of the source
+	require.NoError(t, os.WriteFile(filepath.Join(src, "priv_validator_state.json"), []byte("updated"), 0o600))
+	bz, err = os.ReadFile(filepath.Join(dst, "priv_validator_state.json"))
+	require.NoError(t, err)
+	require.Equal(t, "state", string(bz))
+
+	require.Error(t, copyDir(filepath.Join(src, "missing"), dst))
+}
+
+// replayApp exposes the commit multi store of the replayed application.
+type replayApp struct {
+	types.Application
+	store *rootmulti.Store
+}
+
+func (app replayApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.store
+}
+
+func TestReplayStartHeight(t *testing.T) {
+	// the blocks [1, 2] are pruned from the block store
+	cfg := tmcfg.TestConfig()
+	cfg.DBBackend = "memdb"
+	blockStoreDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	require.NoError(t, err)
+	blockStore := tmstore.NewBlockStore(blockStoreDB)
+	for height := int64(1); height <= 6; height++ {
+		block := tmtypes.MakeBlock(height, nil, &tmtypes.Commit{}, nil)
+		block.ProposerAddress = make([]byte, 20)
+		blockStore.SaveBlock(block, block.MakePartSet(tmtypes.BlockPartSizeBytes), &tmtypes.Commit{Height: height})
+	}
+	_, err = blockStore.PruneBlocks(3)
+	require.NoError(t, err)
+
+	// the app state is only kept for the last versions
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	store.SetPruning(pruningtypes.NewCustomPruningOptions(2, 1))
+	key := storetypes.NewKVStoreKey("a")
+	store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	for i := 0; i < 6; i++ {
+		store.GetCommitKVStore(key).Set([]byte("k"), []byte{byte(i)})
+		store.Commit()
+	}
+
+	r := &blockReplayer{
+		app:        replayApp{store: store},
+		blockStore: blockStore,
+		state:      sm.State{InitialHeight: 1, LastBlockHeight: 6},
+		logger:     log.NewNopLogger(),
+	}
+
+	testCases := []struct {
+		name     string
+		from, to int64
+		expErr   string
+	}{
+		{"pruned block", 2, 6, "invalid block range [2, 6], the blocks which can be replayed are [3, 6]"},
+		{"genesis block", 1, 6, "invalid block range [1, 6]"},
+		{"to above the latest block", 4, 7, "invalid block range [4, 7]"},
+		{"from above the latest block", 7, 0, "invalid block range [7, 6]"},
+		{"pruned app state", 3, 6, "failed to roll back the app state to height 2"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := r.replay(tc.from, tc.to)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
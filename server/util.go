@@ -20,7 +20,9 @@ import (
 	"net/http"
 	"time"
 
+	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/ethermint/server/config"
+	srvflags "github.com/evmos/ethermint/server/flags"
 	"github.com/gorilla/mux"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/spf13/cobra"
@@ -28,6 +30,7 @@ import (
 
 	sdkserver "github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	tmcmd "github.com/tendermint/tendermint/cmd/cometbft/commands"
@@ -59,26 +62,37 @@ func AddCommands(
 	startCmd := StartCmd(opts)
 	addStartFlags(startCmd)
 
+	rootCmd.PersistentFlags().String(srvflags.LogModuleLevels, "", "The logging level of the modules overriding the log_level one (e.g. evm:debug,p2p:error)")
+
 	rootCmd.AddCommand(
 		startCmd,
 		tendermintCmd,
 		sdkserver.ExportCmd(appExport, opts.DefaultNodeHome),
 		version.NewVersionCommand(),
 		sdkserver.NewRollbackCmd(opts.AppCreator, opts.DefaultNodeHome),
+		NewUpgradeDryRunCmd(opts.AppCreator),
 
-		// custom tx indexer command
+		// custom tx indexer commands
 		NewIndexTxCmd(),
+		NewIndexExportCmd(),
+		NewIndexImportCmd(),
+		NewIndexCmd(),
 	)
 }
 
+// ConnectTmWS connects to the Tendermint WS endpoint, the event systems using the client recover
+// their subscriptions once it reconnects.
 func ConnectTmWS(tmRPCAddr, tmEndpoint string, logger tmlog.Logger) *rpcclient.WSClient {
+	var tmWsClient *rpcclient.WSClient
 	tmWsClient, err := rpcclient.NewWS(tmRPCAddr, tmEndpoint,
 		rpcclient.MaxReconnectAttempts(256),
 		rpcclient.ReadWait(120*time.Second),
 		rpcclient.WriteWait(120*time.Second),
 		rpcclient.PingPeriod(50*time.Second),
 		rpcclient.OnReconnect(func() {
-			logger.Debug("EVM RPC reconnects to Tendermint WS", "address", tmRPCAddr+tmEndpoint)
+			logger.Info("EVM RPC reconnected to Tendermint WS", "address", tmRPCAddr+tmEndpoint)
+			telemetry.IncrCounter(1, "json_rpc", "tm_ws", "reconnects")
+			rpcfilters.NotifyTmWSReconnect(tmWsClient)
 		}),
 	)
 
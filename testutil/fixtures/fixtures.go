@@ -0,0 +1,180 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+
+// Package fixtures captures the EVM state of a set of accounts into test fixtures and loads them
+// back, so that the regression scenarios involving complex contracts (eg: mainnet contracts) are
+// captured once and replayed in the unit tests.
+//
+// The fixtures use the genesis alloc json format of go-ethereum, the accounts state captured by the
+// ethereum tooling can be used as well. The files ending with ".gz" are gzip compressed.
+package fixtures
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// Fixture is the EVM state of a set of accounts: balance in wei, nonce, code and non-empty storage
+// slots.
+type Fixture struct {
+	// Description describes the captured scenario
+	Description string `json:"description,omitempty"`
+	// Alloc is the state of the accounts by address
+	Alloc core.GenesisAlloc `json:"alloc"`
+}
+
+// Capture captures the committed state of the given accounts, the missing accounts are skipped. The
+// dirty state of a StateDB must be committed to be captured.
+func Capture(ctx sdk.Context, k statedb.Keeper, addrs ...common.Address) *Fixture {
+	fixture := &Fixture{Alloc: make(core.GenesisAlloc, len(addrs))}
+	for _, addr := range addrs {
+		acct := k.GetAccount(ctx, addr)
+		if acct == nil {
+			continue
+		}
+
+		account := core.GenesisAccount{
+			Balance: new(big.Int).Set(acct.Balance),
+			Nonce:   acct.Nonce,
+		}
+		if acct.IsContract() {
+			account.Code = k.GetCode(ctx, common.BytesToHash(acct.CodeHash))
+		}
+		k.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+			if value != (common.Hash{}) {
+				if account.Storage == nil {
+					account.Storage = make(map[common.Hash]common.Hash)
+				}
+				account.Storage[key] = value
+			}
+			return true
+		})
+		fixture.Alloc[addr] = account
+	}
+	return fixture
+}
+
+// Apply writes the state of the fixture accounts to the StateDB, their previous storage is kept.
+func (f *Fixture) Apply(db *statedb.StateDB) {
+	for addr, account := range f.Alloc {
+		balance := account.Balance
+		if balance == nil {
+			balance = new(big.Int)
+		}
+		switch delta := new(big.Int).Sub(balance, db.GetBalance(addr)); delta.Sign() {
+		case 1:
+			db.AddBalance(addr, delta)
+		case -1:
+			db.SubBalance(addr, delta.Neg(delta))
+		}
+
+		db.SetNonce(addr, account.Nonce)
+		if len(account.Code) > 0 {
+			db.SetCode(addr, account.Code)
+		}
+		for key, value := range account.Storage {
+			db.SetState(addr, key, value)
+		}
+	}
+}
+
+// Load writes the state of the fixture accounts to the keeper.
+func (f *Fixture) Load(ctx sdk.Context, k statedb.Keeper) error {
+	db := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	f.Apply(db)
+	return db.Commit()
+}
+
+// Validate checks that the balances are set and not negative, and that the storage is only set
+// on contracts.
+func (f *Fixture) Validate() error {
+	for addr, account := range f.Alloc {
+		if account.Balance == nil || account.Balance.Sign() < 0 {
+			return fmt.Errorf("invalid balance for account %s", addr)
+		}
+		if len(account.Storage) > 0 && len(account.Code) == 0 {
+			return fmt.Errorf("storage set on the account %s without code", addr)
+		}
+	}
+	return nil
+}
+
+// Save writes the fixture to the given file, compressed if its name ends with ".gz".
+func (f *Fixture) Save(path string) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	var w io.Writer = file
+	if strings.HasSuffix(path, ".gz") {
+		gz := gzip.NewWriter(file)
+		defer func() {
+			if closeErr := gz.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		w = gz
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(f)
+}
+
+// ReadFile reads a fixture saved to the given file.
+func ReadFile(path string) (*Fixture, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	var fixture Fixture
+	if err := json.NewDecoder(r).Decode(&fixture); err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+	}
+	if err := fixture.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+	}
+	return &fixture, nil
+}
@@ -0,0 +1,158 @@
+package fixtures_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/testutil/fixtures"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// memKeeper is an in-memory statedb.Keeper
+type memKeeper struct {
+	accounts map[common.Address]statedb.Account
+	storage  map[common.Address]map[common.Hash]common.Hash
+	codes    map[common.Hash][]byte
+}
+
+var _ statedb.Keeper = &memKeeper{}
+
+func newMemKeeper() *memKeeper {
+	return &memKeeper{
+		accounts: make(map[common.Address]statedb.Account),
+		storage:  make(map[common.Address]map[common.Hash]common.Hash),
+		codes:    make(map[common.Hash][]byte),
+	}
+}
+
+func (k *memKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	acct, ok := k.accounts[addr]
+	if !ok {
+		return nil
+	}
+	return &acct
+}
+
+func (k *memKeeper) GetState(_ sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	return k.storage[addr][key]
+}
+
+func (k *memKeeper) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
+	return k.codes[codeHash]
+}
+
+func (k *memKeeper) ForEachStorage(_ sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	for key, value := range k.storage[addr] {
+		if !cb(key, value) {
+			return
+		}
+	}
+}
+
+func (k *memKeeper) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
+	k.accounts[addr] = account
+	return nil
+}
+
+func (k *memKeeper) SetState(_ sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	if k.storage[addr] == nil {
+		k.storage[addr] = make(map[common.Hash]common.Hash)
+	}
+	k.storage[addr][key] = common.BytesToHash(value)
+}
+
+func (k *memKeeper) SetCode(_ sdk.Context, codeHash []byte, code []byte) {
+	k.codes[common.BytesToHash(codeHash)] = code
+}
+
+func (k *memKeeper) DeleteAccount(_ sdk.Context, addr common.Address) error {
+	delete(k.accounts, addr)
+	delete(k.storage, addr)
+	return nil
+}
+
+func testFixture() *fixtures.Fixture {
+	return &fixtures.Fixture{
+		Description: "a contract and its owner",
+		Alloc: core.GenesisAlloc{
+			common.HexToAddress("0x01"): {
+				Balance: big.NewInt(1000),
+				Nonce:   3,
+			},
+			common.HexToAddress("0x02"): {
+				Balance: big.NewInt(0),
+				Nonce:   1,
+				Code:    []byte{0x60, 0x00},
+				Storage: map[common.Hash]common.Hash{
+					common.HexToHash("0x01"): common.HexToHash("0x0a"),
+					common.HexToHash("0x02"): common.HexToHash("0x0b"),
+				},
+			},
+		},
+	}
+}
+
+func TestLoadAndCapture(t *testing.T) {
+	ctx := sdk.Context{}
+	k := newMemKeeper()
+	fixture := testFixture()
+	require.NoError(t, fixture.Load(ctx, k))
+
+	contract := k.GetAccount(ctx, common.HexToAddress("0x02"))
+	require.NotNil(t, contract)
+	require.Equal(t, crypto.Keccak256([]byte{0x60, 0x00}), contract.CodeHash)
+	require.Equal(t, common.HexToHash("0x0b"), k.GetState(ctx, common.HexToAddress("0x02"), common.HexToHash("0x02")))
+
+	// the empty slots and the missing accounts are skipped
+	k.SetState(ctx, common.HexToAddress("0x02"), common.HexToHash("0x03"), common.Hash{}.Bytes())
+	captured := fixtures.Capture(ctx, k, common.HexToAddress("0x01"), common.HexToAddress("0x02"), common.HexToAddress("0x03"))
+	require.Equal(t, fixture.Alloc, captured.Alloc)
+
+	// loading again overrides the balances
+	k.accounts[common.HexToAddress("0x01")] = statedb.Account{Balance: big.NewInt(5000), CodeHash: statedb.NewEmptyAccount().CodeHash}
+	require.NoError(t, fixture.Load(ctx, k))
+	require.Equal(t, big.NewInt(1000), k.GetAccount(ctx, common.HexToAddress("0x01")).Balance)
+}
+
+func TestSaveAndReadFile(t *testing.T) {
+	fixture := testFixture()
+	for _, name := range []string{"fixture.json", "fixture.json.gz"} {
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, fixture.Save(path), name)
+
+		read, err := fixtures.ReadFile(path)
+		require.NoError(t, err, name)
+		// the zero balance is decoded with a different internal representation
+		expBz, err := json.Marshal(fixture)
+		require.NoError(t, err)
+		bz, err := json.Marshal(read)
+		require.NoError(t, err)
+		require.JSONEq(t, string(expBz), string(bz), name)
+	}
+
+	_, err := fixtures.ReadFile(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, testFixture().Validate())
+
+	fixture := testFixture()
+	fixture.Alloc[common.HexToAddress("0x01")] = core.GenesisAccount{Balance: big.NewInt(-1)}
+	require.Error(t, fixture.Validate())
+
+	fixture = testFixture()
+	fixture.Alloc[common.HexToAddress("0x01")] = core.GenesisAccount{
+		Balance: big.NewInt(1),
+		Storage: map[common.Hash]common.Hash{{}: common.HexToHash("0x01")},
+	}
+	require.Error(t, fixture.Validate())
+}
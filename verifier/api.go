@@ -0,0 +1,56 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package verifier
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Namespace is the JSON-RPC namespace of the verifier API.
+const Namespace = "verifier"
+
+// PublicAPI is the verifier JSON-RPC API.
+type PublicAPI struct {
+	verifier *Verifier
+}
+
+// NewAPI creates an instance of the verifier API.
+func NewAPI(verifier *Verifier) *PublicAPI {
+	return &PublicAPI{verifier}
+}
+
+// VerifyContract compiles the sources of the contract and stores them with its ABI if the
+// bytecode matches the code at the address.
+func (api *PublicAPI) VerifyContract(ctx context.Context, args VerifyArgs) (*VerifiedContract, error) {
+	return api.verifier.Verify(ctx, args)
+}
+
+// GetContract returns the sources and the ABI of the verified contract at the address, nil if not verified.
+func (api *PublicAPI) GetContract(address common.Address) (*VerifiedContract, error) {
+	return api.verifier.GetContract(address)
+}
+
+// GetABI returns the ABI of the verified contract at the address, nil if not verified.
+func (api *PublicAPI) GetABI(address common.Address) (json.RawMessage, error) {
+	contract, err := api.verifier.GetContract(address)
+	if err != nil || contract == nil {
+		return nil, err
+	}
+	return contract.ABI, nil
+}
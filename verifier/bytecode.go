@@ -0,0 +1,76 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package verifier
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// CompareBytecode compares the on-chain code with the compiled deployed bytecode, the immutable
+// variables, set by the constructor, being ignored. It returns an empty MatchType if they differ.
+func CompareBytecode(onchain, compiled []byte, immutables map[string][]ImmutableReference) MatchType {
+	if len(onchain) != len(compiled) {
+		return ""
+	}
+
+	onchain = maskImmutables(onchain, immutables)
+	compiled = maskImmutables(compiled, immutables)
+	if bytes.Equal(onchain, compiled) {
+		return MatchFull
+	}
+
+	onchain, ok := StripMetadata(onchain)
+	if !ok {
+		return ""
+	}
+	compiled, ok = StripMetadata(compiled)
+	if !ok {
+		return ""
+	}
+	if bytes.Equal(onchain, compiled) {
+		return MatchPartial
+	}
+	return ""
+}
+
+// StripMetadata removes the CBOR encoded metadata appended by solc to the bytecode, its length
+// being encoded in the last 2 bytes.
+func StripMetadata(code []byte) ([]byte, bool) {
+	if len(code) < 2 {
+		return nil, false
+	}
+	metadataLen := int(binary.BigEndian.Uint16(code[len(code)-2:])) + 2
+	if metadataLen > len(code) {
+		return nil, false
+	}
+	return code[:len(code)-metadataLen], true
+}
+
+// maskImmutables returns a copy of the code with the immutable variables zeroed.
+func maskImmutables(code []byte, immutables map[string][]ImmutableReference) []byte {
+	masked := make([]byte, len(code))
+	copy(masked, code)
+	for _, refs := range immutables {
+		for _, ref := range refs {
+			if ref.Start < 0 || ref.Length < 0 || ref.Start+ref.Length > len(masked) {
+				continue
+			}
+			copy(masked[ref.Start:ref.Start+ref.Length], make([]byte, ref.Length))
+		}
+	}
+	return masked
+}
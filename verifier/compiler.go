@@ -0,0 +1,144 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// Compiler compiles the solc standard JSON inputs.
+type Compiler interface {
+	Compile(ctx context.Context, input *CompilerInput) (*CompilerOutput, error)
+}
+
+// CompilerInput defines the solc standard JSON input.
+type CompilerInput struct {
+	Language string                 `json:"language"`
+	Sources  map[string]SourceInput `json:"sources"`
+	Settings map[string]interface{} `json:"settings"`
+}
+
+// SourceInput defines the content of a source of the solc standard JSON input.
+type SourceInput struct {
+	Content string `json:"content"`
+}
+
+// CompilerOutput defines the fields of the solc standard JSON output used by the verifier.
+type CompilerOutput struct {
+	Errors    []CompilerError                        `json:"errors,omitempty"`
+	Contracts map[string]map[string]CompiledContract `json:"contracts"`
+}
+
+// CompilerError defines an error or a warning of the solc standard JSON output.
+type CompilerError struct {
+	Severity         string `json:"severity"`
+	FormattedMessage string `json:"formattedMessage"`
+}
+
+// CompiledContract defines the output of a compiled contract.
+type CompiledContract struct {
+	ABI      json.RawMessage `json:"abi"`
+	Metadata string          `json:"metadata"`
+	EVM      struct {
+		DeployedBytecode struct {
+			Object              string                          `json:"object"`
+			ImmutableReferences map[string][]ImmutableReference `json:"immutableReferences"`
+		} `json:"deployedBytecode"`
+	} `json:"evm"`
+}
+
+// ImmutableReference defines the location of an immutable variable in the deployed bytecode.
+type ImmutableReference struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+var _ Compiler = &SolcCompiler{}
+
+// SolcCompiler runs the solc binary installed on the node.
+type SolcCompiler struct {
+	path    string
+	timeout time.Duration
+	slots   chan struct{}
+}
+
+// NewSolcCompiler creates the SolcCompiler running the solc binary at the path, at most
+// maxCompilations processes at a time, each one being killed after the timeout.
+func NewSolcCompiler(path string, timeout time.Duration, maxCompilations int) *SolcCompiler {
+	return &SolcCompiler{
+		path:    path,
+		timeout: timeout,
+		slots:   make(chan struct{}, maxCompilations),
+	}
+}
+
+// Compile runs solc on the standard JSON input:
+// - it waits for a free compilation slot, or returns the context error once cancelled
+// - the process runs in an empty temporary directory, without environment variables
+// - the temporary directory is the base path of the imports, so the imports are resolved from
+// the sources of the input. This only configures the import resolution of solc, it isn't a
+// sandbox: the process runs with the permissions of the node user
+// - the process is killed after the timeout
+func (c *SolcCompiler) Compile(ctx context.Context, input *CompilerInput) (*CompilerOutput, error) {
+	select {
+	case c.slots <- struct{}{}:
+		defer func() { <-c.slots }()
+	case <-ctx.Done():
+		return nil, fmt.Errorf("no compilation slot available: %w", ctx.Err())
+	}
+
+	inputBz, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "ethermint-verifier-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.path, "--standard-json", "--base-path", dir)
+	cmd.Dir = dir
+	cmd.Env = []string{}
+	cmd.Stdin = bytes.NewReader(inputBz)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("compilation timed out after %s", c.timeout)
+		}
+		return nil, fmt.Errorf("failed to run solc: %w: %s", err, stderr.String())
+	}
+
+	var output CompilerOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, fmt.Errorf("invalid solc output: %w", err)
+	}
+	return &output, nil
+}
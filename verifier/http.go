@@ -0,0 +1,107 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package verifier
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
+)
+
+// maxRequestSize is the max size of the verification requests bodies, the larger requests being
+// rejected with 413 before the sources are decoded.
+const maxRequestSize = 10 * 1024 * 1024
+
+// RegisterRoutes registers the verifier REST routes:
+// - POST /contracts verifies a contract
+// - GET /contracts/{address} returns the sources and the ABI of a verified contract
+// - GET /contracts/{address}/abi returns the ABI of a verified contract
+func RegisterRoutes(r *mux.Router, verifier *Verifier) {
+	r.HandleFunc("/contracts", verifyHandler(verifier)).Methods(http.MethodPost)
+	r.HandleFunc("/contracts/{address}", contractHandler(verifier, false)).Methods(http.MethodGet)
+	r.HandleFunc("/contracts/{address}/abi", contractHandler(verifier, true)).Methods(http.MethodGet)
+}
+
+func verifyHandler(verifier *Verifier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > maxRequestSize {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("request body exceeds %d bytes", maxRequestSize))
+			return
+		}
+
+		var args VerifyArgs
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&args); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("request body exceeds %d bytes", maxRequestSize))
+				return
+			}
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		contract, err := verifier.Verify(r.Context(), args)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, contract)
+	}
+}
+
+func contractHandler(verifier *Verifier, abiOnly bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address := mux.Vars(r)["address"]
+		if !common.IsHexAddress(address) {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid address %s", address))
+			return
+		}
+
+		contract, err := verifier.GetContract(common.HexToAddress(address))
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		if contract == nil {
+			writeError(w, http.StatusNotFound, fmt.Errorf("contract %s is not verified", address))
+			return
+		}
+
+		if abiOnly {
+			writeJSON(w, http.StatusOK, contract.ABI)
+			return
+		}
+		writeJSON(w, http.StatusOK, contract)
+	}
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
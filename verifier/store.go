@@ -0,0 +1,66 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package verifier
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const KeyPrefixContract = 1
+
+// Store persists the verified contracts in the node-local db.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore creates the Store
+func NewStore(db dbm.DB) *Store {
+	return &Store{db}
+}
+
+// GetContract returns the verified contract at the address, nil if not verified.
+func (s *Store) GetContract(address common.Address) (*VerifiedContract, error) {
+	bz, err := s.db.Get(ContractKey(address))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var contract VerifiedContract
+	if err := json.Unmarshal(bz, &contract); err != nil {
+		return nil, err
+	}
+	return &contract, nil
+}
+
+// SetContract stores the verified contract, replacing any previous verification of the address.
+func (s *Store) SetContract(contract *VerifiedContract) error {
+	bz, err := json.Marshal(contract)
+	if err != nil {
+		return err
+	}
+	return s.db.SetSync(ContractKey(contract.Address), bz)
+}
+
+// ContractKey returns the key of the verified contract at the address.
+func ContractKey(address common.Address) []byte {
+	return append([]byte{KeyPrefixContract}, address.Bytes()...)
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package verifier
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MatchType defines how closely the compiled bytecode matches the on-chain code.
+type MatchType string
+
+const (
+	// MatchFull means the on-chain code is identical to the compiled one, metadata hash included.
+	MatchFull MatchType = "full"
+	// MatchPartial means the on-chain code is identical to the compiled one, except for the
+	// metadata hash appended by the compiler, e.g. when the comments of the sources differ.
+	MatchPartial MatchType = "partial"
+)
+
+// VerifyArgs defines the sources and compiler settings of a contract to verify.
+type VerifyArgs struct {
+	// Address is the address of the deployed contract.
+	Address common.Address `json:"address"`
+	// ContractName is the name of the contract to verify, optionally prefixed by its source
+	// name, e.g. "contracts/Token.sol:Token".
+	ContractName string `json:"contractName"`
+	// Sources are the source contents by source name, imports being resolved among them.
+	Sources map[string]string `json:"sources"`
+	// CompilerVersion is the expected compiler version, e.g. "0.8.19+commit.7dd6d404".
+	// The version of the compiler used by the node is checked against it if set.
+	CompilerVersion string `json:"compilerVersion,omitempty"`
+	// Settings are the settings of the solc standard JSON input (optimizer, evmVersion,
+	// libraries, remappings...), the output selection being set by the verifier.
+	Settings map[string]interface{} `json:"settings,omitempty"`
+}
+
+// VerifiedContract defines the sources and the ABI of a verified contract.
+type VerifiedContract struct {
+	Address         common.Address         `json:"address"`
+	ContractName    string                 `json:"contractName"`
+	SourceName      string                 `json:"sourceName"`
+	CompilerVersion string                 `json:"compilerVersion"`
+	Settings        map[string]interface{} `json:"settings,omitempty"`
+	Sources         map[string]string      `json:"sources"`
+	ABI             json.RawMessage        `json:"abi"`
+	Metadata        string                 `json:"metadata"`
+	Match           MatchType              `json:"match"`
+}
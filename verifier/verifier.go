@@ -0,0 +1,231 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package verifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// CodeFetcher returns the on-chain code of the contract at the address.
+type CodeFetcher func(ctx context.Context, address common.Address) ([]byte, error)
+
+// NewEVMCodeFetcher returns a CodeFetcher querying the latest code from the evm module.
+func NewEVMCodeFetcher(clientCtx client.Context) CodeFetcher {
+	queryClient := evmtypes.NewQueryClient(clientCtx)
+	return func(ctx context.Context, address common.Address) ([]byte, error) {
+		res, err := queryClient.Code(ctx, &evmtypes.QueryCodeRequest{Address: address.Hex()})
+		if err != nil {
+			return nil, err
+		}
+		return res.Code, nil
+	}
+}
+
+// Verifier recompiles the sources of the contracts and compares the bytecode with the on-chain
+// code, storing the sources and the ABI of the verified contracts.
+type Verifier struct {
+	logger      log.Logger
+	store       *Store
+	compiler    Compiler
+	fetchCode   CodeFetcher
+	compileLock sync.Mutex
+}
+
+// NewVerifier creates the Verifier
+func NewVerifier(logger log.Logger, db dbm.DB, compiler Compiler, fetchCode CodeFetcher) *Verifier {
+	return &Verifier{
+		logger:    logger,
+		store:     NewStore(db),
+		compiler:  compiler,
+		fetchCode: fetchCode,
+	}
+}
+
+// GetContract returns the verified contract at the address, nil if not verified.
+func (v *Verifier) GetContract(address common.Address) (*VerifiedContract, error) {
+	return v.store.GetContract(address)
+}
+
+// Verify compiles the sources and stores the contract if its deployed bytecode matches the
+// on-chain code at the address. The compilations are run one at a time to bound the resources
+// used by the node.
+func (v *Verifier) Verify(ctx context.Context, args VerifyArgs) (*VerifiedContract, error) {
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+
+	code, err := v.fetchCode(ctx, args.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the code of %s: %w", args.Address, err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("no contract code at %s", args.Address)
+	}
+
+	input := &CompilerInput{
+		Language: "Solidity",
+		Sources:  make(map[string]SourceInput, len(args.Sources)),
+		Settings: make(map[string]interface{}, len(args.Settings)+1),
+	}
+	for name, content := range args.Sources {
+		input.Sources[name] = SourceInput{Content: content}
+	}
+	for key, value := range args.Settings {
+		input.Settings[key] = value
+	}
+	input.Settings["outputSelection"] = map[string]interface{}{
+		"*": map[string]interface{}{
+			"*": []string{"abi", "metadata", "evm.deployedBytecode.object", "evm.deployedBytecode.immutableReferences"},
+		},
+	}
+
+	v.compileLock.Lock()
+	output, err := v.compiler.Compile(ctx, input)
+	v.compileLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if err := output.Err(); err != nil {
+		return nil, err
+	}
+
+	sourceName, contractName, compiled, err := output.FindContract(args.ContractName)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(compiled.EVM.DeployedBytecode.Object, "__$") {
+		return nil, errors.New("the bytecode has unlinked libraries, set their addresses in the libraries settings")
+	}
+	bytecode, err := hexutil.Decode("0x" + strings.TrimPrefix(compiled.EVM.DeployedBytecode.Object, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid compiled bytecode: %w", err)
+	}
+
+	compilerVersion, err := metadataCompilerVersion(compiled.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	if args.CompilerVersion != "" && !strings.HasPrefix(compilerVersion, strings.TrimPrefix(args.CompilerVersion, "v")) {
+		return nil, fmt.Errorf("compiler version mismatch, expected %s, got %s", args.CompilerVersion, compilerVersion)
+	}
+
+	match := CompareBytecode(code, bytecode, compiled.EVM.DeployedBytecode.ImmutableReferences)
+	if match == "" {
+		return nil, fmt.Errorf("the compiled bytecode of %s doesn't match the code at %s", contractName, args.Address)
+	}
+
+	contract := &VerifiedContract{
+		Address:         args.Address,
+		ContractName:    contractName,
+		SourceName:      sourceName,
+		CompilerVersion: compilerVersion,
+		Settings:        args.Settings,
+		Sources:         args.Sources,
+		ABI:             compiled.ABI,
+		Metadata:        compiled.Metadata,
+		Match:           match,
+	}
+	if err := v.store.SetContract(contract); err != nil {
+		return nil, err
+	}
+
+	v.logger.Info("verified contract", "address", args.Address.Hex(), "name", contractName, "match", match)
+	return contract, nil
+}
+
+// Validate returns an error if the verification arguments are invalid.
+func (args VerifyArgs) Validate() error {
+	if args.Address == (common.Address{}) {
+		return errors.New("contract address is required")
+	}
+	if args.ContractName == "" {
+		return errors.New("contract name is required")
+	}
+	if len(args.Sources) == 0 {
+		return errors.New("contract sources are required")
+	}
+	return nil
+}
+
+// Err returns the compilation errors, ignoring the warnings.
+func (output *CompilerOutput) Err() error {
+	var msgs []string
+	for _, compilerErr := range output.Errors {
+		if compilerErr.Severity == "error" {
+			msgs = append(msgs, compilerErr.FormattedMessage)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("compilation failed: %s", strings.Join(msgs, "\n"))
+}
+
+// FindContract returns the compiled contract of the name, optionally prefixed by its source name.
+func (output *CompilerOutput) FindContract(name string) (string, string, *CompiledContract, error) {
+	sourceName := ""
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		sourceName, name = name[:i], name[i+1:]
+	}
+
+	var sourceNames []string
+	for source, contracts := range output.Contracts {
+		if _, ok := contracts[name]; ok && (sourceName == "" || source == sourceName) {
+			sourceNames = append(sourceNames, source)
+		}
+	}
+
+	switch len(sourceNames) {
+	case 0:
+		return "", "", nil, fmt.Errorf("contract %s not found in the compiled sources", name)
+	case 1:
+		contract := output.Contracts[sourceNames[0]][name]
+		return sourceNames[0], name, &contract, nil
+	default:
+		sort.Strings(sourceNames)
+		return "", "", nil, fmt.Errorf(
+			"contract %s is defined in several sources (%s), prefix it with its source name",
+			name, strings.Join(sourceNames, ", "),
+		)
+	}
+}
+
+// metadataCompilerVersion returns the compiler version from the contract metadata.
+func metadataCompilerVersion(metadata string) (string, error) {
+	var parsed struct {
+		Compiler struct {
+			Version string `json:"version"`
+		} `json:"compiler"`
+	}
+	if err := json.Unmarshal([]byte(metadata), &parsed); err != nil {
+		return "", fmt.Errorf("invalid contract metadata: %w", err)
+	}
+	return parsed.Compiler.Version, nil
+}
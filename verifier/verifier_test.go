@@ -0,0 +1,317 @@
+package verifier_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/evmos/ethermint/verifier"
+)
+
+const (
+	contractAddress = "0x1000000000000000000000000000000000000001"
+	metadata        = `{"compiler":{"version":"0.8.19+commit.7dd6d404"},"language":"Solidity"}`
+	abi             = `[{"inputs":[],"name":"value","outputs":[{"type":"uint256"}],"type":"function"}]`
+)
+
+// bytecode builds a deployed bytecode with an immutable variable and the metadata trailer
+func bytecode(immutable, metadataHash byte) []byte {
+	code := []byte{0x60, 0x80, 0x60, 0x40, 0x52, 0x7f}
+	code = append(code, common.LeftPadBytes([]byte{immutable}, 32)...)
+	code = append(code, 0xa1, 0x64, metadataHash, 0x00, 0x03)
+	return code
+}
+
+// mockCompiler returns the compiled bytecode of the Counter contract
+type mockCompiler struct {
+	bytecode []byte
+	object   string
+	errors   []verifier.CompilerError
+	input    *verifier.CompilerInput
+}
+
+func (c *mockCompiler) Compile(_ context.Context, input *verifier.CompilerInput) (*verifier.CompilerOutput, error) {
+	c.input = input
+
+	var contract verifier.CompiledContract
+	contract.ABI = json.RawMessage(abi)
+	contract.Metadata = metadata
+	contract.EVM.DeployedBytecode.Object = hexutil.Encode(c.bytecode)[2:]
+	if c.object != "" {
+		contract.EVM.DeployedBytecode.Object = c.object
+	}
+	contract.EVM.DeployedBytecode.ImmutableReferences = map[string][]verifier.ImmutableReference{
+		"3": {{Start: 6, Length: 32}},
+	}
+	return &verifier.CompilerOutput{
+		Errors: c.errors,
+		Contracts: map[string]map[string]verifier.CompiledContract{
+			"contracts/Counter.sol": {"Counter": contract},
+			"contracts/Lib.sol":     {"Lib": {}},
+		},
+	}, nil
+}
+
+func newVerifier(compiler verifier.Compiler, code []byte) *verifier.Verifier {
+	return verifier.NewVerifier(tmlog.NewNopLogger(), dbm.NewMemDB(), compiler, func(_ context.Context, address common.Address) ([]byte, error) {
+		if address != common.HexToAddress(contractAddress) {
+			return nil, nil
+		}
+		return code, nil
+	})
+}
+
+func verifyArgs() verifier.VerifyArgs {
+	return verifier.VerifyArgs{
+		Address:      common.HexToAddress(contractAddress),
+		ContractName: "Counter",
+		Sources:      map[string]string{"contracts/Counter.sol": "contract Counter {}"},
+		Settings:     map[string]interface{}{"optimizer": map[string]interface{}{"enabled": true, "runs": 200}},
+	}
+}
+
+func TestCompareBytecode(t *testing.T) {
+	immutables := map[string][]verifier.ImmutableReference{"3": {{Start: 6, Length: 32}}}
+
+	require.Equal(t, verifier.MatchFull, verifier.CompareBytecode(bytecode(1, 1), bytecode(0, 1), immutables))
+	require.Equal(t, verifier.MatchPartial, verifier.CompareBytecode(bytecode(1, 1), bytecode(0, 2), immutables))
+	require.Equal(t, verifier.MatchType(""), verifier.CompareBytecode(bytecode(1, 1), bytecode(0, 1), nil))
+	require.Equal(t, verifier.MatchType(""), verifier.CompareBytecode(bytecode(1, 1), bytecode(1, 1)[1:], immutables))
+
+	code := bytecode(0, 0)
+	code[0] = 0x61
+	require.Equal(t, verifier.MatchType(""), verifier.CompareBytecode(code, bytecode(0, 0), immutables))
+
+	stripped, ok := verifier.StripMetadata(bytecode(0, 0))
+	require.True(t, ok)
+	require.Equal(t, bytecode(0, 0)[:38], stripped)
+	_, ok = verifier.StripMetadata([]byte{0x00, 0xff})
+	require.False(t, ok)
+}
+
+func TestVerify(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(args *verifier.VerifyArgs, compiler *mockCompiler)
+		expMatch verifier.MatchType
+		expError string
+	}{
+		{"full match", func(*verifier.VerifyArgs, *mockCompiler) {}, verifier.MatchFull, ""},
+		{
+			"partial match",
+			func(_ *verifier.VerifyArgs, compiler *mockCompiler) { compiler.bytecode = bytecode(0, 2) },
+			verifier.MatchPartial, "",
+		},
+		{
+			"contract name prefixed by the source name",
+			func(args *verifier.VerifyArgs, _ *mockCompiler) { args.ContractName = "contracts/Counter.sol:Counter" },
+			verifier.MatchFull, "",
+		},
+		{
+			"compiler version",
+			func(args *verifier.VerifyArgs, _ *mockCompiler) { args.CompilerVersion = "v0.8.19" },
+			verifier.MatchFull, "",
+		},
+		{
+			"compiler version mismatch",
+			func(args *verifier.VerifyArgs, _ *mockCompiler) { args.CompilerVersion = "0.8.20" },
+			"", "compiler version mismatch",
+		},
+		{
+			"bytecode mismatch",
+			func(_ *verifier.VerifyArgs, compiler *mockCompiler) { compiler.bytecode = bytecode(0, 1)[1:] },
+			"", "doesn't match",
+		},
+		{
+			"unlinked library",
+			func(_ *verifier.VerifyArgs, compiler *mockCompiler) {
+				compiler.object = "6080__$1c3b8c1ef2b3c3f8b7a2e0c5f3e4f1c2d3$__6040"
+			},
+			"", "unlinked libraries",
+		},
+		{
+			"compilation error",
+			func(_ *verifier.VerifyArgs, compiler *mockCompiler) {
+				compiler.errors = []verifier.CompilerError{
+					{Severity: "warning", FormattedMessage: "unused variable"},
+					{Severity: "error", FormattedMessage: "ParserError"},
+				}
+			},
+			"", "compilation failed: ParserError",
+		},
+		{
+			"contract not found",
+			func(args *verifier.VerifyArgs, _ *mockCompiler) { args.ContractName = "contracts/Lib.sol:Counter" },
+			"", "not found",
+		},
+		{
+			"no code",
+			func(args *verifier.VerifyArgs, _ *mockCompiler) { args.Address = common.Address{1} },
+			"", "no contract code",
+		},
+		{
+			"no sources",
+			func(args *verifier.VerifyArgs, _ *mockCompiler) { args.Sources = nil },
+			"", "sources are required",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := verifyArgs()
+			compiler := &mockCompiler{bytecode: bytecode(0, 1)}
+			tc.malleate(&args, compiler)
+			v := newVerifier(compiler, bytecode(1, 1))
+
+			contract, err := v.Verify(context.Background(), args)
+			stored, getErr := v.GetContract(args.Address)
+			require.NoError(t, getErr)
+			if tc.expError != "" {
+				require.ErrorContains(t, err, tc.expError)
+				require.Nil(t, stored)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expMatch, contract.Match)
+			require.Equal(t, "contracts/Counter.sol", contract.SourceName)
+			require.Equal(t, "Counter", contract.ContractName)
+			require.Equal(t, "0.8.19+commit.7dd6d404", contract.CompilerVersion)
+			require.JSONEq(t, abi, string(contract.ABI))
+
+			// the output selection is set by the verifier
+			require.Contains(t, compiler.input.Settings, "outputSelection")
+			require.Contains(t, compiler.input.Settings, "optimizer")
+			require.NotContains(t, contract.Settings, "outputSelection")
+			require.Equal(t, "contract Counter {}", compiler.input.Sources["contracts/Counter.sol"].Content)
+
+			bz, err := json.Marshal(contract)
+			require.NoError(t, err)
+			storedBz, err := json.Marshal(stored)
+			require.NoError(t, err)
+			require.JSONEq(t, string(bz), string(storedBz))
+		})
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	v := newVerifier(&mockCompiler{bytecode: bytecode(0, 1)}, bytecode(1, 1))
+	r := mux.NewRouter()
+	verifier.RegisterRoutes(r.PathPrefix("/verifier").Subrouter(), v)
+
+	do := func(method, path string, body interface{}) *httptest.ResponseRecorder {
+		bz, err := json.Marshal(body)
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewReader(bz)))
+		return rec
+	}
+
+	rec := do(http.MethodGet, "/verifier/contracts/"+contractAddress, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	rec = do(http.MethodGet, "/verifier/contracts/0x1", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	args := verifyArgs()
+	args.ContractName = "Unknown"
+	rec = do(http.MethodPost, "/verifier/contracts", args)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "contract Unknown not found")
+
+	rec = do(http.MethodPost, "/verifier/contracts", verifyArgs())
+	require.Equal(t, http.StatusOK, rec.Code)
+	var contract verifier.VerifiedContract
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &contract))
+	require.Equal(t, verifier.MatchFull, contract.Match)
+
+	rec = do(http.MethodGet, "/verifier/contracts/"+contractAddress, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &contract))
+	require.Equal(t, "Counter", contract.ContractName)
+
+	rec = do(http.MethodGet, "/verifier/contracts/"+contractAddress+"/abi", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, abi, rec.Body.String())
+
+	// the bodies above the size limit are rejected, with or without a content length
+	args = verifyArgs()
+	args.Sources["contracts/Large.sol"] = strings.Repeat("a", 10*1024*1024)
+	rec = do(http.MethodPost, "/verifier/contracts", args)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	bz, err := json.Marshal(args)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/verifier/contracts", bytes.NewReader(bz))
+	req.ContentLength = -1
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	// the JSON-RPC API serves the same contracts
+	api := verifier.NewAPI(v)
+	abiRes, err := api.GetABI(common.HexToAddress(contractAddress))
+	require.NoError(t, err)
+	require.JSONEq(t, abi, string(abiRes))
+	res, err := api.GetContract(common.Address{1})
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func TestSolcCompilerNotFound(t *testing.T) {
+	compiler := verifier.NewSolcCompiler("/nonexistent/solc", time.Second, 1)
+	_, err := compiler.Compile(context.Background(), &verifier.CompilerInput{Language: "Solidity"})
+	require.ErrorContains(t, err, "failed to run solc")
+}
+
+// hangingSolc writes a solc script never returning, looping on shell builtins as the
+// compiler runs without PATH
+func hangingSolc(t *testing.T) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not supported")
+	}
+	path := filepath.Join(t.TempDir(), "solc")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\nwhile :; do :; done\n"), 0o700))
+	return path
+}
+
+func TestSolcCompilerTimeout(t *testing.T) {
+	compiler := verifier.NewSolcCompiler(hangingSolc(t), 100*time.Millisecond, 1)
+	_, err := compiler.Compile(context.Background(), &verifier.CompilerInput{Language: "Solidity"})
+	require.ErrorContains(t, err, "compilation timed out after 100ms")
+}
+
+func TestSolcCompilerMaxCompilations(t *testing.T) {
+	compiler := verifier.NewSolcCompiler(hangingSolc(t), time.Second, 1)
+	input := &verifier.CompilerInput{Language: "Solidity"}
+
+	done := make(chan error)
+	go func() {
+		_, err := compiler.Compile(context.Background(), input)
+		done <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	// the only slot is used by the running compilation
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := compiler.Compile(ctx, input)
+	require.ErrorContains(t, err, "no compilation slot available")
+
+	// the slot is released once the first compilation is killed
+	require.ErrorContains(t, <-done, "compilation timed out")
+	_, err = compiler.Compile(context.Background(), input)
+	require.ErrorContains(t, err, "compilation timed out")
+}
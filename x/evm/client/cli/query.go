@@ -16,11 +16,19 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/evmos/ethermint/x/evm/types"
 )
@@ -38,7 +46,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetStorageCmd(),
 		GetCodeCmd(),
+		GetAccountCmd(),
 		GetParamsCmd(),
+		GetBlockBloomCmd(),
+		GetContractStateCmd(),
 	)
 	return cmd
 }
@@ -120,12 +131,56 @@ func GetCodeCmd() *cobra.Command {
 	return cmd
 }
 
-// GetParamsCmd queries the fee market params
+// GetAccountCmd queries the balance, code hash and nonce of a given address
+func GetAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account ADDRESS",
+		Short: "Gets the balance, code hash and nonce of an account",
+		Long:  "Gets the balance, code hash and nonce of an account. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			withStorageHash, err := cmd.Flags().GetBool(flagStorageHash)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccountRequest{
+				Address:         address,
+				WithStorageHash: withStorageHash,
+			}
+
+			res, err := queryClient.Account(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagStorageHash, false, "compute the hash of the sorted storage of the account, iterating its whole storage")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the evm params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
 		Short: "Get the evm params",
-		Long:  "Get the evm parameter values.",
+		Long:  "Get the evm parameter values. If the height is not provided, it will use the latest height from context.",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -135,7 +190,7 @@ func GetParamsCmd() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			res, err := queryClient.Params(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryParamsRequest{})
 			if err != nil {
 				return err
 			}
@@ -147,3 +202,110 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBlockBloomCmd queries the bloom filter of the ethereum logs emitted in a block
+func GetBlockBloomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-bloom HEIGHT",
+		Short: "Gets the bloom filter of the logs emitted in a block",
+		Long:  "Gets the bloom filter of the ethereum logs emitted in the block at the given height, as recorded in the block end events.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height <= 0 {
+				return fmt.Errorf("invalid block height %s", args[0])
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			blockRes, err := node.BlockResults(cmd.Context(), &height)
+			if err != nil {
+				return err
+			}
+
+			bloom, err := bloomFromEvents(blockRes.EndBlockEvents)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(blockBloomResponse{
+				Height: height,
+				Bloom:  hexutil.Encode(bloom.Bytes()),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// blockBloomResponse is the output of the block-bloom query command
+type blockBloomResponse struct {
+	Height int64  `json:"height"`
+	Bloom  string `json:"bloom"`
+}
+
+// GetContractStateCmd exports the code and the full storage of a contract
+func GetContractStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-state ADDRESS",
+		Short: "Exports the code and the full storage of a contract",
+		Long: `Exports the code and the full storage of a contract, in the format of the evm genesis accounts, at the given height.
+If the height is not provided, it will use the latest height from context. The output can be edited and submitted with
+the restore-contract tx.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Code(rpctypes.ContextWithHeight(clientCtx.Height), &types.QueryCodeRequest{
+				Address: address,
+			})
+			if err != nil {
+				return err
+			}
+
+			storagePrefix := types.AddressStoragePrefix(common.HexToAddress(address))
+			bz, _, err := clientCtx.QueryWithData(fmt.Sprintf("/store/%s/subspace", types.StoreKey), storagePrefix)
+			if err != nil {
+				return err
+			}
+
+			var pairs kv.Pairs
+			if err := pairs.Unmarshal(bz); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&types.GenesisAccount{
+				Address: address,
+				Code:    common.Bytes2Hex(res.Code),
+				Storage: storageFromKVPairs(storagePrefix, pairs),
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -19,10 +19,15 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -31,6 +36,19 @@ import (
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
+const (
+	flagTo        = "to"
+	flagValue     = "value"
+	flagGasLimit  = "gas-limit"
+	flagAuthority = "authority"
+	flagResume    = "resume"
+
+	flagSigner        = "signer"
+	flagSignerTimeout = "signer-timeout"
+
+	flagStorageHash = "storage-hash"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -40,7 +58,12 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewEthereumCallCmd(),
+		NewRestoreContractCmd(),
+		NewPauseContractsCmd(),
+	)
 	return cmd
 }
 
@@ -49,7 +72,11 @@ func NewRawTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "raw TX_HEX",
 		Short: "Build cosmos transaction from raw ethereum transaction",
-		Args:  cobra.ExactArgs(1),
+		Long: `Build cosmos transaction from raw ethereum transaction.
+With --signer, the unsigned raw transaction is signed on behalf of the from account by the signer
+registered by the application under that name (eg: an MPC/TSS provider), the asynchronous signers
+being waited for up to --signer-timeout.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			data, err := hexutil.Decode(args[0])
 			if err != nil {
@@ -70,6 +97,20 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
+			signerName, err := cmd.Flags().GetString(flagSigner)
+			if err != nil {
+				return err
+			}
+			if signerName != "" {
+				timeout, err := cmd.Flags().GetDuration(flagSignerTimeout)
+				if err != nil {
+					return err
+				}
+				if err := signRawTx(cmd.Context(), clientCtx, msg, signerName, timeout); err != nil {
+					return err
+				}
+			}
+
 			rsp, err := rpctypes.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
 			if err != nil {
 				return err
@@ -121,6 +162,169 @@ func NewRawTxCmd() *cobra.Command {
 		},
 	}
 
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagSigner, "", "name of the registered signer signing the unsigned raw transaction for the from account")
+	cmd.Flags().Duration(flagSignerTimeout, 5*time.Minute, "max duration waited for the signature of an asynchronous signer")
+	return cmd
+}
+
+// NewEthereumCallCmd command build a cosmos transaction executing an EVM call on behalf of the
+// from account, which can be a multisig account.
+func NewEthereumCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call DATA_HEX",
+		Short: "Execute an EVM call, or a contract creation without --to, on behalf of the from account",
+		Long: `Execute an EVM call, or a contract creation without --to, with the from account as the EVM caller.
+The tx is signed like any cosmos tx, so it can be generated for a multisig account with --generate-only,
+or submitted in a group proposal.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := hexutil.Decode(args[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to decode call data hex bytes")
+			}
+
+			toStr, err := cmd.Flags().GetString(flagTo)
+			if err != nil {
+				return err
+			}
+			var to *common.Address
+			if toStr != "" {
+				if !common.IsHexAddress(toStr) {
+					return fmt.Errorf("invalid to address: %s", toStr)
+				}
+				addr := common.HexToAddress(toStr)
+				to = &addr
+			}
+
+			valueStr, err := cmd.Flags().GetString(flagValue)
+			if err != nil {
+				return err
+			}
+			value, ok := sdkmath.NewIntFromString(valueStr)
+			if !ok {
+				return fmt.Errorf("invalid value: %s", valueStr)
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEthereumCall(clientCtx.GetFromAddress(), to, data, value, gasLimit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagTo, "", "hex address of the called contract, omitted for a contract creation")
+	cmd.Flags().String(flagValue, "0", "amount of evm denom transferred to the called contract")
+	cmd.Flags().Uint64(flagGasLimit, 0, "maximum EVM gas used by the call")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRestoreContractCmd command build a cosmos transaction replacing the code and the storage of
+// a contract, which is meant to be submitted in a governance proposal.
+func NewRestoreContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore-contract FILE",
+		Short: "Replace the code and the full storage of a contract with the state read from a JSON file",
+		Long: `Replace the code and the full storage of a contract with the state read from a JSON file, in the format
+of the contract-state query output. The storage slots missing from the file are deleted.
+The message must be signed by the governance account: generate it with --authority set to the gov module
+address and --generate-only, and submit it in a governance proposal.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := contractFromFile(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+			if authority == "" {
+				authority = clientCtx.GetFromAddress().String()
+			}
+
+			msg := &types.MsgRestoreContract{
+				Authority: authority,
+				Contract:  contract,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, "", "bech32 address of the governance account, defaults to the from account")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPauseContractsCmd command build a cosmos transaction pausing, or resuming, the execution of
+// contracts, which is meant to be submitted in a governance proposal.
+func NewPauseContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-contracts ADDRESS...",
+		Short: "Pause, or resume with --resume, the execution of the calls sent to contracts",
+		Long: `Pause, or resume with --resume, the execution of the calls sent to contracts. The transactions and calls
+sent to a paused contract fail without executing its code, the calls made to it by other contracts are not
+intercepted.
+The message must be signed by the governance account: generate it with --authority set to the gov module
+address and --generate-only, and submit it in a governance proposal.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+			if authority == "" {
+				authority = clientCtx.GetFromAddress().String()
+			}
+
+			resume, err := cmd.Flags().GetBool(flagResume)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetContractsPaused{
+				Authority: authority,
+				Addresses: args,
+				Paused:    !resume,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, "", "bech32 address of the governance account, defaults to the from account")
+	cmd.Flags().Bool(flagResume, false, "resume the execution of the contracts instead of pausing it")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -16,16 +16,32 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/types"
 )
 
+// signerPollInterval is the interval between the signature queries of an asynchronous signer
+var signerPollInterval = time.Second
+
 func accountToHex(addr string) (string, error) {
 	if strings.HasPrefix(addr, sdk.GetConfig().GetBech32AccountAddrPrefix()) {
 		// Check to see if address is Cosmos bech32 formatted
@@ -60,3 +76,106 @@ func formatKeyToHash(key string) string {
 
 	return ethkey.Hex()
 }
+
+// bloomFromEvents returns the block bloom filter from the block end events
+func bloomFromEvents(events []abci.Event) (ethtypes.Bloom, error) {
+	for _, event := range events {
+		if event.Type != types.EventTypeBlockBloom {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyEthereumBloom {
+				return ethtypes.BytesToBloom(attr.Value), nil
+			}
+		}
+	}
+
+	return ethtypes.Bloom{}, errors.New("block bloom event not found")
+}
+
+// storageFromKVPairs returns the contract storage from the pairs of a subspace query of the
+// contract storage prefix
+func storageFromKVPairs(storagePrefix []byte, pairs kv.Pairs) types.Storage {
+	storage := make(types.Storage, len(pairs.Pairs))
+	for i, pair := range pairs.Pairs {
+		key := common.BytesToHash(pair.Key[len(storagePrefix):])
+		storage[i] = types.NewState(key, common.BytesToHash(pair.Value))
+	}
+	return storage
+}
+
+// contractFromFile reads a contract state, in the format of the evm genesis accounts, from a
+// JSON file
+func contractFromFile(cdc codec.JSONCodec, path string) (types.GenesisAccount, error) {
+	var contract types.GenesisAccount
+
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return contract, err
+	}
+
+	if err := cdc.UnmarshalJSON(bz, &contract); err != nil {
+		return contract, errors.Wrap(err, "failed to decode contract state")
+	}
+
+	return contract, nil
+}
+
+// signRawTx signs the raw transaction on behalf of the from account with the signer registered under
+// the name, waiting for the signature of an asynchronous signer until the timeout.
+func signRawTx(
+	ctx context.Context, clientCtx client.Context, msg *types.MsgEthereumTx, signerName string, timeout time.Duration,
+) error {
+	if clientCtx.GetFromAddress().Empty() {
+		return errors.New("the from account signing the transaction is required")
+	}
+
+	signer, err := types.GetSigner(signerName, clientCtx.Keyring)
+	if err != nil {
+		return err
+	}
+
+	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		return err
+	}
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	msg.From = common.BytesToAddress(clientCtx.GetFromAddress()).Hex()
+	err = msg.SignWith(ctx, ethSigner, signer)
+	var pending *types.PendingSignatureError
+	if !errors.As(err, &pending) {
+		return err
+	}
+
+	asyncSigner, ok := signer.(types.AsyncSigner)
+	if !ok {
+		return fmt.Errorf("signer %s returned a pending signature but it isn't asynchronous", signerName)
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "waiting for the signature of the signing request %s\n", pending.RequestID)
+	ticker := time.NewTicker(signerPollInterval)
+	defer ticker.Stop()
+	for {
+		sig, err := asyncSigner.Signature(ctx, pending.RequestID)
+		if err == nil {
+			return msg.ApplySignature(ethSigner, sig)
+		}
+		if !errors.Is(err, types.ErrSignaturePending) {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "signing request %s", pending.RequestID)
+		}
+	}
+}
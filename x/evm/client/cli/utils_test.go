@@ -1,15 +1,25 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
 )
 
 func cosmosAddressFromArg(addr string) (sdk.AccAddress, error) {
@@ -100,3 +110,81 @@ func TestAddressToCosmosAddress(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, baseAddr, ethFormatted)
 }
+
+func TestBloomFromEvents(t *testing.T) {
+	bloom := ethtypes.BytesToBloom([]byte{0x1, 0x2, 0x3})
+
+	testCases := []struct {
+		name      string
+		events    []abci.Event
+		expBloom  ethtypes.Bloom
+		expectErr bool
+	}{
+		{"no events", nil, ethtypes.Bloom{}, true},
+		{
+			"no bloom event",
+			[]abci.Event{{Type: types.EventTypeTxLog}},
+			ethtypes.Bloom{},
+			true,
+		},
+		{
+			"bloom event",
+			[]abci.Event{
+				{Type: types.EventTypeTxLog},
+				{
+					Type: types.EventTypeBlockBloom,
+					Attributes: []abci.EventAttribute{
+						{Key: []byte(types.AttributeKeyEthereumBloom), Value: bloom.Bytes()},
+					},
+				},
+			},
+			bloom,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := bloomFromEvents(tc.events)
+			require.Equal(t, tc.expectErr, err != nil, err)
+			require.Equal(t, tc.expBloom, res)
+		})
+	}
+}
+
+func TestStorageFromKVPairs(t *testing.T) {
+	addr := common.HexToAddress("0x756F45E3FA69347A9A973A725E3C98bC4db0b5a0")
+	storagePrefix := types.AddressStoragePrefix(addr)
+	key := common.BigToHash(common.Big1)
+	value := common.BigToHash(common.Big2)
+
+	storage := storageFromKVPairs(storagePrefix, kv.Pairs{
+		Pairs: []kv.Pair{{Key: append(append([]byte{}, storagePrefix...), key.Bytes()...), Value: common.TrimLeftZeroes(value.Bytes())}},
+	})
+	require.Equal(t, types.Storage{types.NewState(key, value)}, storage)
+	require.Empty(t, storageFromKVPairs(storagePrefix, kv.Pairs{}))
+}
+
+func TestContractFromFile(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	contract := types.GenesisAccount{
+		Address: "0x756F45E3FA69347A9A973A725E3C98bC4db0b5a0",
+		Code:    "6080",
+		Storage: types.Storage{types.NewState(common.BigToHash(common.Big1), common.BigToHash(common.Big2))},
+	}
+
+	path := filepath.Join(t.TempDir(), "contract.json")
+	require.NoError(t, os.WriteFile(path, cdc.MustMarshalJSON(&contract), 0o600))
+
+	res, err := contractFromFile(cdc, path)
+	require.NoError(t, err)
+	require.Equal(t, contract, res)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = contractFromFile(cdc, path)
+	require.Error(t, err)
+
+	_, err = contractFromFile(cdc, filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
@@ -44,6 +44,10 @@ func InitGenesis(
 		panic(fmt.Errorf("error setting params %s", err))
 	}
 
+	if err := k.ValidateDenomMetadata(ctx, data.Params.EvmDenom); err != nil {
+		panic(err)
+	}
+
 	// ensure evm module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the EVM module account has not been set")
@@ -83,6 +87,18 @@ func InitGenesis(
 		}
 	}
 
+	for _, epoch := range data.ChainEpochs {
+		k.SetChainEpoch(ctx, epoch)
+	}
+	for _, headerHash := range data.HeaderHashes {
+		k.SetHeaderHash(ctx, headerHash.Height, common.HexToHash(headerHash.Hash))
+	}
+	for _, addr := range data.PausedContracts {
+		k.SetContractPaused(ctx, common.HexToAddress(addr), true)
+	}
+	// a restart with a bumped chain-id version starts a new epoch
+	k.RecordChainEpoch(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -110,8 +126,22 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 		return false
 	})
 
+	var headerHashes []types.HeaderHash
+	k.IterateHeaderHashes(ctx, func(height int64, hash common.Hash) bool {
+		headerHashes = append(headerHashes, types.HeaderHash{Height: height, Hash: hash.Hex()})
+		return false
+	})
+
+	var pausedContracts []string
+	for _, addr := range k.GetPausedContracts(ctx) {
+		pausedContracts = append(pausedContracts, addr.Hex())
+	}
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:        ethGenAccounts,
+		Params:          k.GetParams(ctx),
+		ChainEpochs:     k.GetChainEpochs(ctx),
+		HeaderHashes:    headerHashes,
+		PausedContracts: pausedContracts,
 	}
 }
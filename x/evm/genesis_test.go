@@ -162,3 +162,33 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 		})
 	}
 }
+
+func (suite *EvmTestSuite) TestInitGenesisChainEpochs() {
+	suite.SetupTest()
+	hash := common.BytesToHash([]byte("header"))
+	genState := types.DefaultGenesisState()
+	genState.ChainEpochs = []types.ChainEpoch{{ChainID: "ethermint_9000-1", StartHeight: 1}}
+	genState.HeaderHashes = []types.HeaderHash{{Height: 99, Hash: hash.Hex()}}
+
+	// restart with a bumped chain-id version
+	ctx := suite.ctx.WithChainID("ethermint_9000-2").WithBlockHeight(100)
+	evm.InitGenesis(ctx, suite.app.EvmKeeper, suite.app.AccountKeeper, *genState)
+
+	expEpochs := []types.ChainEpoch{
+		{ChainID: "ethermint_9000-1", StartHeight: 1, EndHeight: 99},
+		{ChainID: "ethermint_9000-2", StartHeight: 100},
+	}
+	suite.Require().Equal(expEpochs, suite.app.EvmKeeper.GetChainEpochs(ctx))
+	stored, found := suite.app.EvmKeeper.GetHeaderHash(ctx, 99)
+	suite.Require().True(found)
+	suite.Require().Equal(hash, stored)
+
+	exported := evm.ExportGenesis(ctx, suite.app.EvmKeeper, suite.app.AccountKeeper)
+	suite.Require().Equal(expEpochs, exported.ChainEpochs)
+	suite.Require().Contains(exported.HeaderHashes, types.HeaderHash{Height: 99, Hash: hash.Hex()})
+	suite.Require().NoError(exported.Validate())
+
+	// a restart with the same chain-id doesn't start a new epoch
+	evm.InitGenesis(ctx.WithBlockHeight(200), suite.app.EvmKeeper, suite.app.AccountKeeper, *exported)
+	suite.Require().Equal(expEpochs, suite.app.EvmKeeper.GetChainEpochs(ctx))
+}
@@ -24,6 +24,10 @@ import (
 )
 
 // NewHandler returns a handler for Ethermint type messages.
+//
+// Deprecated: the messages are routed to the gRPC Msg service registered by the module in
+// RegisterServices. The legacy handler is only kept for the callers of the legacy router and
+// forwards the messages to the same MsgServer.
 func NewHandler(server types.MsgServer) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (result *sdk.Result, err error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
@@ -32,9 +36,18 @@ func NewHandler(server types.MsgServer) sdk.Handler {
 		case *types.MsgEthereumTx:
 			res, err := server.EthereumTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgEthereumCall:
+			res, err := server.EthereumCall(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgUpdateParams:
 			res, err := server.UpdateParams(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRestoreContract:
+			res, err := server.RestoreContract(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetContractsPaused:
+			res, err := server.SetContractsPaused(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			err := errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, err
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 
@@ -569,7 +570,7 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 		suite.Run(tc.msg, func() {
 			suite.SetupTest()
 			k := suite.app.EvmKeeper
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			// add some fund to pay gas fee
 			k.SetBalance(suite.ctx, suite.from, big.NewInt(1000000000000000))
@@ -658,7 +659,7 @@ func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 			k := suite.app.EvmKeeper
 
 			// test with different hooks scenarios
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			nonce := k.GetNonce(suite.ctx, suite.from)
 			ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.from, big.NewInt(0))
@@ -705,3 +706,28 @@ type FailureHook struct{}
 func (dh *FailureHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	return errors.New("mock error")
 }
+
+func (suite *EvmTestSuite) TestLegacyHandlerRouting() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := types.DefaultParams()
+	params.EnableCreate = false
+	msg := &types.MsgUpdateParams{Authority: authority, Params: params}
+
+	// the messages are routed to the Msg service
+	msgHandler := suite.app.MsgServiceRouter().Handler(msg)
+	suite.Require().NotNil(msgHandler)
+	_, err := msgHandler(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.EvmKeeper.GetParams(suite.ctx).EnableCreate)
+
+	// the deprecated handler forwards them to the same MsgServer
+	msg.Params = types.DefaultParams()
+	_, err = suite.handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.EvmKeeper.GetParams(suite.ctx).EnableCreate)
+
+	_, err = suite.handler(suite.ctx, &types.MsgSetContractsPaused{Authority: "foobar"})
+	suite.Require().Error(err)
+	_, err = suite.handler(suite.ctx, &banktypes.MsgSend{})
+	suite.Require().Error(err)
+}
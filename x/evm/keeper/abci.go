@@ -23,20 +23,26 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper, and stores the header hash of
+// the block for the BLOCKHASH opcode. The max gas of the block is emitted in an event.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+	k.commitHeaderHash(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	k.emitBlockGasLimit(ctx)
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore. The block gas utilization is emitted in an event and the node min gas price multiplier
+// is adjusted with the block fullness. The EVM end block logic doesn't update the validator set, thus it returns an
+// empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
+	k.emitBlockGasUtilization(ctx)
+	k.updateMinGasPriceMultiplier(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -1,10 +1,37 @@
 package keeper_test
 
 import (
-	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
+func (suite *KeeperTestSuite) TestBeginBlockHeaderHash() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	height := int64(evmtypes.HeaderHashRetention + 10)
+
+	// the pruned hash
+	k.SetHeaderHash(suite.ctx, 10, common.HexToHash("0x0a"))
+	ctx := suite.ctx.WithBlockHeight(height).WithHeaderHash(tmhash.Sum([]byte("header")))
+	k.BeginBlock(ctx, types.RequestBeginBlock{})
+
+	hash, found := k.GetHeaderHash(ctx, height)
+	suite.Require().True(found)
+	suite.Require().Equal(common.BytesToHash(tmhash.Sum([]byte("header"))), hash)
+	_, found = k.GetHeaderHash(ctx, 10)
+	suite.Require().False(found)
+
+	// the hash is resolved by the next blocks without the staking historical info
+	next := ctx.WithBlockHeight(height + 1)
+	suite.Require().Equal(hash, k.GetHashFn(next)(uint64(height)))
+}
+
 func (suite *KeeperTestSuite) TestEndBlock() {
 	em := suite.ctx.EventManager()
 	suite.Require().Equal(0, len(em.Events()))
@@ -12,7 +39,72 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	res := suite.app.EvmKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
 	suite.Require().Equal([]types.ValidatorUpdate{}, res)
 
-	// should emit 1 EventTypeBlockBloom event on EndBlock
-	suite.Require().Equal(1, len(em.Events()))
+	// should emit the EventTypeBlockBloom and EventTypeBlockGasUtilization events on EndBlock
+	suite.Require().Equal(2, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
+	suite.Require().Equal(evmtypes.EventTypeBlockGasUtilization, em.Events()[1].Type)
+}
+
+func (suite *KeeperTestSuite) TestBlockGasUtilization() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	attributes := func(event sdk.Event) map[string]string {
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		return attrs
+	}
+
+	ctx := suite.ctx.WithBlockGasMeter(sdk.NewGasMeter(1000000)).WithEventManager(sdk.NewEventManager())
+	k.BeginBlock(ctx, types.RequestBeginBlock{})
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(evmtypes.EventTypeBlockGasLimit, events[len(events)-1].Type)
+	suite.Require().Equal("1000000", attributes(events[len(events)-1])[evmtypes.AttributeKeyMaxGas])
+
+	// a tx of two messages is executed, then a tx passes the ante handler but fails in DeliverTx
+	ctx.BlockGasMeter().ConsumeGas(250000, "txs")
+	k.SetLastAdmittedTxTransient(ctx, 2)
+	k.AddBlockStatsTransient(ctx, 100000, big.NewInt(1), false)
+	k.AddBlockStatsTransient(ctx, 150000, big.NewInt(1), false)
+	k.SetLastAdmittedTxTransient(ctx, 1)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.EndBlock(ctx, types.RequestEndBlock{})
+	events = ctx.EventManager().Events()
+	event := events[len(events)-1]
+	suite.Require().Equal(evmtypes.EventTypeBlockGasUtilization, event.Type)
+	attrs := attributes(event)
+	suite.Require().Equal("250000", attrs[evmtypes.AttributeKeyGasUsed])
+	suite.Require().Equal("1000000", attrs[evmtypes.AttributeKeyMaxGas])
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 2).String(), attrs[evmtypes.AttributeKeyUtilization])
+	suite.Require().Equal("250000", attrs[evmtypes.AttributeKeyEVMGasUsed])
+	suite.Require().Equal("2", attrs[evmtypes.AttributeKeyEVMTxCount])
+	// the tx failure isn't a block gas check rejection
+	suite.Require().Equal("0", attrs[evmtypes.AttributeKeySkippedTxCount])
+
+	// the block gas check rejects the last admitted tx, its gas pushing the meter past its limit
+	suite.Require().Panics(func() {
+		ctx.BlockGasMeter().ConsumeGas(800000, "block gas meter")
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.EndBlock(ctx, types.RequestEndBlock{})
+	events = ctx.EventManager().Events()
+	attrs = attributes(events[len(events)-1])
+	suite.Require().Equal("1000000", attrs[evmtypes.AttributeKeyGasUsed])
+	suite.Require().Equal("2", attrs[evmtypes.AttributeKeyEVMTxCount])
+	suite.Require().Equal("1", attrs[evmtypes.AttributeKeySkippedTxCount])
+
+	// an executed tx isn't skipped even if a later cosmos tx exhausts the block gas
+	k.SetLastAdmittedTxTransient(ctx, 1)
+	k.AddBlockStatsTransient(ctx, 21000, big.NewInt(1), false)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.EndBlock(ctx, types.RequestEndBlock{})
+	events = ctx.EventManager().Events()
+	attrs = attributes(events[len(events)-1])
+	suite.Require().Equal("3", attrs[evmtypes.AttributeKeyEVMTxCount])
+	suite.Require().Equal("0", attrs[evmtypes.AttributeKeySkippedTxCount])
 }
@@ -0,0 +1,129 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// adaptiveGasPriceChangeDenominator bounds the change of the multiplier after each block, like the
+// EIP-1559 base fee change denominator.
+const adaptiveGasPriceChangeDenominator = 8
+
+// AdaptiveGasPrice raises the min gas price accepted and advertised by the node while the recent
+// blocks are fuller than the target, and lowers it back while they're emptier. The multiplier
+// applied to the min gas price stays within 1 and the configured maximum. It's node local, the
+// consensus never depends on it.
+type AdaptiveGasPrice struct {
+	maxMultiplier  sdk.Dec
+	targetFullness sdk.Dec
+
+	mtx        sync.RWMutex
+	multiplier sdk.Dec
+}
+
+// NewAdaptiveGasPrice returns an AdaptiveGasPrice whose multiplier grows up to maxMultiplier while
+// the ratio of the block gas consumed to the block gas limit is above targetFullness.
+func NewAdaptiveGasPrice(maxMultiplier, targetFullness float64) (*AdaptiveGasPrice, error) {
+	if maxMultiplier <= 1 {
+		return nil, fmt.Errorf("adaptive gas price max multiplier must be greater than 1, got %v", maxMultiplier)
+	}
+	if targetFullness <= 0 || targetFullness > 1 {
+		return nil, fmt.Errorf("adaptive gas price target fullness must be within (0, 1], got %v", targetFullness)
+	}
+	maxDec, err := sdk.NewDecFromStr(strconv.FormatFloat(maxMultiplier, 'f', 6, 64))
+	if err != nil {
+		return nil, err
+	}
+	targetDec, err := sdk.NewDecFromStr(strconv.FormatFloat(targetFullness, 'f', 6, 64))
+	if err != nil {
+		return nil, err
+	}
+	return &AdaptiveGasPrice{
+		maxMultiplier:  maxDec,
+		targetFullness: targetDec,
+		multiplier:     sdk.OneDec(),
+	}, nil
+}
+
+// Multiplier returns the current multiplier of the node min gas price.
+func (a *AdaptiveGasPrice) Multiplier() sdk.Dec {
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+	return a.multiplier
+}
+
+// update adjusts the multiplier with the gas consumed by a block, by at most 1/8 of its value when
+// the block is empty or full with the default target.
+func (a *AdaptiveGasPrice) update(gasUsed, gasLimit uint64) {
+	if gasLimit == 0 {
+		return
+	}
+	fullness := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).QuoInt(sdk.NewIntFromUint64(gasLimit))
+	delta := fullness.Sub(a.targetFullness).Quo(a.targetFullness).QuoInt64(adaptiveGasPriceChangeDenominator)
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	multiplier := a.multiplier.Mul(sdk.OneDec().Add(delta))
+	if multiplier.LT(sdk.OneDec()) {
+		multiplier = sdk.OneDec()
+	}
+	if multiplier.GT(a.maxMultiplier) {
+		multiplier = a.maxMultiplier
+	}
+	a.multiplier = multiplier
+
+	telemetry.SetGauge(float32(multiplier.MustFloat64()), "evm", "min_gas_price_multiplier")
+}
+
+// SetAdaptiveGasPrice enables the adjustment of the node min gas price with the block fullness. It
+// should be called only once during initialization, it panics if called more than once.
+func (k *Keeper) SetAdaptiveGasPrice(adaptive *AdaptiveGasPrice) *Keeper {
+	if k.adaptiveGasPrice != nil {
+		panic("cannot set evm adaptive gas price twice")
+	}
+
+	k.adaptiveGasPrice = adaptive
+	return k
+}
+
+// GetMinGasPriceMultiplier returns the multiplier applied by the node to its min gas price, 1 if the
+// adaptive gas price is disabled.
+func (k Keeper) GetMinGasPriceMultiplier() sdk.Dec {
+	if k.adaptiveGasPrice == nil {
+		return sdk.OneDec()
+	}
+	return k.adaptiveGasPrice.Multiplier()
+}
+
+// updateMinGasPriceMultiplier adjusts the node min gas price multiplier with the fullness of the
+// block, the blocks without a gas limit are ignored.
+func (k Keeper) updateMinGasPriceMultiplier(ctx sdk.Context) {
+	if k.adaptiveGasPrice == nil || ctx.BlockGasMeter() == nil {
+		return
+	}
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Block == nil || cp.Block.MaxGas <= 0 {
+		return
+	}
+	k.adaptiveGasPrice.update(ctx.BlockGasMeter().GasConsumedToLimit(), uint64(cp.Block.MaxGas))
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestAdaptiveGasPrice() {
+	const maxGas = 1000000
+
+	testCases := []struct {
+		name          string
+		gasUsed       []uint64
+		expMultiplier sdk.Dec
+	}{
+		{"empty blocks keep the floor", []uint64{0, 0}, sdk.OneDec()},
+		{"target fullness keeps the multiplier", []uint64{500000}, sdk.OneDec()},
+		{"full block raises by 1/8", []uint64{maxGas}, sdk.NewDecWithPrec(1125, 3)},
+		{"empty block lowers it back", []uint64{maxGas, maxGas, 0}, sdk.MustNewDecFromStr("1.107421875")},
+		{"capped at the max", []uint64{maxGas, maxGas, maxGas, maxGas, maxGas}, sdk.NewDecWithPrec(15, 1)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().Equal(sdk.OneDec(), suite.app.EvmKeeper.GetMinGasPriceMultiplier())
+
+			adaptive, err := keeper.NewAdaptiveGasPrice(1.5, 0.5)
+			suite.Require().NoError(err)
+			suite.app.EvmKeeper.SetAdaptiveGasPrice(adaptive)
+
+			cp := suite.app.BaseApp.GetConsensusParams(suite.ctx)
+			cp.Block.MaxGas = maxGas
+			for _, gasUsed := range tc.gasUsed {
+				meter := sdk.NewGasMeter(maxGas)
+				meter.ConsumeGas(gasUsed, "block")
+				ctx := suite.ctx.WithConsensusParams(cp).WithBlockGasMeter(meter)
+				suite.app.EvmKeeper.EndBlock(ctx, abci.RequestEndBlock{Height: ctx.BlockHeight()})
+			}
+
+			suite.Require().Equal(tc.expMultiplier, suite.app.EvmKeeper.GetMinGasPriceMultiplier())
+
+			res, err := suite.queryClient.MinGasPriceMultiplier(suite.ctx, &types.QueryMinGasPriceMultiplierRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expMultiplier, res.Multiplier)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetLastAdmittedTxTransient records the number of messages of the last ethereum tx of the current
+// block which passed the ante handler, along with the number of ethereum txs executed before it.
+func (k Keeper) SetLastAdmittedTxTransient(ctx sdk.Context, msgCount uint64) {
+	executed := k.GetBlockStatsTransient(ctx).TxCount
+	bz := append(sdk.Uint64ToBigEndian(msgCount), sdk.Uint64ToBigEndian(executed)...)
+	ctx.TransientStore(k.transientKey).Set(types.KeyPrefixTransientLastAdmittedTx, bz)
+}
+
+// GetLastAdmittedTxTransient returns the number of messages of the last ethereum tx of the current
+// block which passed the ante handler, along with the number of ethereum txs executed before it.
+func (k Keeper) GetLastAdmittedTxTransient(ctx sdk.Context) (msgCount, executedBefore uint64) {
+	bz := ctx.TransientStore(k.transientKey).Get(types.KeyPrefixTransientLastAdmittedTx)
+	if len(bz) != 16 {
+		return 0, 0
+	}
+	return sdk.BigEndianToUint64(bz[:8]), sdk.BigEndianToUint64(bz[8:])
+}
+
+// skippedTxs returns the number of ethereum txs rejected by the block gas check. The check rejects at
+// most one tx per block, the one whose gas pushes the block gas meter past its limit, the next txs
+// being rejected before the ante handler. Thus the messages of the last ethereum tx which passed the
+// ante handler are skipped when the block gas meter is past its limit and the tx wasn't executed.
+func (k Keeper) skippedTxs(ctx sdk.Context) uint64 {
+	if ctx.BlockGasMeter() == nil || !ctx.BlockGasMeter().IsPastLimit() {
+		return 0
+	}
+
+	msgCount, executedBefore := k.GetLastAdmittedTxTransient(ctx)
+	if k.GetBlockStatsTransient(ctx).TxCount > executedBefore {
+		return 0
+	}
+	return msgCount
+}
+
+// emitBlockGasLimit records the max gas of the block from the consensus params, 0 if unlimited.
+func (k Keeper) emitBlockGasLimit(ctx sdk.Context) {
+	maxGas := ethermint.BlockGasLimit(ctx)
+	telemetry.SetGauge(float32(maxGas), types.ModuleName, types.MetricKeyBlockMaxGas)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBlockGasLimit,
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		sdk.NewAttribute(types.AttributeKeyMaxGas, strconv.FormatUint(maxGas, 10)),
+	))
+}
+
+// emitBlockGasUtilization records the gas consumed by the block against its max gas, along with the
+// number of ethereum txs skipped because of the block gas exhaustion: the txs which passed the ante
+// handler but whose execution was discarded by the block gas check since it didn't fit in the
+// remaining block gas.
+func (k Keeper) emitBlockGasUtilization(ctx sdk.Context) {
+	var gasUsed uint64
+	if ctx.BlockGasMeter() != nil {
+		gasUsed = ctx.BlockGasMeter().GasConsumedToLimit()
+	}
+	maxGas := ethermint.BlockGasLimit(ctx)
+	utilization := sdk.ZeroDec()
+	if maxGas > 0 {
+		utilization = sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).QuoInt(sdk.NewIntFromUint64(maxGas))
+	}
+
+	stats := k.GetBlockStatsTransient(ctx)
+	skipped := k.skippedTxs(ctx)
+
+	telemetry.SetGauge(float32(gasUsed), types.ModuleName, types.MetricKeyBlockGasUsed)
+	telemetry.SetGauge(float32(utilization.MustFloat64()), types.ModuleName, types.MetricKeyBlockGasRatio)
+	if skipped > 0 {
+		telemetry.IncrCounter(float32(skipped), types.ModuleName, types.MetricKeySkippedTxs)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBlockGasUtilization,
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		sdk.NewAttribute(types.AttributeKeyMaxGas, strconv.FormatUint(maxGas, 10)),
+		sdk.NewAttribute(types.AttributeKeyUtilization, utilization.String()),
+		sdk.NewAttribute(types.AttributeKeyEVMGasUsed, strconv.FormatUint(stats.GasUsed, 10)),
+		sdk.NewAttribute(types.AttributeKeyEVMTxCount, strconv.FormatUint(stats.TxCount, 10)),
+		sdk.NewAttribute(types.AttributeKeySkippedTxCount, strconv.FormatUint(skipped, 10)),
+	))
+}
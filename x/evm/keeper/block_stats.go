@@ -0,0 +1,49 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// GetBlockStatsTransient returns the statistics of the ethereum transactions executed in the current block.
+func (k Keeper) GetBlockStatsTransient(ctx sdk.Context) types.BlockStats {
+	stats := types.BlockStats{GasPriceSum: sdk.ZeroInt()}
+	bz := ctx.TransientStore(k.transientKey).Get(types.KeyPrefixTransientBlockStats)
+	if len(bz) == 0 {
+		return stats
+	}
+
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// AddBlockStatsTransient adds an executed ethereum transaction to the statistics of the current block.
+func (k Keeper) AddBlockStatsTransient(ctx sdk.Context, gasUsed uint64, gasPrice *big.Int, failed bool) {
+	stats := k.GetBlockStatsTransient(ctx)
+	stats.TxCount++
+	if failed {
+		stats.FailedTxCount++
+	}
+	stats.GasUsed += gasUsed
+	stats.GasPriceSum = stats.GasPriceSum.Add(sdk.NewIntFromBigInt(gasPrice))
+
+	ctx.TransientStore(k.transientKey).Set(types.KeyPrefixTransientBlockStats, k.cdc.MustMarshal(&stats))
+}
@@ -0,0 +1,18 @@
+package keeper_test
+
+import (
+	"math/big"
+)
+
+func (suite *KeeperTestSuite) TestBlockStats() {
+	suite.SetupTest()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(10000000000000))
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, suite.address, big.NewInt(100))
+
+	stats := suite.app.EvmKeeper.GetBlockStatsTransient(suite.ctx)
+	suite.Require().Equal(uint64(2), stats.TxCount)
+	suite.Require().Zero(stats.FailedTxCount)
+	suite.Require().NotZero(stats.GasUsed)
+	suite.Require().False(stats.GasPriceSum.IsNegative())
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetChainEpoch stores the chain epoch, keyed by its start height.
+func (k Keeper) SetChainEpoch(ctx sdk.Context, epoch types.ChainEpoch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixChainEpoch)
+	store.Set(sdk.Uint64ToBigEndian(uint64(epoch.StartHeight)), k.cdc.MustMarshal(&epoch))
+}
+
+// GetChainEpochs returns the chain epochs ordered by start height.
+func (k Keeper) GetChainEpochs(ctx sdk.Context) []types.ChainEpoch {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixChainEpoch)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	epochs := []types.ChainEpoch{}
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.ChainEpoch
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+		epochs = append(epochs, epoch)
+	}
+	return epochs
+}
+
+// GetChainEpoch returns the chain epoch the block of the given height was produced in, it returns
+// false if the height is prior to the recorded history.
+func (k Keeper) GetChainEpoch(ctx sdk.Context, height int64) (types.ChainEpoch, bool) {
+	if height < 0 {
+		return types.ChainEpoch{}, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixChainEpoch)
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ChainEpoch{}, false
+	}
+	var epoch types.ChainEpoch
+	k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+	if epoch.EndHeight != 0 && height > epoch.EndHeight {
+		return types.ChainEpoch{}, false
+	}
+	return epoch, true
+}
+
+// RecordChainEpoch starts a new chain epoch at the current height if the chain-id of the context
+// differs from the one of the latest epoch, closing the latter. It's called at genesis, so that a
+// restart from an exported genesis with a bumped chain-id version is recorded.
+func (k Keeper) RecordChainEpoch(ctx sdk.Context) {
+	startHeight := ctx.BlockHeight()
+	if startHeight < 1 {
+		startHeight = 1
+	}
+
+	if last, found := k.latestChainEpoch(ctx); found {
+		switch {
+		case last.ChainID == ctx.ChainID():
+			return
+		case last.StartHeight >= startHeight:
+			// the new chain doesn't continue the heights of the previous one, so the previous epochs
+			// can't be told apart by height anymore and the history is restarted.
+			k.Logger(ctx).Info(
+				"chain epoch overlaps the previous ones, resetting the history",
+				"chain-id", ctx.ChainID(), "height", startHeight, "previous-start-height", last.StartHeight,
+			)
+			k.deleteChainEpochs(ctx)
+		default:
+			last.EndHeight = startHeight - 1
+			k.SetChainEpoch(ctx, last)
+		}
+	}
+
+	k.SetChainEpoch(ctx, types.ChainEpoch{
+		ChainID:     ctx.ChainID(),
+		StartHeight: startHeight,
+	})
+}
+
+// latestChainEpoch returns the chain epoch with the highest start height.
+func (k Keeper) latestChainEpoch(ctx sdk.Context) (types.ChainEpoch, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixChainEpoch)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ChainEpoch{}, false
+	}
+	var epoch types.ChainEpoch
+	k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+	return epoch, true
+}
+
+// deleteChainEpochs deletes all the stored chain epochs.
+func (k Keeper) deleteChainEpochs(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixChainEpoch)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
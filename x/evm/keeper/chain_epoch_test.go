@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordChainEpoch() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	ctx := suite.ctx.WithChainID("ethermint_9000-1").WithBlockHeight(0)
+
+	k.RecordChainEpoch(ctx)
+	k.RecordChainEpoch(ctx.WithBlockHeight(10))
+	suite.Require().Equal([]types.ChainEpoch{{ChainID: "ethermint_9000-1", StartHeight: 1}}, k.GetChainEpochs(ctx))
+
+	k.RecordChainEpoch(ctx.WithChainID("ethermint_9000-2").WithBlockHeight(50))
+	suite.Require().Equal([]types.ChainEpoch{
+		{ChainID: "ethermint_9000-1", StartHeight: 1, EndHeight: 49},
+		{ChainID: "ethermint_9000-2", StartHeight: 50},
+	}, k.GetChainEpochs(ctx))
+
+	testCases := []struct {
+		height     int64
+		expChainID string
+	}{
+		{0, ""},
+		{1, "ethermint_9000-1"},
+		{49, "ethermint_9000-1"},
+		{50, "ethermint_9000-2"},
+		{1000, "ethermint_9000-2"},
+	}
+	for _, tc := range testCases {
+		epoch, found := k.GetChainEpoch(ctx, tc.height)
+		suite.Require().Equal(tc.expChainID != "", found, tc.height)
+		suite.Require().Equal(tc.expChainID, epoch.ChainID, tc.height)
+	}
+
+	// a restart from height 1 can't be told apart from the previous epochs
+	k.RecordChainEpoch(ctx.WithChainID("ethermint_9000-3").WithBlockHeight(1))
+	suite.Require().Equal([]types.ChainEpoch{{ChainID: "ethermint_9000-3", StartHeight: 1}}, k.GetChainEpochs(ctx))
+
+	res, err := suite.queryClient.ChainEpochs(sdk.WrapSDKContext(ctx), &types.QueryChainEpochsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(k.GetChainEpochs(ctx), res.Epochs)
+}
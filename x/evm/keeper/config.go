@@ -29,7 +29,16 @@ import (
 
 // EVMConfig creates the EVMConfig based on current state
 func (k *Keeper) EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress, chainID *big.Int) (*statedb.EVMConfig, error) {
-	params := k.GetParams(ctx)
+	return k.evmConfig(ctx, k.GetParams(ctx), proposerAddress, chainID)
+}
+
+// EVMConfigAtHeight creates the EVMConfig like EVMConfig, with the params in effect at the end of the
+// block of the given height instead of the current ones, for the queries replaying past blocks.
+func (k *Keeper) EVMConfigAtHeight(ctx sdk.Context, height int64, proposerAddress sdk.ConsAddress, chainID *big.Int) (*statedb.EVMConfig, error) {
+	return k.evmConfig(ctx, k.GetParamsAtHeight(ctx, height), proposerAddress, chainID)
+}
+
+func (k *Keeper) evmConfig(ctx sdk.Context, params types.Params, proposerAddress sdk.ConsAddress, chainID *big.Int) (*statedb.EVMConfig, error) {
 	ethCfg := params.ChainConfig.EthereumConfig(chainID)
 
 	// get the coinbase address from the block proposer
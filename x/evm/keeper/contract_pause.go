@@ -0,0 +1,117 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// IsContractPaused returns true if the execution of the contract at the given address is paused
+// by governance.
+func (k Keeper) IsContractPaused(ctx sdk.Context, addr common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPausedContract)
+	return store.Has(addr.Bytes())
+}
+
+// SetContractPaused pauses, or resumes, the execution of the contract at the given address.
+func (k Keeper) SetContractPaused(ctx sdk.Context, addr common.Address, paused bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPausedContract)
+	if !paused {
+		store.Delete(addr.Bytes())
+		return
+	}
+	store.Set(addr.Bytes(), []byte{1})
+}
+
+// GetPausedContracts returns the addresses of the paused contracts, ordered by address.
+func (k Keeper) GetPausedContracts(ctx sdk.Context) []common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPausedContract)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addrs []common.Address
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, common.BytesToAddress(iterator.Key()))
+	}
+	return addrs
+}
+
+// HasPausedContracts returns true if the execution of at least one contract is paused.
+func (k Keeper) HasPausedContracts(ctx sdk.Context) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPausedContract)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// pauseReader reads the paused contracts, it's implemented by the keeper and the state keepers
+// recording the state read by an execution.
+type pauseReader interface {
+	IsContractPaused(ctx sdk.Context, addr common.Address) bool
+	HasPausedContracts(ctx sdk.Context) bool
+}
+
+// pausedCallPanic aborts the execution calling a paused contract, it's recovered by runEVM.
+type pausedCallPanic struct {
+	address common.Address
+}
+
+// contractPauseGuard wraps the tracer of an execution to reject the calls to the paused contracts.
+// The EVM notifies the tracer of a nested call before loading the code of the callee, so the code
+// of a paused contract is never run while its state stays readable, EXTCODECOPY and friends keep
+// returning the real code.
+type contractPauseGuard struct {
+	vm.EVMLogger
+
+	ctx    sdk.Context
+	pauses pauseReader
+	paused map[common.Address]bool
+}
+
+var _ vm.EVMLogger = &contractPauseGuard{}
+
+func newContractPauseGuard(ctx sdk.Context, tracer vm.EVMLogger, pauses pauseReader) *contractPauseGuard {
+	return &contractPauseGuard{
+		EVMLogger: tracer,
+		ctx:       ctx,
+		pauses:    pauses,
+		paused:    make(map[common.Address]bool),
+	}
+}
+
+// CaptureEnter implements vm.EVMLogger, it panics with pausedCallPanic if the code run by the
+// call is the one of a paused contract.
+func (g *contractPauseGuard) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	switch typ {
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		paused, ok := g.paused[to]
+		if !ok {
+			paused = g.pauses.IsContractPaused(g.ctx, to)
+			g.paused[to] = paused
+		}
+		if paused {
+			panic(pausedCallPanic{address: to})
+		}
+	}
+	g.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestSetContractsPaused() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	recipient := tests.GenerateAddress()
+
+	transfer := func() *types.MsgEthereumTxResponse {
+		data, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(1))
+		suite.Require().NoError(err)
+		msg := ethtypes.NewMessage(
+			suite.address, &contractAddr, k.GetNonce(suite.ctx, suite.address), big.NewInt(0), 100_000,
+			big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true,
+		)
+		res, err := k.ApplyMessage(suite.ctx, msg, nil, true)
+		suite.Require().NoError(err)
+		return res
+	}
+	setPaused := func(paused bool) {
+		_, err := k.SetContractsPaused(sdk.WrapSDKContext(suite.ctx), &types.MsgSetContractsPaused{
+			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			Addresses: []string{contractAddr.Hex()},
+			Paused:    paused,
+		})
+		suite.Require().NoError(err)
+	}
+
+	// only the governance can pause the contracts
+	_, err := k.SetContractsPaused(sdk.WrapSDKContext(suite.ctx), &types.MsgSetContractsPaused{
+		Authority: sdk.AccAddress(suite.address.Bytes()).String(),
+		Addresses: []string{contractAddr.Hex()},
+		Paused:    true,
+	})
+	suite.Require().Error(err)
+	suite.Require().False(k.IsContractPaused(suite.ctx, contractAddr))
+
+	setPaused(true)
+	suite.Require().True(k.IsContractPaused(suite.ctx, contractAddr))
+	suite.Require().Equal(contractAddr, k.GetPausedContracts(suite.ctx)[0])
+
+	res := transfer()
+	suite.Require().True(res.Failed())
+	suite.Require().Contains(res.VmError, types.ErrContractPaused.Error())
+	suite.Require().Empty(res.Logs)
+
+	setPaused(false)
+	suite.Require().False(k.IsContractPaused(suite.ctx, contractAddr))
+	suite.Require().Empty(k.GetPausedContracts(suite.ctx))
+
+	res = transfer()
+	suite.Require().False(res.Failed())
+	suite.Require().Len(res.Logs, 1)
+}
+
+func (suite *KeeperTestSuite) TestContractPausedNestedCall() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+
+	// the proxy forwards the calldata to the contract with the given call opcode and returns the call status
+	proxyCode := func(op byte) []byte {
+		code := common.FromHex("0x36600060003760006000366000")
+		if op == 0xf1 || op == 0xf2 {
+			// CALL and CALLCODE take a value
+			code = append(code, 0x60, 0x00)
+		}
+		code = append(code, 0x73)
+		code = append(code, contractAddr.Bytes()...)
+		return append(code, append([]byte{0x5a, op}, common.FromHex("0x60005260206000f3")...)...)
+	}
+	data, err := types.ERC20Contract.ABI.Pack("balanceOf", suite.address)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+		op   byte
+	}{
+		{"CALL", 0xf1},
+		{"CALLCODE", 0xf2},
+		{"DELEGATECALL", 0xf4},
+		{"STATICCALL", 0xfa},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proxy := suite.deployRuntimeCode(proxyCode(tc.op))
+			call := func() *types.MsgEthereumTxResponse {
+				msg := ethtypes.NewMessage(
+					suite.address, &proxy, k.GetNonce(suite.ctx, suite.address), big.NewInt(0), 100_000,
+					big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true,
+				)
+				res, err := k.ApplyMessage(suite.ctx, msg, nil, true)
+				suite.Require().NoError(err)
+				return res
+			}
+
+			res := call()
+			suite.Require().False(res.Failed())
+			suite.Require().Equal(common.BigToHash(big.NewInt(1)).Bytes(), res.Ret)
+
+			// the call to the paused contract fails the whole execution
+			k.SetContractPaused(suite.ctx, contractAddr, true)
+			res = call()
+			suite.Require().True(res.Failed())
+			suite.Require().Contains(res.VmError, types.ErrContractPaused.Error())
+			suite.Require().Contains(res.VmError, contractAddr.Hex())
+			suite.Require().Equal(uint64(100_000), res.GasUsed)
+
+			k.SetContractPaused(suite.ctx, contractAddr, false)
+			res = call()
+			suite.Require().False(res.Failed())
+			suite.Require().Equal(common.BigToHash(big.NewInt(1)).Bytes(), res.Ret)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestContractPausedCode() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	codeHash := k.GetAccount(suite.ctx, contractAddr).CodeHash
+
+	// the reader returns the EXTCODESIZE and the EXTCODEHASH of the contract
+	readerCode := append([]byte{0x73}, contractAddr.Bytes()...)
+	readerCode = append(readerCode, 0x3b, 0x60, 0x00, 0x52, 0x73)
+	readerCode = append(readerCode, contractAddr.Bytes()...)
+	readerCode = append(readerCode, common.FromHex("0x3f60205260406000f3")...)
+	reader := suite.deployRuntimeCode(readerCode)
+
+	read := func() []byte {
+		msg := ethtypes.NewMessage(
+			suite.address, &reader, k.GetNonce(suite.ctx, suite.address), big.NewInt(0), 100_000,
+			big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true,
+		)
+		res, err := k.ApplyMessage(suite.ctx, msg, nil, true)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed())
+		return res.Ret
+	}
+
+	expected := read()
+	suite.Require().Equal(codeHash, expected[32:])
+	suite.Require().NotZero(new(big.Int).SetBytes(expected[:32]).Uint64())
+
+	// the code of the paused contract is still readable, only its execution is rejected
+	k.SetContractPaused(suite.ctx, contractAddr, true)
+	suite.Require().Equal(expected, read())
+	suite.Require().Equal(codeHash, k.GetAccount(suite.ctx, contractAddr).CodeHash)
+}
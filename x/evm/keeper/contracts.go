@@ -0,0 +1,109 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// IterateContracts iterates over the accounts with a non-empty code hash in ascending address order.
+func (k Keeper) IterateContracts(ctx sdk.Context, cb func(addr common.Address, codeHash common.Hash) (stop bool)) {
+	k.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		ethAcct, ok := account.(ethermint.EthAccountI)
+		if !ok {
+			return false
+		}
+
+		codeHash := ethAcct.GetCodeHash()
+		if bytes.Equal(codeHash.Bytes(), types.EmptyCodeHash) {
+			return false
+		}
+		return cb(ethAcct.EthAddress(), codeHash)
+	})
+}
+
+// GetContracts returns a page of the contracts with their code size, the page starts either at the
+// address of the key or after the offset of the request, like the sdk store pagination.
+func (k Keeper) GetContracts(ctx sdk.Context, pageReq *query.PageRequest) ([]types.ContractInfo, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Reverse {
+		return nil, nil, fmt.Errorf("reverse pagination isn't supported for the contracts")
+	}
+
+	key, offset, limit, countTotal := pageReq.Key, pageReq.Offset, pageReq.Limit, pageReq.CountTotal
+	if len(key) != 0 && offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+	// the total is only counted with the offset pagination
+	countTotal = countTotal && len(key) == 0
+
+	var (
+		contracts []types.ContractInfo
+		nextKey   []byte
+		count     uint64
+		err       error
+	)
+	k.IterateContracts(ctx, func(addr common.Address, codeHash common.Hash) bool {
+		// the iteration is aborted once the query deadline is exceeded
+		if err = contextErr(ctx); err != nil {
+			return true
+		}
+		if len(key) != 0 && bytes.Compare(addr.Bytes(), key) < 0 {
+			return false
+		}
+
+		count++
+		if count <= offset {
+			return false
+		}
+		if uint64(len(contracts)) == limit {
+			if nextKey == nil {
+				nextKey = addr.Bytes()
+			}
+			return !countTotal
+		}
+
+		contracts = append(contracts, types.ContractInfo{
+			Address:  addr.Hex(),
+			CodeSize: uint64(len(k.GetCode(ctx, codeHash))),
+		})
+		return false
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = count
+	}
+	return contracts, res, nil
+}
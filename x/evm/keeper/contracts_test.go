@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestIterateContracts() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	codeSizes := map[common.Address]uint64{}
+	for i := 1; i <= 3; i++ {
+		addr := tests.GenerateAddress()
+		code := bytes.Repeat([]byte{0x60}, i)
+		codeHash := crypto.Keccak256(code)
+		k.SetCode(suite.ctx, codeHash, code)
+		suite.Require().NoError(k.SetAccount(suite.ctx, addr, statedb.Account{Balance: new(big.Int), CodeHash: codeHash}))
+		codeSizes[addr] = uint64(i)
+	}
+	// an account without code isn't a contract
+	suite.Require().NoError(k.SetAccount(suite.ctx, tests.GenerateAddress(), *statedb.NewEmptyAccount()))
+
+	var contracts []types.ContractInfo
+	var prev common.Address
+	k.IterateContracts(suite.ctx, func(addr common.Address, codeHash common.Hash) bool {
+		suite.Require().Equal(-1, bytes.Compare(prev.Bytes(), addr.Bytes()))
+		prev = addr
+		contracts = append(contracts, types.ContractInfo{
+			Address:  addr.Hex(),
+			CodeSize: uint64(len(k.GetCode(suite.ctx, codeHash))),
+		})
+		return false
+	})
+
+	found := 0
+	for _, contract := range contracts {
+		if size, ok := codeSizes[common.HexToAddress(contract.Address)]; ok {
+			suite.Require().Equal(size, contract.CodeSize)
+			found++
+		}
+	}
+	suite.Require().Equal(len(codeSizes), found)
+
+	// the key pagination walks through all the contracts
+	var paged []types.ContractInfo
+	pageReq := &query.PageRequest{Limit: 1}
+	for {
+		res, err := suite.queryClient.Contracts(sdk.WrapSDKContext(suite.ctx), &types.QueryContractsRequest{Pagination: pageReq})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Contracts, 1)
+		paged = append(paged, res.Contracts...)
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	}
+	suite.Require().Equal(contracts, paged)
+
+	// the offset pagination counts the total
+	res, err := suite.queryClient.Contracts(sdk.WrapSDKContext(suite.ctx), &types.QueryContractsRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(contracts[1:2], res.Contracts)
+	suite.Require().Equal(uint64(len(contracts)), res.Pagination.Total)
+
+	_, err = suite.queryClient.Contracts(sdk.WrapSDKContext(suite.ctx), &types.QueryContractsRequest{
+		Pagination: &query.PageRequest{Key: []byte{0x01}, Offset: 1},
+	})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetDenomMetadataDecimals enables the validation of the bank metadata of the evm denom at genesis,
+// whose display unit must have the given decimals. 0 disables the validation.
+func (k *Keeper) SetDenomMetadataDecimals(decimals uint32) *Keeper {
+	k.denomMetadataDecimals = decimals
+	return k
+}
+
+// ValidateDenomMetadata checks, when enabled, that the evm denom has a valid bank metadata, with a
+// display unit of the configured decimals, and that none of its units is also a unit of another
+// denom. It's a noop when the validation is disabled.
+func (k Keeper) ValidateDenomMetadata(ctx sdk.Context, denom string) error {
+	if k.denomMetadataDecimals == 0 {
+		return nil
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidDenomMetadata,
+			"evm denom %s has no bank metadata, add it to the bank genesis denom_metadata", denom,
+		)
+	}
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidDenomMetadata, "evm denom %s: %s", denom, err)
+	}
+	if metadata.Display == denom {
+		return errorsmod.Wrapf(
+			types.ErrInvalidDenomMetadata,
+			"evm denom %s must have a display unit of %d decimals, distinct from the base unit", denom, k.denomMetadataDecimals,
+		)
+	}
+
+	units := make(map[string]struct{})
+	for _, unit := range metadata.DenomUnits {
+		// the display unit is part of the units, as checked by the metadata validation
+		if unit.Denom == metadata.Display && unit.Exponent != k.denomMetadataDecimals {
+			return errorsmod.Wrapf(
+				types.ErrInvalidDenomMetadata,
+				"evm denom %s display unit %s has %d decimals, expected %d", denom, unit.Denom, unit.Exponent, k.denomMetadataDecimals,
+			)
+		}
+		units[unit.Denom] = struct{}{}
+		for _, alias := range unit.Aliases {
+			units[alias] = struct{}{}
+		}
+	}
+
+	var err error
+	k.bankKeeper.IterateAllDenomMetaData(ctx, func(other banktypes.Metadata) bool {
+		if other.Base == denom {
+			return false
+		}
+		for _, unit := range other.DenomUnits {
+			for _, name := range append([]string{unit.Denom}, unit.Aliases...) {
+				if _, conflict := units[name]; conflict {
+					err = errorsmod.Wrapf(
+						types.ErrInvalidDenomMetadata,
+						"evm denom %s unit %s is also a unit of the denom %s", denom, name, other.Base,
+					)
+					return true
+				}
+			}
+		}
+		return false
+	})
+	return err
+}
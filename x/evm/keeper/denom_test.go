@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestValidateDenomMetadata() {
+	denom := suite.EvmDenom()
+	metadata := func(display string, exponent uint32, aliases ...string) banktypes.Metadata {
+		return banktypes.Metadata{
+			Description: "evm denom",
+			Base:        denom,
+			Display:     display,
+			Name:        "Photon",
+			Symbol:      "PHOTON",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: denom, Exponent: 0, Aliases: aliases},
+				{Denom: "photon", Exponent: exponent},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		decimals uint32
+		malleate func()
+		expErr   bool
+	}{
+		{"validation disabled", 0, func() {}, false},
+		{"missing metadata", 18, func() {}, true},
+		{
+			"valid metadata",
+			18,
+			func() {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata("photon", 18))
+			},
+			false,
+		},
+		{
+			"configured decimals",
+			6,
+			func() {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata("photon", 6))
+			},
+			false,
+		},
+		{
+			"wrong decimals",
+			18,
+			func() {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata("photon", 6))
+			},
+			true,
+		},
+		{
+			"display unit is the base unit",
+			18,
+			func() {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata(denom, 18))
+			},
+			true,
+		},
+		{
+			"invalid metadata",
+			18,
+			func() {
+				invalid := metadata("photon", 18)
+				invalid.Symbol = ""
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, invalid)
+			},
+			true,
+		},
+		{
+			"alias conflicting with another denom",
+			18,
+			func() {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata("photon", 18, "nanophoton"))
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+					Base:    "uatom",
+					Display: "atom",
+					Name:    "Atom",
+					Symbol:  "ATOM",
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: "uatom", Exponent: 0, Aliases: []string{"nanophoton"}},
+						{Denom: "atom", Exponent: 6},
+					},
+				})
+			},
+			true,
+		},
+		{
+			"unrelated denoms",
+			18,
+			func() {
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata("photon", 18))
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+					Base:    "uatom",
+					Display: "atom",
+					Name:    "Atom",
+					Symbol:  "ATOM",
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: "uatom", Exponent: 0},
+						{Denom: "atom", Exponent: 6},
+					},
+				})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.SetDenomMetadataDecimals(tc.decimals)
+			defer suite.app.EvmKeeper.SetDenomMetadataDecimals(0)
+
+			tc.malleate()
+			err := suite.app.EvmKeeper.ValidateDenomMetadata(suite.ctx, denom)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrInvalidDenomMetadata)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
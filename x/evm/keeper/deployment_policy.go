@@ -0,0 +1,38 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// checkDeployedCode checks the code of the contracts deployed by a message against the deployment
+// policy, including the contracts created by other contracts.
+func checkDeployedCode(stateDB *statedb.StateDB, policy types.DeploymentPolicy) error {
+	if policy.IsEmpty() {
+		return nil
+	}
+	return stateDB.ForEachDeployedCode(func(addr common.Address, code []byte) error {
+		if err := policy.CheckCode(code); err != nil {
+			return errorsmod.Wrapf(err, "contract %s", addr.Hex())
+		}
+		return nil
+	})
+}
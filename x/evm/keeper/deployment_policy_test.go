@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestDeploymentPolicy() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(1000))
+	suite.Require().NoError(err)
+	data := append(types.ERC20Contract.Bin, ctorArgs...)
+
+	deploy := func() (*types.MsgEthereumTxResponse, []byte) {
+		nonce := k.GetNonce(suite.ctx, suite.address)
+		msg := ethtypes.NewMessage(
+			suite.address, nil, nonce, big.NewInt(0), 2_000_000,
+			big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true,
+		)
+		res, err := k.ApplyMessage(suite.ctx, msg, nil, true)
+		suite.Require().NoError(err)
+		return res, k.GetCode(suite.ctx, common.BytesToHash(k.GetAccountOrEmpty(suite.ctx, crypto.CreateAddress(suite.address, nonce)).CodeHash))
+	}
+
+	// the ERC20 reads msg.sender
+	params := k.GetParams(suite.ctx)
+	params.DeploymentPolicy = types.DeploymentPolicy{DeniedPatterns: []string{"CALLER"}}
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+	res, code := deploy()
+	suite.Require().True(res.Failed())
+	suite.Require().Contains(res.VmError, types.ErrCodeRejected.Error())
+	suite.Require().Empty(code)
+
+	params.DeploymentPolicy = types.DeploymentPolicy{DeniedPatterns: []string{"SELFDESTRUCT"}}
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+	res, code = deploy()
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().NotEmpty(code)
+}
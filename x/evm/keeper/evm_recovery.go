@@ -0,0 +1,107 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// runEVM runs the EVM execution and recovers from the panics raised by it, so that a
+// bug in the interpreter, a precompile or a tracer fails the transaction instead of
+// crashing the node. The state changes of the execution are reverted and the returned
+// error is constant, since it ends up in the tx result committed by the consensus, the
+// stack hash identifying the panic site is only logged. The out of gas panics of the SDK
+// gas meters are not recovered, they are handled by the baseapp.
+func (k *Keeper) runEVM(ctx sdk.Context, stateDB *statedb.StateDB, txHash common.Hash, run func()) (err error) {
+	snapshot := stateDB.Snapshot()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if _, ok := r.(sdk.ErrorOutOfGas); ok {
+			panic(r)
+		}
+		// the call to a paused contract fails the execution like a revert, the execution is
+		// deterministic so it isn't logged
+		if paused, ok := r.(pausedCallPanic); ok {
+			stateDB.RevertToSnapshot(snapshot)
+			err = errorsmod.Wrapf(types.ErrContractPaused, "contract %s", paused.address.Hex())
+			return
+		}
+
+		stackHash := panicStackHash()
+		stateDB.RevertToSnapshot(snapshot)
+
+		k.Logger(ctx).Error(
+			"recovered from evm execution panic",
+			"height", ctx.BlockHeight(),
+			"tx-hash", txHash.Hex(),
+			"stack-hash", stackHash.Hex(),
+			"panic", fmt.Sprint(r),
+			"stack", string(debug.Stack()),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEVMPanic,
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeyEthereumTxHash, txHash.Hex()),
+			),
+		)
+
+		telemetry.IncrCounter(1, types.ModuleName, types.MetricKeyRecoveredPanic)
+
+		err = types.ErrEVMPanic
+	}()
+
+	run()
+	return nil
+}
+
+// panicStackHash returns the hash of the function names and lines of the call frames of the
+// panicking goroutine. Unlike the printed stack trace it doesn't contain goroutine ids nor
+// argument values, so it identifies the panic site consistently across the nodes running the
+// same binary, but it differs between binaries so it must not be part of the tx result.
+func panicStackHash() common.Hash {
+	pcs := make([]uintptr, 64)
+	// skip runtime.Callers, panicStackHash and the deferred function
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	hasher := sha256.New()
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(hasher, "%s:%d\n", frame.Function, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return common.BytesToHash(hasher.Sum(nil))
+}
@@ -0,0 +1,253 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// ConvertFeeTokens converts the fee tokens of the payer to the evm denom missing from its balance to
+// pay the fees, trying the tokens in the order of the params until one of them is converted. It's a
+// no-op if the balance covers the fees or no fee token is set. It returns the gas used by the
+// conversion, charged to the tx along with the gas used by its execution.
+//
+// The payer opts in a fee token by approving the evm module account to spend it, the allowance
+// signed by the payer being the maximum amount of tokens converted. Each conversion transfers the
+// tokens quoted by the converter from the payer to the converter within the allowance, then calls
+// the converter which must transfer the missing evm denom to the payer. A failed conversion is
+// reverted before trying the next token. The conversion calls share a gas limit of
+// FeeConversionGasLimit, bounded by the gas limit of the tx.
+func (k *Keeper) ConvertFeeTokens(ctx sdk.Context, payer common.Address, fees sdk.Coins, gasLimit uint64) (uint64, error) {
+	params := k.GetParams(ctx)
+	if !params.HasFeeTokens() {
+		return 0, nil
+	}
+
+	fee := fees.AmountOf(params.EvmDenom)
+	balance := k.bankKeeper.GetBalance(ctx, payer.Bytes(), params.EvmDenom).Amount
+	if balance.GTE(fee) {
+		return 0, nil
+	}
+	missing := fee.Sub(balance)
+	feeAmount := params.ToWei(missing)
+
+	if gasLimit > types.FeeConversionGasLimit {
+		gasLimit = types.FeeConversionGasLimit
+	}
+	meter := sdk.NewGasMeter(gasLimit)
+
+	errs := make([]string, 0, len(params.FeeTokens))
+	for _, feeToken := range params.FeeTokens {
+		cacheCtx, write := ctx.CacheContext()
+		tokenAmount, err := k.convertFeeToken(cacheCtx, meter, feeToken, payer, feeAmount)
+		if err == nil {
+			if balance := k.bankKeeper.GetBalance(cacheCtx, payer.Bytes(), params.EvmDenom).Amount; balance.LT(fee) {
+				err = fmt.Errorf("converter %s didn't pay the fees, balance %s", feeToken.Converter, balance)
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", feeToken.Address, err))
+			if meter.IsOutOfGas() {
+				break
+			}
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFeeTokenConversion,
+			sdk.NewAttribute(types.AttributeKeyPayer, payer.Hex()),
+			sdk.NewAttribute(types.AttributeKeyToken, common.HexToAddress(feeToken.Address).Hex()),
+			sdk.NewAttribute(types.AttributeKeyTokenAmount, tokenAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, missing.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, params.EvmDenom),
+		))
+		return meter.GasConsumed(), nil
+	}
+
+	return meter.GasConsumed(), errorsmod.Wrapf(
+		types.ErrFeeTokenConversion,
+		"payer %s misses %s%s: %s", payer, missing, params.EvmDenom, strings.Join(errs, "; "),
+	)
+}
+
+// convertFeeToken converts the amount of the fee token paying the fee amount in wei, and returns it.
+func (k *Keeper) convertFeeToken(
+	ctx sdk.Context, meter sdk.GasMeter, feeToken types.FeeToken, payer common.Address, feeAmount *big.Int,
+) (*big.Int, error) {
+	token := common.HexToAddress(feeToken.Address)
+	converter := common.HexToAddress(feeToken.Converter)
+	module := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+
+	ret, err := k.callFeeContract(ctx, meter, module, token, types.ERC20Contract.ABI, "allowance", false, payer, module)
+	if err != nil {
+		return nil, err
+	}
+	out, err := types.ERC20Contract.ABI.Unpack("allowance", ret)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid allowance")
+	}
+	allowance, ok := out[0].(*big.Int)
+	if !ok || allowance.Sign() <= 0 {
+		return nil, errors.New("the evm module isn't allowed to spend the token")
+	}
+
+	ret, err = k.callFeeContract(ctx, meter, module, converter, types.FeeConverterABI, "quoteFee", false, token, feeAmount)
+	if err != nil {
+		return nil, err
+	}
+	out, err = types.FeeConverterABI.Unpack("quoteFee", ret)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid quote")
+	}
+	tokenAmount, ok := out[0].(*big.Int)
+	if !ok || tokenAmount.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid quote %v", out[0])
+	}
+	if tokenAmount.Cmp(allowance) > 0 {
+		return nil, fmt.Errorf("quote of %s tokens exceeds the allowance of %s", tokenAmount, allowance)
+	}
+
+	// the tokens not returning a value on transfer are supported
+	ret, err = k.callFeeContract(ctx, meter, module, token, types.ERC20Contract.ABI, "transferFrom", true, payer, converter, tokenAmount)
+	if err != nil {
+		return nil, err
+	}
+	if len(ret) > 0 {
+		out, err := types.ERC20Contract.ABI.Unpack("transferFrom", ret)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "invalid transfer result")
+		}
+		if success, ok := out[0].(bool); !ok || !success {
+			return nil, fmt.Errorf("transfer of %s tokens to the converter failed", tokenAmount)
+		}
+	}
+
+	if _, err := k.callFeeContract(ctx, meter, module, converter, types.FeeConverterABI, "convertFee", true, token, payer, tokenAmount, feeAmount); err != nil {
+		return nil, err
+	}
+	return tokenAmount, nil
+}
+
+// callFeeContract calls a method of a contract involved in the fee token conversions, the call is
+// limited to the gas left in the meter, which is charged the gas used.
+func (k *Keeper) callFeeContract(
+	ctx sdk.Context,
+	meter sdk.GasMeter,
+	from, to common.Address,
+	contract abi.ABI,
+	method string,
+	commit bool,
+	args ...interface{},
+) ([]byte, error) {
+	data, err := contract.Pack(method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to pack %s", method)
+	}
+
+	gasLimit := meter.GasRemaining()
+	evmCtx := ctx.WithGasMeter(ethermint.NewInfiniteGasMeterWithLimit(gasLimit))
+	msg := ethtypes.NewMessage(
+		from, &to, k.GetNonce(evmCtx, from), new(big.Int), gasLimit,
+		new(big.Int), new(big.Int), new(big.Int), data, nil, true,
+	)
+
+	// the gas used by the call is captured by a tracer, the gas used of the evm transactions being
+	// raised to the min gas multiplier of their gas limit
+	ethCfg := k.GetParams(ctx).ChainConfig.EthereumConfig(k.eip155ChainID)
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, ethCfg, false)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to compute the intrinsic gas of %s", method)
+	}
+	tracer := &feeCallGasTracer{}
+	res, err := k.ApplyMessage(evmCtx, msg, tracer, commit)
+	if err != nil {
+		// the call isn't executed if the gas left doesn't cover its intrinsic gas
+		meter.ConsumeGas(gasLimit, "fee token conversion")
+		return nil, errorsmod.Wrapf(err, "failed to call %s on %s", method, to)
+	}
+	meter.ConsumeGas(intrinsicGas+tracer.gasUsed, "fee token conversion")
+	if res.Failed() {
+		return nil, fmt.Errorf("%s on %s failed: %s", method, to, res.VmError)
+	}
+	return res.Ret, nil
+}
+
+// feeCallGasTracer captures the gas used by the execution of a fee contract call.
+type feeCallGasTracer struct {
+	types.NoOpTracer
+	gasUsed uint64
+}
+
+// CaptureEnd implements vm.EVMLogger, it's called at the end of the top call frame.
+func (t *feeCallGasTracer) CaptureEnd(_ []byte, gasUsed uint64, _ time.Duration, _ error) {
+	t.gasUsed = gasUsed
+}
+
+// SetFeeConversionGasTransient records the gas used by the fee token conversion of the tx, charged
+// to the tx when it's executed.
+func (k Keeper) SetFeeConversionGasTransient(ctx sdk.Context, txHash common.Hash, gas uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeConversionGas)
+	store.Set(txHash.Bytes(), sdk.Uint64ToBigEndian(gas))
+}
+
+// GetFeeConversionGasTransient returns the gas used by the fee token conversion of the tx, 0 if its
+// fees weren't paid with fee tokens.
+func (k Keeper) GetFeeConversionGasTransient(ctx sdk.Context, txHash common.Hash) uint64 {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeConversionGas)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// chargeFeeConversionGas returns the message with its gas limit reduced by the gas used by the fee
+// token conversion of the tx, along with that gas, so that the execution and the conversion share
+// the gas limit signed by the sender. It fails if the conversion used more gas than the gas limit.
+func (k Keeper) chargeFeeConversionGas(ctx sdk.Context, txHash common.Hash, msg core.Message) (core.Message, uint64, error) {
+	gas := k.GetFeeConversionGasTransient(ctx, txHash)
+	if gas == 0 {
+		return msg, 0, nil
+	}
+	if gas > msg.Gas() {
+		return nil, 0, errorsmod.Wrapf(
+			errortypes.ErrOutOfGas,
+			"fee conversion gas %d exceeds the gas limit %d", gas, msg.Gas(),
+		)
+	}
+	return ethtypes.NewMessage(
+		msg.From(), msg.To(), msg.Nonce(), msg.Value(), msg.Gas()-gas,
+		msg.GasPrice(), msg.GasFeeCap(), msg.GasTipCap(), msg.Data(), msg.AccessList(), msg.IsFake(),
+	), gas, nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// feeConverterCode returns the code of a converter quoting two tokens per wei of fee, and paying the
+// fee amount to the payer from its own balance.
+func feeConverterCode() []byte {
+	quoteID := types.FeeConverterABI.Methods["quoteFee"].ID
+	convertID := types.FeeConverterABI.Methods["convertFee"].ID
+
+	code := []byte{0x60, 0x00, 0x35, 0x60, 0xe0, 0x1c} // selector := calldataload(0) >> 224
+	code = append(code, 0x80, 0x63)                    // dup1 push4 quoteFee
+	code = append(code, quoteID...)
+	code = append(code, 0x14, 0x60, 0x1d, 0x57, 0x63) // eq push1 quote jumpi push4 convertFee
+	code = append(code, convertID...)
+	code = append(code,
+		0x14, 0x60, 0x2c, 0x57, // eq push1 convert jumpi
+		0x60, 0x00, 0x80, 0xfd, // revert(0, 0)
+		// quote: return 2 * feeAmount
+		0x5b, 0x60, 0x24, 0x35, 0x60, 0x02, 0x02, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3,
+		// convert: call(gas, payer, feeAmount, 0, 0, 0, 0)
+		0x5b, 0x60, 0x00, 0x80, 0x80, 0x80, 0x60, 0x64, 0x35, 0x60, 0x24, 0x35, 0x5a, 0xf1,
+		0x60, 0x41, 0x57, 0x60, 0x00, 0x80, 0xfd, // revert if the call failed
+		0x5b, 0x00,
+	)
+	return code
+}
+
+func (suite *KeeperTestSuite) TestConvertFeeTokens() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	payer := tests.GenerateAddress()
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, payer.Bytes()))
+	suite.Require().NoError(k.SetBalance(suite.ctx, payer, big.NewInt(40)))
+
+	token := suite.DeployTestContract(suite.T(), payer, big.NewInt(1000))
+	converter := tests.GenerateAddress()
+	suite.Require().NoError(k.DeploySystemContract(suite.ctx, converter, feeConverterCode(), nil))
+	suite.Require().NoError(k.SetBalance(suite.ctx, converter, big.NewInt(1000)))
+
+	params := k.GetParams(suite.ctx)
+	params.FeeTokens = []types.FeeToken{
+		// no converter deployed, the conversion fails and the next token is tried
+		{Address: tests.GenerateAddress().Hex(), Converter: tests.GenerateAddress().Hex()},
+		{Address: token.Hex(), Converter: converter.Hex()},
+	}
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+	module := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+	callToken := func(from common.Address, method string, args ...interface{}) []byte {
+		data, err := types.ERC20Contract.ABI.Pack(method, args...)
+		suite.Require().NoError(err)
+		res, err := k.EthereumCall(sdk.WrapSDKContext(suite.ctx), types.NewMsgEthereumCall(from.Bytes(), &token, data, sdk.ZeroInt(), 100_000))
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		return res.Ret
+	}
+	tokenBalance := func(addr common.Address) *big.Int {
+		return new(big.Int).SetBytes(callToken(suite.address, "balanceOf", addr))
+	}
+
+	// the balance covers the fees
+	gas, err := k.ConvertFeeTokens(suite.ctx, payer, sdk.NewCoins(sdk.NewInt64Coin(params.EvmDenom, 40)), 1_000_000)
+	suite.Require().NoError(err)
+	suite.Require().Zero(gas)
+	suite.Require().Equal(big.NewInt(1000), tokenBalance(payer))
+
+	// the payer didn't allow the evm module to spend its tokens
+	fees := sdk.NewCoins(sdk.NewInt64Coin(params.EvmDenom, 100))
+	gas, err = k.ConvertFeeTokens(suite.ctx, payer, fees, 1_000_000)
+	suite.Require().ErrorIs(err, types.ErrFeeTokenConversion)
+	suite.Require().NotZero(gas)
+	suite.Require().Equal(big.NewInt(1000), tokenBalance(payer))
+
+	// the quote of 120 tokens exceeds the allowance
+	callToken(payer, "approve", module, big.NewInt(100))
+	_, err = k.ConvertFeeTokens(suite.ctx, payer, fees, 1_000_000)
+	suite.Require().ErrorIs(err, types.ErrFeeTokenConversion)
+	suite.Require().Equal(big.NewInt(1000), tokenBalance(payer))
+
+	// the conversion calls are limited by the gas limit of the tx
+	callToken(payer, "approve", module, big.NewInt(150))
+	gas, err = k.ConvertFeeTokens(suite.ctx, payer, fees, 30_000)
+	suite.Require().ErrorIs(err, types.ErrFeeTokenConversion)
+	suite.Require().Equal(uint64(30_000), gas)
+	suite.Require().Equal(big.NewInt(1000), tokenBalance(payer))
+
+	// 60 are missing, paid with 120 tokens
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	gas, err = k.ConvertFeeTokens(suite.ctx, payer, fees, 1_000_000)
+	suite.Require().NoError(err)
+	suite.Require().NotZero(gas)
+	suite.Require().LessOrEqual(gas, types.FeeConversionGasLimit)
+	suite.Require().NoError(k.DeductTxCostsFromUserBalance(suite.ctx, fees, payer))
+	suite.Require().Equal(big.NewInt(880), tokenBalance(payer))
+	suite.Require().Equal(big.NewInt(120), tokenBalance(converter))
+	suite.Require().Equal(big.NewInt(30), new(big.Int).SetBytes(callToken(suite.address, "allowance", payer, module)))
+	suite.Require().Equal(big.NewInt(0), k.GetBalance(suite.ctx, payer))
+	suite.Require().Equal(big.NewInt(940), k.GetBalance(suite.ctx, converter))
+
+	var conversions [][]string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeFeeTokenConversion {
+			continue
+		}
+		var attrs []string
+		for _, attr := range event.Attributes {
+			attrs = append(attrs, string(attr.Value))
+		}
+		conversions = append(conversions, attrs)
+	}
+	suite.Require().Equal([][]string{{payer.Hex(), token.Hex(), "120", "60", params.EvmDenom}}, conversions)
+
+	// the payer doesn't hold enough tokens, nothing is converted
+	callToken(payer, "approve", module, big.NewInt(10_000))
+	fees = sdk.NewCoins(sdk.NewInt64Coin(params.EvmDenom, 500))
+	_, err = k.ConvertFeeTokens(suite.ctx, payer, fees, 1_000_000)
+	suite.Require().ErrorIs(err, types.ErrFeeTokenConversion)
+	suite.Require().Equal(big.NewInt(880), tokenBalance(payer))
+	suite.Require().Equal(big.NewInt(940), k.GetBalance(suite.ctx, converter))
+}
+
+func (suite *KeeperTestSuite) TestFeeConversionGasCharged() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	ethSigner := ethtypes.LatestSignerForChainID(k.ChainID())
+	txData := &ethtypes.LegacyTx{GasPrice: big.NewInt(0), Gas: 30_000, To: &common.Address{}, Value: big.NewInt(0)}
+	tx, err := newSignedEthTx(txData, k.GetNonce(suite.ctx, suite.address), sdk.AccAddress(suite.address.Bytes()), suite.signer, ethSigner)
+	suite.Require().NoError(err)
+
+	// the gas used by the conversion in the ante handler is added to the gas used by the execution,
+	// which is left the rest of the gas limit
+	k.SetFeeConversionGasTransient(suite.ctx, tx.AsTransaction().Hash(), 5_000)
+	res, err := k.ApplyTransaction(suite.ctx, tx)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed())
+	suite.Require().Equal(uint64(26_000), res.GasUsed)
+
+	// the conversion can't use more gas than the gas limit
+	tx, err = newSignedEthTx(txData, k.GetNonce(suite.ctx, suite.address), sdk.AccAddress(suite.address.Bytes()), suite.signer, ethSigner)
+	suite.Require().NoError(err)
+	k.SetFeeConversionGasTransient(suite.ctx, tx.AsTransaction().Hash(), 30_001)
+	_, err = k.ApplyTransaction(suite.ctx, tx)
+	suite.Require().ErrorIs(err, errortypes.ErrOutOfGas)
+}
@@ -67,11 +67,19 @@ func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*typ
 	ctx := sdk.UnwrapSDKContext(c)
 	acct := k.GetAccountOrEmpty(ctx, addr)
 
-	return &types.QueryAccountResponse{
+	res := &types.QueryAccountResponse{
 		Balance:  acct.Balance.String(),
 		CodeHash: common.BytesToHash(acct.CodeHash).Hex(),
 		Nonce:    acct.Nonce,
-	}, nil
+	}
+	if req.WithStorageHash {
+		storageHash, err := k.GetStorageHash(ctx, addr)
+		if err != nil {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		res.StorageHash = storageHash.Hex()
+	}
+	return res, nil
 }
 
 func (k Keeper) CosmosAccount(c context.Context, req *types.QueryCosmosAccountRequest) (*types.QueryCosmosAccountResponse, error) {
@@ -224,13 +232,36 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	}, nil
 }
 
+// queryContext unwraps the sdk context of an evm execution query, its logger tagged with the ID of
+// the JSON-RPC request the query is issued for, if any. The deadline of the query is set on the
+// context, so that the EVM executions and the store iterations are aborted once it's exceeded.
+func queryContext(c context.Context) sdk.Context {
+	ctx := sdk.UnwrapSDKContext(c)
+	if id := types.RequestIDFromIncomingContext(c); id != "" {
+		ctx = ctx.WithLogger(ctx.Logger().With("request_id", id))
+	}
+	if _, ok := c.Deadline(); ok {
+		ctx = ctx.WithContext(c)
+	}
+	return ctx
+}
+
+// contextErr returns the error of the go context of the query once its deadline is exceeded or it's
+// canceled, nil otherwise.
+func contextErr(ctx sdk.Context) error {
+	if goCtx := ctx.Context(); goCtx != nil {
+		return goCtx.Err()
+	}
+	return nil
+}
+
 // EthCall implements eth_call rpc api.
 func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.MsgEthereumTxResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := queryContext(c)
 
 	var args types.TransactionArgs
 	err := json.Unmarshal(req.Args, &args)
@@ -241,11 +272,17 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	cfg, err := k.EVMConfigAtHeight(ctx, ctx.BlockHeight(), GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// apply the state overrides in a branch of the query context
+	ctx, _ = ctx.CacheContext()
+	if err := k.ApplyStateOverrides(ctx, req.Overrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -266,13 +303,166 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// SimulateBundle implements the ethermint_simulateBundle rpc api. The calls are executed in order
+// on a branch of the query state, each call seeing the state changes of the previous ones. The
+// nonce of the sender is incremented after each call, as it would be by a transaction. The isolated
+// calls of the eth_call batches discard their state changes instead, only sharing the state reads.
+func (k Keeper) SimulateBundle(c context.Context, req *types.QuerySimulateBundleRequest) (*types.QuerySimulateBundleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Calls) > types.MaxBundleCalls {
+		return nil, status.Errorf(codes.InvalidArgument, "bundle of %d calls exceeds the maximum of %d", len(req.Calls), types.MaxBundleCalls)
+	}
+
+	ctx := queryContext(c)
+
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfigAtHeight(ctx, ctx.BlockHeight(), GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the calls are executed in a branch of the query context shared by the bundle
+	ctx, _ = ctx.CacheContext()
+	if err := k.ApplyStateOverrides(ctx, req.Overrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	blockHash := common.BytesToHash(ctx.HeaderHash())
+	results := make([]*types.MsgEthereumTxResponse, 0, len(req.Calls))
+	var logIndex uint
+	for i, bz := range req.Calls {
+		if req.Isolated {
+			// each call of a batch gets its own outcome, the calls failing before the execution
+			// return their error like the failed executions
+			res, err := k.isolatedCall(ctx, bz, req.GasCap, cfg, blockHash)
+			if err != nil {
+				res = &types.MsgEthereumTxResponse{VmError: err.Error()}
+			}
+			results = append(results, res)
+			continue
+		}
+
+		var args types.TransactionArgs
+		if err := json.Unmarshal(bz, &args); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d: %s", i, err.Error())
+		}
+
+		from := args.GetFrom()
+		nonce := k.GetNonce(ctx, from)
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d: %s", i, err.Error())
+		}
+
+		txConfig := statedb.NewTxConfig(blockHash, common.Hash{}, uint(i), logIndex)
+		res, err := k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "call %d: %s", i, err.Error())
+		}
+
+		// the contract creations already increment the nonce
+		if msg.To() != nil {
+			account := k.GetAccountOrEmpty(ctx, from)
+			account.Nonce = nonce + 1
+			if err := k.SetAccount(ctx, from, account); err != nil {
+				return nil, status.Errorf(codes.Internal, "call %d: %s", i, err.Error())
+			}
+		}
+
+		logIndex += uint(len(res.Logs))
+		results = append(results, res)
+	}
+
+	return &types.QuerySimulateBundleResponse{Results: results}, nil
+}
+
+// isolatedCall executes an isolated call of SimulateBundle, the state changes are discarded and the
+// reads are cached by the context branch shared by the bundle.
+func (k Keeper) isolatedCall(
+	ctx sdk.Context, bz []byte, gasCap uint64, cfg *statedb.EVMConfig, blockHash common.Hash,
+) (*types.MsgEthereumTxResponse, error) {
+	var args types.TransactionArgs
+	if err := json.Unmarshal(bz, &args); err != nil {
+		return nil, err
+	}
+
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, statedb.NewEmptyTxConfig(blockHash))
+}
+
+// StateDiff implements the ethermint_dryRunTransaction rpc api. It executes the call like EthCall
+// and returns the state changes the execution would apply, which are discarded. The fees and the
+// nonce increment of the sender, applied by the ante handler to the transactions, aren't included.
+func (k Keeper) StateDiff(c context.Context, req *types.EthCallRequest) (*types.QueryStateDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := queryContext(c)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfigAtHeight(ctx, ctx.BlockHeight(), GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// apply the state overrides in a branch of the query context
+	ctx, _ = ctx.CacheContext()
+	if err := k.ApplyStateOverrides(ctx, req.Overrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// the commit is recorded as a diff instead of being applied
+	recorder := newStateDiffRecorder(&k)
+	res, _, err := k.applyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig, recorder)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStateDiffResponse{
+		Result:   res,
+		Accounts: recorder.diff(),
+	}, nil
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := queryContext(c)
 	chainID, err := getChainID(ctx, req.ChainId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -288,6 +478,71 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	cfg, err := k.EVMConfigAtHeight(ctx, ctx.BlockHeight(), GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+
+	gas, err := k.estimateGas(ctx, args, req.GasCap, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &types.EstimateGasResponse{Gas: gas}, nil
+}
+
+// EstimateGasBulk implements the ethermint_estimateGasBulk rpc api. The gas of each call is
+// estimated like EstimateGas, independently of the other calls, in a branch of the query context
+// shared by the calls so that the state reads are cached. The estimation errors are returned in the
+// results.
+func (k Keeper) EstimateGasBulk(c context.Context, req *types.QueryEstimateGasBulkRequest) (*types.QueryEstimateGasBulkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Calls) > types.MaxBundleCalls {
+		return nil, status.Errorf(codes.InvalidArgument, "bulk of %d calls exceeds the maximum of %d", len(req.Calls), types.MaxBundleCalls)
+	}
+	if req.GasCap < ethparams.TxGas {
+		return nil, status.Error(codes.InvalidArgument, "gas cap cannot be lower than 21,000")
+	}
+
+	ctx := queryContext(c)
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfigAtHeight(ctx, ctx.BlockHeight(), GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+
+	ctx, _ = ctx.CacheContext()
+
+	results := make([]types.GasEstimateResult, len(req.Calls))
+	for i, bz := range req.Calls {
+		var args types.TransactionArgs
+		if err := json.Unmarshal(bz, &args); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d: %s", i, err.Error())
+		}
+
+		gas, err := k.estimateGas(ctx, args, req.GasCap, cfg)
+		if err != nil {
+			results[i].Error = err.Error()
+			var revertErr *types.RevertError
+			if errors.As(err, &revertErr) {
+				results[i].Reverted = true
+				results[i].RevertData = common.FromHex(revertErr.ErrorData().(string))
+			}
+			continue
+		}
+		results[i].Gas = gas
+	}
+
+	return &types.QueryEstimateGasBulkResponse{Results: results}, nil
+}
+
+// estimateGas binary searches the lowest gas limit of the call executing without error, capped by
+// the gas cap.
+func (k Keeper) estimateGas(ctx sdk.Context, args types.TransactionArgs, reqGasCap uint64, cfg *statedb.EVMConfig) (uint64, error) {
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -304,21 +559,17 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		if params != nil && params.Block != nil && params.Block.MaxGas > 0 {
 			hi = uint64(params.Block.MaxGas)
 		} else {
-			hi = req.GasCap
+			hi = reqGasCap
 		}
 	}
 
 	// TODO: Recap the highest gas limit with account's available balance.
 
 	// Recap the highest gas allowance with specified gascap.
-	if req.GasCap != 0 && hi > req.GasCap {
-		hi = req.GasCap
+	if reqGasCap != 0 && hi > reqGasCap {
+		hi = reqGasCap
 	}
 	gasCap = hi
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to load evm config")
-	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -327,9 +578,9 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
 	// convert the tx args to an ethereum message
-	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	msg, err := args.ToMessage(reqGasCap, cfg.BaseFee)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return 0, status.Error(codes.Internal, err.Error())
 	}
 
 	// NOTE: the errors from the executable below should be consistent with go-ethereum,
@@ -366,28 +617,28 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	// Execute the binary search and hone in on an executable gas limit
 	hi, err = types.BinSearch(lo, hi, executable)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// Reject the transaction as invalid if it still fails at the highest allowance
 	if hi == gasCap {
 		failed, result, err := executable(hi)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 
 		if failed {
 			if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
 				if result.VmError == vm.ErrExecutionReverted.Error() {
-					return nil, types.NewExecErrorWithReason(result.Ret)
+					return 0, types.NewExecErrorWithReason(result.Ret)
 				}
-				return nil, errors.New(result.VmError)
+				return 0, errors.New(result.VmError)
 			}
 			// Otherwise, the specified gas cap is too low
-			return nil, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
+			return 0, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
 		}
 	}
-	return &types.EstimateGasResponse{Gas: hi}, nil
+	return hi, nil
 }
 
 // TraceTx configures a new tracer according to the provided configuration, and
@@ -409,7 +660,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := queryContext(c)
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -417,11 +668,12 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	// the block is replayed with the params in effect at its beginning
+	cfg, err := k.EVMConfigAtHeight(ctx, contextHeight-1, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
 	}
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	signer := types.MakeSigner(cfg.ChainConfig, ctx.BlockHeight())
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 	for i, tx := range req.Predecessors {
@@ -486,7 +738,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := queryContext(c)
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -495,11 +747,12 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	// the block is replayed with the params in effect at its beginning
+	cfg, err := k.EVMConfigAtHeight(ctx, contextHeight-1, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	signer := types.MakeSigner(cfg.ChainConfig, ctx.BlockHeight())
 	txsLength := len(req.Txs)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
@@ -529,6 +782,71 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the queried state, with the optional state
+// overrides applied. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := queryContext(c)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfigAtHeight(ctx, ctx.BlockHeight(), GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// apply the state overrides in a branch of the query context
+	ctx, _ = ctx.CacheContext()
+	if err := k.ApplyStateOverrides(ctx, req.Overrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -539,6 +857,25 @@ func (k *Keeper) traceTx(
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceMsg traces the execution of a message with the tracer of the trace config,
+// defaulting to the struct logger.
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -547,10 +884,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -570,7 +903,7 @@ func (k *Keeper) traceTx(
 		Overrides:        overrides,
 	}
 
-	tracer = logger.NewStructLogger(&logConfig)
+	tracer = types.NewLimitedStructLogger(&logConfig, traceConfig)
 
 	tCtx := &tracers.Context{
 		BlockHash: txConfig.BlockHash,
@@ -633,6 +966,57 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// ChainEpochs implements the Query/ChainEpochs gRPC method
+func (k Keeper) ChainEpochs(c context.Context, _ *types.QueryChainEpochsRequest) (*types.QueryChainEpochsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryChainEpochsResponse{Epochs: k.GetChainEpochs(ctx)}, nil
+}
+
+// StorageUsage implements the Query/StorageUsage gRPC method
+func (k Keeper) StorageUsage(c context.Context, req *types.QueryStorageUsageRequest) (*types.QueryStorageUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryStorageUsageResponse{
+		Usage: k.GetStorageUsage(ctx, common.HexToAddress(req.Address)),
+	}, nil
+}
+
+// Contracts implements the Query/Contracts gRPC method
+func (k Keeper) Contracts(c context.Context, req *types.QueryContractsRequest) (*types.QueryContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := queryContext(c)
+	contracts, pageRes, err := k.GetContracts(ctx, req.Pagination)
+	if err != nil {
+		if ctxErr := contextErr(ctx); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MinGasPriceMultiplier implements the Query/MinGasPriceMultiplier gRPC method
+func (k Keeper) MinGasPriceMultiplier(_ context.Context, _ *types.QueryMinGasPriceMultiplierRequest) (*types.QueryMinGasPriceMultiplierResponse, error) {
+	return &types.QueryMinGasPriceMultiplierResponse{Multiplier: k.GetMinGasPriceMultiplier()}, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -1,20 +1,26 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/statedb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -71,6 +77,22 @@ func (suite *KeeperTestSuite) TestQueryAccount() {
 			},
 			true,
 		},
+		{
+			"success - with the storage hash",
+			func() {
+				expAccount = &types.QueryAccountResponse{
+					Balance:     "0",
+					CodeHash:    common.BytesToHash(crypto.Keccak256(nil)).Hex(),
+					Nonce:       0,
+					StorageHash: ethtypes.EmptyRootHash.Hex(),
+				}
+				req = &types.QueryAccountRequest{
+					Address:         suite.address.String(),
+					WithStorageHash: true,
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -981,6 +1003,71 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceTxCaptureLimits() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	trace := func(traceConfig *types.TraceConfig) ethlogger.ExecutionResult {
+		res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+			Msg:         txMsg,
+			TraceConfig: traceConfig,
+		})
+		suite.Require().NoError(err)
+
+		var result ethlogger.ExecutionResult
+		suite.Require().NoError(json.Unmarshal(res.Data, &result))
+		return result
+	}
+
+	maxSizes := func(result ethlogger.ExecutionResult) (memory, stack, storage int) {
+		for _, log := range result.StructLogs {
+			if log.Memory != nil && len(*log.Memory) > memory {
+				memory = len(*log.Memory)
+			}
+			if log.Stack != nil && len(*log.Stack) > stack {
+				stack = len(*log.Stack)
+			}
+			if log.Storage != nil && len(*log.Storage) > storage {
+				storage = len(*log.Storage)
+			}
+		}
+		return memory, stack, storage
+	}
+
+	memory, stack, storage := maxSizes(trace(&types.TraceConfig{EnableMemory: true}))
+	suite.Require().Greater(memory, 1)
+	suite.Require().Greater(stack, 1)
+	suite.Require().Greater(storage, 1)
+
+	result := trace(&types.TraceConfig{
+		EnableMemory:   true,
+		MaxMemorySize:  32,
+		MaxStackSize:   1,
+		MaxStorageSize: 1,
+	})
+	suite.Require().NotEmpty(result.StructLogs)
+	memory, stack, storage = maxSizes(result)
+	suite.Require().Equal(1, memory)
+	suite.Require().Equal(1, stack)
+	suite.Require().Equal(1, storage)
+
+	// the memory limit is rounded up to the 32 bytes words
+	for _, tc := range []struct {
+		limit    uint64
+		expWords int
+	}{
+		{1, 1},
+		{31, 1},
+		{33, 2},
+	} {
+		memory, _, _ = maxSizes(trace(&types.TraceConfig{EnableMemory: true, MaxMemorySize: tc.limit}))
+		suite.Require().Equal(tc.expWords, memory, "limit %d", tc.limit)
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	var (
 		txs         []*types.MsgEthereumTx
@@ -1335,6 +1422,110 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallStateOverrides() {
+	suite.SetupTest()
+
+	from := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	// returns the value of the storage slot 0
+	code := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	value := hexutil.Big(*big.NewInt(1000))
+	balance := &value
+	slot := common.BigToHash(big.NewInt(42))
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &contract, Value: &value})
+	suite.Require().NoError(err)
+
+	call := func(overrides *types.StateOverride) (*types.MsgEthereumTxResponse, error) {
+		req := &types.EthCallRequest{Args: args, GasCap: uint64(config.DefaultGasCap)}
+		if overrides != nil {
+			req.Overrides, err = json.Marshal(overrides)
+			suite.Require().NoError(err)
+		}
+		return suite.queryClient.EthCall(suite.ctx, req)
+	}
+
+	// the sender can't afford the value without the balance override
+	res, err := call(nil)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+
+	res, err = call(&types.StateOverride{
+		from: {Balance: &balance},
+		contract: {
+			Code:      &code,
+			StateDiff: &map[common.Hash]common.Hash{{}: slot},
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed())
+	suite.Require().Equal(slot.Bytes(), res.Ret)
+
+	// the overrides are not persisted
+	suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, from).Sign())
+	suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(code)))
+
+	_, err = call(&types.StateOverride{
+		contract: {
+			State:     &map[common.Hash]common.Hash{},
+			StateDiff: &map[common.Hash]common.Hash{},
+		},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	suite.SetupTest()
+
+	from := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	// returns the value of the storage slot 0
+	code := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	slot := common.BigToHash(big.NewInt(42))
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &contract})
+	suite.Require().NoError(err)
+	overrides, err := json.Marshal(&types.StateOverride{
+		contract: {
+			Code:  &code,
+			State: &map[common.Hash]common.Hash{{}: slot},
+		},
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceCallRequest{
+		Args:        args,
+		GasCap:      uint64(config.DefaultGasCap),
+		TraceConfig: &types.TraceConfig{Limit: -1},
+	})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceCallRequest{
+		Args:      args,
+		GasCap:    uint64(config.DefaultGasCap),
+		Overrides: overrides,
+	})
+	suite.Require().NoError(err)
+
+	var result ethlogger.ExecutionResult
+	suite.Require().NoError(json.Unmarshal(res.Data, &result))
+	suite.Require().False(result.Failed)
+	suite.Require().Equal(common.Bytes2Hex(slot.Bytes()), result.ReturnValue)
+	suite.Require().Len(result.StructLogs, 7)
+
+	res, err = suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceCallRequest{
+		Args:        args,
+		GasCap:      uint64(config.DefaultGasCap),
+		Overrides:   overrides,
+		TraceConfig: &types.TraceConfig{Tracer: "callTracer"},
+	})
+	suite.Require().NoError(err)
+
+	var callResult map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(res.Data, &callResult))
+	suite.Require().Equal(hexutil.Encode(slot.Bytes()), callResult["output"])
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1402,6 +1593,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.ctx, nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.ctx, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1412,3 +1609,242 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSimulateBundle() {
+	suite.SetupTest()
+
+	recipient := tests.GenerateAddress()
+	supply := big.NewInt(1000)
+	amount := big.NewInt(10)
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	contract := crypto.CreateAddress(suite.address, nonce)
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, supply)
+	suite.Require().NoError(err)
+	deployData := hexutil.Bytes(append(types.ERC20Contract.Bin, ctorArgs...))
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, amount)
+	suite.Require().NoError(err)
+	balanceData, err := types.ERC20Contract.ABI.Pack("balanceOf", recipient)
+	suite.Require().NoError(err)
+
+	calls := []types.TransactionArgs{
+		{From: &suite.address, Data: &deployData},
+		{From: &suite.address, To: &contract, Data: (*hexutil.Bytes)(&transferData)},
+		{From: &suite.address, To: &contract, Data: (*hexutil.Bytes)(&balanceData)},
+	}
+	req := &types.QuerySimulateBundleRequest{GasCap: uint64(config.DefaultGasCap)}
+	for i := range calls {
+		bz, err := json.Marshal(&calls[i])
+		suite.Require().NoError(err)
+		req.Calls = append(req.Calls, bz)
+	}
+
+	res, err := suite.queryClient.SimulateBundle(suite.ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 3)
+	for _, callRes := range res.Results {
+		suite.Require().False(callRes.Failed(), callRes.VmError)
+		suite.Require().NotZero(callRes.GasUsed)
+	}
+
+	// each call executes on the state of the previous ones
+	suite.Require().Equal(common.LeftPadBytes(amount.Bytes(), 32), res.Results[2].Ret)
+
+	// the log indexes follow each other across the calls
+	suite.Require().NotEmpty(res.Results[1].Logs)
+	deployLogs := res.Results[0].Logs
+	suite.Require().Equal(uint64(len(deployLogs)), res.Results[1].Logs[0].Index)
+	suite.Require().Equal(uint64(1), res.Results[1].Logs[0].TxIndex)
+
+	// the bundle doesn't modify the state
+	suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, contract))
+	suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+
+	// the isolated calls execute on the state of the block
+	req.Isolated = true
+	res, err = suite.queryClient.SimulateBundle(suite.ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 3)
+	suite.Require().False(res.Results[0].Failed(), res.Results[0].VmError)
+	suite.Require().Empty(res.Results[2].Ret)
+	suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, contract))
+
+	// a failing isolated call returns its error in its result, the other calls are executed
+	isolatedReq := &types.QuerySimulateBundleRequest{
+		GasCap:   req.GasCap,
+		Isolated: true,
+		Calls:    [][]byte{req.Calls[0], []byte("invalid args"), req.Calls[0]},
+	}
+	res, err = suite.queryClient.SimulateBundle(suite.ctx, isolatedReq)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 3)
+	suite.Require().False(res.Results[0].Failed(), res.Results[0].VmError)
+	suite.Require().True(res.Results[1].Failed())
+	suite.Require().Zero(res.Results[1].GasUsed)
+	suite.Require().False(res.Results[2].Failed(), res.Results[2].VmError)
+	req.Isolated = false
+
+	// invalid call args
+	req.Calls = append(req.Calls, []byte("invalid args"))
+	_, err = suite.queryClient.SimulateBundle(suite.ctx, req)
+	suite.Require().ErrorContains(err, "call 3")
+
+	// too many calls
+	req.Calls = make([][]byte, types.MaxBundleCalls+1)
+	_, err = suite.queryClient.SimulateBundle(suite.ctx, req)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasBulk() {
+	suite.SetupTest()
+
+	recipient := tests.GenerateAddress()
+	contract := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(10))
+	suite.Require().NoError(err)
+	overdraftData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(1001))
+	suite.Require().NoError(err)
+
+	calls := []types.TransactionArgs{
+		{From: &suite.address, To: &contract, Data: (*hexutil.Bytes)(&transferData)},
+		{From: &suite.address, To: &contract, Data: (*hexutil.Bytes)(&overdraftData)},
+		{From: &suite.address, To: &recipient},
+	}
+	req := &types.QueryEstimateGasBulkRequest{GasCap: uint64(config.DefaultGasCap)}
+	for i := range calls {
+		bz, err := json.Marshal(&calls[i])
+		suite.Require().NoError(err)
+		req.Calls = append(req.Calls, bz)
+	}
+
+	res, err := suite.queryClient.EstimateGasBulk(suite.ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 3)
+
+	// the estimations match the ones of the single calls
+	single, err := suite.queryClient.EstimateGas(suite.ctx, &types.EthCallRequest{Args: req.Calls[0], GasCap: req.GasCap})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.GasEstimateResult{Gas: single.Gas}, res.Results[0])
+	suite.Require().Equal(types.GasEstimateResult{Gas: ethparams.TxGas}, res.Results[2])
+
+	// the reverted call returns the error of eth_estimateGas
+	suite.Require().Zero(res.Results[1].Gas)
+	suite.Require().True(res.Results[1].Reverted)
+	suite.Require().Equal(types.NewExecErrorWithReason(res.Results[1].RevertData).Error(), res.Results[1].Error)
+
+	// invalid call args
+	req.Calls = append(req.Calls, []byte("invalid args"))
+	_, err = suite.queryClient.EstimateGasBulk(suite.ctx, req)
+	suite.Require().ErrorContains(err, "call 3")
+
+	// too many calls
+	req.Calls = make([][]byte, types.MaxBundleCalls+1)
+	_, err = suite.queryClient.EstimateGasBulk(suite.ctx, req)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestStateDiff() {
+	suite.SetupTest()
+
+	recipient := tests.GenerateAddress()
+	value := hexutil.Big(*big.NewInt(1000))
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, suite.address, big.NewInt(1_000_000)))
+	balance := suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address)
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	emptyCodeHash := common.BytesToHash(types.EmptyCodeHash).Hex()
+
+	stateDiff := func(args types.TransactionArgs) *types.QueryStateDiffResponse {
+		bz, err := json.Marshal(&args)
+		suite.Require().NoError(err)
+		res, err := suite.queryClient.StateDiff(suite.ctx, &types.EthCallRequest{Args: bz, GasCap: uint64(config.DefaultGasCap)})
+		suite.Require().NoError(err)
+		suite.Require().False(res.Result.Failed(), res.Result.VmError)
+		return res
+	}
+
+	// value transfer
+	res := stateDiff(types.TransactionArgs{From: &suite.address, To: &recipient, Value: &value})
+	expAccounts := []types.AccountDiff{
+		{
+			Address:        suite.address.Hex(),
+			BalanceBefore:  balance.String(),
+			BalanceAfter:   new(big.Int).Sub(balance, big.NewInt(1000)).String(),
+			NonceBefore:    nonce,
+			NonceAfter:     nonce,
+			CodeHashBefore: emptyCodeHash,
+			CodeHashAfter:  emptyCodeHash,
+		},
+		{
+			Address:        recipient.Hex(),
+			BalanceBefore:  "0",
+			BalanceAfter:   "1000",
+			CodeHashBefore: emptyCodeHash,
+			CodeHashAfter:  emptyCodeHash,
+		},
+	}
+	if bytes.Compare(recipient.Bytes(), suite.address.Bytes()) < 0 {
+		expAccounts[0], expAccounts[1] = expAccounts[1], expAccounts[0]
+	}
+	suite.Require().Equal(expAccounts, res.Accounts)
+
+	// contract deployment
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(1000))
+	suite.Require().NoError(err)
+	data := hexutil.Bytes(append(types.ERC20Contract.Bin, ctorArgs...))
+	res = stateDiff(types.TransactionArgs{From: &suite.address, Data: &data})
+
+	contract := crypto.CreateAddress(suite.address, nonce)
+	var contractDiff, senderDiff *types.AccountDiff
+	for i := range res.Accounts {
+		switch res.Accounts[i].Address {
+		case contract.Hex():
+			contractDiff = &res.Accounts[i]
+		case suite.address.Hex():
+			senderDiff = &res.Accounts[i]
+		}
+	}
+	suite.Require().NotNil(contractDiff)
+	suite.Require().True(contractDiff.Created)
+	suite.Require().False(contractDiff.Destroyed)
+	suite.Require().NotEqual(emptyCodeHash, contractDiff.CodeHashAfter)
+	suite.Require().NotEmpty(contractDiff.Storage)
+	suite.Require().NotNil(senderDiff)
+	suite.Require().Equal(nonce+1, senderDiff.NonceAfter)
+
+	// the dry runs don't modify the state
+	suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, contract))
+	suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, recipient))
+	suite.Require().Equal(balance, suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address))
+	suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+}
+
+func (suite *KeeperTestSuite) TestQueryDeadline() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+
+	address := tests.GenerateAddress()
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	contract := suite.DeployTestContract(suite.T(), address, supply)
+	data, err := types.ERC20Contract.ABI.Pack("balanceOf", address)
+	suite.Require().NoError(err)
+	args, err := json.Marshal(&types.TransactionArgs{To: &contract, From: &address, Data: (*hexutil.Bytes)(&data)})
+	suite.Require().NoError(err)
+	req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+
+	// the queries whose deadline isn't exceeded are executed
+	ctx, cancel := context.WithTimeout(sdk.WrapSDKContext(suite.ctx), time.Minute)
+	defer cancel()
+	_, err = k.EthCall(ctx, req)
+	suite.Require().NoError(err)
+	_, err = k.Contracts(ctx, &types.QueryContractsRequest{})
+	suite.Require().NoError(err)
+
+	// the EVM executions and the store iterations are aborted once it's exceeded
+	expired, cancelExpired := context.WithDeadline(sdk.WrapSDKContext(suite.ctx), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	_, err = k.EthCall(expired, req)
+	suite.Require().ErrorContains(err, context.DeadlineExceeded.Error())
+	_, err = k.Contracts(expired, &types.QueryContractsRequest{})
+	suite.Require().Equal(codes.DeadlineExceeded, status.Code(err))
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// GetHeaderHash returns the header hash of the block of the given height, it returns false if the
+// hash isn't stored, e.g. if the block is out of the retention window.
+func (k Keeper) GetHeaderHash(ctx sdk.Context, height int64) (common.Hash, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHeaderHash)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height)))
+	if len(bz) == 0 {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz), true
+}
+
+// SetHeaderHash stores the header hash of the block of the given height.
+func (k Keeper) SetHeaderHash(ctx sdk.Context, height int64, hash common.Hash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHeaderHash)
+	store.Set(sdk.Uint64ToBigEndian(uint64(height)), hash.Bytes())
+}
+
+// DeleteHeaderHash deletes the header hash of the block of the given height.
+func (k Keeper) DeleteHeaderHash(ctx sdk.Context, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHeaderHash)
+	store.Delete(sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// IterateHeaderHashes iterates over the stored header hashes in ascending height order.
+func (k Keeper) IterateHeaderHashes(ctx sdk.Context, cb func(height int64, hash common.Hash) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHeaderHash)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		height := int64(sdk.BigEndianToUint64(iterator.Key()))
+		if cb(height, common.BytesToHash(iterator.Value())) {
+			return
+		}
+	}
+}
+
+// commitHeaderHash stores the header hash of the current block, and prunes the hashes out of the
+// retention window, so that the BLOCKHASH opcode doesn't depend on the staking historical info.
+func (k Keeper) commitHeaderHash(ctx sdk.Context) {
+	hash, err := k.currentHeaderHash(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to compute the header hash", "height", ctx.BlockHeight(), "error", err)
+		return
+	}
+	k.SetHeaderHash(ctx, ctx.BlockHeight(), hash)
+
+	if pruneHeight := ctx.BlockHeight() - types.HeaderHashRetention; pruneHeight > 0 {
+		k.DeleteHeaderHash(ctx, pruneHeight)
+	}
+}
+
+// currentHeaderHash returns the header hash of the current block, computed from the header if the
+// context hash isn't set (eg: checkTxState).
+func (k Keeper) currentHeaderHash(ctx sdk.Context) (common.Hash, error) {
+	if headerHash := ctx.HeaderHash(); len(headerHash) != 0 {
+		return common.BytesToHash(headerHash), nil
+	}
+
+	contextBlockHeader := ctx.BlockHeader()
+	header, err := tmtypes.HeaderFromProto(&contextBlockHeader)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(header.Hash()), nil
+}
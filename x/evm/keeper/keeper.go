@@ -71,11 +71,28 @@ type Keeper struct {
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
 
+	// optional recorder of the accounts and slots accessed by the evm messages
+	accessListRecorder types.AccessListRecorder
+
 	// custom stateless precompiled smart contracts
 	customPrecompiles evm.PrecompiledContracts
 
 	// evm constructor function
 	evmConstructor evm.Constructor
+	// store used to generate the ICS23 proofs of the EVM state
+	proofQuerier storetypes.Queryable
+	// optional cache of the CheckTx speculative execution results reused by DeliverTx
+	speculativeCache *SpeculativeCache
+	// optional tracker of the mempool txs evicted after their ttl
+	mempoolTTL *MempoolTTL
+	// optional adjustment of the node min gas price with the block fullness
+	adaptiveGasPrice *AdaptiveGasPrice
+	// approximate memory budget of the state cached by each StateDB, 0 for unbounded
+	stateDBCacheBudget uint64
+	// decimals of the display unit of the evm denom validated at genesis, 0 disables the validation
+	denomMetadataDecimals uint32
+	// max number of storage slots hashed by the storage hash queries, 0 for unbounded
+	storageHashMaxSlots uint64
 	// Legacy subspace
 	ss paramstypes.Subspace
 }
@@ -206,6 +223,27 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// ----------------------------------------------------------------------------
+// Balance reservation
+// ----------------------------------------------------------------------------
+
+// GetReservedBalance returns the value of the sender txs accepted in the mempool since the last
+// commit, which isn't transferred during CheckTx unlike the fees which are deducted.
+func (k Keeper) GetReservedBalance(ctx sdk.Context, addr common.Address) *big.Int {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientReservedBalance)
+	return new(big.Int).SetBytes(store.Get(addr.Bytes()))
+}
+
+// ReserveBalance adds the value of a tx accepted in the mempool to the reserved balance of the sender.
+func (k Keeper) ReserveBalance(ctx sdk.Context, addr common.Address, amount *big.Int) {
+	if amount == nil || amount.Sign() <= 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientReservedBalance)
+	reserved := new(big.Int).Add(k.GetReservedBalance(ctx, addr), amount)
+	store.Set(addr.Bytes(), reserved.Bytes())
+}
+
 // ----------------------------------------------------------------------------
 // Log
 // ----------------------------------------------------------------------------
@@ -259,6 +297,45 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// CleanHooks resets the hooks for the EVM module
+// NOTE: Should only be used for testing purposes
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
+
+// SetAccessListRecorder sets the recorder of the EIP-2929 access lists of the evm messages
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetAccessListRecorder(recorder types.AccessListRecorder) *Keeper {
+	if k.accessListRecorder != nil {
+		panic("cannot set access list recorder twice")
+	}
+
+	k.accessListRecorder = recorder
+	return k
+}
+
+// SetStateDBCacheBudget sets the approximate memory budget of the state cached by the StateDB of each
+// evm execution, 0 for an unbounded cache.
+func (k *Keeper) SetStateDBCacheBudget(budget uint64) *Keeper {
+	k.stateDBCacheBudget = budget
+	return k
+}
+
+// SetStorageHashMaxSlots sets the max number of storage slots of the accounts whose storage hash is
+// computed by the queries, 0 for unbounded.
+func (k *Keeper) SetStorageHashMaxSlots(maxSlots uint64) *Keeper {
+	k.storageHashMaxSlots = maxSlots
+	return k
+}
+
+// newStateDB creates the StateDB of an evm execution, with the configured cache budget.
+func (k *Keeper) newStateDB(ctx sdk.Context, stateKeeper statedb.Keeper, txConfig statedb.TxConfig) *statedb.StateDB {
+	stateDB := statedb.New(ctx, stateKeeper, txConfig)
+	stateDB.SetCacheBudget(k.stateDBCacheBudget)
+	return stateDB
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -318,7 +395,7 @@ func (k *Keeper) GetNonce(ctx sdk.Context, addr common.Address) uint64 {
 	return acct.GetSequence()
 }
 
-// GetBalance load account's balance of gas token
+// GetBalance load account's balance of gas token, in wei
 func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 	evmParams := k.GetParams(ctx)
@@ -328,7 +405,7 @@ func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 		return big.NewInt(-1)
 	}
 	coin := k.bankKeeper.GetBalance(ctx, cosmosAddr, evmDenom)
-	return coin.Amount.BigInt()
+	return evmParams.ToWei(coin.Amount)
 }
 
 // GetBaseFee returns current base fee, return values:
@@ -311,7 +311,9 @@ func (suite *KeeperTestSuite) DeployTestContract(t require.TestingT, owner commo
 	rsp, err := suite.app.EvmKeeper.EthereumTx(ctx, erc20DeployTx)
 	require.NoError(t, err)
 	require.Empty(t, rsp.VmError)
-	return crypto.CreateAddress(suite.address, nonce)
+	contractAddr := crypto.CreateAddress(suite.address, nonce)
+	require.Equal(t, contractAddr.Hex(), rsp.ContractAddress)
+	return contractAddr
 }
 
 func (suite *KeeperTestSuite) TransferERC20Token(t require.TestingT, contractAddr, from, to common.Address, amount *big.Int) *types.MsgEthereumTx {
@@ -421,7 +423,9 @@ func (suite *KeeperTestSuite) DeployTestMessageCall(t require.TestingT) common.A
 	rsp, err := suite.app.EvmKeeper.EthereumTx(ctx, erc20DeployTx)
 	require.NoError(t, err)
 	require.Empty(t, rsp.VmError)
-	return crypto.CreateAddress(suite.address, nonce)
+	contractAddr := crypto.CreateAddress(suite.address, nonce)
+	require.Equal(t, contractAddr.Hex(), rsp.ContractAddress)
+	return contractAddr
 }
 
 func (suite *KeeperTestSuite) TestBaseFee() {
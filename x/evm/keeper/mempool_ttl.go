@@ -0,0 +1,135 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// mempoolTTLCacheSize is the max number of mempool txs whose first CheckTx is tracked, the txs
+// removed from the mempool without being delivered are eventually dropped.
+const mempoolTTLCacheSize = 20000
+
+// MempoolTTL tracks when the ethereum transactions were accepted in the node mempool, so that the
+// ones unconfirmed after the TTL fail the mempool recheck and are evicted.
+type MempoolTTL struct {
+	blocks   int64
+	duration time.Duration
+	seen     *lru.Cache
+	feed     event.Feed
+}
+
+// mempoolEntry is the height and block time of the first CheckTx of a transaction.
+type mempoolEntry struct {
+	height int64
+	time   time.Time
+}
+
+// NewMempoolTTL returns a MempoolTTL evicting the transactions after the given number of blocks or
+// duration since their first CheckTx, 0 disables the corresponding limit. The duration is measured
+// with the block times.
+func NewMempoolTTL(blocks int64, duration time.Duration) (*MempoolTTL, error) {
+	seen, err := lru.New(mempoolTTLCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &MempoolTTL{
+		blocks:   blocks,
+		duration: duration,
+		seen:     seen,
+	}, nil
+}
+
+// SubscribeEvictions subscribes to the hashes of the transactions evicted from the mempool after
+// their TTL.
+func (m *MempoolTTL) SubscribeEvictions(ch chan<- common.Hash) event.Subscription {
+	return m.feed.Subscribe(ch)
+}
+
+// check records the first CheckTx of the transaction, and returns true if the transaction is
+// expired on a recheck.
+func (m *MempoolTTL) check(ctx sdk.Context, txHash common.Hash) bool {
+	value, ok := m.seen.Get(txHash)
+	if !ok {
+		// a tx rechecked but untracked was accepted before the node restarted
+		m.seen.Add(txHash, mempoolEntry{height: ctx.BlockHeight(), time: ctx.BlockTime()})
+		return false
+	}
+	if !ctx.IsReCheckTx() {
+		return false
+	}
+
+	entry := value.(mempoolEntry)
+	expired := (m.blocks > 0 && ctx.BlockHeight()-entry.height >= m.blocks) ||
+		(m.duration > 0 && ctx.BlockTime().Sub(entry.time) >= m.duration)
+	if expired {
+		m.seen.Remove(txHash)
+		// the slow subscribers don't block the recheck
+		go m.feed.Send(txHash)
+	}
+	return expired
+}
+
+// SetMempoolTTL enables the eviction of the ethereum transactions unconfirmed after the TTL from
+// the node mempool. It should be called only once during initialization, it panics if called more
+// than once.
+func (k *Keeper) SetMempoolTTL(ttl *MempoolTTL) *Keeper {
+	if k.mempoolTTL != nil {
+		panic("cannot set evm mempool ttl twice")
+	}
+
+	k.mempoolTTL = ttl
+	return k
+}
+
+// SubscribeMempoolEvictions subscribes to the hashes of the transactions evicted from the mempool
+// after their TTL. The subscription never receives anything if the TTL is disabled.
+func (k *Keeper) SubscribeMempoolEvictions(ch chan<- common.Hash) event.Subscription {
+	if k.mempoolTTL == nil {
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			<-quit
+			return nil
+		})
+	}
+	return k.mempoolTTL.SubscribeEvictions(ch)
+}
+
+// CheckMempoolTTL records the first CheckTx of the ethereum transaction and returns an error on the
+// recheck of an expired transaction, so that it's evicted from the mempool. The transaction stops
+// being tracked once delivered. It's a no-op if the TTL is disabled.
+func (k *Keeper) CheckMempoolTTL(ctx sdk.Context, txHash common.Hash) error {
+	if k.mempoolTTL == nil {
+		return nil
+	}
+
+	if !ctx.IsCheckTx() {
+		k.mempoolTTL.seen.Remove(txHash)
+		return nil
+	}
+
+	if k.mempoolTTL.check(ctx, txHash) {
+		return errorsmod.Wrapf(types.ErrTxExpired, "tx %s not included within the mempool ttl", txHash.Hex())
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestMempoolTTL() {
+	txHash := common.BytesToHash([]byte("tx"))
+	start := time.Unix(1000, 0).UTC()
+
+	testCases := []struct {
+		name     string
+		blocks   int64
+		duration time.Duration
+		height   int64
+		time     time.Time
+		expired  bool
+	}{
+		{"within the blocks ttl", 2, 0, 11, start.Add(time.Hour), false},
+		{"blocks ttl elapsed", 2, 0, 12, start, true},
+		{"within the duration ttl", 0, time.Minute, 100, start.Add(59 * time.Second), false},
+		{"duration ttl elapsed", 0, time.Minute, 11, start.Add(time.Minute), true},
+		{"first limit reached", 5, time.Minute, 15, start, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ttl, err := keeper.NewMempoolTTL(tc.blocks, tc.duration)
+			suite.Require().NoError(err)
+			suite.app.EvmKeeper.SetMempoolTTL(ttl)
+
+			evicted := make(chan common.Hash, 1)
+			sub := suite.app.EvmKeeper.SubscribeMempoolEvictions(evicted)
+			defer sub.Unsubscribe()
+
+			checkCtx := suite.ctx.WithIsCheckTx(true).WithBlockHeight(10).WithBlockTime(start)
+			suite.Require().NoError(suite.app.EvmKeeper.CheckMempoolTTL(checkCtx, txHash))
+
+			recheckCtx := checkCtx.WithIsReCheckTx(true).WithBlockHeight(tc.height).WithBlockTime(tc.time)
+			err = suite.app.EvmKeeper.CheckMempoolTTL(recheckCtx, txHash)
+			if !tc.expired {
+				suite.Require().NoError(err)
+				return
+			}
+
+			suite.Require().ErrorIs(err, types.ErrTxExpired)
+			select {
+			case hash := <-evicted:
+				suite.Require().Equal(txHash, hash)
+			case <-time.After(time.Second):
+				suite.Fail("eviction not notified")
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMempoolTTLDelivered() {
+	txHash := common.BytesToHash([]byte("tx"))
+	ttl, err := keeper.NewMempoolTTL(1, 0)
+	suite.Require().NoError(err)
+	suite.app.EvmKeeper.SetMempoolTTL(ttl)
+
+	checkCtx := suite.ctx.WithIsCheckTx(true).WithBlockHeight(10)
+	suite.Require().NoError(suite.app.EvmKeeper.CheckMempoolTTL(checkCtx, txHash))
+
+	// the delivered tx isn't tracked anymore, a recheck after a restart tracks it again
+	suite.Require().NoError(suite.app.EvmKeeper.CheckMempoolTTL(suite.ctx.WithBlockHeight(11), txHash))
+	recheckCtx := checkCtx.WithIsReCheckTx(true).WithBlockHeight(20)
+	suite.Require().NoError(suite.app.EvmKeeper.CheckMempoolTTL(recheckCtx, txHash))
+	suite.Require().ErrorIs(suite.app.EvmKeeper.CheckMempoolTTL(recheckCtx.WithBlockHeight(21), txHash), types.ErrTxExpired)
+
+	// disabled ttl
+	suite.SetupTest()
+	suite.Require().NoError(suite.app.EvmKeeper.CheckMempoolTTL(recheckCtx, txHash))
+}
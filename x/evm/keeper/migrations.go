@@ -16,6 +16,8 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v4 "github.com/evmos/ethermint/x/evm/migrations/v4"
 	v5 "github.com/evmos/ethermint/x/evm/migrations/v5"
@@ -45,3 +47,28 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate5to6 migrates the store from consensus version 5 to 6, it computes the storage usage of
+// the existing contracts.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.RecomputeStorageUsage(ctx)
+	return nil
+}
+
+// Migrate6to7 migrates the store from consensus version 6 to 7, it sorts the extra EIPs of the
+// params in ascending order and removes the duplicates, so that the instruction set doesn't depend
+// on the order of the EIPs.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	eips := make([]int64, len(params.ExtraEIPs))
+	copy(eips, params.ExtraEIPs)
+	sort.Slice(eips, func(i, j int) bool { return eips[i] < eips[j] })
+
+	params.ExtraEIPs = eips[:0]
+	for i, eip := range eips {
+		if i == 0 || eip != eips[i-1] {
+			params.ExtraEIPs = append(params.ExtraEIPs, eip)
+		}
+	}
+	return m.keeper.SetParams(ctx, params)
+}
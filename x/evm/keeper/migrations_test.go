@@ -30,6 +30,10 @@ func (suite *KeeperTestSuite) TestMigrations() {
 			"Run Migrate3to4",
 			migrator.Migrate3to4,
 		},
+		{
+			"Run Migrate5to6",
+			migrator.Migrate5to6,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -39,3 +43,25 @@ func (suite *KeeperTestSuite) TestMigrations() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	suite.SetupTest()
+	migrator := evmkeeper.NewMigrator(*suite.app.EvmKeeper, newMockSubspace(types.DefaultParams()))
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	// the unsorted EIPs are rejected by the params validation, they are stored directly
+	params.ExtraEIPs = []int64{3198, 1884, 3198, 1344}
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Set(types.KeyPrefixParams, suite.app.AppCodec().MustMarshal(&params))
+	// the EIPs are applied in the stored order until the migration
+	suite.Require().Equal([]int{3198, 1884, 3198, 1344}, suite.app.EvmKeeper.GetParams(suite.ctx).EIPs())
+
+	suite.Require().NoError(migrator.Migrate6to7(suite.ctx))
+	suite.Require().Equal([]int64{1344, 1884, 3198}, suite.app.EvmKeeper.GetParams(suite.ctx).ExtraEIPs)
+
+	// the params without extra EIPs are unchanged
+	params.ExtraEIPs = nil
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(migrator.Migrate6to7(suite.ctx))
+	suite.Require().Empty(suite.app.EvmKeeper.GetParams(suite.ctx).ExtraEIPs)
+}
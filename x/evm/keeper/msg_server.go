@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strconv"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -27,10 +28,16 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
+	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -89,6 +96,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		}
 	}()
 
+	gasPrice, tip := k.effectiveGasPrice(ctx, tx)
 	attrs := []sdk.Attribute{
 		sdk.NewAttribute(sdk.AttributeKeyAmount, tx.Value().String()),
 		// add event for ethereum transaction hash format
@@ -97,6 +105,10 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		sdk.NewAttribute(types.AttributeKeyTxIndex, strconv.FormatUint(txIndex, 10)),
 		// add event for eth tx gas used, we can't get it from cosmos tx result when it contains multiple eth tx msgs.
 		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(response.GasUsed, 10)),
+		// add event for the tip actually paid, so that the fee history reflects the payments
+		sdk.NewAttribute(types.AttributeKeyEffectiveTip, tip.String()),
+		// add event for the gas price actually paid, so that the indexer can aggregate the block statistics
+		sdk.NewAttribute(types.AttributeKeyEffectiveGasPrice, gasPrice.String()),
 	}
 
 	if len(ctx.TxBytes()) > 0 {
@@ -111,6 +123,11 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 
 	if response.Failed() {
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.VmError))
+
+		// add event for the revert data, so that the receipts can expose the revert reason
+		if revert := response.Revert(); len(revert) > 0 {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxRevertReason, hexutil.Encode(revert)))
+		}
 	}
 
 	txLogAttrs := make([]sdk.Attribute, len(response.Logs))
@@ -143,6 +160,197 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 	return response, nil
 }
 
+// effectiveGasPrice returns the gas price paid by the transaction, charged by the ante handler and
+// refunded with the leftover gas, along with the tip per gas: the gas price minus the base fee of the
+// block. The full gas price is the tip when the base fee is disabled.
+func (k *Keeper) effectiveGasPrice(ctx sdk.Context, tx *ethtypes.Transaction) (gasPrice, tip *big.Int) {
+	ethCfg := k.GetParams(ctx).ChainConfig.EthereumConfig(k.eip155ChainID)
+	baseFee := k.GetBaseFee(ctx, ethCfg)
+	if baseFee == nil {
+		return tx.GasPrice(), tx.GasPrice()
+	}
+
+	gasPrice = types.EffectiveGasPrice(baseFee, tx.GasFeeCap(), tx.GasTipCap())
+	tip = new(big.Int).Sub(gasPrice, baseFee)
+	if tip.Sign() < 0 {
+		tip = new(big.Int)
+	}
+	return gasPrice, tip
+}
+
+// EthereumCall implements the gRPC MsgServer interface. It executes an EVM call, or a contract
+// creation, with the Cosmos sender account as the caller. The EVM gas used is charged to the tx gas
+// meter, and the sender nonce is only increased by the contract creations, the Cosmos account
+// sequence protecting the tx against replays.
+//
+// It's the message the interchain accounts execute on this chain: MsgEthereumTx can't be relayed
+// since it's authenticated by the eth ante handler. A reverted call doesn't fail the message, so the
+// acknowledgement returns the revert data to the controller chain in the response.
+func (k *Keeper) EthereumCall(goCtx context.Context, msg *types.MsgEthereumCall) (*types.MsgEthereumCallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	// the module derived accounts (interchain accounts, group policies) have 32 bytes addresses,
+	// the EVM executes their calls with an address derived from them, which must hold the
+	// transferred value
+	from := types.CallerAddress(sender)
+	if len(sender) != common.AddressLength && msg.Value.IsPositive() {
+		if err := k.transferCallValue(ctx, sender, from.Bytes(), msg.Value); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to transfer the call value")
+		}
+	}
+
+	// like the ethereum txs, the call is executed in a cache context committed once the post
+	// processing hooks succeed
+	var commit func()
+	tmpCtx := ctx
+	if k.hooks != nil {
+		tmpCtx, commit = ctx.CacheContext()
+	}
+
+	// the state accesses are already accounted for by the evm gas, charged below
+	evmCtx := tmpCtx.WithGasMeter(ethermint.NewInfiniteGasMeterWithLimit(msg.GasLimit))
+
+	cfg, err := k.EVMConfig(evmCtx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+	// the logs of the call follow the ones of the block, the call isn't counted as an ethereum tx
+	// of the block so the tx index isn't increased
+	txConfig := k.TxConfig(evmCtx, common.Hash{})
+
+	to := msg.GetToAddress()
+	nonce := k.GetNonce(evmCtx, from)
+	ethMsg := ethtypes.NewMessage(
+		from, to, nonce, msg.Value.BigInt(), msg.GasLimit,
+		new(big.Int), new(big.Int), new(big.Int), msg.Data, nil, true,
+	)
+
+	res, err := k.ApplyMessageWithConfig(evmCtx, ethMsg, nil, true, cfg, txConfig)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply message")
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm call")
+
+	if !res.Failed() {
+		logs := types.LogsToEthereum(res.Logs)
+		receipt := &ethtypes.Receipt{
+			Status:           ethtypes.ReceiptStatusSuccessful,
+			Bloom:            ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+			Logs:             logs,
+			TxHash:           txConfig.TxHash,
+			GasUsed:          res.GasUsed,
+			BlockHash:        txConfig.BlockHash,
+			BlockNumber:      big.NewInt(ctx.BlockHeight()),
+			TransactionIndex: txConfig.TxIndex,
+		}
+		if to == nil {
+			receipt.ContractAddress = crypto.CreateAddress(from, nonce)
+		}
+		if err := k.PostTxProcessing(evmCtx, ethMsg, receipt); err != nil {
+			// the state changes of the call are discarded along with its logs
+			res.VmError = types.ErrPostTxProcessing.Error()
+			k.Logger(ctx).Error("call post processing failed", "error", err)
+			res.Logs = nil
+		} else {
+			// the post processing can alter the logs
+			res.Logs = types.NewLogsFromEth(receipt.Logs)
+			if len(receipt.Logs) > 0 {
+				// the logs are added to the block bloom, like the ones of the ethereum txs
+				bloom := k.GetBlockBloomTransient(evmCtx)
+				bloom.Or(bloom, new(big.Int).SetBytes(ethtypes.LogsBloom(receipt.Logs)))
+				k.SetBlockBloomTransient(evmCtx, bloom)
+				k.SetLogSizeTransient(evmCtx, uint64(txConfig.LogIndex)+uint64(len(receipt.Logs)))
+			}
+			if commit != nil {
+				commit()
+				ctx.EventManager().EmitEvents(tmpCtx.EventManager().Events())
+			}
+		}
+	}
+
+	// the value transferred to the evm address of a 32 bytes sender is refunded if the call failed,
+	// as the evm reverted its transfer
+	if res.Failed() && len(sender) != common.AddressLength && msg.Value.IsPositive() {
+		if err := k.transferCallValue(ctx, from.Bytes(), sender, msg.Value); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to refund the call value")
+		}
+	}
+
+	response := &types.MsgEthereumCallResponse{
+		Ret:     res.Ret,
+		VmError: res.VmError,
+		GasUsed: res.GasUsed,
+		Logs:    res.Logs,
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, from.Hex()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Value.String()),
+		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+	}
+	if to != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, to.Hex()))
+	} else if !res.Failed() {
+		response.ContractAddress = crypto.CreateAddress(from, nonce).Hex()
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyContractAddress, response.ContractAddress))
+	}
+
+	if res.Failed() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, res.VmError))
+
+		if revert := res.Revert(); len(revert) > 0 {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxRevertReason, hexutil.Encode(revert)))
+		}
+	}
+
+	txLogAttrs := make([]sdk.Attribute, len(res.Logs))
+	for i, log := range res.Logs {
+		value, err := json.Marshal(log)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to encode log")
+		}
+		txLogAttrs[i] = sdk.NewAttribute(types.AttributeKeyTxLog, string(value))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEthereumCall,
+			attrs...,
+		),
+		sdk.NewEvent(
+			types.EventTypeTxLog,
+			txLogAttrs...,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return response, nil
+}
+
+// transferCallValue transfers the value of a call between a 32 bytes sender and its evm address
+// through the module account.
+func (k *Keeper) transferCallValue(ctx sdk.Context, from, to sdk.AccAddress, wei sdkmath.Int) error {
+	params := k.GetParams(ctx)
+	value, err := params.FromWei(wei.BigInt())
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid call value")
+	}
+	coins := sdk.Coins{sdk.NewCoin(params.EvmDenom, value)}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
+}
+
 // UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
 // proposal passes, it updates the module parameters. The update can only be
 // performed if the requested authority is the Cosmos SDK governance module
@@ -159,3 +367,87 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RestoreContract implements the gRPC MsgServer interface. When a RestoreContract
+// proposal passes, it replaces the code and the whole storage of the contract account,
+// creating the account if it doesn't exist. The update can only be performed if the
+// requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) RestoreContract(goCtx context.Context, req *types.MsgRestoreContract) (*types.MsgRestoreContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	address := common.HexToAddress(req.Contract.Address)
+
+	if acct := k.accountKeeper.GetAccount(ctx, address.Bytes()); acct != nil {
+		if _, ok := acct.(ethermint.EthAccountI); !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidAccount, "account %s must be an EthAccount interface, got %T", req.Contract.Address, acct)
+		}
+	}
+
+	code := common.Hex2Bytes(req.Contract.Code)
+	codeHash := crypto.Keccak256Hash(code)
+
+	account := k.GetAccountOrEmpty(ctx, address)
+	account.CodeHash = codeHash.Bytes()
+	if err := k.SetAccount(ctx, address, account); err != nil {
+		return nil, err
+	}
+	k.SetCode(ctx, codeHash.Bytes(), code)
+
+	// collect the keys first, the store can't be written while it's iterated
+	var keys []common.Hash
+	k.ForEachStorage(ctx, address, func(key, _ common.Hash) bool {
+		keys = append(keys, key)
+		return true
+	})
+	for _, key := range keys {
+		k.SetState(ctx, address, key, nil)
+	}
+	for _, state := range req.Contract.Storage {
+		// the zero values are deleted, as the state db commits them
+		var value []byte
+		if v := common.HexToHash(state.Value); v != (common.Hash{}) {
+			value = v.Bytes()
+		}
+		k.SetState(ctx, address, common.HexToHash(state.Key), value)
+	}
+
+	k.Logger(ctx).Info(
+		"contract restored",
+		"ethereum-address", address.Hex(),
+		"code-hash", codeHash.Hex(),
+		"storage-slots", len(req.Contract.Storage),
+	)
+
+	return &types.MsgRestoreContractResponse{}, nil
+}
+
+// SetContractsPaused implements the gRPC MsgServer interface. When a SetContractsPaused
+// proposal passes, it pauses, or resumes, the execution of the calls sent to the contracts.
+// The update can only be performed if the requested authority is the Cosmos SDK governance
+// module account.
+func (k *Keeper) SetContractsPaused(goCtx context.Context, req *types.MsgSetContractsPaused) (*types.MsgSetContractsPausedResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, hexAddr := range req.Addresses {
+		address := common.HexToAddress(hexAddr)
+		k.SetContractPaused(ctx, address, req.Paused)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContractPause,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, address.Hex()),
+				sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(req.Paused)),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("contracts execution paused", "contracts", req.Addresses, "paused", req.Paused)
+
+	return &types.MsgSetContractsPausedResponse{}, nil
+}
@@ -3,11 +3,20 @@ package keeper_test
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/evmos/ethermint/x/evm/types"
 )
@@ -78,10 +87,174 @@ func (suite *KeeperTestSuite) TestEthereumTx() {
 			suite.Require().NoError(err)
 			suite.Require().Equal(expectedGasUsed, res.GasUsed)
 			suite.Require().False(res.Failed())
+
+			// the tip and the gas price paid are emitted for the fee history and the block statistics
+			baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, chainCfg)
+			var tip, gasPrice string
+			for _, event := range suite.ctx.EventManager().Events() {
+				for _, attr := range event.Attributes {
+					if event.Type != types.EventTypeEthereumTx {
+						continue
+					}
+					switch string(attr.Key) {
+					case types.AttributeKeyEffectiveTip:
+						tip = string(attr.Value)
+					case types.AttributeKeyEffectiveGasPrice:
+						gasPrice = string(attr.Value)
+					}
+				}
+			}
+			effectiveTip := msg.AsTransaction().EffectiveGasTipValue(baseFee)
+			suite.Require().Equal(effectiveTip.String(), tip)
+			suite.Require().Equal(new(big.Int).Add(baseFee, effectiveTip).String(), gasPrice)
 		})
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthereumCall() {
+	suite.SetupTest()
+
+	// an account without key, e.g. a multisig or a group policy account
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	from := common.BytesToAddress(sender)
+	recipient := tests.GenerateAddress()
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", from, big.NewInt(1000))
+	suite.Require().NoError(err)
+	deployMsg := types.NewMsgEthereumCall(sender, nil, append(types.ERC20Contract.Bin, ctorArgs...), sdkmath.ZeroInt(), 2_000_000)
+	suite.Require().NoError(deployMsg.ValidateBasic())
+
+	ctx := suite.ctx.WithGasMeter(sdk.NewGasMeter(10_000_000))
+	res, err := suite.app.EvmKeeper.EthereumCall(ctx, deployMsg)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(crypto.CreateAddress(from, 0).Hex(), res.ContractAddress)
+	suite.Require().Equal(res.GasUsed, ctx.GasMeter().GasConsumed())
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(ctx, from))
+
+	contract := common.HexToAddress(res.ContractAddress)
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(400))
+	suite.Require().NoError(err)
+	res, err = suite.app.EvmKeeper.EthereumCall(ctx, types.NewMsgEthereumCall(sender, &contract, transferData, sdkmath.ZeroInt(), 100_000))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Empty(res.ContractAddress)
+	// calls don't increase the nonce
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(ctx, from))
+
+	balanceData, err := types.ERC20Contract.ABI.Pack("balanceOf", recipient)
+	suite.Require().NoError(err)
+	res, err = suite.app.EvmKeeper.EthereumCall(ctx, types.NewMsgEthereumCall(sender, &contract, balanceData, sdkmath.ZeroInt(), 100_000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.LeftPadBytes(big.NewInt(400).Bytes(), 32), res.Ret)
+
+	// the sender has no balance to transfer
+	res, err = suite.app.EvmKeeper.EthereumCall(ctx, types.NewMsgEthereumCall(sender, &recipient, nil, sdkmath.NewInt(1), 100_000))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+}
+
+func (suite *KeeperTestSuite) TestEthereumCallPostProcessing() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	hook := &LogRecordHook{}
+	k.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
+
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	from := common.BytesToAddress(sender)
+	recipient := tests.GenerateAddress()
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", from, big.NewInt(1000))
+	suite.Require().NoError(err)
+	res, err := k.EthereumCall(suite.ctx, types.NewMsgEthereumCall(sender, nil, append(types.ERC20Contract.Bin, ctorArgs...), sdkmath.ZeroInt(), 2_000_000))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	// the logs are indexed after the ones of the block and added to the block bloom
+	k.SetLogSizeTransient(suite.ctx, 3)
+	contract := common.HexToAddress(res.ContractAddress)
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(400))
+	suite.Require().NoError(err)
+	res, err = k.EthereumCall(suite.ctx, types.NewMsgEthereumCall(sender, &contract, transferData, sdkmath.ZeroInt(), 100_000))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(hook.Logs, 1)
+	suite.Require().Equal(uint(3), hook.Logs[0].Index)
+	suite.Require().Equal(uint64(3), res.Logs[0].Index)
+	suite.Require().Equal(uint64(4), k.GetLogSizeTransient(suite.ctx))
+	suite.Require().True(ethtypes.BytesToBloom(k.GetBlockBloomTransient(suite.ctx).Bytes()).Test(contract.Bytes()))
+	suite.Require().Zero(k.GetTxIndexTransient(suite.ctx))
+
+	// the state changes and the logs of the call are discarded when the hooks fail
+	k.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(FailureHook{}))
+	res, err = k.EthereumCall(suite.ctx, types.NewMsgEthereumCall(sender, &contract, transferData, sdkmath.ZeroInt(), 100_000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ErrPostTxProcessing.Error(), res.VmError)
+	suite.Require().Empty(res.Logs)
+	suite.Require().Equal(uint64(4), k.GetLogSizeTransient(suite.ctx))
+
+	k.CleanHooks()
+	balanceData, err := types.ERC20Contract.ABI.Pack("balanceOf", recipient)
+	suite.Require().NoError(err)
+	res, err = k.EthereumCall(suite.ctx, types.NewMsgEthereumCall(sender, &contract, balanceData, sdkmath.ZeroInt(), 100_000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.LeftPadBytes(big.NewInt(400).Bytes(), 32), res.Ret)
+}
+
+func (suite *KeeperTestSuite) TestEthereumCallInterchainAccount() {
+	suite.SetupTest()
+
+	// the interchain accounts execute the msgs through the msg service router
+	icaAddr := icatypes.GenerateAddress(suite.ctx, "connection-0", "icacontroller-owner")
+	suite.Require().Len(icaAddr, 32)
+	evmAddr := types.CallerAddress(icaAddr)
+	suite.Require().NotEqual(common.BytesToAddress(icaAddr), evmAddr)
+	recipient := tests.GenerateAddress()
+
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, icaAddr, sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1000)))
+	suite.Require().NoError(err)
+
+	execute := func(msg *types.MsgEthereumCall) (*types.MsgEthereumCallResponse, error) {
+		handler := suite.app.MsgServiceRouter().Handler(msg)
+		suite.Require().NotNil(handler)
+		res, err := handler(suite.ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		var rsp types.MsgEthereumCallResponse
+		suite.Require().NoError(suite.app.AppCodec().Unmarshal(res.MsgResponses[0].Value, &rsp))
+		return &rsp, nil
+	}
+
+	// the value is transferred from the interchain account through its evm address
+	rsp, err := execute(types.NewMsgEthereumCall(icaAddr, &recipient, nil, sdkmath.NewInt(400), 21000))
+	suite.Require().NoError(err)
+	suite.Require().False(rsp.Failed(), rsp.VmError)
+	suite.Require().Equal(int64(400), suite.app.BankKeeper.GetBalance(suite.ctx, recipient.Bytes(), evmDenom).Amount.Int64())
+	suite.Require().Equal(int64(600), suite.app.BankKeeper.GetBalance(suite.ctx, icaAddr, evmDenom).Amount.Int64())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, evmAddr.Bytes(), evmDenom).IsZero())
+
+	_, err = execute(types.NewMsgEthereumCall(icaAddr, &recipient, nil, sdkmath.NewInt(1000), 21000))
+	suite.Require().Error(err)
+
+	// a reverted call succeeds with the revert data, the init code reverting with 0x2a
+	revertCode := common.FromHex("0x602a60005260206000fd")
+	rsp, err = execute(types.NewMsgEthereumCall(icaAddr, nil, revertCode, sdkmath.ZeroInt(), 100_000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(vm.ErrExecutionReverted.Error(), rsp.VmError)
+	suite.Require().Equal(common.LeftPadBytes([]byte{0x2a}, 32), rsp.Ret)
+	suite.Require().Empty(rsp.ContractAddress)
+
+	// the value of a failed call is refunded to the interchain account
+	rsp, err = execute(types.NewMsgEthereumCall(icaAddr, nil, revertCode, sdkmath.NewInt(100), 100_000))
+	suite.Require().NoError(err)
+	suite.Require().True(rsp.Failed())
+	suite.Require().Equal(int64(600), suite.app.BankKeeper.GetBalance(suite.ctx, icaAddr, evmDenom).Amount.Int64())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, evmAddr.Bytes(), evmDenom).IsZero())
+}
+
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	testCases := []struct {
 		name      string
@@ -115,3 +288,121 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRestoreContract() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := tests.GenerateAddress()
+	oldKey, newKey, zeroKey := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2)), common.BigToHash(big.NewInt(3))
+	value := common.BigToHash(big.NewInt(42))
+	code := []byte{0x60, 0x80}
+
+	testCases := []struct {
+		name      string
+		malleate  func() *types.MsgRestoreContract
+		expectErr bool
+	}{
+		{
+			"fail - invalid authority",
+			func() *types.MsgRestoreContract {
+				return &types.MsgRestoreContract{
+					Authority: "foobar",
+					Contract:  types.GenesisAccount{Address: contract.Hex(), Code: common.Bytes2Hex(code)},
+				}
+			},
+			true,
+		},
+		{
+			"fail - not an eth account",
+			func() *types.MsgRestoreContract {
+				moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+				acc := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+				suite.Require().NotNil(acc)
+				return &types.MsgRestoreContract{
+					Authority: authority,
+					Contract:  types.GenesisAccount{Address: moduleAddr.Hex(), Code: common.Bytes2Hex(code)},
+				}
+			},
+			true,
+		},
+		{
+			"pass - new contract",
+			func() *types.MsgRestoreContract {
+				return &types.MsgRestoreContract{
+					Authority: authority,
+					Contract: types.GenesisAccount{
+						Address: contract.Hex(),
+						Code:    common.Bytes2Hex(code),
+						Storage: types.Storage{types.NewState(newKey, value)},
+					},
+				}
+			},
+			false,
+		},
+		{
+			"pass - existing contract, the missing slots are deleted",
+			func() *types.MsgRestoreContract {
+				vmdb := suite.StateDB()
+				vmdb.AddBalance(contract, big.NewInt(100))
+				vmdb.SetNonce(contract, 1)
+				vmdb.SetCode(contract, []byte{0x1})
+				vmdb.SetState(contract, oldKey, value)
+				vmdb.SetState(contract, newKey, common.BigToHash(big.NewInt(7)))
+				suite.Require().NoError(vmdb.Commit())
+
+				return &types.MsgRestoreContract{
+					Authority: authority,
+					Contract: types.GenesisAccount{
+						Address: contract.Hex(),
+						Code:    common.Bytes2Hex(code),
+						Storage: types.Storage{types.NewState(newKey, value)},
+					},
+				}
+			},
+			false,
+		},
+		{
+			"pass - the zeroed slots of the snapshot are deleted",
+			func() *types.MsgRestoreContract {
+				vmdb := suite.StateDB()
+				vmdb.SetCode(contract, []byte{0x1})
+				vmdb.SetState(contract, zeroKey, value)
+				suite.Require().NoError(vmdb.Commit())
+
+				return &types.MsgRestoreContract{
+					Authority: authority,
+					Contract: types.GenesisAccount{
+						Address: contract.Hex(),
+						Code:    common.Bytes2Hex(code),
+						Storage: types.Storage{types.NewState(newKey, value), types.NewState(zeroKey, common.Hash{})},
+					},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			msg := tc.malleate()
+
+			// the balance and the nonce of the account are kept
+			before := suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, contract)
+
+			_, err := suite.app.EvmKeeper.RestoreContract(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			after := suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, contract)
+			suite.Require().Equal(crypto.Keccak256(code), after.CodeHash)
+			suite.Require().Equal(before.Nonce, after.Nonce)
+			suite.Require().Equal(before.Balance, after.Balance)
+			suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(after.CodeHash)))
+			suite.Require().Equal(types.Storage{types.NewState(newKey, value)}, suite.app.EvmKeeper.GetAccountStorage(suite.ctx, contract))
+			suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetStorageUsage(suite.ctx, contract).Slots)
+		})
+	}
+}
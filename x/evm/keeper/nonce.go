@@ -0,0 +1,58 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// IncrementNonce verifies that the nonce of an ethereum tx is the sequence of its sender and
+// increments it. The EVM nonce of an account is its cosmos sequence, shared with the cosmos txs it
+// signs, so that both kinds of txs can interleave: an ethereum tx must use the sequence left by the
+// previous tx of the sender, whatever its kind. A sender with a base account is upgraded to an eth
+// account, keeping its account number and sequence.
+func (k *Keeper) IncrementNonce(ctx sdk.Context, sender sdk.AccAddress, nonce uint64) error {
+	acc := k.accountKeeper.GetAccount(ctx, sender)
+	if acc == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s is nil", common.BytesToAddress(sender))
+	}
+
+	if expected := acc.GetSequence(); nonce != expected {
+		return errorsmod.Wrapf(errortypes.ErrInvalidSequence, "invalid nonce; got %d, expected %d", nonce, expected)
+	}
+
+	// upgrade the base accounts created by the bank transfers to eth accounts the first time they
+	// send an eth tx
+	if baseAcc, ok := acc.(*authtypes.BaseAccount); ok {
+		acc = &ethermint.EthAccount{
+			BaseAccount: baseAcc,
+			CodeHash:    common.BytesToHash(types.EmptyCodeHash).Hex(),
+		}
+	}
+
+	if err := acc.SetSequence(nonce + 1); err != nil {
+		return errorsmod.Wrapf(err, "failed to set sequence to %d", nonce+1)
+	}
+	k.accountKeeper.SetAccount(ctx, acc)
+	return nil
+}
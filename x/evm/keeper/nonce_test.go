@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+func (suite *KeeperTestSuite) TestIncrementNonceMixedWorkload() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	addr := tests.GenerateAddress()
+	cosmosAddr := sdk.AccAddress(addr.Bytes())
+
+	suite.Require().ErrorIs(k.IncrementNonce(suite.ctx, cosmosAddr, 0), errortypes.ErrUnknownAddress)
+
+	// the base account created by a bank transfer is upgraded by its first eth tx
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, cosmosAddr))
+	suite.Require().NoError(k.IncrementNonce(suite.ctx, cosmosAddr, 0))
+	_, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, cosmosAddr).(ethermint.EthAccountI)
+	suite.Require().True(ok)
+	suite.Require().Equal(uint64(1), k.GetNonce(suite.ctx, addr))
+
+	// a cosmos tx signed by the account consumes the next sequence
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("aphoton", 1))
+	suite.Require().NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(cosmosAddr, tests.GenerateAddress().Bytes(), coins)))
+	isd := authante.NewIncrementSequenceDecorator(suite.app.AccountKeeper)
+	_, err := isd.AnteHandle(suite.ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), k.GetNonce(suite.ctx, addr))
+
+	// the eth txs continue from the sequence left by the cosmos tx
+	suite.Require().ErrorIs(k.IncrementNonce(suite.ctx, cosmosAddr, 1), errortypes.ErrInvalidSequence)
+	suite.Require().NoError(k.IncrementNonce(suite.ctx, cosmosAddr, 2))
+	suite.Require().Equal(uint64(3), k.GetNonce(suite.ctx, addr))
+	suite.Require().Equal(uint64(3), suite.app.AccountKeeper.GetAccount(suite.ctx, cosmosAddr).GetSequence())
+}
@@ -31,7 +31,8 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
-// SetParams sets the EVM params each in their individual key for better get performance
+// SetParams sets the EVM params each in their individual key for better get performance. The params
+// are recorded in the params history at the current height.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
 		return err
@@ -43,6 +44,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	k.setParamsHistory(ctx, bz)
 	store.Set(types.KeyPrefixParams, bz)
 	return nil
 }
@@ -0,0 +1,75 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// GetParamsAtHeight returns the evm params in effect at the end of the block of the given height,
+// so that the replays of the past blocks don't use the params changed since. The heights prior to
+// the recorded history are given the oldest recorded params, and the current params are returned if
+// the params were never recorded.
+func (k Keeper) GetParamsAtHeight(ctx sdk.Context, height int64) types.Params {
+	if height < 0 {
+		height = 0
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParamsHistory)
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		oldest := store.Iterator(nil, nil)
+		defer oldest.Close()
+		if !oldest.Valid() {
+			return k.GetParams(ctx)
+		}
+		iterator = oldest
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(iterator.Value(), &params)
+	return params
+}
+
+// setParamsHistory records the encoded params as the ones in effect from the end of the current
+// block, a change overriding the previous ones of the same block. On the first change of a chain
+// upgraded from a version without history, the params in effect until then are recorded at height 0
+// so that the prior heights aren't given the new params.
+func (k Keeper) setParamsHistory(ctx sdk.Context, bz []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParamsHistory)
+	height := ctx.BlockHeight()
+	if height < 0 {
+		height = 0
+	}
+
+	if height > 0 && !k.hasParamsHistory(ctx) {
+		if prev := ctx.KVStore(k.storeKey).Get(types.KeyPrefixParams); len(prev) > 0 {
+			store.Set(sdk.Uint64ToBigEndian(0), prev)
+		}
+	}
+	store.Set(sdk.Uint64ToBigEndian(uint64(height)), bz)
+}
+
+// hasParamsHistory returns true if any params are recorded in the params history.
+func (k Keeper) hasParamsHistory(ctx sdk.Context) bool {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParamsHistory).Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestGetParamsAtHeight() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	genesisParams := k.GetParams(suite.ctx)
+
+	noCreate := genesisParams
+	noCreate.EnableCreate = false
+	suite.Require().NoError(k.SetParams(suite.ctx.WithBlockHeight(10), noCreate))
+
+	extraEIPs := noCreate
+	extraEIPs.ExtraEIPs = []int64{2200}
+	suite.Require().NoError(k.SetParams(suite.ctx.WithBlockHeight(20), extraEIPs))
+
+	testCases := []struct {
+		height    int64
+		expParams types.Params
+	}{
+		{0, genesisParams},
+		{9, genesisParams},
+		{10, noCreate},
+		{19, noCreate},
+		{20, extraEIPs},
+		{1000, extraEIPs},
+	}
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expParams, k.GetParamsAtHeight(suite.ctx, tc.height), tc.height)
+	}
+
+	// the queries replaying a past block use the params in effect at that height
+	cfg, err := k.EVMConfigAtHeight(suite.ctx, 15, sdk.ConsAddress(suite.ctx.BlockHeader().ProposerAddress), k.ChainID())
+	suite.Require().NoError(err)
+	suite.Require().False(cfg.Params.EnableCreate)
+	suite.Require().Empty(cfg.Params.ExtraEIPs)
+}
+
+func (suite *KeeperTestSuite) TestParamsHistoryUpgrade() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	prevParams := k.GetParams(suite.ctx)
+
+	// a chain upgraded from a version without params history
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.KeyPrefixParamsHistory)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	suite.Require().Equal(prevParams, k.GetParamsAtHeight(suite.ctx, 5))
+
+	params := prevParams
+	params.EnableCreate = false
+	suite.Require().NoError(k.SetParams(suite.ctx.WithBlockHeight(30), params))
+
+	// the params in use before the first recorded change are kept for the prior heights
+	suite.Require().Equal(prevParams, k.GetParamsAtHeight(suite.ctx, 5))
+	suite.Require().Equal(prevParams, k.GetParamsAtHeight(suite.ctx, 29))
+	suite.Require().Equal(params, k.GetParamsAtHeight(suite.ctx, 30))
+	suite.Require().Equal(params, k.GetParams(suite.ctx))
+}
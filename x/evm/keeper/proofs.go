@@ -0,0 +1,80 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetProofQuerier sets the store used to generate the storage proofs, typically
+// the application commit multistore.
+// It should be called only once during initialization, it panics if called more than once.
+func (k *Keeper) SetProofQuerier(q storetypes.Queryable) *Keeper {
+	if k.proofQuerier != nil {
+		panic("cannot set evm proof querier twice")
+	}
+
+	k.proofQuerier = q
+	return k
+}
+
+// QueryProofs returns the values of the given storage slots of a contract
+// together with their ICS23 proofs against the application hash, so that
+// light-client bridges can verify the EVM state on counterparty chains.
+//
+// The proofs are generated at the IAVL version of the given height, and verify
+// against the application hash included in the header of the next block.
+func (k Keeper) QueryProofs(address common.Address, slots []common.Hash, height int64) ([]types.StorageProof, error) {
+	if k.proofQuerier == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrLogic, "evm proof querier not set")
+	}
+
+	if height <= 0 {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidHeight, "invalid proof height %d", height)
+	}
+
+	proofs := make([]types.StorageProof, len(slots))
+	for i, slot := range slots {
+		res := k.proofQuerier.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", types.StoreKey),
+			Data:   types.StateKey(address, slot.Bytes()),
+			Height: height,
+			Prove:  true,
+		})
+
+		if !res.IsOK() {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "failed to query proof of slot %s: %s", slot.Hex(), res.Log)
+		}
+
+		proofs[i] = types.StorageProof{
+			Address: address,
+			Key:     slot,
+			Value:   common.BytesToHash(res.Value),
+			Height:  res.Height,
+			Proof:   res.ProofOps,
+		}
+	}
+
+	return proofs, nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryProofs() {
+	suite.SetupTest()
+
+	address := common.HexToAddress("0x756F45E3FA69347A9A973A725E3C98bC4db0b5a0")
+	setKey := common.BytesToHash([]byte("set"))
+	unsetKey := common.BytesToHash([]byte("unset"))
+	value := common.BytesToHash([]byte("value"))
+
+	suite.app.EvmKeeper.SetState(suite.ctx, address, setKey, value.Bytes())
+	res := suite.app.Commit()
+	height := suite.app.LastBlockHeight()
+
+	proofs, err := suite.app.EvmKeeper.QueryProofs(address, []common.Hash{setKey, unsetKey}, height)
+	suite.Require().NoError(err)
+	suite.Require().Len(proofs, 2)
+
+	suite.Require().Equal(value, proofs[0].Value)
+	suite.Require().Equal(height, proofs[0].Height)
+	suite.Require().NoError(proofs[0].Verify(res.Data))
+
+	suite.Require().Equal(common.Hash{}, proofs[1].Value)
+	suite.Require().NoError(proofs[1].Verify(res.Data))
+
+	// tampered values must not verify
+	tampered := proofs[0]
+	tampered.Value = common.BytesToHash([]byte("other"))
+	suite.Require().Error(tampered.Verify(res.Data))
+
+	_, err = suite.app.EvmKeeper.QueryProofs(address, []common.Hash{setKey}, 0)
+	suite.Require().Error(err)
+
+	_, err = suite.app.EvmKeeper.QueryProofs(address, []common.Hash{setKey}, height+10)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestStorageProofVerifyMissing() {
+	proof := types.StorageProof{Key: common.BytesToHash([]byte("key"))}
+	suite.Require().Error(proof.Verify([]byte("apphash")))
+}
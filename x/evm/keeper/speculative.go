@@ -0,0 +1,412 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SpeculativeCache caches the results of the speculative executions of the ethereum transactions
+// done in CheckTx, keyed by tx hash, so that DeliverTx reuses them instead of running the EVM
+// again if the state read by the execution is unchanged.
+type SpeculativeCache struct {
+	results *lru.Cache
+}
+
+// NewSpeculativeCache returns a cache of the speculative execution results of the given number of
+// transactions.
+func NewSpeculativeCache(size int) (*SpeculativeCache, error) {
+	results, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &SpeculativeCache{results: results}, nil
+}
+
+// Len returns the number of the cached execution results.
+func (c *SpeculativeCache) Len() int {
+	return c.results.Len()
+}
+
+func (c *SpeculativeCache) add(txHash common.Hash, result *speculativeResult) {
+	c.results.Add(txHash, result)
+}
+
+// take removes and returns the execution result of the tx, a result is used at most once.
+func (c *SpeculativeCache) take(txHash common.Hash) (*speculativeResult, bool) {
+	value, ok := c.results.Get(txHash)
+	if !ok {
+		return nil, false
+	}
+	c.results.Remove(txHash)
+	return value.(*speculativeResult), true
+}
+
+// speculativeResult is the result of a speculative execution with the state it depends on.
+type speculativeResult struct {
+	config   speculativeConfig
+	accounts []accountRead
+	slots    []slotRead
+	pauses   []pauseRead
+	// hasPaused is true if a contract was paused during the execution
+	hasPaused bool
+	// writes are the state writes of the StateDB commit, in order
+	writes     []func(ctx sdk.Context, k *Keeper) error
+	res        *types.MsgEthereumTxResponse
+	accessList ethtypes.AccessList
+}
+
+// speculativeConfig is the part of the EVM configuration the execution results depend on.
+type speculativeConfig struct {
+	params           []byte
+	rules            params.Rules
+	minGasMultiplier sdk.Dec
+}
+
+type accountRead struct {
+	address common.Address
+	account *statedb.Account
+}
+
+type slotRead struct {
+	address common.Address
+	key     common.Hash
+	value   common.Hash
+}
+
+type pauseRead struct {
+	address common.Address
+	paused  bool
+}
+
+// SetSpeculativeCache enables the speculative execution of the ethereum transactions in CheckTx.
+// It should be called only once during initialization, it panics if called more than once.
+func (k *Keeper) SetSpeculativeCache(cache *SpeculativeCache) *Keeper {
+	if k.speculativeCache != nil {
+		panic("cannot set evm speculative cache twice")
+	}
+
+	k.speculativeCache = cache
+	return k
+}
+
+// speculationEnabled returns true if the speculative execution is enabled and the execution isn't
+// traced, a traced execution must actually run.
+func (k *Keeper) speculationEnabled() bool {
+	return k.speculativeCache != nil && k.tracer == ""
+}
+
+// speculativeConfig returns the configuration the execution results depend on at the height of
+// the context.
+func (k *Keeper) speculativeConfig(ctx sdk.Context, cfg *statedb.EVMConfig) speculativeConfig {
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	rules.ChainID = nil
+	return speculativeConfig{
+		params:           k.cdc.MustMarshal(&cfg.Params),
+		rules:            rules,
+		minGasMultiplier: k.GetMinGasMultiplier(ctx),
+	}
+}
+
+func (c speculativeConfig) equal(other speculativeConfig) bool {
+	return string(c.params) == string(other.params) &&
+		c.rules == other.rules &&
+		c.minGasMultiplier.Equal(other.minGasMultiplier)
+}
+
+// SpeculateTransaction executes the ethereum transaction on a branch of the check state, and caches
+// the results if they only depend on the state read by the execution. It's a no-op if the
+// speculative execution is disabled.
+func (k *Keeper) SpeculateTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx) {
+	if !k.speculationEnabled() {
+		return
+	}
+	// the gas left to the execution of the txs paying their fees with fee tokens depends on the gas
+	// of the conversion, which may differ in DeliverTx
+	if k.GetFeeConversionGasTransient(ctx, common.HexToHash(msgEth.Hash)) > 0 {
+		return
+	}
+
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+
+	// the coinbase is left empty, the check state doesn't always know the block proposer and the
+	// executions reading the coinbase aren't cached anyway
+	params := k.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(k.eip155ChainID)
+	cfg := &statedb.EVMConfig{
+		Params:      params,
+		ChainConfig: ethCfg,
+		BaseFee:     k.GetBaseFee(ctx, ethCfg),
+	}
+	ethTx := msgEth.AsTransaction()
+	signer := types.MakeSigner(cfg.ChainConfig, ctx.BlockHeight())
+	msg, err := msgEth.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return
+	}
+
+	recorder := newSpeculativeRecorder(k)
+	res, accessList, err := k.applyMessageWithConfig(ctx, msg, recorder, true, cfg, k.TxConfig(ctx, ethTx.Hash()), recorder)
+	if err != nil || recorder.notReusable {
+		return
+	}
+
+	k.speculativeCache.add(ethTx.Hash(), &speculativeResult{
+		config:     k.speculativeConfig(ctx, cfg),
+		accounts:   recorder.accounts,
+		slots:      recorder.slots,
+		pauses:     recorder.pauses,
+		hasPaused:  recorder.hasPaused,
+		writes:     recorder.writes,
+		res:        res,
+		accessList: accessList,
+	})
+}
+
+// applySpeculativeResult applies the cached speculative execution result of the message, if the
+// state and the configuration it depends on are unchanged. It returns a nil response if there is
+// no result to reuse, the message must then be executed.
+func (k *Keeper) applySpeculativeResult(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	if !k.speculationEnabled() {
+		return nil, nil
+	}
+
+	result, found := k.speculativeCache.take(txConfig.TxHash)
+	if !found || !result.valid(ctx, k, k.speculativeConfig(ctx, cfg)) {
+		telemetry.IncrCounter(1, types.ModuleName, types.MetricKeySpeculativeMiss)
+		return nil, nil
+	}
+	telemetry.IncrCounter(1, types.ModuleName, types.MetricKeySpeculativeHit)
+
+	for _, write := range result.writes {
+		if err := write(ctx, k); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+		}
+	}
+
+	if result.accessList != nil && k.accessListRecorder != nil {
+		k.accessListRecorder.RecordAccessList(ctx, msg, result.accessList)
+	}
+
+	// the logs are tied to the block and the position of the tx in it
+	res := *result.res
+	res.Logs = make([]*types.Log, len(result.res.Logs))
+	for i, log := range result.res.Logs {
+		l := *log
+		l.BlockHash = txConfig.BlockHash.Hex()
+		l.BlockNumber = uint64(ctx.BlockHeight())
+		l.TxIndex = uint64(txConfig.TxIndex)
+		l.Index = uint64(txConfig.LogIndex) + uint64(i)
+		res.Logs[i] = &l
+	}
+
+	return &res, nil
+}
+
+// valid returns true if the result is still valid for the given state and configuration.
+func (r *speculativeResult) valid(ctx sdk.Context, k *Keeper, config speculativeConfig) bool {
+	if !r.config.equal(config) {
+		return false
+	}
+
+	for _, read := range r.accounts {
+		if !accountsEqual(read.account, k.GetAccount(ctx, read.address)) {
+			return false
+		}
+	}
+
+	for _, read := range r.slots {
+		if k.GetState(ctx, read.address, read.key) != read.value {
+			return false
+		}
+	}
+
+	// the calls are only checked against the paused contracts if at least one is paused
+	if k.HasPausedContracts(ctx) != r.hasPaused {
+		return false
+	}
+
+	for _, read := range r.pauses {
+		if k.IsContractPaused(ctx, read.address) != read.paused {
+			return false
+		}
+	}
+
+	return true
+}
+
+func accountsEqual(a, b *statedb.Account) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Nonce == b.Nonce &&
+		a.Balance.Cmp(b.Balance) == 0 &&
+		string(a.CodeHash) == string(b.CodeHash)
+}
+
+// blockDependentOpCodes are the opcodes reading the block context, or the gas price which depends
+// on the block base fee.
+var blockDependentOpCodes = map[vm.OpCode]bool{
+	vm.BLOCKHASH:  true,
+	vm.COINBASE:   true,
+	vm.TIMESTAMP:  true,
+	vm.NUMBER:     true,
+	vm.DIFFICULTY: true,
+	vm.GASLIMIT:   true,
+	vm.BASEFEE:    true,
+	vm.GASPRICE:   true,
+}
+
+// speculativeRecorder is the state keeper of a speculative execution. It records the state read
+// by the execution, and records the state writes of the commit instead of applying them. It's also
+// the tracer of the execution, to detect the results depending on the block context.
+type speculativeRecorder struct {
+	types.NoOpTracer
+
+	keeper   *Keeper
+	accounts []accountRead
+	slots    []slotRead
+	pauses   []pauseRead
+	writes   []func(ctx sdk.Context, k *Keeper) error
+
+	hasPaused bool
+
+	// notReusable is true if the results can't be reused in another block
+	notReusable bool
+}
+
+var (
+	_ statedb.Keeper = &speculativeRecorder{}
+	_ pauseReader    = &speculativeRecorder{}
+	_ vm.EVMLogger   = &speculativeRecorder{}
+)
+
+func newSpeculativeRecorder(k *Keeper) *speculativeRecorder {
+	return &speculativeRecorder{keeper: k}
+}
+
+// GetAccount implements statedb.Keeper
+func (r *speculativeRecorder) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account {
+	account := r.keeper.GetAccount(ctx, addr)
+	read := accountRead{address: addr}
+	if account != nil {
+		read.account = &statedb.Account{
+			Nonce:    account.Nonce,
+			Balance:  new(big.Int).Set(account.Balance),
+			CodeHash: account.CodeHash,
+		}
+	}
+	r.accounts = append(r.accounts, read)
+	return account
+}
+
+// GetState implements statedb.Keeper
+func (r *speculativeRecorder) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	value := r.keeper.GetState(ctx, addr, key)
+	r.slots = append(r.slots, slotRead{address: addr, key: key, value: value})
+	return value
+}
+
+// GetCode implements statedb.Keeper, the code is immutable for a given code hash.
+func (r *speculativeRecorder) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
+	return r.keeper.GetCode(ctx, codeHash)
+}
+
+// HasPausedContracts implements pauseReader
+func (r *speculativeRecorder) HasPausedContracts(ctx sdk.Context) bool {
+	r.hasPaused = r.keeper.HasPausedContracts(ctx)
+	return r.hasPaused
+}
+
+// IsContractPaused implements pauseReader
+func (r *speculativeRecorder) IsContractPaused(ctx sdk.Context, addr common.Address) bool {
+	paused := r.keeper.IsContractPaused(ctx, addr)
+	r.pauses = append(r.pauses, pauseRead{address: addr, paused: paused})
+	return paused
+}
+
+// ForEachStorage implements statedb.Keeper, the iterated storage isn't recorded so the results
+// can't be reused.
+func (r *speculativeRecorder) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	r.notReusable = true
+	r.keeper.ForEachStorage(ctx, addr, cb)
+}
+
+// SetAccount implements statedb.Keeper
+func (r *speculativeRecorder) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
+	account.Balance = new(big.Int).Set(account.Balance)
+	r.writes = append(r.writes, func(ctx sdk.Context, k *Keeper) error {
+		if err := k.SetAccount(ctx, addr, account); err != nil {
+			return errorsmod.Wrap(err, "failed to set account")
+		}
+		return nil
+	})
+	return nil
+}
+
+// SetState implements statedb.Keeper
+func (r *speculativeRecorder) SetState(_ sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	r.writes = append(r.writes, func(ctx sdk.Context, k *Keeper) error {
+		k.SetState(ctx, addr, key, value)
+		return nil
+	})
+}
+
+// SetCode implements statedb.Keeper
+func (r *speculativeRecorder) SetCode(_ sdk.Context, codeHash []byte, code []byte) {
+	r.writes = append(r.writes, func(ctx sdk.Context, k *Keeper) error {
+		k.SetCode(ctx, codeHash, code)
+		return nil
+	})
+}
+
+// DeleteAccount implements statedb.Keeper
+func (r *speculativeRecorder) DeleteAccount(_ sdk.Context, addr common.Address) error {
+	r.writes = append(r.writes, func(ctx sdk.Context, k *Keeper) error {
+		if err := k.DeleteAccount(ctx, addr); err != nil {
+			return errorsmod.Wrap(err, "failed to delete account")
+		}
+		return nil
+	})
+	return nil
+}
+
+// CaptureState implements vm.EVMLogger
+func (r *speculativeRecorder) CaptureState(_ uint64, op vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+	if blockDependentOpCodes[op] {
+		r.notReusable = true
+	}
+}
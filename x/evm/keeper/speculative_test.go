@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	// counterCode increments the slot 0: PUSH1 0 SLOAD PUSH1 1 ADD PUSH1 0 SSTORE STOP
+	counterCode = common.FromHex("0x60005460010160005500")
+	// blockNumberCode stores the block number in the slot 0: NUMBER PUSH1 0 SSTORE STOP
+	blockNumberCode = common.FromHex("0x4360005500")
+)
+
+func (suite *KeeperTestSuite) deployRuntimeCode(code []byte) common.Address {
+	addr := tests.GenerateAddress()
+	vmdb := suite.StateDB()
+	vmdb.SetCode(addr, code)
+	suite.Require().NoError(vmdb.Commit())
+	return addr
+}
+
+func (suite *KeeperTestSuite) signedCallTx(to common.Address, gasLimit uint64) *types.MsgEthereumTx {
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	tx := types.NewTx(chainID, nonce, &to, nil, gasLimit, nil, nil, nil, nil, nil)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+	return tx
+}
+
+func (suite *KeeperTestSuite) TestSpeculativeExecution() {
+	slot := common.Hash{}
+
+	testCases := []struct {
+		name     string
+		code     []byte
+		malleate func(ctx sdk.Context, contract common.Address)
+		expCache int
+		expValue common.Hash
+	}{
+		{
+			"reuse the speculative result",
+			counterCode,
+			func(sdk.Context, common.Address) {},
+			1,
+			common.BigToHash(big.NewInt(1)),
+		},
+		{
+			"the state read is changed, execute again",
+			counterCode,
+			func(ctx sdk.Context, contract common.Address) {
+				suite.app.EvmKeeper.SetState(ctx, contract, slot, common.BigToHash(big.NewInt(5)).Bytes())
+			},
+			1,
+			common.BigToHash(big.NewInt(6)),
+		},
+		{
+			"the contract is paused, execute again",
+			counterCode,
+			func(ctx sdk.Context, contract common.Address) {
+				suite.app.EvmKeeper.SetContractPaused(ctx, contract, true)
+			},
+			1,
+			common.Hash{},
+		},
+		{
+			"the execution reads the block context, not cached",
+			blockNumberCode,
+			func(sdk.Context, common.Address) {},
+			0,
+			common.BigToHash(big.NewInt(suite.ctx.BlockHeight())),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			cache, err := keeper.NewSpeculativeCache(10)
+			suite.Require().NoError(err)
+			suite.app.EvmKeeper.SetSpeculativeCache(cache)
+			suite.Require().Panics(func() {
+				suite.app.EvmKeeper.SetSpeculativeCache(cache)
+			})
+
+			contract := suite.deployRuntimeCode(tc.code)
+			tx := suite.signedCallTx(contract, 100000)
+
+			// the expected result of the execution without speculation
+			branchCtx, _ := suite.ctx.CacheContext()
+			tc.malleate(branchCtx, contract)
+			expRes, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(branchCtx), tx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(0, cache.Len())
+
+			suite.app.EvmKeeper.SpeculateTransaction(suite.ctx.WithIsCheckTx(true), tx)
+			suite.Require().Equal(tc.expCache, cache.Len())
+			// the speculative execution doesn't change the state
+			suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, contract, slot))
+
+			tc.malleate(suite.ctx, contract)
+			res, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(0, cache.Len(), "the result is used at most once")
+			suite.Require().Equal(expRes.GasUsed, res.GasUsed)
+			suite.Require().Equal(expRes.VmError, res.VmError)
+			suite.Require().Equal(tc.expValue, suite.app.EvmKeeper.GetState(suite.ctx, contract, slot))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSpeculativeExecutionDisabled() {
+	suite.SetupTest()
+	contract := suite.deployRuntimeCode(counterCode)
+	tx := suite.signedCallTx(contract, 100000)
+
+	suite.app.EvmKeeper.SpeculateTransaction(suite.ctx.WithIsCheckTx(true), tx)
+	_, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.app.EvmKeeper.GetState(suite.ctx, contract, common.Hash{}))
+}
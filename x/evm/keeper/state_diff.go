@@ -0,0 +1,142 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// stateDiffRecorder is the state keeper of a dry run. It reads the state from the keeper, and
+// records the state writes of the commit as a diff with the current state instead of applying them.
+type stateDiffRecorder struct {
+	keeper   *Keeper
+	accounts map[common.Address]*types.AccountDiff
+}
+
+var _ statedb.Keeper = &stateDiffRecorder{}
+
+func newStateDiffRecorder(k *Keeper) *stateDiffRecorder {
+	return &stateDiffRecorder{
+		keeper:   k,
+		accounts: make(map[common.Address]*types.AccountDiff),
+	}
+}
+
+// GetAccount implements statedb.Keeper
+func (r *stateDiffRecorder) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account {
+	return r.keeper.GetAccount(ctx, addr)
+}
+
+// GetState implements statedb.Keeper
+func (r *stateDiffRecorder) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	return r.keeper.GetState(ctx, addr, key)
+}
+
+// GetCode implements statedb.Keeper
+func (r *stateDiffRecorder) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
+	return r.keeper.GetCode(ctx, codeHash)
+}
+
+// ForEachStorage implements statedb.Keeper
+func (r *stateDiffRecorder) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	r.keeper.ForEachStorage(ctx, addr, cb)
+}
+
+// SetAccount implements statedb.Keeper
+func (r *stateDiffRecorder) SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error {
+	diff := r.account(ctx, addr)
+	diff.BalanceAfter = account.Balance.String()
+	diff.NonceAfter = account.Nonce
+	diff.CodeHashAfter = common.BytesToHash(account.CodeHash).Hex()
+	return nil
+}
+
+// SetState implements statedb.Keeper
+func (r *stateDiffRecorder) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	diff := r.account(ctx, addr)
+	diff.Storage = append(diff.Storage, types.StorageDiff{
+		Key:    key.Hex(),
+		Before: r.keeper.GetState(ctx, addr, key).Hex(),
+		After:  common.BytesToHash(value).Hex(),
+	})
+}
+
+// SetCode implements statedb.Keeper, the code changes are part of the account code hash.
+func (r *stateDiffRecorder) SetCode(sdk.Context, []byte, []byte) {}
+
+// DeleteAccount implements statedb.Keeper
+func (r *stateDiffRecorder) DeleteAccount(ctx sdk.Context, addr common.Address) error {
+	diff := r.account(ctx, addr)
+	diff.BalanceAfter = "0"
+	diff.NonceAfter = 0
+	diff.CodeHashAfter = common.BytesToHash(types.EmptyCodeHash).Hex()
+	diff.Destroyed = true
+	return nil
+}
+
+// account returns the diff of the account, initialized with its current state.
+func (r *stateDiffRecorder) account(ctx sdk.Context, addr common.Address) *types.AccountDiff {
+	if diff, ok := r.accounts[addr]; ok {
+		return diff
+	}
+
+	account := r.keeper.GetAccountOrEmpty(ctx, addr)
+	diff := &types.AccountDiff{
+		Address:        addr.Hex(),
+		BalanceBefore:  account.Balance.String(),
+		NonceBefore:    account.Nonce,
+		CodeHashBefore: common.BytesToHash(account.CodeHash).Hex(),
+	}
+	diff.BalanceAfter, diff.NonceAfter, diff.CodeHashAfter = diff.BalanceBefore, diff.NonceBefore, diff.CodeHashBefore
+	r.accounts[addr] = diff
+	return diff
+}
+
+// diff returns the changed accounts sorted by address, the accounts only touched by the execution
+// are left out.
+func (r *stateDiffRecorder) diff() []types.AccountDiff {
+	emptyCodeHash := common.BytesToHash(types.EmptyCodeHash).Hex()
+
+	addrs := make([]common.Address, 0, len(r.accounts))
+	for addr, diff := range r.accounts {
+		unchanged := diff.BalanceBefore == diff.BalanceAfter &&
+			diff.NonceBefore == diff.NonceAfter &&
+			diff.CodeHashBefore == diff.CodeHashAfter &&
+			len(diff.Storage) == 0 &&
+			!diff.Destroyed
+		if !unchanged {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	accounts := make([]types.AccountDiff, len(addrs))
+	for i, addr := range addrs {
+		diff := r.accounts[addr]
+		diff.Created = diff.CodeHashBefore == emptyCodeHash && diff.CodeHashAfter != emptyCodeHash
+		accounts[i] = *diff
+	}
+	return accounts
+}
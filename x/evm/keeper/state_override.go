@@ -0,0 +1,91 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// ApplyStateOverrides decodes the json encoded state overrides and writes them in
+// the state of the given context. It must only be called on a branched context
+// of a query, as the overrides are committed to the store.
+func (k *Keeper) ApplyStateOverrides(ctx sdk.Context, bz []byte) error {
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var overrides types.StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return fmt.Errorf("invalid state overrides: %w", err)
+	}
+
+	stateDB := k.newStateDB(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	for addr, account := range overrides {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+
+		if account.Balance != nil {
+			balance := (*big.Int)(*account.Balance)
+			if balance.Sign() < 0 {
+				return fmt.Errorf("account %s has a negative balance override", addr.Hex())
+			}
+			stateDB.SubBalance(addr, stateDB.GetBalance(addr))
+			stateDB.AddBalance(addr, balance)
+		}
+
+		if account.State != nil {
+			// replace the entire storage of the account
+			var keys []common.Hash
+			if err := stateDB.ForEachStorage(addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			}); err != nil {
+				return err
+			}
+			for _, key := range keys {
+				stateDB.SetState(addr, key, common.Hash{})
+			}
+			for key, value := range *account.State {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return stateDB.Commit()
+}
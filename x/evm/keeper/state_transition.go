@@ -33,7 +33,6 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/params"
 )
 
 // NewEVM generates a go-ethereum VM from the provided Message fields and the chain parameters
@@ -75,7 +74,8 @@ func (k *Keeper) NewEVM(
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
 //  1. The requested height matches the current height from context (and thus same epoch number)
-//  2. The requested height is from an previous height from the same chain epoch
+//  2. The requested height is from an previous height, possibly from a previous chain epoch as the
+//     stored header hashes are carried over the chain-id upgrades by the genesis export
 //  3. The requested height is from a height greater than the latest one
 func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	return func(height uint64) common.Hash {
@@ -90,25 +90,22 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 			// Case 1: The requested height matches the one from the context so we can retrieve the header
 			// hash directly from the context.
 			// Note: The headerHash is only set at begin block, it will be nil in case of a query context
-			headerHash := ctx.HeaderHash()
-			if len(headerHash) != 0 {
-				return common.BytesToHash(headerHash)
-			}
-
-			// only recompute the hash if not set (eg: checkTxState)
-			contextBlockHeader := ctx.BlockHeader()
-			header, err := tmtypes.HeaderFromProto(&contextBlockHeader)
+			// and is recomputed from the header.
+			hash, err := k.currentHeaderHash(ctx)
 			if err != nil {
 				k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
 				return common.Hash{}
 			}
-
-			headerHash = header.Hash()
-			return common.BytesToHash(headerHash)
+			return hash
 
 		case ctx.BlockHeight() > h:
-			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
-			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// Case 2: if the chain is not the current height we need to retrieve the hash from the store,
+			// written at begin block or imported from the genesis of a new chain epoch. The staking
+			// historical info is only used for the heights prior to the store of the hashes.
+			if hash, found := k.GetHeaderHash(ctx, h); found {
+				return hash
+			}
+
 			histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if !found {
 				k.Logger(ctx).Debug("historical info not found", "height", h)
@@ -160,11 +157,18 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	txConfig := k.TxConfig(ctx, ethTx.Hash())
 
 	// get the signer according to the chain rules from the config and block height
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	signer := types.MakeSigner(cfg.ChainConfig, ctx.BlockHeight())
 	msg, err := msgEth.AsMessage(signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
+	gasLimit := msg.Gas()
+
+	// the gas used by the fee token conversion in the ante handler is charged to the tx
+	msg, conversionGas, err := k.chargeFeeConversionGas(ctx, ethTx.Hash(), msg)
+	if err != nil {
+		return nil, err
+	}
 
 	// snapshot to contain the tx processing and post processing in same scope
 	var commit func()
@@ -177,11 +181,16 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	// reuse the CheckTx speculative execution results if the state they depend on is unchanged
+	res, err := k.applySpeculativeResult(tmpCtx, msg, cfg, txConfig)
+	if err == nil && res == nil {
+		// pass true to commit the StateDB
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
+	res.GasUsed += conversionGas
 
 	logs := types.LogsToEthereum(res.Logs)
 
@@ -240,7 +249,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+	if err = k.RefundGas(ctx, msg, gasLimit-res.GasUsed, cfg.Params); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
 
@@ -251,6 +260,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	}
 
 	k.SetTxIndexTransient(ctx, uint64(txConfig.TxIndex)+1)
+	k.AddBlockStatsTransient(ctx, res.GasUsed, msg.GasPrice(), res.Failed())
 
 	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
 	if err != nil {
@@ -318,21 +328,73 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 ) (*types.MsgEthereumTxResponse, error) {
+	res, accessList, err := k.applyMessageWithConfig(ctx, msg, tracer, commit, cfg, txConfig, k)
+	if err != nil {
+		return nil, err
+	}
+
+	if accessList != nil && k.accessListRecorder != nil {
+		k.accessListRecorder.RecordAccessList(ctx, msg, accessList)
+	}
+
+	return res, nil
+}
+
+// applyMessageWithConfig applies the message like ApplyMessageWithConfig, with the state read
+// from, and committed to, the given state keeper. It returns the access list of the message once
+// the Berlin hard fork is enabled, nil otherwise.
+func (k *Keeper) applyMessageWithConfig(ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	stateKeeper statedb.Keeper,
+) (*types.MsgEthereumTxResponse, ethtypes.AccessList, error) {
 	var (
-		ret   []byte // return bytes from evm execution
-		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
+		ret             []byte // return bytes from evm execution
+		vmErr           error  // vm errors do not effect consensus and are therefore not assigned to err
+		contractAddress string // hex address of the created contract
 	)
 
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To() == nil {
-		return nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
 	} else if !cfg.Params.EnableCall && msg.To() != nil {
-		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
-	stateDB := statedb.New(ctx, k, txConfig)
+	// the calls to the paused contracts are rejected by the tracer, which is only wrapped while
+	// a contract is paused since a traced execution is slower
+	pauses, ok := stateKeeper.(pauseReader)
+	if !ok {
+		pauses = k
+	}
+	hasPaused := pauses.HasPausedContracts(ctx)
+	if tracer == nil {
+		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
+	}
+	if hasPaused {
+		tracer = newContractPauseGuard(ctx, tracer, pauses)
+	}
+
+	stateDB := k.newStateDB(ctx, stateKeeper, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
+	// the executions of the queries are canceled once their deadline is exceeded, the context of the
+	// transactions is never done
+	if goCtx := ctx.Context(); goCtx != nil && goCtx.Done() != nil {
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-goCtx.Done():
+				evm.Cancel()
+			case <-done:
+			}
+		}()
+	}
+
 	leftoverGas := msg.Gas()
 
 	// Allow the tracer captures the tx level events, mainly the gas consumption.
@@ -351,43 +413,79 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+		return nil, nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
 	}
 	leftoverGas -= intrinsicGas
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	if rules.IsBerlin {
 		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
 	}
 
+	// the execution is reverted if a deployed code is rejected or the sender can't pay the storage deposits
+	snapshot := stateDB.Snapshot()
+
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
 		// - increase sender's nonce by one no matter the result.
 		stateDB.SetNonce(sender.Address(), msg.Nonce())
-		ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data(), leftoverGas, msg.Value())
+		contractAddress = crypto.CreateAddress(sender.Address(), msg.Nonce()).Hex()
+		if err := k.runEVM(ctx, stateDB, txConfig.TxHash, func() {
+			ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data(), leftoverGas, msg.Value())
+		}); err != nil {
+			// the panicking execution consumes all the gas
+			ret, leftoverGas, vmErr = nil, 0, err
+		}
 		stateDB.SetNonce(sender.Address(), msg.Nonce()+1)
+	} else if hasPaused && pauses.IsContractPaused(ctx, *msg.To()) {
+		// the call fails like a revert, without executing the code nor transferring the value
+		vmErr = errorsmod.Wrapf(types.ErrContractPaused, "contract %s", msg.To().Hex())
 	} else {
-		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
+		if err := k.runEVM(ctx, stateDB, txConfig.TxHash, func() {
+			ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
+		}); err != nil {
+			// the panicking execution consumes all the gas
+			ret, leftoverGas, vmErr = nil, 0, err
+		}
+	}
+
+	// the canceled execution stops without error, its result is discarded
+	if err := contextErr(ctx); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "evm execution aborted")
 	}
 
-	refundQuotient := params.RefundQuotient
+	if vmErr == nil {
+		vmErr = checkDeployedCode(stateDB, cfg.Params.DeploymentPolicy)
+		if vmErr == nil {
+			vmErr = chargeStorageDeposit(stateDB, msg.From(), cfg.Params)
+		}
+		if vmErr != nil {
+			stateDB.RevertToSnapshot(snapshot)
+			if contractCreation {
+				stateDB.SetNonce(sender.Address(), msg.Nonce()+1)
+			}
+		}
+	}
 
-	// After EIP-3529: refunds are capped to gasUsed / 5
-	if isLondon {
-		refundQuotient = params.RefundQuotientEIP3529
+	var accessList ethtypes.AccessList
+	if rules.IsBerlin {
+		accessList = stateDB.AccessList()
 	}
 
+	refundQuotient := cfg.Params.GasRefundQuotient(isLondon)
+
 	// calculate gas refund
 	if msg.Gas() < leftoverGas {
-		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+		return nil, nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
 	}
 	// refund gas
 	temporaryGasUsed := msg.Gas() - leftoverGas
@@ -397,12 +495,13 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	var vmError string
 	if vmErr != nil {
 		vmError = vmErr.Error()
+		k.Logger(ctx).Debug("evm execution failed", "from", msg.From(), "to", msg.To(), "error", vmError)
 	}
 
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
 		if err := stateDB.Commit(); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+			return nil, nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
 	}
 
@@ -414,7 +513,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
 
 	if msg.Gas() < leftoverGas {
-		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.Gas(), leftoverGas)
+		return nil, nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.Gas(), leftoverGas)
 	}
 
 	gasUsed := sdk.MaxDec(minimumGasUsed, sdk.NewDec(int64(temporaryGasUsed))).TruncateInt().Uint64()
@@ -422,10 +521,11 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	leftoverGas = msg.Gas() - gasUsed
 
 	return &types.MsgEthereumTxResponse{
-		GasUsed: gasUsed,
-		VmError: vmError,
-		Ret:     ret,
-		Logs:    types.NewLogsFromEth(stateDB.Logs()),
-		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+		GasUsed:         gasUsed,
+		VmError:         vmError,
+		Ret:             ret,
+		Logs:            types.NewLogsFromEth(stateDB.Logs()),
+		Hash:            txConfig.TxHash.Hex(),
+		ContractAddress: contractAddress,
+	}, accessList, nil
 }
@@ -7,10 +7,12 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/keeper"
@@ -87,6 +89,15 @@ func (suite *KeeperTestSuite) TestGetHashFn() {
 			},
 			common.BytesToHash(hash),
 		},
+		{
+			"case 2.4: height lower than current one, stored at begin block",
+			1,
+			func() {
+				suite.app.EvmKeeper.SetHeaderHash(suite.ctx, 1, common.BytesToHash(tmhash.Sum([]byte("stored"))))
+				suite.ctx = suite.ctx.WithBlockHeight(10)
+			},
+			common.BytesToHash(tmhash.Sum([]byte("stored"))),
+		},
 		{
 			"case 3: height greater than current one",
 			200,
@@ -324,6 +335,13 @@ func (suite *KeeperTestSuite) TestGasToRefund() {
 			0,
 			true,
 		},
+		{
+			"gas refund disabled",
+			11,
+			types.DisabledRefundQuotient,
+			0,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -445,7 +463,7 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 			refund := keeper.GasToRefund(vmdb.GetRefund(), gasUsed, tc.refundQuotient)
 			suite.Require().Equal(tc.expGasRefund, refund)
 
-			err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, refund, "aphoton")
+			err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, refund, types.DefaultParams())
 			if tc.noError {
 				suite.Require().NoError(err)
 			} else {
@@ -456,6 +474,59 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestRefundGasFeeDenom() {
+	suite.SetupTest()
+
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin("ufee", 1000000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, feeCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, authtypes.FeeCollectorName, feeCoins))
+
+	keeperParams := types.DefaultParams()
+	keeperParams.FeeDenom = "ufee"
+	keeperParams.FeeConversionRate = sdk.NewDecWithPrec(15, 1)
+
+	// 3 gas left at a gas price of 5, refunded as floor(3 * 5 * 1.5) ufee
+	m := ethtypes.NewMessage(
+		suite.address, &common.Address{}, 0, big.NewInt(0), params.TxGas, big.NewInt(5), nil, nil, nil, nil, false,
+	)
+	evmBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), keeperParams.EvmDenom)
+	suite.Require().NoError(suite.app.EvmKeeper.RefundGas(suite.ctx, m, 3, keeperParams))
+
+	suite.Require().Equal(int64(22), suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), "ufee").Amount.Int64())
+	suite.Require().Equal(evmBalance, suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), keeperParams.EvmDenom))
+}
+
+func (suite *KeeperTestSuite) TestFeeEvents() {
+	suite.SetupTest()
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, 100))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, fees))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), fees))
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, suite.address))
+
+	m := ethtypes.NewMessage(
+		suite.address, &common.Address{}, 0, big.NewInt(0), params.TxGas, big.NewInt(10), nil, nil, nil, nil, false,
+	)
+	suite.Require().NoError(suite.app.EvmKeeper.RefundGas(suite.ctx, m, 4, types.DefaultParams()))
+
+	var flows [][]string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeFee {
+			continue
+		}
+		var attrs []string
+		for _, attr := range event.Attributes {
+			attrs = append(attrs, string(attr.Value))
+		}
+		flows = append(flows, attrs)
+	}
+	suite.Require().Equal([][]string{
+		{suite.address.Hex(), "100", types.DefaultEVMDenom, types.AttributeValueReasonDeduct},
+		{suite.address.Hex(), "40", types.DefaultEVMDenom, types.AttributeValueReasonRefund},
+	}, flows)
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
@@ -719,3 +790,92 @@ func (suite *KeeperTestSuite) TestGetProposerAddress() {
 		})
 	}
 }
+
+type accessListRecorder struct {
+	accessLists []ethtypes.AccessList
+}
+
+func (r *accessListRecorder) RecordAccessList(_ sdk.Context, _ core.Message, accessList ethtypes.AccessList) {
+	r.accessLists = append(r.accessLists, accessList)
+}
+
+func (suite *KeeperTestSuite) TestAccessListRecorder() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(10000000000000))
+	suite.Commit()
+
+	recorder := &accessListRecorder{}
+	suite.app.EvmKeeper.SetAccessListRecorder(recorder)
+	suite.Require().Panics(func() {
+		suite.app.EvmKeeper.SetAccessListRecorder(recorder)
+	})
+
+	to := common.BigToAddress(big.NewInt(1000))
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, to, big.NewInt(100))
+	suite.Require().NotEmpty(recorder.accessLists)
+
+	// the accesses are reset for every message
+	accessList := recorder.accessLists[len(recorder.accessLists)-1]
+	suite.Require().Equal(recorder.accessLists[0], accessList)
+
+	var contractSlots []common.Hash
+	addresses := make(map[common.Address]bool)
+	for _, tuple := range accessList {
+		addresses[tuple.Address] = true
+		if tuple.Address == contractAddr {
+			contractSlots = tuple.StorageKeys
+		}
+	}
+	suite.Require().True(addresses[suite.address])
+	suite.Require().True(addresses[common.BytesToAddress([]byte{1})], "precompiles are warm")
+	// the balances of the sender and the recipient
+	suite.Require().Len(contractSlots, 2)
+}
+
+type panicTracer struct {
+	types.NoOpTracer
+}
+
+func (panicTracer) CaptureStart(_ *vm.EVM, _, _ common.Address, _ bool, _ []byte, _ uint64, _ *big.Int) {
+	panic("tracer panic")
+}
+
+func (suite *KeeperTestSuite) TestApplyMessagePanicRecovery() {
+	suite.SetupTest()
+
+	proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+	config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+	suite.Require().NoError(err)
+	chainCfg := config.Params.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	for i := 0; i < 2; i++ {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		msg, err := newNativeMessage(nonce, suite.ctx.BlockHeight(), suite.address, chainCfg, suite.signer, signer, ethtypes.AccessListTxType, nil, nil)
+		suite.Require().NoError(err)
+		recipientBalance := suite.app.EvmKeeper.GetBalance(suite.ctx, *msg.To())
+
+		ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+		txConfig := suite.app.EvmKeeper.TxConfig(ctx, common.BigToHash(big.NewInt(int64(i))))
+		res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(ctx, msg, panicTracer{}, true, config, txConfig)
+		suite.Require().NoError(err)
+		suite.Require().True(res.Failed())
+		// the error doesn't depend on the binary, it's part of the tx result
+		suite.Require().Equal(types.ErrEVMPanic.Error(), res.VmError)
+		suite.Require().Equal(msg.Gas(), res.GasUsed)
+
+		// the value transfer is reverted
+		suite.Require().Equal(recipientBalance, suite.app.EvmKeeper.GetBalance(ctx, *msg.To()))
+
+		events := ctx.EventManager().Events()
+		suite.Require().Len(events, 1)
+		suite.Require().Equal(types.EventTypeEVMPanic, events[0].Type)
+
+		attrs := make(map[string]string)
+		for _, attr := range events[0].Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		suite.Require().Equal(fmt.Sprintf("%d", ctx.BlockHeight()), attrs[types.AttributeKeyHeight])
+		suite.Require().Equal(txConfig.TxHash.Hex(), attrs[types.AttributeKeyEthereumTxHash])
+	}
+}
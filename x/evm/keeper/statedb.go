@@ -85,13 +85,18 @@ func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(ke
 }
 
 // SetBalance update account's balance, compare with current balance first, then decide to mint or burn.
+// The wei amount is converted to the evm denom, failing on precision loss unless it's rounded down.
 func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 
 	params := k.GetParams(ctx)
+	newBalance, err := params.FromWei(amount)
+	if err != nil {
+		return errorsmod.Wrapf(err, "balance of %s", addr)
+	}
 	coin := k.bankKeeper.GetBalance(ctx, cosmosAddr, params.EvmDenom)
 	balance := coin.Amount.BigInt()
-	delta := new(big.Int).Sub(amount, balance)
+	delta := new(big.Int).Sub(newBalance.BigInt(), balance)
 	switch delta.Sign() {
 	case 1:
 		// mint
@@ -154,9 +159,12 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 	return nil
 }
 
-// SetState update contract storage, delete if value is empty.
+// SetState update contract storage, delete if value is empty. The storage usage of the contract is
+// updated accordingly.
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	k.updateStorageUsage(ctx, addr, key, store.Get(key.Bytes()), value)
+
 	action := "updated"
 	if len(value) == 0 {
 		store.Delete(key.Bytes())
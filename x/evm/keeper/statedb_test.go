@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -13,11 +15,14 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/server/config"
 	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil/fixtures"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/evmos/ethermint/x/evm/types"
 )
@@ -901,6 +906,31 @@ func (suite *KeeperTestSuite) TestSetBalance() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSetBalanceWeiConversion() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	params := k.GetParams(suite.ctx)
+	params.WeiConversionExponent = 12
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+	addr := tests.GenerateAddress()
+
+	wei := new(big.Int).Mul(big.NewInt(5), big.NewInt(1e12))
+	suite.Require().NoError(k.SetBalance(suite.ctx, addr, wei))
+	suite.Require().Equal(wei, k.GetBalance(suite.ctx, addr))
+	coin := suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), params.EvmDenom)
+	suite.Require().Equal(sdkmath.NewInt(5), coin.Amount)
+
+	// the balance isn't changed by a rejected amount
+	err := k.SetBalance(suite.ctx, addr, new(big.Int).Add(wei, big.NewInt(1)))
+	suite.Require().ErrorIs(err, types.ErrPrecisionLoss)
+	suite.Require().Equal(wei, k.GetBalance(suite.ctx, addr))
+
+	params.RoundDownPrecisionLoss = true
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+	suite.Require().NoError(k.SetBalance(suite.ctx, addr, new(big.Int).Sub(wei, big.NewInt(1))))
+	suite.Require().Equal(new(big.Int).Mul(big.NewInt(4), big.NewInt(1e12)), k.GetBalance(suite.ctx, addr))
+}
+
 func (suite *KeeperTestSuite) TestDeleteAccount() {
 	supply := big.NewInt(100)
 	contractAddr := suite.DeployTestContract(suite.T(), suite.address, supply)
@@ -941,3 +971,24 @@ func (suite *KeeperTestSuite) TestDeleteAccount() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestFixtureReplay() {
+	suite.SetupTest()
+	recipient := tests.GenerateAddress()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, big.NewInt(10))
+	fixture := fixtures.Capture(suite.ctx, suite.app.EvmKeeper, contractAddr)
+
+	// replay the contract state on a fresh chain
+	suite.SetupTest()
+	suite.Require().NoError(fixture.Load(suite.ctx, suite.app.EvmKeeper))
+
+	balanceData, err := types.ERC20Contract.ABI.Pack("balanceOf", recipient)
+	suite.Require().NoError(err)
+	args, err := json.Marshal(&types.TransactionArgs{To: &contractAddr, Data: (*hexutil.Bytes)(&balanceData)})
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.EthCall(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{Args: args, GasCap: uint64(config.DefaultGasCap)})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.LeftPadBytes(big.NewInt(10).Bytes(), 32), res.Ret)
+}
@@ -0,0 +1,174 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// GetStorageUsage returns the storage used by the contract at the given address, the usage is empty
+// if the contract has no non-empty storage slot.
+func (k Keeper) GetStorageUsage(ctx sdk.Context, addr common.Address) types.StorageUsage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStorageUsage)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return types.StorageUsage{Address: addr.Hex()}
+	}
+
+	var usage types.StorageUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// setStorageUsage stores the storage used by a contract, the usage is deleted once the contract has
+// no non-empty storage slot.
+func (k Keeper) setStorageUsage(ctx sdk.Context, usage types.StorageUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStorageUsage)
+	key := common.HexToAddress(usage.Address).Bytes()
+	if usage.Slots == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&usage))
+}
+
+// updateStorageUsage accounts the write of a storage slot, given the previous and new values of
+// the slot. The empty values are not counted.
+func (k Keeper) updateStorageUsage(ctx sdk.Context, addr common.Address, key common.Hash, prev, value []byte) {
+	wasSet, isSet := isNonEmptySlot(prev), isNonEmptySlot(value)
+	if !wasSet && !isSet || wasSet && isSet && len(prev) == len(value) {
+		return
+	}
+
+	usage := k.GetStorageUsage(ctx, addr)
+	if wasSet {
+		usage.Slots--
+		usage.Bytes -= uint64(len(key) + len(prev))
+	}
+	if isSet {
+		usage.Slots++
+		usage.Bytes += uint64(len(key) + len(value))
+	}
+	k.setStorageUsage(ctx, usage)
+}
+
+// RecomputeStorageUsage recomputes the storage used by every contract from the contracts storage.
+func (k Keeper) RecomputeStorageUsage(ctx sdk.Context) {
+	usageStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStorageUsage)
+	var stale [][]byte
+	usageIterator := usageStore.Iterator(nil, nil)
+	for ; usageIterator.Valid(); usageIterator.Next() {
+		stale = append(stale, usageIterator.Key())
+	}
+	usageIterator.Close()
+	for _, key := range stale {
+		usageStore.Delete(key)
+	}
+
+	// the usages are written once the storage is iterated
+	var usages []types.StorageUsage
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStorage)
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if len(key) != common.AddressLength+common.HashLength || !isNonEmptySlot(value) {
+			continue
+		}
+
+		addr := common.BytesToAddress(key[:common.AddressLength]).Hex()
+		if len(usages) == 0 || usages[len(usages)-1].Address != addr {
+			usages = append(usages, types.StorageUsage{Address: addr})
+		}
+		usage := &usages[len(usages)-1]
+		usage.Slots++
+		usage.Bytes += uint64(common.HashLength + len(value))
+	}
+	iterator.Close()
+
+	for _, usage := range usages {
+		k.setStorageUsage(ctx, usage)
+	}
+}
+
+// GetStorageHash returns the keccak256 hash of the non-empty storage slots of the account at the
+// given address, each slot hashed as its key followed by its value in the ascending order of the
+// keys. The hash of an account without storage is the empty trie root, like go-ethereum. It isn't a
+// trie root, the storage proofs aren't verified against it, but it changes with any storage write.
+// It fails without iterating the storage if the account has more slots than the configured max.
+func (k Keeper) GetStorageHash(ctx sdk.Context, addr common.Address) (common.Hash, error) {
+	if k.storageHashMaxSlots > 0 {
+		if slots := k.GetStorageUsage(ctx, addr).Slots; slots > k.storageHashMaxSlots {
+			return common.Hash{}, errorsmod.Wrapf(
+				types.ErrStorageTooLarge, "%d storage slots, the max is %d", slots, k.storageHashMaxSlots,
+			)
+		}
+	}
+
+	hasher := crypto.NewKeccakState()
+	empty := true
+	k.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+		if value == (common.Hash{}) {
+			return true
+		}
+		empty = false
+		hasher.Write(key.Bytes())
+		hasher.Write(value.Bytes())
+		return true
+	})
+	if empty {
+		return ethtypes.EmptyRootHash, nil
+	}
+
+	var hash common.Hash
+	hasher.Read(hash[:]) //nolint:errcheck // the keccak state never fails to read
+	return hash, nil
+}
+
+// chargeStorageDeposit charges the sender the deposits of the storage slots created by a message,
+// the deposits are burned with the balance change when the state is committed.
+func chargeStorageDeposit(stateDB *statedb.StateDB, sender common.Address, params types.Params) error {
+	deposit := params.StorageSlotDepositWei()
+	if deposit == nil {
+		return nil
+	}
+	slots := stateDB.CreatedStorageSlots()
+	if slots == 0 {
+		return nil
+	}
+
+	total := new(big.Int).Mul(deposit, new(big.Int).SetUint64(slots))
+	if stateDB.GetBalance(sender).Cmp(total) < 0 {
+		return errorsmod.Wrapf(types.ErrInsufficientStorageDeposit, "%s wei for %d storage slots", total, slots)
+	}
+	stateDB.SubBalance(sender, total)
+	return nil
+}
+
+// isNonEmptySlot returns true if the stored slot value isn't empty, the zero value being stored as
+// an empty hash.
+func isNonEmptySlot(value []byte) bool {
+	return len(value) > 0 && common.BytesToHash(value) != (common.Hash{})
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestStorageUsage() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	addr := tests.GenerateAddress()
+	key1, key2 := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))
+
+	testCases := []struct {
+		name     string
+		key      common.Hash
+		value    []byte
+		expSlots uint64
+	}{
+		{"set a slot", key1, common.BigToHash(big.NewInt(10)).Bytes(), 1},
+		{"update a slot", key1, common.BigToHash(big.NewInt(20)).Bytes(), 1},
+		{"set another slot", key2, common.BigToHash(big.NewInt(30)).Bytes(), 2},
+		{"set a slot to the empty hash", key1, common.Hash{}.Bytes(), 1},
+		{"set an empty slot to the empty hash", key1, common.Hash{}.Bytes(), 1},
+		{"delete a slot", key2, nil, 0},
+	}
+	for _, tc := range testCases {
+		k.SetState(suite.ctx, addr, tc.key, tc.value)
+		usage := k.GetStorageUsage(suite.ctx, addr)
+		suite.Require().Equal(addr.Hex(), usage.Address, tc.name)
+		suite.Require().Equal(tc.expSlots, usage.Slots, tc.name)
+		suite.Require().Equal(tc.expSlots*2*common.HashLength, usage.Bytes, tc.name)
+	}
+
+	// the accounts deletion clears the usage
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Require().NotZero(k.GetStorageUsage(suite.ctx, contractAddr).Slots)
+	suite.Require().NoError(k.DeleteAccount(suite.ctx, contractAddr))
+	suite.Require().Zero(k.GetStorageUsage(suite.ctx, contractAddr).Slots)
+}
+
+func (suite *KeeperTestSuite) TestQueryStorageUsage() {
+	suite.SetupTest()
+	addr := tests.GenerateAddress()
+	suite.app.EvmKeeper.SetState(suite.ctx, addr, common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(1)).Bytes())
+
+	res, err := suite.queryClient.StorageUsage(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageUsageRequest{Address: addr.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StorageUsage{Address: addr.Hex(), Slots: 1, Bytes: 64}, res.Usage)
+
+	_, err = suite.queryClient.StorageUsage(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageUsageRequest{Address: "0x"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestStorageHash() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	addr := tests.GenerateAddress()
+	key1, key2 := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))
+	value1, value2 := common.BigToHash(big.NewInt(10)), common.BigToHash(big.NewInt(20))
+	requireStorageHash := func(expHash common.Hash) {
+		hash, err := k.GetStorageHash(suite.ctx, addr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expHash, hash)
+	}
+
+	requireStorageHash(ethtypes.EmptyRootHash)
+
+	// the slots are hashed in the order of their keys, whatever the order of the writes
+	k.SetState(suite.ctx, addr, key2, value2.Bytes())
+	k.SetState(suite.ctx, addr, key1, value1.Bytes())
+	requireStorageHash(crypto.Keccak256Hash(key1.Bytes(), value1.Bytes(), key2.Bytes(), value2.Bytes()))
+
+	// the storage isn't hashed past the max number of slots
+	k.SetStorageHashMaxSlots(1)
+	_, err := k.GetStorageHash(suite.ctx, addr)
+	suite.Require().ErrorIs(err, types.ErrStorageTooLarge)
+	_, err = k.Account(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRequest{Address: addr.Hex(), WithStorageHash: true})
+	suite.Require().Equal(codes.ResourceExhausted, status.Code(err))
+	res, err := k.Account(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRequest{Address: addr.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.StorageHash)
+
+	// the empty slots are skipped
+	k.SetState(suite.ctx, addr, key1, common.Hash{}.Bytes())
+	requireStorageHash(crypto.Keccak256Hash(key2.Bytes(), value2.Bytes()))
+	k.SetStorageHashMaxSlots(0)
+
+	k.SetState(suite.ctx, addr, key2, nil)
+	requireStorageHash(ethtypes.EmptyRootHash)
+}
+
+func (suite *KeeperTestSuite) TestRecomputeStorageUsage() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	expUsage := k.GetStorageUsage(suite.ctx, contractAddr)
+	suite.Require().NotZero(expUsage.Slots)
+
+	// the usage is missing for the contracts deployed before the storage accounting
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(append(types.KeyPrefixStorageUsage, contractAddr.Bytes()...))
+	suite.Require().Zero(k.GetStorageUsage(suite.ctx, contractAddr).Slots)
+
+	k.RecomputeStorageUsage(suite.ctx)
+	suite.Require().Equal(expUsage, k.GetStorageUsage(suite.ctx, contractAddr))
+}
+
+func (suite *KeeperTestSuite) TestStorageSlotDeposit() {
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+
+	suite.Require().NoError(k.SetBalance(suite.ctx, suite.address, big.NewInt(1_000_000)))
+	params := k.GetParams(suite.ctx)
+	params.StorageSlotDeposit = sdkmath.NewInt(1000)
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+	transfer := func(to common.Address) *types.MsgEthereumTxResponse {
+		data, err := types.ERC20Contract.ABI.Pack("transfer", to, big.NewInt(1))
+		suite.Require().NoError(err)
+		msg := ethtypes.NewMessage(
+			suite.address, &contractAddr, k.GetNonce(suite.ctx, suite.address), big.NewInt(0), 100_000,
+			big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true,
+		)
+		res, err := k.ApplyMessage(suite.ctx, msg, nil, true)
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// the balance of a new recipient is a new slot
+	recipient := tests.GenerateAddress()
+	balance := k.GetBalance(suite.ctx, suite.address)
+	usage := k.GetStorageUsage(suite.ctx, contractAddr)
+	res := transfer(recipient)
+	suite.Require().False(res.Failed())
+	suite.Require().Equal(new(big.Int).Sub(balance, big.NewInt(1000)), k.GetBalance(suite.ctx, suite.address))
+	suite.Require().Equal(usage.Slots+1, k.GetStorageUsage(suite.ctx, contractAddr).Slots)
+
+	// updating existing slots is free
+	balance = k.GetBalance(suite.ctx, suite.address)
+	res = transfer(recipient)
+	suite.Require().False(res.Failed())
+	suite.Require().Equal(balance, k.GetBalance(suite.ctx, suite.address))
+
+	// the execution is reverted if the sender can't pay the deposit
+	params.StorageSlotDeposit = sdkmath.NewIntFromBigInt(balance).AddRaw(1)
+	suite.Require().NoError(k.SetParams(suite.ctx, params))
+	recipient = tests.GenerateAddress()
+	res = transfer(recipient)
+	suite.Require().True(res.Failed())
+	suite.Require().Contains(res.VmError, types.ErrInsufficientStorageDeposit.Error())
+	suite.Require().Equal(balance, k.GetBalance(suite.ctx, suite.address))
+	suite.Require().Equal(usage.Slots+1, k.GetStorageUsage(suite.ctx, contractAddr).Slots)
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// DeploySystemContract writes the code and the initial storage of a system contract at a fixed
+// address, it's meant to be called from upgrade handlers so that the contract is deployed
+// deterministically on every node without running any constructor.
+//
+// The previous storage of the address is cleared, the balance is kept and the nonce is bumped to 1
+// as for any created contract (EIP-161). An audit record is stored and an event is emitted.
+func (k *Keeper) DeploySystemContract(ctx sdk.Context, addr common.Address, code []byte, storage types.Storage) error {
+	if len(code) == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidAccount, "empty code for system contract %s", addr)
+	}
+	if err := storage.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "invalid storage for system contract %s", addr)
+	}
+
+	// the code hash can't be set on a non ethereum account, e.g. a module account
+	if acct := k.accountKeeper.GetAccount(ctx, addr.Bytes()); acct != nil {
+		if _, ok := acct.(ethermint.EthAccountI); !ok {
+			return errorsmod.Wrapf(types.ErrInvalidAccount, "system contract address %s is a %T", addr, acct)
+		}
+	}
+
+	var keys []common.Hash
+	k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+		keys = append(keys, key)
+		return true
+	})
+	for _, key := range keys {
+		k.SetState(ctx, addr, key, nil)
+	}
+
+	codeHash := crypto.Keccak256Hash(code)
+	k.SetCode(ctx, codeHash.Bytes(), code)
+
+	account := k.GetAccountOrEmpty(ctx, addr)
+	if account.Nonce == 0 {
+		account.Nonce = 1
+	}
+	account.CodeHash = codeHash.Bytes()
+	if err := k.SetAccount(ctx, addr, account); err != nil {
+		return err
+	}
+
+	// the storage was cleared above, so the empty slots are left unset
+	for _, state := range storage {
+		if value := common.HexToHash(state.Value); value != (common.Hash{}) {
+			k.SetState(ctx, addr, common.HexToHash(state.Key), value.Bytes())
+		}
+	}
+
+	k.SetSystemContractDeployment(ctx, types.SystemContractDeployment{
+		Address:      addr.Hex(),
+		CodeHash:     codeHash.Hex(),
+		Height:       ctx.BlockHeight(),
+		StorageSlots: uint64(len(storage)),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeploySystemContract,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, addr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash.Hex()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"system contract deployed",
+		"ethereum-address", addr.Hex(),
+		"code-hash", codeHash.Hex(),
+		"storage-slots", len(storage),
+	)
+	return nil
+}
+
+// GetSystemContractDeployment returns the audit record of the last system contract deployed at the
+// given address, it returns false if no system contract was deployed there.
+func (k Keeper) GetSystemContractDeployment(ctx sdk.Context, addr common.Address) (types.SystemContractDeployment, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSystemContract)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return types.SystemContractDeployment{}, false
+	}
+
+	var record types.SystemContractDeployment
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetSystemContractDeployment stores the audit record of a system contract deployment.
+func (k Keeper) SetSystemContractDeployment(ctx sdk.Context, record types.SystemContractDeployment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSystemContract)
+	store.Set(common.HexToAddress(record.Address).Bytes(), k.cdc.MustMarshal(&record))
+}
+
+// IterateSystemContractDeployments iterates over the audit records of the system contracts, ordered
+// by address, until cb returns true.
+func (k Keeper) IterateSystemContractDeployments(ctx sdk.Context, cb func(record types.SystemContractDeployment) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSystemContract)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SystemContractDeployment
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
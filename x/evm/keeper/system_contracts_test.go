@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/server/config"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestDeploySystemContract() {
+	// returns the value of the storage slot 0
+	code := common.FromHex("0x60005460005260206000f3")
+	addr := tests.GenerateAddress()
+	slot0, stale := common.BigToHash(big.NewInt(0)), common.BigToHash(big.NewInt(5))
+	value := common.BigToHash(big.NewInt(42))
+
+	suite.SetupTest()
+	k := suite.app.EvmKeeper
+	suite.Require().NoError(k.SetBalance(suite.ctx, addr, big.NewInt(100)))
+	k.SetState(suite.ctx, addr, stale, value.Bytes())
+
+	suite.Require().Error(k.DeploySystemContract(suite.ctx, addr, nil, nil))
+	suite.Require().Error(k.DeploySystemContract(suite.ctx, addr, code, types.Storage{{Key: " ", Value: value.Hex()}}))
+	feeCollector := common.BytesToAddress(suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+	suite.Require().Error(k.DeploySystemContract(suite.ctx, feeCollector, code, nil))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err := k.DeploySystemContract(suite.ctx, addr, code, types.Storage{types.NewState(slot0, value)})
+	suite.Require().NoError(err)
+
+	acct := k.GetAccount(suite.ctx, addr)
+	suite.Require().NotNil(acct)
+	suite.Require().Equal(uint64(1), acct.Nonce)
+	suite.Require().Equal(big.NewInt(100), acct.Balance)
+	suite.Require().Equal(crypto.Keccak256(code), acct.CodeHash)
+	suite.Require().Equal(code, k.GetCode(suite.ctx, common.BytesToHash(acct.CodeHash)))
+	suite.Require().Equal(common.Hash{}, k.GetState(suite.ctx, addr, stale))
+
+	args, err := json.Marshal(&types.TransactionArgs{To: &addr})
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.EthCall(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+		Args:   args,
+		GasCap: uint64(config.DefaultGasCap),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(value.Bytes(), res.Ret)
+
+	record, found := k.GetSystemContractDeployment(suite.ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SystemContractDeployment{
+		Address:      addr.Hex(),
+		CodeHash:     crypto.Keccak256Hash(code).Hex(),
+		Height:       suite.ctx.BlockHeight(),
+		StorageSlots: 1,
+	}, record)
+
+	var records []types.SystemContractDeployment
+	k.IterateSystemContractDeployments(suite.ctx, func(record types.SystemContractDeployment) bool {
+		records = append(records, record)
+		return false
+	})
+	suite.Require().Equal([]types.SystemContractDeployment{record}, records)
+
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeDeploySystemContract, events[0].Type)
+
+	_, found = k.GetSystemContractDeployment(suite.ctx, tests.GenerateAddress())
+	suite.Require().False(found)
+}
@@ -73,9 +73,24 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 		return errorsmod.Wrapf(err, "failed to deduct full gas cost %s from the user %s balance", fees, from)
 	}
 
+	emitFeeEvents(ctx, from, fees, types.AttributeValueReasonDeduct)
 	return nil
 }
 
+// emitFeeEvents emits a fee flow event for each of the coins paid or refunded to the payer, so the
+// fee flows can be reconstructed from the event stream alone.
+func emitFeeEvents(ctx sdk.Context, payer common.Address, coins sdk.Coins, reason string) {
+	for _, coin := range coins {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFee,
+			sdk.NewAttribute(types.AttributeKeyPayer, payer.Hex()),
+			sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		))
+	}
+}
+
 // VerifyFee is used to return the fee for the given transaction data in sdk.Coins. It checks that the
 // gas limit is not reached, the gas limit is higher than the intrinsic gas and that the
 // base fee is higher than the gas fee cap.
@@ -133,7 +148,23 @@ func CheckSenderBalance(
 	balance sdkmath.Int,
 	txData types.TxData,
 ) error {
-	cost := txData.Cost()
+	return checkSenderFunds(balance, txData.Cost())
+}
+
+// CheckSenderValueBalance validates that the tx value is positive and that the
+// sender has enough funds to transfer it, the fees being paid in another denom.
+func CheckSenderValueBalance(
+	balance sdkmath.Int,
+	txData types.TxData,
+) error {
+	value := txData.GetValue()
+	if value == nil {
+		value = new(big.Int)
+	}
+	return checkSenderFunds(balance, value)
+}
+
+func checkSenderFunds(balance sdkmath.Int, cost *big.Int) error {
 
 	if cost.Sign() < 0 {
 		return errorsmod.Wrapf(
@@ -145,7 +176,7 @@ func CheckSenderBalance(
 	if balance.IsNegative() || balance.BigInt().Cmp(cost) < 0 {
 		return errorsmod.Wrapf(
 			errortypes.ErrInsufficientFunds,
-			"sender balance < tx cost (%s < %s)", balance, txData.Cost(),
+			"sender balance < tx cost (%s < %s)", balance, cost,
 		)
 	}
 	return nil
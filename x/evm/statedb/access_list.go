@@ -17,7 +17,11 @@
 package statedb
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 type accessList struct {
@@ -116,3 +120,25 @@ func (al *accessList) DeleteSlot(address common.Address, slot common.Hash) {
 func (al *accessList) DeleteAddress(address common.Address) {
 	delete(al.addresses, address)
 }
+
+// ToAccessList returns the accounts and slots of the access list, sorted by address
+// and slot to be deterministic.
+func (al *accessList) ToAccessList() ethtypes.AccessList {
+	list := make(ethtypes.AccessList, 0, len(al.addresses))
+	for address, idx := range al.addresses {
+		tuple := ethtypes.AccessTuple{Address: address, StorageKeys: []common.Hash{}}
+		if idx >= 0 {
+			for slot := range al.slots[idx] {
+				tuple.StorageKeys = append(tuple.StorageKeys, slot)
+			}
+			sort.Slice(tuple.StorageKeys, func(i, j int) bool {
+				return bytes.Compare(tuple.StorageKeys[i].Bytes(), tuple.StorageKeys[j].Bytes()) < 0
+			})
+		}
+		list = append(list, tuple)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i].Address.Bytes(), list[j].Address.Bytes()) < 0
+	})
+	return list
+}
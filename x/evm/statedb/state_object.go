@@ -26,6 +26,15 @@ import (
 
 var emptyCodeHash = crypto.Keccak256(nil)
 
+const (
+	// objectCacheSize is the approximate memory used by a cached state object, without its code and
+	// storage
+	objectCacheSize = 256
+	// slotCacheSize is the approximate memory used by a cached storage slot, including the map
+	// overhead
+	slotCacheSize = 2*common.HashLength + 32
+)
+
 // Account is the Ethereum consensus representation of accounts.
 // These objects are stored in the storage of auth module.
 type Account struct {
@@ -99,6 +108,11 @@ func newObject(db *StateDB, address common.Address, account Account) *stateObjec
 	}
 }
 
+// cacheSize returns the approximate memory used by the state object.
+func (s *stateObject) cacheSize() uint64 {
+	return objectCacheSize + uint64(len(s.code)) + uint64(len(s.originStorage)+len(s.dirtyStorage))*slotCacheSize
+}
+
 // empty returns whether the account is considered empty.
 func (s *stateObject) empty() bool {
 	return s.account.Nonce == 0 && s.account.Balance.Sign() == 0 && bytes.Equal(s.account.CodeHash, emptyCodeHash)
@@ -158,6 +172,7 @@ func (s *stateObject) Code() []byte {
 	}
 	code := s.db.keeper.GetCode(s.db.ctx, common.BytesToHash(s.CodeHash()))
 	s.code = code
+	s.db.cacheSize += uint64(len(code))
 	return code
 }
 
@@ -220,6 +235,7 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
+	s.db.cacheSize += slotCacheSize
 	return value
 }
 
@@ -248,5 +264,8 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 }
 
 func (s *stateObject) setState(key, value common.Hash) {
+	if _, dirty := s.dirtyStorage[key]; !dirty {
+		s.db.cacheSize += slotCacheSize
+	}
 	s.dirtyStorage[key] = value
 }
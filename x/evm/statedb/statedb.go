@@ -21,6 +21,7 @@ import (
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -65,6 +66,12 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// Approximate memory used by the cached state, and the budget above which the state that isn't
+	// modified is evicted from the cache, 0 for an unbounded cache
+	cacheSize      uint64
+	cacheBudget    uint64
+	evictThreshold uint64
 }
 
 // New creates a new state from a given trie.
@@ -80,6 +87,19 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	}
 }
 
+// SetCacheBudget sets the approximate memory budget of the cached state, the state that isn't
+// modified by the transaction is evicted from the cache above it, and loaded again from the keeper
+// when needed. 0 disables the eviction.
+func (s *StateDB) SetCacheBudget(budget uint64) {
+	s.cacheBudget = budget
+	s.evictThreshold = budget
+}
+
+// CacheSize returns the approximate memory used by the cached state.
+func (s *StateDB) CacheSize() uint64 {
+	return s.cacheSize
+}
+
 // Keeper returns the underlying `Keeper`
 func (s *StateDB) Keeper() Keeper {
 	return s.keeper
@@ -217,6 +237,12 @@ func (s *StateDB) AddPreimage(_ common.Hash, _ []byte) {}
 // getStateObject retrieves a state object given by the address, returning nil if
 // the object is not found.
 func (s *StateDB) getStateObject(addr common.Address) *stateObject {
+	// The eviction only happens here, before any state object is returned, so that no caller holds
+	// an evicted object.
+	if s.cacheBudget > 0 && s.cacheSize > s.evictThreshold {
+		s.evictCache()
+	}
+
 	// Prefer live objects if any is available
 	if obj := s.stateObjects[addr]; obj != nil {
 		return obj
@@ -229,6 +255,7 @@ func (s *StateDB) getStateObject(addr common.Address) *stateObject {
 	// Insert into the live set
 	obj := newObject(s, addr, *account)
 	s.setStateObject(obj)
+	s.cacheSize += obj.cacheSize()
 	return obj
 }
 
@@ -247,6 +274,7 @@ func (s *StateDB) createObject(addr common.Address) (newobj, prev *stateObject)
 	prev = s.getStateObject(addr)
 
 	newobj = newObject(s, addr, Account{})
+	s.cacheSize += newobj.cacheSize()
 	if prev == nil {
 		s.journal.append(createObjectChange{account: &addr})
 	} else {
@@ -371,8 +399,13 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 // - Add precompiles to access list (2929)
 // - Add the contents of the optional tx access list (2930)
 //
+// The access list is reset beforehand, so that the accounts and slots warmed by a
+// previous message are charged as cold again.
+//
 // This method should only be called if Yolov3/Berlin/2929+2930 is applicable at the current number.
 func (s *StateDB) PrepareAccessList(sender common.Address, dst *common.Address, precompiles []common.Address, list ethtypes.AccessList) {
+	s.accessList = newAccessList()
+
 	s.AddAddressToAccessList(sender)
 	if dst != nil {
 		s.AddAddressToAccessList(*dst)
@@ -389,6 +422,12 @@ func (s *StateDB) PrepareAccessList(sender common.Address, dst *common.Address,
 	}
 }
 
+// AccessList returns the accounts and storage slots accessed so far, as tracked by
+// the EIP-2929 access list.
+func (s *StateDB) AccessList() ethtypes.AccessList {
+	return s.accessList.ToAccessList()
+}
+
 // AddAddressToAccessList adds the given address to the access list
 func (s *StateDB) AddAddressToAccessList(addr common.Address) {
 	if s.accessList.AddAddress(addr) {
@@ -476,3 +515,67 @@ func (s *StateDB) Commit() error {
 	}
 	return nil
 }
+
+// CreatedStorageSlots returns the number of storage slots set from an empty to a non-empty value by
+// the dirty state, the storage of the self-destructed accounts isn't counted.
+func (s *StateDB) CreatedStorageSlots() uint64 {
+	var created uint64
+	for addr := range s.journal.dirties {
+		obj := s.stateObjects[addr]
+		if obj == nil || obj.suicided {
+			continue
+		}
+		for key, value := range obj.dirtyStorage {
+			if value != (common.Hash{}) && obj.GetCommittedState(key) == (common.Hash{}) {
+				created++
+			}
+		}
+	}
+	return created
+}
+
+// ForEachDeployedCode iterates the code set by the dirty state, in address order, until the callback
+// returns an error. The code of the self-destructed accounts is skipped.
+func (s *StateDB) ForEachDeployedCode(cb func(addr common.Address, code []byte) error) error {
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		if obj == nil || obj.suicided || !obj.dirtyCode {
+			continue
+		}
+		if err := cb(addr, obj.code); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evictCache drops the cached state that isn't modified by the transaction: the accounts without
+// journal entries, and the committed storage of the slots that aren't dirty. The keeper state doesn't
+// change during the lifetime of the StateDB, so the evicted state is loaded again identically. The
+// committed value of the dirty slots is kept, the commit compares them to skip the noop writes.
+func (s *StateDB) evictCache() {
+	before := s.cacheSize
+	s.cacheSize = 0
+	for addr, obj := range s.stateObjects {
+		if _, dirty := s.journal.dirties[addr]; !dirty {
+			delete(s.stateObjects, addr)
+			continue
+		}
+		for key := range obj.originStorage {
+			if _, dirty := obj.dirtyStorage[key]; !dirty {
+				delete(obj.originStorage, key)
+			}
+		}
+		s.cacheSize += obj.cacheSize()
+	}
+
+	// the modified state can't be evicted, the next eviction happens once the cache grew by half of
+	// the budget above it, instead of at every access
+	s.evictThreshold = s.cacheBudget
+	if retained := s.cacheSize + s.cacheBudget/2; retained > s.evictThreshold {
+		s.evictThreshold = retained
+	}
+
+	telemetry.IncrCounter(1, "evm", "statedb", "cache_evictions")
+	telemetry.IncrCounter(float32(before-s.cacheSize), "evm", "statedb", "cache_evicted_bytes")
+}
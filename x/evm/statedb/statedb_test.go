@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -455,6 +456,26 @@ func (suite *StateDBTestSuite) TestAccessList() {
 			suite.Require().True(addrPresent)
 			suite.Require().False(slotPresent)
 		}},
+		{"prepare access list resets the previous accesses", func(db vm.StateDB) {
+			db.AddSlotToAccessList(address3, value1)
+			db.PrepareAccessList(address, &address2, nil, nil)
+
+			suite.Require().False(db.AddressInAccessList(address3))
+			suite.Require().Equal(ethtypes.AccessList{
+				{Address: address, StorageKeys: []common.Hash{}},
+				{Address: address2, StorageKeys: []common.Hash{}},
+			}, db.(*statedb.StateDB).AccessList())
+		}},
+		{"sorted access list", func(db vm.StateDB) {
+			db.AddSlotToAccessList(address3, value2)
+			db.AddAddressToAccessList(address2)
+			db.AddSlotToAccessList(address3, value1)
+
+			suite.Require().Equal(ethtypes.AccessList{
+				{Address: address2, StorageKeys: []common.Hash{}},
+				{Address: address3, StorageKeys: []common.Hash{value1, value2}},
+			}, db.(*statedb.StateDB).AccessList())
+		}},
 	}
 
 	for _, tc := range testCases {
@@ -565,6 +586,49 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+func (suite *StateDBTestSuite) TestCacheBudget() {
+	const slots = 1000
+	dirtyKey := common.BigToHash(big.NewInt(slots + 1))
+
+	run := func(budget uint64) (*MockKeeper, uint64) {
+		keeper := NewMockKeeper()
+		db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		for i := 0; i < slots; i++ {
+			db.SetState(address, common.BigToHash(big.NewInt(int64(i))), common.BigToHash(big.NewInt(int64(i+1))))
+		}
+		suite.Require().NoError(db.Commit())
+
+		db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+		db.SetCacheBudget(budget)
+		db.AddBalance(address2, big.NewInt(10))
+		db.SetState(address, dirtyKey, common.BigToHash(big.NewInt(1)))
+		// overwrite a committed slot with its own value, the commit must keep skipping it
+		db.SetState(address, common.BigToHash(big.NewInt(0)), common.BigToHash(big.NewInt(1)))
+
+		var maxSize uint64
+		for i := 0; i < slots; i++ {
+			key := common.BigToHash(big.NewInt(int64(i)))
+			suite.Require().Equal(common.BigToHash(big.NewInt(int64(i+1))), db.GetState(address, key))
+			suite.Require().Equal(common.BigToHash(big.NewInt(int64(i+1))), db.GetCommittedState(address, key))
+			suite.Require().Equal(common.BigToHash(big.NewInt(1)), db.GetState(address, dirtyKey))
+			suite.Require().Equal(big.NewInt(10), db.GetBalance(address2))
+			if db.CacheSize() > maxSize {
+				maxSize = db.CacheSize()
+			}
+		}
+		suite.Require().NoError(db.Commit())
+		return keeper, maxSize
+	}
+
+	unbounded, unboundedSize := run(0)
+	bounded, boundedSize := run(4096)
+
+	suite.Require().Less(boundedSize, uint64(8192))
+	suite.Require().Greater(unboundedSize, boundedSize)
+	suite.Require().Equal(unbounded.accounts, bounded.accounts)
+	suite.Require().Equal(slots+1, len(bounded.accounts[address].states))
+}
+
 func CollectContractStorage(db vm.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	db.ForEachStorage(address, func(k, v common.Hash) bool {
@@ -577,3 +641,51 @@ func CollectContractStorage(db vm.StateDB) statedb.Storage {
 func TestStateDBTestSuite(t *testing.T) {
 	suite.Run(t, &StateDBTestSuite{})
 }
+
+// FuzzLogsOrder checks that the logs keep their emission order and consecutive indexes across any
+// sequence of snapshots and reverts.
+func FuzzLogsOrder(f *testing.F) {
+	f.Add([]byte{0, 0, 0}, uint(0))
+	f.Add([]byte{0, 1, 0, 0, 2, 0}, uint(7))
+	f.Add([]byte{1, 0, 1, 0, 0, 2, 0, 2, 0}, uint(3))
+
+	f.Fuzz(func(t *testing.T, ops []byte, logIndex uint) {
+		txConfig := statedb.NewTxConfig(blockHash, common.BigToHash(big.NewInt(1)), 2, logIndex)
+		db := statedb.New(sdk.Context{}, NewMockKeeper(), txConfig)
+
+		// reference model: the data of the emitted logs, and the number of logs at each snapshot
+		var (
+			expected  [][]byte
+			snapshots []int
+			revisions []int
+		)
+		for i, op := range ops {
+			switch op % 3 {
+			case 0:
+				data := []byte{byte(i)}
+				db.AddLog(&ethtypes.Log{Address: address, Data: data})
+				expected = append(expected, data)
+			case 1:
+				revisions = append(revisions, db.Snapshot())
+				snapshots = append(snapshots, len(expected))
+			case 2:
+				if len(revisions) == 0 {
+					continue
+				}
+				last := len(revisions) - 1
+				db.RevertToSnapshot(revisions[last])
+				expected = expected[:snapshots[last]]
+				revisions, snapshots = revisions[:last], snapshots[:last]
+			}
+		}
+
+		logs := db.Logs()
+		require.Len(t, logs, len(expected))
+		for i, log := range logs {
+			require.Equal(t, expected[i], log.Data)
+			require.Equal(t, logIndex+uint(i), log.Index)
+			require.Equal(t, uint(2), log.TxIndex)
+			require.Equal(t, blockHash, log.BlockHash)
+		}
+	})
+}
@@ -38,7 +38,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName       = "ethermint/MsgUpdateParams"
+	ethereumCallName       = "ethermint/MsgEthereumCall"
+	restoreContractName    = "ethermint/MsgRestoreContract"
+	setContractsPausedName = "ethermint/MsgSetContractsPaused"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -57,6 +60,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgEthereumCall{},
+		&MsgRestoreContract{},
+		&MsgSetContractsPaused{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -104,4 +110,7 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgEthereumCall{}, ethereumCallName, nil)
+	cdc.RegisterConcrete(&MsgRestoreContract{}, restoreContractName, nil)
+	cdc.RegisterConcrete(&MsgSetContractsPaused{}, setContractsPausedName, nil)
 }
@@ -0,0 +1,154 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// IsEmpty returns true if the deployment policy doesn't check the deployed code.
+func (dp DeploymentPolicy) IsEmpty() bool {
+	return len(dp.DeniedPatterns) == 0 && dp.MaxCodeSize == 0 && dp.MaxJumpDests == 0
+}
+
+// Validate performs a basic validation of the denied patterns and the allowed code hashes.
+func (dp DeploymentPolicy) Validate() error {
+	for _, pattern := range dp.DeniedPatterns {
+		if _, err := parseOpcodePattern(pattern); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool, len(dp.AllowedCodeHashes))
+	for _, codeHash := range dp.AllowedCodeHashes {
+		bz, err := hexutil.Decode(codeHash)
+		if err != nil || len(bz) != common.HashLength {
+			return fmt.Errorf("invalid allowed code hash %s", codeHash)
+		}
+		if seen[codeHash] {
+			return fmt.Errorf("duplicate allowed code hash %s", codeHash)
+		}
+		seen[codeHash] = true
+	}
+	return nil
+}
+
+// CheckCode returns an error if the deployed code is rejected by the policy.
+func (dp DeploymentPolicy) CheckCode(code []byte) error {
+	if dp.IsEmpty() || dp.isAllowed(code) {
+		return nil
+	}
+
+	if dp.MaxCodeSize > 0 && uint64(len(code)) > dp.MaxCodeSize {
+		return errorsmod.Wrapf(ErrCodeRejected, "code size %d exceeds the max %d", len(code), dp.MaxCodeSize)
+	}
+
+	ops := decodeOpcodes(code)
+
+	if dp.MaxJumpDests > 0 {
+		var jumpDests uint64
+		for _, op := range ops {
+			if op == vm.JUMPDEST {
+				jumpDests++
+			}
+		}
+		if jumpDests > dp.MaxJumpDests {
+			return errorsmod.Wrapf(ErrCodeRejected, "%d jump destinations exceed the max %d", jumpDests, dp.MaxJumpDests)
+		}
+	}
+
+	for _, pattern := range dp.DeniedPatterns {
+		// validated with the params
+		seq, err := parseOpcodePattern(pattern)
+		if err != nil {
+			return err
+		}
+		if containsOpcodes(ops, seq) {
+			return errorsmod.Wrapf(ErrCodeRejected, "denied opcode pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// isAllowed returns true if the hash of the code is allowed.
+func (dp DeploymentPolicy) isAllowed(code []byte) bool {
+	if len(dp.AllowedCodeHashes) == 0 {
+		return false
+	}
+	codeHash := crypto.Keccak256Hash(code)
+	for _, allowed := range dp.AllowedCodeHashes {
+		if common.HexToHash(allowed) == codeHash {
+			return true
+		}
+	}
+	return false
+}
+
+// parseOpcodePattern parses the space separated opcode names of a pattern.
+func parseOpcodePattern(pattern string) ([]vm.OpCode, error) {
+	names := strings.Fields(pattern)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("denied opcode pattern cannot be blank")
+	}
+
+	seq := make([]vm.OpCode, len(names))
+	for i, name := range names {
+		op := vm.StringToOp(strings.ToUpper(name))
+		// the unknown names map to STOP
+		if op == vm.STOP && !strings.EqualFold(name, vm.STOP.String()) {
+			return nil, fmt.Errorf("unknown opcode %s in denied pattern %q", name, pattern)
+		}
+		seq[i] = op
+	}
+	return seq, nil
+}
+
+// decodeOpcodes decodes the code linearly, skipping the push data.
+func decodeOpcodes(code []byte) []vm.OpCode {
+	ops := make([]vm.OpCode, 0, len(code))
+	for pc := 0; pc < len(code); pc++ {
+		op := vm.OpCode(code[pc])
+		ops = append(ops, op)
+		if op.IsPush() {
+			pc += int(op - vm.PUSH1 + 1)
+		}
+	}
+	return ops
+}
+
+// containsOpcodes returns true if seq is a sequence of consecutive opcodes of ops.
+func containsOpcodes(ops, seq []vm.OpCode) bool {
+	for i := 0; i+len(seq) <= len(ops); i++ {
+		match := true
+		for j, op := range seq {
+			if ops[i+j] != op {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentPolicyValidate(t *testing.T) {
+	codeHash := crypto.Keccak256Hash([]byte{0x00}).Hex()
+
+	testCases := []struct {
+		name     string
+		policy   DeploymentPolicy
+		expError bool
+	}{
+		{"empty", DeploymentPolicy{}, false},
+		{"valid", DeploymentPolicy{DeniedPatterns: []string{"SELFDESTRUCT", "caller selfdestruct", "STOP"}, AllowedCodeHashes: []string{codeHash}}, false},
+		{"blank pattern", DeploymentPolicy{DeniedPatterns: []string{" "}}, true},
+		{"unknown opcode", DeploymentPolicy{DeniedPatterns: []string{"CALLER SUICIDE"}}, true},
+		{"invalid code hash", DeploymentPolicy{AllowedCodeHashes: []string{"0x1234"}}, true},
+		{"duplicate code hash", DeploymentPolicy{AllowedCodeHashes: []string{codeHash, codeHash}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.policy.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestDeploymentPolicyCheckCode(t *testing.T) {
+	// PUSH1 0xff CALLER SELFDESTRUCT JUMPDEST JUMPDEST
+	code := []byte{0x60, 0xff, 0x33, 0xff, 0x5b, 0x5b}
+	// PUSH2 0x33ff STOP, the pattern is in the push data
+	pushData := []byte{0x61, 0x33, 0xff, 0x00}
+
+	testCases := []struct {
+		name     string
+		policy   DeploymentPolicy
+		code     []byte
+		expError bool
+	}{
+		{"empty policy", DeploymentPolicy{}, code, false},
+		{"denied opcode", DeploymentPolicy{DeniedPatterns: []string{"SELFDESTRUCT"}}, code, true},
+		{"denied sequence", DeploymentPolicy{DeniedPatterns: []string{"CALLER SELFDESTRUCT"}}, code, true},
+		{"sequence not consecutive", DeploymentPolicy{DeniedPatterns: []string{"PUSH1 SELFDESTRUCT"}}, code, false},
+		{"pattern in push data", DeploymentPolicy{DeniedPatterns: []string{"SELFDESTRUCT"}}, pushData, false},
+		{"max code size", DeploymentPolicy{MaxCodeSize: 5}, code, true},
+		{"code size within limit", DeploymentPolicy{MaxCodeSize: 6}, code, false},
+		{"max jump dests", DeploymentPolicy{MaxJumpDests: 1}, code, true},
+		{"jump dests within limit", DeploymentPolicy{MaxJumpDests: 2}, code, false},
+		{
+			"allowed code hash",
+			DeploymentPolicy{DeniedPatterns: []string{"SELFDESTRUCT"}, AllowedCodeHashes: []string{crypto.Keccak256Hash(code).Hex()}},
+			code,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.policy.CheckCode(tc.code)
+		if tc.expError {
+			require.ErrorIs(t, err, ErrCodeRejected, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -49,6 +49,17 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrTxUnderpriced
+	codeErrEVMPanic
+	codeErrInvalidDenomMetadata
+	codeErrTxExpired
+	codeErrPrecisionLoss
+	codeErrInsufficientStorageDeposit
+	codeErrFeeTokenConversion
+	codeErrContractPaused
+	codeErrCodeRejected
+	codeErrSignaturePending
+	codeErrStorageTooLarge
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -116,6 +127,41 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrTxUnderpriced returns an error if the gas price of a tx is lower than the minimum accepted by the node
+	ErrTxUnderpriced = errorsmod.Register(ModuleName, codeErrTxUnderpriced, "transaction underpriced")
+
+	// ErrEVMPanic returns an error if the EVM execution panicked
+	ErrEVMPanic = errorsmod.Register(ModuleName, codeErrEVMPanic, "evm execution panicked")
+
+	// ErrInvalidDenomMetadata returns an error if the bank metadata of the evm denom is missing or invalid
+	ErrInvalidDenomMetadata = errorsmod.Register(ModuleName, codeErrInvalidDenomMetadata, "invalid evm denom metadata")
+
+	// ErrTxExpired returns an error if a transaction isn't included within the mempool ttl
+	ErrTxExpired = errorsmod.Register(ModuleName, codeErrTxExpired, "transaction expired in mempool")
+
+	// ErrPrecisionLoss returns an error if a wei amount can't be converted to the evm denom without truncation
+	ErrPrecisionLoss = errorsmod.Register(ModuleName, codeErrPrecisionLoss, "wei amount precision loss")
+
+	// ErrInsufficientStorageDeposit returns an error if the sender can't pay the deposits of the storage
+	// slots created by a transaction.
+	ErrInsufficientStorageDeposit = errorsmod.Register(ModuleName, codeErrInsufficientStorageDeposit, "insufficient funds for storage deposit")
+
+	// ErrFeeTokenConversion returns an error if none of the fee tokens could be converted to pay the
+	// fees of a transaction.
+	ErrFeeTokenConversion = errorsmod.Register(ModuleName, codeErrFeeTokenConversion, "failed to convert fee tokens")
+
+	// ErrContractPaused returns an error if the execution of the called contract is paused by governance
+	ErrContractPaused = errorsmod.Register(ModuleName, codeErrContractPaused, "contract execution paused")
+
+	// ErrCodeRejected returns an error if the code of a deployed contract is rejected by the deployment policy
+	ErrCodeRejected = errorsmod.Register(ModuleName, codeErrCodeRejected, "contract code rejected by the deployment policy")
+
+	// ErrSignaturePending returns an error if the signature of an asynchronous signer isn't produced yet
+	ErrSignaturePending = errorsmod.Register(ModuleName, codeErrSignaturePending, "signature pending")
+
+	// ErrStorageTooLarge returns an error if the storage of an account has more slots than the node hashes
+	ErrStorageTooLarge = errorsmod.Register(ModuleName, codeErrStorageTooLarge, "account storage too large")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
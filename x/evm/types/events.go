@@ -17,9 +17,23 @@ package types
 
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
-	EventTypeTxLog      = "tx_log"
+	EventTypeEthereumTx   = TypeMsgEthereumTx
+	EventTypeEthereumCall = TypeMsgEthereumCall
+	EventTypeBlockBloom   = "block_bloom"
+	EventTypeTxLog        = "tx_log"
+	EventTypeEVMPanic     = "evm_panic"
+	// system contract written by an upgrade handler
+	EventTypeDeploySystemContract = "deploy_system_contract"
+	// fee flow of the evm txs: the fees deducted from the sender and the leftover gas refunded to
+	// it. The fees are kept by the fee collector, the base fee isn't burned.
+	EventTypeFee = "evm_fee"
+	// fee token converted to evm denom to pay the fees of an evm tx
+	EventTypeFeeTokenConversion = "evm_fee_token_conversion"
+	// contract execution paused or resumed by governance
+	EventTypeContractPause = "evm_contract_pause"
+	// block gas limit at the beginning of the block and gas utilization at its end
+	EventTypeBlockGasLimit       = "evm_block_gas_limit"
+	EventTypeBlockGasUtilization = "evm_block_gas_utilization"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -29,11 +43,46 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	// tip per gas paid by an eth tx, the effective gas price minus the base fee
+	AttributeKeyEffectiveTip = "effectiveTip"
+	// gas price paid by an eth tx, after the refund of the leftover gas
+	AttributeKeyEffectiveGasPrice = "effectiveGasPrice"
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
-	AttributeValueCategory       = ModuleName
-	AttributeKeyEthereumBloom    = "bloom"
+	// hex encoded revert data of a reverted tx
+	AttributeKeyEthereumTxRevertReason = "ethereumTxRevertReason"
+	AttributeValueCategory             = ModuleName
+	AttributeKeyEthereumBloom          = "bloom"
+	AttributeKeyHeight                 = "height"
+	AttributeKeyCodeHash               = "codeHash"
+	// fee flow attributes, one event is emitted per coin
+	AttributeKeyPayer  = "payer"
+	AttributeKeyAmount = "amount"
+	AttributeKeyDenom  = "denom"
+	AttributeKeyReason = "reason"
+	// fee token conversion attributes
+	AttributeKeyToken       = "token"
+	AttributeKeyTokenAmount = "tokenAmount"
+	// contract pause attribute
+	AttributeKeyPaused = "paused"
+	// block gas attributes
+	AttributeKeyMaxGas         = "maxGas"
+	AttributeKeyGasUsed        = "gasUsed"
+	AttributeKeyEVMGasUsed     = "evmGasUsed"
+	AttributeKeyUtilization    = "utilization"
+	AttributeKeyEVMTxCount     = "evmTxCount"
+	AttributeKeySkippedTxCount = "skippedTxCount"
 
-	MetricKeyTransitionDB = "transition_db"
-	MetricKeyStaticCall   = "static_call"
+	AttributeValueReasonDeduct = "deduct"
+	AttributeValueReasonRefund = "refund"
+
+	MetricKeyTransitionDB    = "transition_db"
+	MetricKeyStaticCall      = "static_call"
+	MetricKeyRecoveredPanic  = "recovered_panic"
+	MetricKeySpeculativeHit  = "speculative_hit"
+	MetricKeySpeculativeMiss = "speculative_miss"
+	MetricKeyBlockMaxGas     = "block_max_gas"
+	MetricKeyBlockGasUsed    = "block_gas_used"
+	MetricKeyBlockGasRatio   = "block_gas_utilization"
+	MetricKeySkippedTxs      = "skipped_txs"
 )
@@ -40,6 +40,40 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// fee_denom is the token denomination paying the gas fees, when it differs from the
+	// evm_denom transferred by msg.value. Empty means the gas fees are paid in evm_denom.
+	FeeDenom string `protobuf:"bytes,7,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty" yaml:"fee_denom"`
+	// fee_conversion_rate is the amount of fee_denom paying one unit of evm_denom, the gas
+	// prices and the base fee being expressed in evm_denom. Only used if fee_denom is set.
+	FeeConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=fee_conversion_rate,json=feeConversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_conversion_rate" yaml:"fee_conversion_rate"`
+	// max_tx_size is the maximum size in bytes of the RLP encoded ethereum transactions, 0 for no limit
+	MaxTxSize uint64 `protobuf:"varint,9,opt,name=max_tx_size,json=maxTxSize,proto3" json:"max_tx_size,omitempty" yaml:"max_tx_size"`
+	// max_calldata_size is the maximum size in bytes of the data of the ethereum transactions, 0 for no
+	// limit
+	MaxCalldataSize uint64 `protobuf:"varint,10,opt,name=max_calldata_size,json=maxCalldataSize,proto3" json:"max_calldata_size,omitempty" yaml:"max_calldata_size"`
+	// refund_quotient caps the gas refunds of the ethereum transactions to gas_used / refund_quotient, 0
+	// keeps the quotient of the fork (2, or 5 after London as per EIP-3529) and the max uint64 value
+	// disables the refunds
+	RefundQuotient uint64 `protobuf:"varint,11,opt,name=refund_quotient,json=refundQuotient,proto3" json:"refund_quotient,omitempty" yaml:"refund_quotient"`
+	// wei_conversion_exponent defines the conversion of the evm_denom bank balances to the wei amounts
+	// of the EVM, one unit of evm_denom being 10^wei_conversion_exponent wei. It's 0 for an evm_denom of
+	// 18 decimals.
+	WeiConversionExponent uint32 `protobuf:"varint,12,opt,name=wei_conversion_exponent,json=weiConversionExponent,proto3" json:"wei_conversion_exponent,omitempty" yaml:"wei_conversion_exponent"`
+	// round_down_precision_loss rounds down the wei amounts which are not a multiple of one unit of
+	// evm_denom when converted to bank balances, instead of rejecting them.
+	RoundDownPrecisionLoss bool `protobuf:"varint,13,opt,name=round_down_precision_loss,json=roundDownPrecisionLoss,proto3" json:"round_down_precision_loss,omitempty" yaml:"round_down_precision_loss"`
+	// storage_slot_deposit is the amount of evm_denom charged to the sender of an ethereum transaction
+	// for each contract storage slot it sets from an empty to a non-empty value, the deposits are
+	// burned. Zero disables the deposits.
+	StorageSlotDeposit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=storage_slot_deposit,json=storageSlotDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"storage_slot_deposit" yaml:"storage_slot_deposit"`
+	// fee_tokens are the governance approved ERC20 tokens converted to evm_denom, in order, to pay the
+	// gas fees of the ethereum transactions whose sender balance doesn't cover them. The senders opt in
+	// a token by approving the evm module account to spend it, the allowance being the max amount of
+	// tokens converted. They can't be set along with a fee_denom differing from evm_denom.
+	FeeTokens []FeeToken `protobuf:"bytes,15,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens" yaml:"fee_tokens"`
+	// deployment_policy defines the checks of the code of the deployed contracts, the transactions
+	// deploying a rejected code failing. It's disabled when empty.
+	DeploymentPolicy DeploymentPolicy `protobuf:"bytes,16,opt,name=deployment_policy,json=deploymentPolicy,proto3" json:"deployment_policy" yaml:"deployment_policy"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +151,198 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetMaxTxSize() uint64 {
+	if m != nil {
+		return m.MaxTxSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCalldataSize() uint64 {
+	if m != nil {
+		return m.MaxCalldataSize
+	}
+	return 0
+}
+
+func (m *Params) GetRefundQuotient() uint64 {
+	if m != nil {
+		return m.RefundQuotient
+	}
+	return 0
+}
+
+func (m *Params) GetWeiConversionExponent() uint32 {
+	if m != nil {
+		return m.WeiConversionExponent
+	}
+	return 0
+}
+
+func (m *Params) GetRoundDownPrecisionLoss() bool {
+	if m != nil {
+		return m.RoundDownPrecisionLoss
+	}
+	return false
+}
+
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *Params) GetDeploymentPolicy() DeploymentPolicy {
+	if m != nil {
+		return m.DeploymentPolicy
+	}
+	return DeploymentPolicy{}
+}
+
+// DeploymentPolicy defines the checks of the code returned by the init code of the contracts, before
+// it's persisted. The contracts created by other contracts are checked too.
+type DeploymentPolicy struct {
+	// denied_patterns are the opcode sequences rejected in the deployed code, each pattern being the
+	// space separated names of consecutive opcodes, e.g. "SELFDESTRUCT" or "CALLER SELFDESTRUCT". The
+	// code is decoded linearly, the push data being skipped.
+	DeniedPatterns []string `protobuf:"bytes,1,rep,name=denied_patterns,json=deniedPatterns,proto3" json:"denied_patterns,omitempty"`
+	// max_code_size is the max size in bytes of the deployed code, on top of the EIP-170 limit (0=unlimited).
+	MaxCodeSize uint64 `protobuf:"varint,2,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// max_jump_dests is the max number of JUMPDEST instructions of the deployed code (0=unlimited).
+	MaxJumpDests uint64 `protobuf:"varint,3,opt,name=max_jump_dests,json=maxJumpDests,proto3" json:"max_jump_dests,omitempty"`
+	// allowed_code_hashes are the hex hashes of the codes deployed without being checked, e.g. audited
+	// contracts whose appended data decodes as a denied pattern.
+	AllowedCodeHashes []string `protobuf:"bytes,4,rep,name=allowed_code_hashes,json=allowedCodeHashes,proto3" json:"allowed_code_hashes,omitempty"`
+}
+
+func (m *DeploymentPolicy) Reset()         { *m = DeploymentPolicy{} }
+func (m *DeploymentPolicy) String() string { return proto.CompactTextString(m) }
+func (*DeploymentPolicy) ProtoMessage()    {}
+func (*DeploymentPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *DeploymentPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeploymentPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeploymentPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentPolicy.Merge(m, src)
+}
+func (m *DeploymentPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentPolicy proto.InternalMessageInfo
+
+func (m *DeploymentPolicy) GetDeniedPatterns() []string {
+	if m != nil {
+		return m.DeniedPatterns
+	}
+	return nil
+}
+
+func (m *DeploymentPolicy) GetMaxCodeSize() uint64 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *DeploymentPolicy) GetMaxJumpDests() uint64 {
+	if m != nil {
+		return m.MaxJumpDests
+	}
+	return 0
+}
+
+func (m *DeploymentPolicy) GetAllowedCodeHashes() []string {
+	if m != nil {
+		return m.AllowedCodeHashes
+	}
+	return nil
+}
+
+// FeeToken defines an ERC20 token paying the gas fees through a converter contract, e.g. a pool
+// holding evm_denom liquidity.
+type FeeToken struct {
+	// address is the hex address of the ERC20 token contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// converter is the hex address of the contract converting the token to evm_denom, called by the evm
+	// module account. It implements quoteFee(address token, uint256 feeAmount) returning the amount of
+	// tokens paying feeAmount wei, and convertFee(address token, address payer, uint256 tokenAmount,
+	// uint256 feeAmount) which must transfer feeAmount wei to the payer once it received tokenAmount.
+	Converter string `protobuf:"bytes,2,opt,name=converter,proto3" json:"converter,omitempty"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeToken) GetConverter() string {
+	if m != nil {
+		return m.Converter
+	}
+	return ""
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -165,7 +391,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +446,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +503,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +579,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +694,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +735,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,13 +789,25 @@ type TraceConfig struct {
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
 	// tracer_json_config configures the tracer using a JSON string
 	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
+	// max_memory_size defines the maximum number of memory bytes captured per
+	// step, rounded up to the 32 bytes words, zero means unlimited
+	MaxMemorySize uint64 `protobuf:"varint,14,opt,name=max_memory_size,json=maxMemorySize,proto3" json:"maxMemorySize"`
+	// max_stack_size defines the maximum number of stack items captured per step,
+	// counted from the top of the stack, zero means unlimited
+	MaxStackSize uint64 `protobuf:"varint,15,opt,name=max_stack_size,json=maxStackSize,proto3" json:"maxStackSize"`
+	// max_storage_size defines the maximum number of storage slots captured per
+	// step, zero means unlimited
+	MaxStorageSize uint64 `protobuf:"varint,16,opt,name=max_storage_size,json=maxStorageSize,proto3" json:"maxStorageSize"`
+	// max_return_data_size defines the maximum number of return data bytes
+	// captured per step, zero means unlimited
+	MaxReturnDataSize uint64 `protobuf:"varint,17,opt,name=max_return_data_size,json=maxReturnDataSize,proto3" json:"maxReturnDataSize"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,435 +913,681 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
-	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
-	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
-	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
-	proto.RegisterType((*Log)(nil), "ethermint.evm.v1.Log")
-	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
-	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
-	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+func (m *TraceConfig) GetMaxMemorySize() uint64 {
+	if m != nil {
+		return m.MaxMemorySize
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
+func (m *TraceConfig) GetMaxStackSize() uint64 {
+	if m != nil {
+		return m.MaxStackSize
+	}
+	return 0
+}
 
-var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0xe3, 0xc6,
-	0x15, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0xb2, 0x44, 0x8f, 0xb5, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x2f,
-	0x0a, 0x17, 0x48, 0xec, 0xd8, 0x81, 0xd1, 0x45, 0x82, 0x16, 0xb5, 0x76, 0x9d, 0xc4, 0xee, 0x36,
-	0x35, 0xc6, 0x0e, 0x0a, 0x14, 0x28, 0x88, 0x11, 0x39, 0xa1, 0x18, 0x93, 0x1c, 0x61, 0x66, 0xa8,
-	0x95, 0xda, 0x3e, 0x40, 0x8b, 0xde, 0xf4, 0x09, 0x8a, 0x3c, 0x4e, 0xd0, 0xab, 0xbd, 0x2c, 0x7a,
-	0x41, 0x14, 0xde, 0x3b, 0x5f, 0xea, 0x09, 0x8a, 0xf9, 0x11, 0xf5, 0x63, 0xa3, 0xad, 0x75, 0xe5,
-	0xf9, 0xce, 0x39, 0xf3, 0x7d, 0x33, 0x67, 0xce, 0x68, 0x0e, 0x0d, 0x9e, 0x13, 0xd1, 0x23, 0x2c,
-	0x8d, 0x33, 0x71, 0x44, 0x06, 0xe9, 0xd1, 0xe0, 0x58, 0xfe, 0x39, 0xec, 0x33, 0x2a, 0x28, 0x74,
-	0x4a, 0xdf, 0xa1, 0x34, 0x0e, 0x8e, 0x9f, 0xb7, 0x22, 0x1a, 0x51, 0xe5, 0x3c, 0x92, 0x23, 0x1d,
-	0xe7, 0xfd, 0xa5, 0x02, 0x36, 0xae, 0x30, 0xc3, 0x29, 0x87, 0xc7, 0xa0, 0x4a, 0x06, 0xa9, 0x1f,
-	0x92, 0x8c, 0xa6, 0xed, 0xd5, 0xfd, 0xd5, 0x83, 0x6a, 0xa7, 0x35, 0x2e, 0x5c, 0x67, 0x84, 0xd3,
-	0xe4, 0x33, 0xaf, 0x74, 0x79, 0xc8, 0x26, 0x83, 0xf4, 0xb5, 0x1c, 0xc2, 0x9f, 0x83, 0x2d, 0x92,
-	0xe1, 0x6e, 0x42, 0xfc, 0x80, 0x11, 0x2c, 0x48, 0x7b, 0x6d, 0x7f, 0xf5, 0xc0, 0xee, 0xb4, 0xc7,
-	0x85, 0xdb, 0x32, 0xd3, 0x66, 0xdd, 0x1e, 0xaa, 0x6b, 0xfc, 0x4a, 0x41, 0xf8, 0x33, 0x50, 0x9b,
-	0xf8, 0x71, 0x92, 0xb4, 0x2b, 0x6a, 0xf2, 0xee, 0xb8, 0x70, 0xe1, 0xfc, 0x64, 0x9c, 0x24, 0x1e,
-	0x02, 0x66, 0x2a, 0x4e, 0x12, 0x78, 0x06, 0x00, 0x19, 0x0a, 0x86, 0x7d, 0x12, 0xf7, 0x79, 0xdb,
-	0xda, 0xaf, 0x1c, 0x54, 0x3a, 0xde, 0x5d, 0xe1, 0x56, 0xcf, 0xa5, 0xf5, 0xfc, 0xe2, 0x8a, 0x8f,
-	0x0b, 0x77, 0xdb, 0x90, 0x94, 0x81, 0x1e, 0xaa, 0x2a, 0x70, 0x1e, 0xf7, 0x39, 0xfc, 0x3d, 0xa8,
-	0x07, 0x3d, 0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe3, 0xa8, 0xbd, 0xbe, 0xbf, 0x7a, 0x50, 0x3b,
-	0xf9, 0xd1, 0xe1, 0x62, 0xde, 0x0e, 0x5f, 0xc9, 0xa8, 0x57, 0x2a, 0xa8, 0xf3, 0xe2, 0x87, 0xc2,
-	0x5d, 0x19, 0x17, 0xee, 0x8e, 0xa6, 0x9e, 0x25, 0xf0, 0x50, 0x2d, 0x98, 0x46, 0xc2, 0x13, 0xf0,
-	0x0c, 0x27, 0x09, 0x7d, 0xeb, 0xe7, 0x99, 0x4c, 0x34, 0x09, 0x04, 0x09, 0x7d, 0x31, 0xe4, 0xed,
-	0x0d, 0xb9, 0x49, 0xb4, 0xa3, 0x9c, 0xdf, 0x4c, 0x7d, 0x37, 0x43, 0xee, 0xfd, 0x7d, 0x1b, 0xd4,
-	0x66, 0xd4, 0x60, 0x0a, 0x9a, 0x3d, 0x9a, 0x12, 0x2e, 0x08, 0x0e, 0xfd, 0x6e, 0x42, 0x83, 0x5b,
-	0x73, 0x2c, 0xaf, 0xff, 0x55, 0xb8, 0x3f, 0x89, 0x62, 0xd1, 0xcb, 0xbb, 0x87, 0x01, 0x4d, 0x8f,
-	0x02, 0xca, 0x53, 0xca, 0xcd, 0x9f, 0x8f, 0x79, 0x78, 0x7b, 0x24, 0x46, 0x7d, 0xc2, 0x0f, 0x2f,
-	0x32, 0x31, 0x2e, 0xdc, 0x5d, 0xbd, 0xd8, 0x05, 0x2a, 0x0f, 0x35, 0x4a, 0x4b, 0x47, 0x1a, 0xe0,
-	0x08, 0x34, 0x42, 0x4c, 0xfd, 0x6f, 0x29, 0xbb, 0x35, 0x6a, 0x6b, 0x4a, 0xed, 0xfa, 0xff, 0x57,
-	0xbb, 0x2b, 0xdc, 0xfa, 0xeb, 0xb3, 0xdf, 0x7c, 0x41, 0xd9, 0xad, 0xe2, 0x1c, 0x17, 0xee, 0x33,
-	0xad, 0x3e, 0xcf, 0xec, 0xa1, 0x7a, 0x88, 0x69, 0x19, 0x06, 0x7f, 0x0b, 0x9c, 0x32, 0x80, 0xe7,
-	0xfd, 0x3e, 0x65, 0xc2, 0x54, 0xc3, 0xc7, 0x77, 0x85, 0xdb, 0x30, 0x94, 0xd7, 0xda, 0x33, 0x2e,
-	0xdc, 0x0f, 0x16, 0x48, 0xcd, 0x1c, 0x0f, 0x35, 0x0c, 0xad, 0x09, 0x85, 0x1c, 0xd4, 0x49, 0xdc,
-	0x3f, 0x3e, 0xfd, 0xc4, 0xec, 0xc8, 0x52, 0x3b, 0xba, 0x7a, 0xd2, 0x8e, 0x6a, 0xe7, 0x17, 0x57,
-	0xc7, 0xa7, 0x9f, 0x4c, 0x36, 0x64, 0xce, 0x7e, 0x96, 0xd6, 0x43, 0x35, 0x0d, 0xf5, 0x6e, 0x2e,
-	0x80, 0x81, 0x7e, 0x0f, 0xf3, 0x9e, 0xaa, 0xac, 0x6a, 0xe7, 0xe0, 0xae, 0x70, 0x81, 0x66, 0xfa,
-	0x0a, 0xf3, 0xde, 0xf4, 0x5c, 0xba, 0xa3, 0x3f, 0xe0, 0x4c, 0xc4, 0x79, 0x3a, 0xe1, 0x02, 0x7a,
-	0xb2, 0x8c, 0x2a, 0xd7, 0x7f, 0x6a, 0xd6, 0xbf, 0xb1, 0xf4, 0xfa, 0x4f, 0x1f, 0x5b, 0xff, 0xe9,
-	0xfc, 0xfa, 0x75, 0x4c, 0x29, 0xfa, 0xd2, 0x88, 0x6e, 0x2e, 0x2d, 0xfa, 0xf2, 0x31, 0xd1, 0x97,
-	0xf3, 0xa2, 0x3a, 0x46, 0x16, 0xfb, 0x42, 0x26, 0xda, 0xf6, 0xf2, 0xc5, 0xfe, 0x20, 0xa9, 0x8d,
-	0xd2, 0xa2, 0xe5, 0xfe, 0x04, 0x5a, 0x01, 0xcd, 0xb8, 0x90, 0xb6, 0x8c, 0xf6, 0x13, 0x62, 0x34,
-	0xab, 0x4a, 0xf3, 0xe2, 0x49, 0x9a, 0x2f, 0xcc, 0xaf, 0xc1, 0x23, 0x7c, 0x1e, 0xda, 0x99, 0x37,
-	0x6b, 0xf5, 0x3e, 0x70, 0xfa, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x8c, 0x32, 0x50, 0xca, 0xe7,
-	0x4f, 0x52, 0x36, 0xf7, 0x60, 0x91, 0xcb, 0x43, 0xcd, 0xa9, 0x49, 0x2b, 0x7e, 0x07, 0x1a, 0xb1,
-	0x5c, 0x46, 0x37, 0x4f, 0x8c, 0x5e, 0x4d, 0xe9, 0xbd, 0x7a, 0x92, 0x9e, 0xb9, 0xcc, 0xf3, 0x4c,
-	0x1e, 0xda, 0x9a, 0x18, 0xb4, 0x56, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f, 0x12, 0x1c, 0xc4, 0x84,
-	0x19, 0xbd, 0xba, 0xd2, 0xfb, 0xf2, 0x49, 0x7a, 0x1f, 0x6a, 0xbd, 0x87, 0x6c, 0x1e, 0x72, 0xa4,
-	0xf1, 0x4b, 0x6d, 0xd3, 0xb2, 0x21, 0xa8, 0x77, 0x09, 0x4b, 0xe2, 0xcc, 0x08, 0x6e, 0x29, 0xc1,
-	0xb3, 0x27, 0x09, 0x9a, 0x3a, 0x9d, 0xe5, 0xf1, 0x50, 0x4d, 0xc3, 0x52, 0x25, 0xa1, 0x59, 0x48,
-	0x27, 0x2a, 0xdb, 0xcb, 0xab, 0xcc, 0xf2, 0x78, 0xa8, 0xa6, 0xa1, 0x56, 0x19, 0x82, 0x1d, 0xcc,
-	0x18, 0x7d, 0xbb, 0x90, 0x43, 0xa8, 0xc4, 0xbe, 0x7a, 0x92, 0xd8, 0x73, 0x2d, 0xf6, 0x08, 0x9d,
-	0x87, 0xb6, 0x95, 0x75, 0x2e, 0x8b, 0x39, 0x80, 0x11, 0xc3, 0xa3, 0x05, 0xe1, 0xd6, 0xf2, 0x87,
-	0xf7, 0x90, 0xcd, 0x43, 0x8e, 0x34, 0xce, 0xc9, 0xfe, 0x11, 0xb4, 0x52, 0xc2, 0x22, 0xe2, 0x67,
-	0x44, 0xf0, 0x7e, 0x12, 0x0b, 0x23, 0xfc, 0x6c, 0xf9, 0xfb, 0xf8, 0x18, 0x9f, 0x87, 0xa0, 0x32,
-	0x7f, 0x6d, 0xac, 0xe5, 0xe5, 0xe0, 0x3d, 0x9c, 0x45, 0x3d, 0x1c, 0x1b, 0xd9, 0xdd, 0xe5, 0x2f,
-	0xc7, 0x3c, 0x93, 0x87, 0xb6, 0x26, 0x86, 0xb2, 0x7e, 0x02, 0x9c, 0x05, 0xf9, 0xa4, 0x7e, 0x3e,
-	0x58, 0xbe, 0x7e, 0x66, 0x79, 0x64, 0xfb, 0xa1, 0xa0, 0x52, 0xb9, 0xb4, 0xec, 0x86, 0xd3, 0xbc,
-	0xb4, 0xec, 0xa6, 0xe3, 0x5c, 0x5a, 0xb6, 0xe3, 0x6c, 0x5f, 0x5a, 0xf6, 0x8e, 0xd3, 0x42, 0x5b,
-	0x23, 0x9a, 0x50, 0x7f, 0xf0, 0xa9, 0x9e, 0x84, 0x6a, 0xe4, 0x2d, 0xe6, 0xe6, 0x37, 0x12, 0x35,
-	0x02, 0x2c, 0x70, 0x32, 0xe2, 0x26, 0x55, 0xc8, 0xd1, 0x09, 0x9c, 0x79, 0xb5, 0x8f, 0xc0, 0xfa,
-	0xb5, 0x90, 0x8d, 0x9b, 0x03, 0x2a, 0xb7, 0x64, 0xa4, 0xbb, 0x11, 0x24, 0x87, 0xb0, 0x05, 0xd6,
-	0x07, 0x38, 0xc9, 0x75, 0x07, 0x58, 0x45, 0x1a, 0x78, 0x57, 0xa0, 0x79, 0xc3, 0x70, 0xc6, 0x71,
-	0x20, 0x62, 0x9a, 0xbd, 0xa1, 0x11, 0x87, 0x10, 0x58, 0xea, 0x55, 0xd4, 0x73, 0xd5, 0x18, 0xfe,
-	0x14, 0x58, 0x09, 0x8d, 0x78, 0x7b, 0x6d, 0xbf, 0x72, 0x50, 0x3b, 0x79, 0xf6, 0xb0, 0x07, 0x7b,
-	0x43, 0x23, 0xa4, 0x42, 0xbc, 0x7f, 0xac, 0x81, 0xca, 0x1b, 0x1a, 0xc1, 0x36, 0xd8, 0xc4, 0x61,
-	0xc8, 0x08, 0xe7, 0x86, 0x69, 0x02, 0xe1, 0x2e, 0xd8, 0x10, 0xb4, 0x1f, 0x07, 0x9a, 0xae, 0x8a,
-	0x0c, 0x92, 0xc2, 0x21, 0x16, 0x58, 0xf5, 0x15, 0x75, 0xa4, 0xc6, 0xf0, 0x04, 0xd4, 0xd5, 0xce,
-	0xfc, 0x2c, 0x4f, 0xbb, 0x84, 0xa9, 0xf6, 0xc0, 0xea, 0x34, 0xef, 0x0b, 0xb7, 0xa6, 0xec, 0x5f,
-	0x2b, 0x33, 0x9a, 0x05, 0xf0, 0x23, 0xb0, 0x29, 0x86, 0xb3, 0x2f, 0xfb, 0xce, 0x7d, 0xe1, 0x36,
-	0xc5, 0x74, 0x9b, 0xf2, 0xe1, 0x46, 0x1b, 0x62, 0xa8, 0x1e, 0xf0, 0x23, 0x60, 0x8b, 0xa1, 0x1f,
-	0x67, 0x21, 0x19, 0xaa, 0xc7, 0xdb, 0xea, 0xb4, 0xee, 0x0b, 0xd7, 0x99, 0x09, 0xbf, 0x90, 0x3e,
-	0xb4, 0x29, 0x86, 0x6a, 0x00, 0x3f, 0x02, 0x40, 0x2f, 0x49, 0x29, 0xe8, 0xa7, 0x77, 0xeb, 0xbe,
-	0x70, 0xab, 0xca, 0xaa, 0xb8, 0xa7, 0x43, 0xe8, 0x81, 0x75, 0xcd, 0x6d, 0x2b, 0xee, 0xfa, 0x7d,
-	0xe1, 0xda, 0x09, 0x8d, 0x34, 0xa7, 0x76, 0xc9, 0x54, 0x31, 0x92, 0xd2, 0x01, 0x09, 0xd5, 0xeb,
-	0x66, 0xa3, 0x09, 0xf4, 0xfe, 0xba, 0x06, 0xec, 0x9b, 0x21, 0x22, 0x3c, 0x4f, 0x04, 0xfc, 0x02,
-	0x38, 0x01, 0xcd, 0x04, 0xc3, 0x81, 0xf0, 0xe7, 0x52, 0xdb, 0x79, 0x31, 0x7d, 0x69, 0x16, 0x23,
-	0x3c, 0xd4, 0x9c, 0x98, 0xce, 0x4c, 0xfe, 0x5b, 0x60, 0xbd, 0x9b, 0x50, 0x9a, 0xaa, 0x4a, 0xa8,
-	0x23, 0x0d, 0x20, 0x52, 0x59, 0x53, 0xa7, 0x5c, 0x51, 0x9d, 0xf6, 0x8f, 0x1f, 0x9e, 0xf2, 0x42,
-	0xa9, 0x74, 0x76, 0x4d, 0xb7, 0xdd, 0xd0, 0xda, 0x66, 0xbe, 0x27, 0x73, 0xab, 0x4a, 0xc9, 0x01,
-	0x15, 0x46, 0x84, 0x3a, 0xb4, 0x3a, 0x92, 0x43, 0xf8, 0x1c, 0xd8, 0x8c, 0x0c, 0x08, 0x13, 0x24,
-	0x54, 0x87, 0x63, 0xa3, 0x12, 0xc3, 0x0f, 0x81, 0x1d, 0x61, 0xee, 0xe7, 0x9c, 0x84, 0xfa, 0x24,
-	0xd0, 0x66, 0x84, 0xf9, 0x37, 0x9c, 0x84, 0x9f, 0x59, 0x7f, 0xfe, 0xde, 0x5d, 0xf1, 0x30, 0xa8,
-	0x9d, 0x05, 0x01, 0xe1, 0xfc, 0x26, 0xef, 0x27, 0xe4, 0xbf, 0x54, 0xd8, 0x09, 0xa8, 0x73, 0x41,
-	0x19, 0x8e, 0x88, 0x7f, 0x4b, 0x46, 0xa6, 0xce, 0x74, 0xd5, 0x18, 0xfb, 0xaf, 0xc8, 0x88, 0xa3,
-	0x59, 0x60, 0x24, 0xbe, 0xb7, 0x40, 0xed, 0x86, 0xe1, 0x80, 0x98, 0x0e, 0x5f, 0xd6, 0xaa, 0x84,
-	0xcc, 0x48, 0x18, 0x24, 0xb5, 0x45, 0x9c, 0x12, 0x9a, 0x0b, 0x73, 0x9f, 0x26, 0x50, 0xce, 0x60,
-	0x84, 0x0c, 0x49, 0xa0, 0xd2, 0x68, 0x21, 0x83, 0xe0, 0x29, 0xd8, 0x0a, 0x63, 0xae, 0x3e, 0x97,
-	0xb8, 0xc0, 0xc1, 0xad, 0xde, 0x7e, 0xc7, 0xb9, 0x2f, 0xdc, 0xba, 0x71, 0x5c, 0x4b, 0x3b, 0x9a,
-	0x43, 0xf0, 0x73, 0xd0, 0x9c, 0x4e, 0x53, 0xab, 0xd5, 0x1f, 0x28, 0x1d, 0x78, 0x5f, 0xb8, 0x8d,
-	0x32, 0x54, 0x79, 0xd0, 0x02, 0x96, 0x27, 0x1d, 0x92, 0x6e, 0x1e, 0xa9, 0xe2, 0xb3, 0x91, 0x06,
-	0xd2, 0x9a, 0xc4, 0x69, 0x2c, 0x54, 0xb1, 0xad, 0x23, 0x0d, 0xe0, 0xe7, 0xa0, 0x4a, 0x07, 0x84,
-	0xb1, 0x38, 0x24, 0x5c, 0xb5, 0x3a, 0xff, 0xeb, 0x5b, 0x0b, 0x4d, 0xe3, 0xe5, 0xe6, 0xcc, 0xa7,
-	0x60, 0x4a, 0x52, 0xca, 0x46, 0xaa, 0x77, 0x31, 0x9b, 0xd3, 0x8e, 0x5f, 0x2b, 0x3b, 0x9a, 0x43,
-	0xb0, 0x03, 0xa0, 0x99, 0xc6, 0x88, 0xc8, 0x59, 0xe6, 0xab, 0xfb, 0x5f, 0x57, 0x73, 0xd5, 0x2d,
-	0xd4, 0x5e, 0xa4, 0x9c, 0xaf, 0xb1, 0xc0, 0xe8, 0x81, 0x05, 0xfe, 0x02, 0x40, 0x7d, 0x26, 0xfe,
-	0x77, 0x9c, 0x96, 0x1f, 0x8b, 0xba, 0xb5, 0x50, 0xfa, 0xda, 0x6b, 0xd6, 0xec, 0x68, 0x74, 0xc9,
-	0xa9, 0xd9, 0xc5, 0xa5, 0x65, 0x5b, 0xce, 0xfa, 0xa5, 0x65, 0x6f, 0x3a, 0x76, 0x99, 0x3f, 0xb3,
-	0x0b, 0xb4, 0x33, 0xc1, 0x33, 0xcb, 0xeb, 0xfc, 0xf2, 0x87, 0xbb, 0xbd, 0xd5, 0x77, 0x77, 0x7b,
-	0xab, 0xff, 0xbe, 0xdb, 0x5b, 0xfd, 0xdb, 0xfb, 0xbd, 0x95, 0x77, 0xef, 0xf7, 0x56, 0xfe, 0xf9,
-	0x7e, 0x6f, 0xe5, 0x77, 0xb3, 0xef, 0x03, 0x19, 0xc8, 0xe7, 0x61, 0xfa, 0xfd, 0x3f, 0x54, 0xff,
-	0x01, 0x50, 0x6f, 0x44, 0x77, 0x43, 0x7d, 0xd9, 0x7f, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xe1, 0x18, 0x7a, 0x1f, 0x10, 0x00, 0x00,
+func (m *TraceConfig) GetMaxStorageSize() uint64 {
+	if m != nil {
+		return m.MaxStorageSize
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TraceConfig) GetMaxReturnDataSize() uint64 {
+	if m != nil {
+		return m.MaxReturnDataSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BlockStats defines the aggregated statistics of the ethereum transactions
+// executed in a block.
+type BlockStats struct {
+	// tx_count is the number of ethereum transactions
+	TxCount uint64 `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// failed_tx_count is the number of ethereum transactions failed in the EVM
+	FailedTxCount uint64 `protobuf:"varint,2,opt,name=failed_tx_count,json=failedTxCount,proto3" json:"failed_tx_count,omitempty"`
+	// gas_used is the gas used by the ethereum transactions
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_price_sum is the sum of the effective gas prices of the ethereum
+	// transactions
+	GasPriceSum github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=gas_price_sum,json=gasPriceSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_price_sum"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowUnprotectedTxs {
-		i--
-		if m.AllowUnprotectedTxs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+func (m *BlockStats) Reset()         { *m = BlockStats{} }
+func (m *BlockStats) String() string { return proto.CompactTextString(m) }
+func (*BlockStats) ProtoMessage()    {}
+func (*BlockStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
+}
+func (m *BlockStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvm(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
-		for _, num1 := range m.ExtraEIPs {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.EnableCall {
-		i--
-		if m.EnableCall {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.EnableCreate {
-		i--
-		if m.EnableCreate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+}
+func (m *BlockStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockStats.Merge(m, src)
+}
+func (m *BlockStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockStats proto.InternalMessageInfo
+
+func (m *BlockStats) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
 	}
-	if len(m.EvmDenom) > 0 {
-		i -= len(m.EvmDenom)
-		copy(dAtA[i:], m.EvmDenom)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.EvmDenom)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *BlockStats) GetFailedTxCount() uint64 {
+	if m != nil {
+		return m.FailedTxCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockStats) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SystemContractDeployment defines the audit record of a system contract
+// written at a fixed address by an upgrade handler.
+type SystemContractDeployment struct {
+	// address is the hex address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// code_hash is the hex encoded hash of the deployed code
+	CodeHash string `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// height is the block height of the deployment
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// storage_slots is the number of storage slots initialized by the deployment
+	StorageSlots uint64 `protobuf:"varint,4,opt,name=storage_slots,json=storageSlots,proto3" json:"storage_slots,omitempty"`
 }
 
-func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CancunBlock != nil {
-		{
-			size := m.CancunBlock.Size()
-			i -= size
-			if _, err := m.CancunBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+func (m *SystemContractDeployment) Reset()         { *m = SystemContractDeployment{} }
+func (m *SystemContractDeployment) String() string { return proto.CompactTextString(m) }
+func (*SystemContractDeployment) ProtoMessage()    {}
+func (*SystemContractDeployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{11}
+}
+func (m *SystemContractDeployment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SystemContractDeployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SystemContractDeployment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+		return b[:n], nil
 	}
-	if m.ShanghaiBlock != nil {
-		{
-			size := m.ShanghaiBlock.Size()
-			i -= size
-			if _, err := m.ShanghaiBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+}
+func (m *SystemContractDeployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemContractDeployment.Merge(m, src)
+}
+func (m *SystemContractDeployment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SystemContractDeployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemContractDeployment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemContractDeployment proto.InternalMessageInfo
+
+func (m *SystemContractDeployment) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.MergeNetsplitBlock != nil {
-		{
-			size := m.MergeNetsplitBlock.Size()
-			i -= size
-			if _, err := m.MergeNetsplitBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+	return ""
+}
+
+func (m *SystemContractDeployment) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
 	}
-	if m.GrayGlacierBlock != nil {
-		{
-			size := m.GrayGlacierBlock.Size()
-			i -= size
-			if _, err := m.GrayGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return ""
+}
+
+func (m *SystemContractDeployment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.ArrowGlacierBlock != nil {
-		{
-			size := m.ArrowGlacierBlock.Size()
-			i -= size
-			if _, err := m.ArrowGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	return 0
+}
+
+func (m *SystemContractDeployment) GetStorageSlots() uint64 {
+	if m != nil {
+		return m.StorageSlots
 	}
-	if m.LondonBlock != nil {
-		{
-			size := m.LondonBlock.Size()
-			i -= size
-			if _, err := m.LondonBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	return 0
+}
+
+// ChainEpoch defines a range of blocks produced under the same chain-id, a new
+// epoch starts when the chain is restarted from an exported genesis with a
+// bumped chain-id version (eg: ethermint_9000-1 -> ethermint_9000-2).
+type ChainEpoch struct {
+	// chain_id is the chain-id of the epoch
+	ChainID string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// start_height is the first block height of the epoch
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last block height of the epoch, zero for the current epoch
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *ChainEpoch) Reset()         { *m = ChainEpoch{} }
+func (m *ChainEpoch) String() string { return proto.CompactTextString(m) }
+func (*ChainEpoch) ProtoMessage()    {}
+func (*ChainEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{12}
+}
+func (m *ChainEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+		return b[:n], nil
 	}
-	if m.BerlinBlock != nil {
-		{
-			size := m.BerlinBlock.Size()
-			i -= size
-			if _, err := m.BerlinBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+}
+func (m *ChainEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainEpoch.Merge(m, src)
+}
+func (m *ChainEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainEpoch proto.InternalMessageInfo
+
+func (m *ChainEpoch) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	if m.MuirGlacierBlock != nil {
-		{
-			size := m.MuirGlacierBlock.Size()
-			i -= size
-			if _, err := m.MuirGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	return ""
+}
+
+func (m *ChainEpoch) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	if m.IstanbulBlock != nil {
-		{
-			size := m.IstanbulBlock.Size()
-			i -= size
-			if _, err := m.IstanbulBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	return 0
+}
+
+func (m *ChainEpoch) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	if m.PetersburgBlock != nil {
-		{
-			size := m.PetersburgBlock.Size()
-			i -= size
-			if _, err := m.PetersburgBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	return 0
+}
+
+// HeaderHash defines the header hash of the block of a given height.
+type HeaderHash struct {
+	// height is the block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// hash is the hex encoded header hash
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *HeaderHash) Reset()         { *m = HeaderHash{} }
+func (m *HeaderHash) String() string { return proto.CompactTextString(m) }
+func (*HeaderHash) ProtoMessage()    {}
+func (*HeaderHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{13}
+}
+func (m *HeaderHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	if m.ConstantinopleBlock != nil {
-		{
-			size := m.ConstantinopleBlock.Size()
-			i -= size
-			if _, err := m.ConstantinopleBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *HeaderHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderHash.Merge(m, src)
+}
+func (m *HeaderHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderHash proto.InternalMessageInfo
+
+func (m *HeaderHash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.ByzantiumBlock != nil {
-		{
-			size := m.ByzantiumBlock.Size()
-			i -= size
-			if _, err := m.ByzantiumBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return 0
+}
+
+func (m *HeaderHash) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	if m.EIP158Block != nil {
-		{
-			size := m.EIP158Block.Size()
-			i -= size
-			if _, err := m.EIP158Block.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	return ""
+}
+
+// StorageUsage defines the storage used by a contract, only the non-empty storage
+// slots are counted.
+type StorageUsage struct {
+	// address is the hex address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// slots is the number of non-empty storage slots
+	Slots uint64 `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
+	// bytes is the total size of the keys and values of the non-empty storage slots
+	Bytes uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (m *StorageUsage) Reset()         { *m = StorageUsage{} }
+func (m *StorageUsage) String() string { return proto.CompactTextString(m) }
+func (*StorageUsage) ProtoMessage()    {}
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{14}
+}
+func (m *StorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if m.EIP155Block != nil {
-		{
-			size := m.EIP155Block.Size()
-			i -= size
-			if _, err := m.EIP155Block.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+}
+func (m *StorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUsage.Merge(m, src)
+}
+func (m *StorageUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUsage proto.InternalMessageInfo
+
+func (m *StorageUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StorageUsage) GetSlots() uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*DeploymentPolicy)(nil), "ethermint.evm.v1.DeploymentPolicy")
+	proto.RegisterType((*FeeToken)(nil), "ethermint.evm.v1.FeeToken")
+	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
+	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
+	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
+	proto.RegisterType((*Log)(nil), "ethermint.evm.v1.Log")
+	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
+	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
+	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+	proto.RegisterType((*BlockStats)(nil), "ethermint.evm.v1.BlockStats")
+	proto.RegisterType((*SystemContractDeployment)(nil), "ethermint.evm.v1.SystemContractDeployment")
+	proto.RegisterType((*ChainEpoch)(nil), "ethermint.evm.v1.ChainEpoch")
+	proto.RegisterType((*HeaderHash)(nil), "ethermint.evm.v1.HeaderHash")
+	proto.RegisterType((*StorageUsage)(nil), "ethermint.evm.v1.StorageUsage")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
+
+var fileDescriptor_d21ecc92c8c8583e = []byte{
+	// 2400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x6f, 0x1c, 0xb7,
+	0xf9, 0xf7, 0x5a, 0x2b, 0x69, 0x97, 0xfb, 0x36, 0xa2, 0xd6, 0xce, 0xda, 0x4e, 0x34, 0x0a, 0xff,
+	0x41, 0xfe, 0x2a, 0x90, 0x48, 0xb1, 0x03, 0xa7, 0x6e, 0xd2, 0x16, 0xf5, 0x4a, 0x72, 0x2c, 0xd5,
+	0x49, 0x55, 0x4a, 0x41, 0x81, 0x00, 0xc5, 0x80, 0x9a, 0xa1, 0x56, 0x13, 0xcd, 0x0c, 0x37, 0x43,
+	0xce, 0x6a, 0x37, 0x0d, 0xd0, 0x6b, 0x80, 0x02, 0x45, 0xaf, 0xbd, 0x14, 0xfd, 0x12, 0xbd, 0xf6,
+	0x1c, 0xf4, 0x94, 0x63, 0xd1, 0xc3, 0xa0, 0x50, 0x6e, 0x3a, 0xee, 0x27, 0x28, 0xf8, 0x90, 0xfb,
+	0x2a, 0xc7, 0x88, 0x74, 0x9a, 0x79, 0xde, 0x7e, 0x3f, 0x92, 0xcf, 0xc3, 0x57, 0x74, 0x9f, 0xab,
+	0x53, 0x9e, 0xc6, 0x61, 0xa2, 0xb6, 0x78, 0x2f, 0xde, 0xea, 0x3d, 0xd4, 0x9f, 0xcd, 0x6e, 0x2a,
+	0x94, 0xc0, 0xce, 0xd8, 0xb6, 0xa9, 0x95, 0xbd, 0x87, 0xf7, 0x9b, 0x1d, 0xd1, 0x11, 0x60, 0xdc,
+	0xd2, 0x7f, 0xc6, 0x8f, 0x7c, 0x83, 0xd0, 0xd2, 0x01, 0x4b, 0x59, 0x2c, 0xf1, 0x43, 0x54, 0xe6,
+	0xbd, 0xd8, 0x0b, 0x78, 0x22, 0xe2, 0x56, 0x61, 0xbd, 0xb0, 0x51, 0x6e, 0x37, 0x87, 0xb9, 0xeb,
+	0x0c, 0x58, 0x1c, 0x7d, 0x48, 0xc6, 0x26, 0x42, 0x4b, 0xbc, 0x17, 0xef, 0xe8, 0x5f, 0xfc, 0x0b,
+	0x54, 0xe3, 0x09, 0x3b, 0x8e, 0xb8, 0xe7, 0xa7, 0x9c, 0x29, 0xde, 0xba, 0xbd, 0x5e, 0xd8, 0x28,
+	0xb5, 0x5b, 0xc3, 0xdc, 0x6d, 0xda, 0xb0, 0x69, 0x33, 0xa1, 0x55, 0x23, 0x6f, 0x83, 0x88, 0x7f,
+	0x8a, 0x2a, 0x23, 0x3b, 0x8b, 0xa2, 0xd6, 0x02, 0x04, 0xdf, 0x1d, 0xe6, 0x2e, 0x9e, 0x0d, 0x66,
+	0x51, 0x44, 0x28, 0xb2, 0xa1, 0x2c, 0x8a, 0xf0, 0x53, 0x84, 0x78, 0x5f, 0xa5, 0xcc, 0xe3, 0x61,
+	0x57, 0xb6, 0x8a, 0xeb, 0x0b, 0x1b, 0x0b, 0x6d, 0x72, 0x91, 0xbb, 0xe5, 0x5d, 0xad, 0xdd, 0xdd,
+	0x3b, 0x90, 0xc3, 0xdc, 0x5d, 0xb1, 0x20, 0x63, 0x47, 0x42, 0xcb, 0x20, 0xec, 0x86, 0x5d, 0x89,
+	0x7f, 0x8f, 0xaa, 0xfe, 0x29, 0x0b, 0x13, 0xcf, 0x17, 0xc9, 0x49, 0xd8, 0x69, 0x2d, 0xae, 0x17,
+	0x36, 0x2a, 0x8f, 0xde, 0xd8, 0x9c, 0x1f, 0xb7, 0xcd, 0x6d, 0xed, 0xb5, 0x0d, 0x4e, 0xed, 0x07,
+	0xdf, 0xe6, 0xee, 0xad, 0x61, 0xee, 0xae, 0x1a, 0xe8, 0x69, 0x00, 0x42, 0x2b, 0xfe, 0xc4, 0x13,
+	0x3f, 0x42, 0x77, 0x58, 0x14, 0x89, 0x73, 0x2f, 0x4b, 0xf4, 0x40, 0x73, 0x5f, 0xf1, 0xc0, 0x53,
+	0x7d, 0xd9, 0x5a, 0xd2, 0x9d, 0xa4, 0xab, 0x60, 0xfc, 0x6c, 0x62, 0x3b, 0xea, 0x43, 0x02, 0x4e,
+	0x38, 0xb7, 0x09, 0x58, 0x9e, 0x4f, 0xc0, 0xd8, 0x44, 0x68, 0xe9, 0x84, 0x73, 0x93, 0x80, 0xaf,
+	0xd1, 0xaa, 0xd6, 0xfb, 0x22, 0xe9, 0xf1, 0x54, 0x86, 0x22, 0xf1, 0x52, 0x9d, 0x86, 0x12, 0x04,
+	0xbf, 0xd0, 0xad, 0xfd, 0x4f, 0xee, 0xbe, 0xdd, 0x09, 0xd5, 0x69, 0x76, 0xbc, 0xe9, 0x8b, 0x78,
+	0xcb, 0x17, 0x32, 0x16, 0xd2, 0x7e, 0xde, 0x95, 0xc1, 0xd9, 0x96, 0x1a, 0x74, 0xb9, 0xdc, 0xdc,
+	0xe1, 0xfe, 0x30, 0x77, 0xef, 0x4f, 0xa8, 0xe6, 0x20, 0x09, 0x5d, 0x39, 0xe1, 0x7c, 0x7b, 0xac,
+	0xa4, 0x3a, 0x7f, 0x1f, 0xa0, 0x4a, 0xcc, 0xfa, 0x9e, 0xea, 0x7b, 0x32, 0xfc, 0x8a, 0xb7, 0xca,
+	0xeb, 0x85, 0x8d, 0xe2, 0x74, 0xfe, 0xa6, 0x8c, 0x84, 0x96, 0x63, 0xd6, 0x3f, 0xea, 0x1f, 0x86,
+	0x5f, 0x71, 0xfc, 0x1c, 0xad, 0x68, 0x93, 0xce, 0x6b, 0xc0, 0x14, 0x33, 0xd1, 0x08, 0xa2, 0x5f,
+	0x1f, 0xe6, 0x6e, 0x6b, 0x12, 0x3d, 0xe3, 0x42, 0x68, 0x23, 0x66, 0xfd, 0x6d, 0xab, 0x02, 0xa4,
+	0x6d, 0xd4, 0x48, 0xf9, 0x49, 0x96, 0x04, 0xde, 0x97, 0x99, 0x50, 0x21, 0x4f, 0x54, 0xab, 0x02,
+	0x38, 0xf7, 0x87, 0xb9, 0x7b, 0xd7, 0xe0, 0xcc, 0x39, 0x10, 0x5a, 0x37, 0x9a, 0xdf, 0x5a, 0x05,
+	0xfe, 0x1c, 0xbd, 0x76, 0xce, 0xc3, 0xe9, 0x1e, 0xf3, 0x7e, 0x57, 0x24, 0x1a, 0xac, 0xba, 0x5e,
+	0xd8, 0xa8, 0xb5, 0xc9, 0x30, 0x77, 0xd7, 0x0c, 0xd8, 0x0f, 0x38, 0x12, 0x7a, 0xe7, 0x9c, 0x87,
+	0x93, 0xe1, 0xd9, 0xb5, 0x7a, 0xec, 0xa1, 0x7b, 0xa9, 0xd0, 0xf4, 0x81, 0x38, 0x4f, 0xbc, 0x6e,
+	0xca, 0xfd, 0x10, 0x02, 0x23, 0x21, 0x65, 0xab, 0x06, 0x05, 0xff, 0xd6, 0x30, 0x77, 0xd7, 0x6d,
+	0x53, 0x7f, 0xc8, 0x95, 0xd0, 0xbb, 0x60, 0xdb, 0x11, 0xe7, 0xc9, 0xc1, 0xc8, 0xf2, 0x42, 0x48,
+	0x89, 0xff, 0x88, 0x9a, 0x52, 0x89, 0x94, 0x75, 0xb8, 0x27, 0x23, 0xa1, 0xbc, 0x80, 0x77, 0x85,
+	0x0c, 0x55, 0xab, 0x0e, 0x25, 0xf0, 0xc9, 0x35, 0x4a, 0x60, 0x2f, 0x51, 0xc3, 0xdc, 0x7d, 0x60,
+	0x5a, 0xf2, 0x32, 0x4c, 0x42, 0xb1, 0x55, 0x1f, 0x46, 0x42, 0xed, 0x18, 0x25, 0x3e, 0x42, 0x48,
+	0xd7, 0x8b, 0x12, 0x67, 0x3c, 0x91, 0xad, 0xc6, 0xfa, 0xc2, 0x46, 0xe5, 0xd1, 0xfd, 0xab, 0xd3,
+	0xe8, 0x19, 0xe7, 0x47, 0xda, 0xa5, 0x7d, 0xcf, 0xce, 0xa1, 0x95, 0x49, 0xad, 0x99, 0x58, 0x42,
+	0xcb, 0x27, 0xd6, 0x49, 0xe2, 0x2f, 0xd1, 0x4a, 0xc0, 0xbb, 0x91, 0x18, 0xc4, 0x3c, 0x51, 0x5e,
+	0x57, 0x44, 0xa1, 0x3f, 0x68, 0x39, 0x30, 0x47, 0xc9, 0x55, 0xf0, 0x9d, 0xb1, 0xeb, 0x01, 0x78,
+	0xb6, 0xd7, 0x2d, 0x89, 0x2d, 0xa5, 0x2b, 0x50, 0x84, 0x3a, 0xc1, 0x5c, 0x0c, 0xf9, 0x47, 0x01,
+	0x39, 0xf3, 0x40, 0xf8, 0xff, 0x51, 0x23, 0xe0, 0x49, 0xc8, 0x03, 0xaf, 0xcb, 0x94, 0xe2, 0x69,
+	0x22, 0x5b, 0x85, 0xf5, 0x85, 0x8d, 0x32, 0xad, 0x1b, 0xf5, 0x81, 0xd5, 0x62, 0x82, 0x6a, 0x50,
+	0xb0, 0x22, 0xe0, 0xa6, 0x9e, 0xf5, 0x52, 0x58, 0xa4, 0x7a, 0x82, 0x6c, 0x8b, 0x80, 0x43, 0xb5,
+	0xbe, 0x85, 0xea, 0xda, 0xe7, 0x8b, 0x2c, 0xee, 0x7a, 0x01, 0x97, 0x4a, 0xc2, 0x92, 0x57, 0xa4,
+	0xd5, 0x98, 0xf5, 0xf7, 0xb3, 0xb8, 0xbb, 0xa3, 0x75, 0x78, 0x13, 0x99, 0xd5, 0x81, 0x07, 0x06,
+	0xed, 0x94, 0xc9, 0x53, 0x6e, 0x56, 0xb9, 0x32, 0x5d, 0xb1, 0x26, 0x8d, 0xf9, 0x1c, 0x0c, 0xa4,
+	0x8d, 0x4a, 0xa3, 0xc1, 0xc5, 0x2d, 0xb4, 0xcc, 0x82, 0x20, 0xe5, 0x52, 0x9a, 0x15, 0x9c, 0x8e,
+	0x44, 0xfc, 0x3a, 0x2a, 0x9b, 0xba, 0x55, 0x3c, 0x85, 0xb6, 0x95, 0xe9, 0x44, 0x41, 0xfe, 0xb6,
+	0x82, 0x2a, 0x53, 0x0b, 0x1d, 0x8e, 0x51, 0xe3, 0x54, 0xc4, 0x5c, 0x2a, 0xce, 0x02, 0xef, 0x38,
+	0x12, 0xfe, 0x99, 0xdd, 0x11, 0x76, 0xae, 0x55, 0x4c, 0x76, 0x06, 0xce, 0x41, 0x11, 0x5a, 0x1f,
+	0x6b, 0xda, 0x5a, 0x81, 0x07, 0xa8, 0x1e, 0x30, 0xe1, 0x9d, 0x88, 0xf4, 0xcc, 0xb2, 0x41, 0x0b,
+	0xdb, 0x87, 0x3f, 0x9e, 0xed, 0x22, 0x77, 0xab, 0x3b, 0x4f, 0x7f, 0xf3, 0x4c, 0xa4, 0x67, 0x80,
+	0x39, 0xcc, 0xdd, 0x3b, 0x36, 0xf9, 0x33, 0xc8, 0x84, 0x56, 0x03, 0x26, 0xc6, 0x6e, 0xf8, 0x77,
+	0xc8, 0x19, 0x3b, 0xc8, 0xac, 0xdb, 0x15, 0xa9, 0xb2, 0x1b, 0xd1, 0xbb, 0x17, 0xb9, 0x5b, 0xb7,
+	0x90, 0x87, 0xc6, 0x32, 0xcc, 0xdd, 0xd7, 0xe6, 0x40, 0x6d, 0x0c, 0xa1, 0x75, 0x0b, 0x6b, 0x5d,
+	0xb1, 0x44, 0x55, 0x1e, 0x76, 0x1f, 0x3e, 0x7e, 0xcf, 0xf6, 0xa8, 0x08, 0x3d, 0x3a, 0xb8, 0x56,
+	0x8f, 0x2a, 0xbb, 0x7b, 0x07, 0x0f, 0x1f, 0xbf, 0x37, 0xea, 0x90, 0xdd, 0x76, 0xa6, 0x61, 0x09,
+	0xad, 0x18, 0xd1, 0xf4, 0x66, 0x0f, 0x59, 0x11, 0xaa, 0x06, 0x36, 0xb5, 0x72, 0x7b, 0xe3, 0x22,
+	0x77, 0x91, 0x41, 0xd2, 0x25, 0x33, 0xc9, 0xcb, 0xf1, 0xe0, 0x2b, 0x96, 0xa8, 0x30, 0x8b, 0x47,
+	0x58, 0xc8, 0x04, 0x6b, 0xaf, 0x71, 0xfb, 0x1f, 0xdb, 0xf6, 0x2f, 0xdd, 0xb8, 0xfd, 0x8f, 0x5f,
+	0xd6, 0xfe, 0xc7, 0xb3, 0xed, 0x37, 0x3e, 0x63, 0xd2, 0x27, 0x96, 0x74, 0xf9, 0xc6, 0xa4, 0x4f,
+	0x5e, 0x46, 0xfa, 0x64, 0x96, 0xd4, 0xf8, 0xe8, 0x62, 0x9f, 0x1b, 0x89, 0x56, 0xe9, 0xe6, 0xc5,
+	0x7e, 0x65, 0x50, 0xeb, 0x63, 0x8d, 0xa1, 0xfb, 0x1a, 0x35, 0x7d, 0x91, 0x48, 0xa5, 0x75, 0x89,
+	0xe8, 0x46, 0xdc, 0x72, 0x96, 0x81, 0x73, 0xef, 0x26, 0xab, 0xf5, 0xcb, 0xf0, 0x08, 0x5d, 0x9d,
+	0x55, 0x1b, 0xf6, 0x2e, 0x72, 0xba, 0x5c, 0xf1, 0x54, 0x1e, 0x67, 0x69, 0xc7, 0x32, 0x23, 0x60,
+	0xde, 0xbd, 0x16, 0xb3, 0x9d, 0x07, 0xf3, 0x58, 0x84, 0x36, 0x26, 0x2a, 0xc3, 0xf8, 0x05, 0xaa,
+	0x87, 0xba, 0x19, 0xc7, 0x59, 0x64, 0xf9, 0x2a, 0xc0, 0xb7, 0x7d, 0x2d, 0x3e, 0x3b, 0x99, 0x67,
+	0x91, 0x08, 0xad, 0x8d, 0x14, 0x86, 0x2b, 0x43, 0x38, 0xce, 0xc2, 0xd4, 0xeb, 0x44, 0xcc, 0x0f,
+	0x79, 0x6a, 0xf9, 0xaa, 0xc0, 0xf7, 0xf1, 0xb5, 0xf8, 0xee, 0x19, 0xbe, 0xab, 0x68, 0x84, 0x3a,
+	0x5a, 0xf9, 0xb1, 0xd1, 0x19, 0xda, 0x00, 0x55, 0x8f, 0x79, 0x1a, 0x85, 0x89, 0x25, 0xac, 0x01,
+	0xe1, 0xd3, 0x6b, 0x11, 0xda, 0x3a, 0x9d, 0xc6, 0x21, 0xb4, 0x62, 0xc4, 0x31, 0x4b, 0x24, 0x92,
+	0x40, 0x8c, 0x58, 0x56, 0x6e, 0xce, 0x32, 0x8d, 0x43, 0x68, 0xc5, 0x88, 0x86, 0xa5, 0x8f, 0x56,
+	0x59, 0x9a, 0x8a, 0xf3, 0xb9, 0x31, 0xc4, 0x40, 0xf6, 0xfc, 0x5a, 0x64, 0xf6, 0x38, 0xf9, 0x12,
+	0x38, 0x42, 0x57, 0x40, 0x3b, 0x33, 0x8a, 0x19, 0xc2, 0x9d, 0x94, 0x0d, 0xe6, 0x88, 0x9b, 0x37,
+	0x4f, 0xde, 0x55, 0x34, 0x42, 0x1d, 0xad, 0x9c, 0xa1, 0xfd, 0x03, 0x6a, 0xc6, 0x3c, 0xed, 0x70,
+	0x2f, 0xe1, 0x4a, 0x76, 0xa3, 0x50, 0x59, 0xe2, 0x3b, 0x37, 0x9f, 0x8f, 0x2f, 0xc3, 0x23, 0x14,
+	0x83, 0xfa, 0x53, 0xab, 0x1d, 0x4f, 0x0e, 0x79, 0xca, 0x92, 0xce, 0x29, 0x0b, 0x2d, 0xed, 0xdd,
+	0x9b, 0x4f, 0x8e, 0x59, 0x24, 0x42, 0x6b, 0x23, 0xc5, 0xb8, 0x7e, 0x7c, 0x96, 0xf8, 0xd9, 0xa8,
+	0x7e, 0x5e, 0xbb, 0x79, 0xfd, 0x4c, 0xe3, 0xe8, 0x9b, 0x0f, 0x88, 0xc0, 0xb2, 0x5f, 0x2c, 0xd5,
+	0x9d, 0xc6, 0x7e, 0xb1, 0xd4, 0x70, 0x9c, 0xfd, 0x62, 0xc9, 0x71, 0x56, 0xf6, 0x8b, 0xa5, 0x55,
+	0xa7, 0x49, 0x6b, 0x03, 0x11, 0x09, 0xaf, 0xf7, 0xbe, 0x09, 0xa2, 0x15, 0x7e, 0xce, 0xa4, 0x5d,
+	0x23, 0x69, 0xdd, 0x67, 0x8a, 0x45, 0x03, 0x69, 0x87, 0x8a, 0x3a, 0x66, 0x00, 0xa7, 0x76, 0xed,
+	0x2d, 0xb4, 0x78, 0xa8, 0xf4, 0x9d, 0xc3, 0x41, 0x0b, 0x67, 0x7c, 0x60, 0x4f, 0x37, 0xfa, 0x17,
+	0x37, 0xd1, 0x62, 0x8f, 0x45, 0x19, 0xb7, 0xa7, 0x1a, 0x23, 0x90, 0x03, 0xd4, 0x38, 0x4a, 0x59,
+	0x22, 0x99, 0xaf, 0xe0, 0xa8, 0xdc, 0x91, 0x18, 0xa3, 0x22, 0xec, 0x8a, 0x26, 0x16, 0xfe, 0xf1,
+	0x4f, 0x50, 0x31, 0x12, 0x1d, 0xd9, 0xba, 0x0d, 0xe7, 0xd6, 0x3b, 0x57, 0x8f, 0x96, 0x2f, 0x44,
+	0x87, 0x82, 0x0b, 0xf9, 0xd7, 0x6d, 0xb4, 0xf0, 0x42, 0x74, 0x5e, 0x71, 0xc6, 0xba, 0x8b, 0x96,
+	0x94, 0xe8, 0x86, 0xbe, 0x81, 0x2b, 0x53, 0x2b, 0x69, 0x62, 0x7d, 0x63, 0x81, 0x73, 0x45, 0x95,
+	0xc2, 0x3f, 0x7e, 0x84, 0xaa, 0xd0, 0x33, 0x2f, 0xc9, 0xe2, 0x63, 0x9e, 0xc2, 0xf1, 0xa0, 0xd8,
+	0x6e, 0x5c, 0xe6, 0x6e, 0x05, 0xf4, 0x9f, 0x82, 0x9a, 0x4e, 0x0b, 0xf8, 0x1d, 0xb4, 0xac, 0xfa,
+	0xd3, 0x3b, 0xfb, 0xea, 0x65, 0xee, 0x36, 0xd4, 0xa4, 0x9b, 0x7a, 0xe3, 0xa6, 0x4b, 0xaa, 0xaf,
+	0xbf, 0x78, 0x0b, 0x95, 0x54, 0xdf, 0x0b, 0x93, 0x80, 0xf7, 0x61, 0xf3, 0x2e, 0xb6, 0x9b, 0x97,
+	0xb9, 0xeb, 0x4c, 0xb9, 0xef, 0x69, 0x1b, 0x5d, 0x56, 0x7d, 0xf8, 0xc1, 0xef, 0x20, 0x64, 0x9a,
+	0x04, 0x0c, 0x66, 0xeb, 0xad, 0x5d, 0xe6, 0x6e, 0x19, 0xb4, 0x80, 0x3d, 0xf9, 0xc5, 0x04, 0x2d,
+	0x1a, 0xec, 0x12, 0x60, 0x57, 0x2f, 0x73, 0xb7, 0x14, 0x89, 0x8e, 0xc1, 0x34, 0x26, 0x3d, 0x54,
+	0x29, 0x8f, 0x45, 0x8f, 0x07, 0xb0, 0xbb, 0x95, 0xe8, 0x48, 0x24, 0x7f, 0xba, 0x8d, 0x4a, 0x47,
+	0x7d, 0xca, 0x65, 0x16, 0x29, 0xfc, 0x0c, 0x39, 0xbe, 0x48, 0x54, 0xca, 0x7c, 0xe5, 0xcd, 0x0c,
+	0x6d, 0xfb, 0xc1, 0x64, 0xa7, 0x99, 0xf7, 0x20, 0xb4, 0x31, 0x52, 0x3d, 0xb5, 0xe3, 0xdf, 0x44,
+	0x8b, 0xc7, 0x91, 0x10, 0x31, 0x54, 0x42, 0x95, 0x1a, 0x01, 0x53, 0x18, 0x35, 0xc8, 0xf2, 0x02,
+	0x5c, 0x20, 0xde, 0xbc, 0x9a, 0xe5, 0xb9, 0x52, 0x69, 0xdf, 0xb5, 0xf7, 0x87, 0xba, 0xe1, 0xb6,
+	0xf1, 0x44, 0x8f, 0x2d, 0x94, 0x92, 0x83, 0x16, 0x52, 0xae, 0x20, 0x69, 0x55, 0xaa, 0x7f, 0xf1,
+	0x7d, 0x54, 0x4a, 0x39, 0x9c, 0xa6, 0x03, 0x48, 0x4e, 0x89, 0x8e, 0x65, 0x7c, 0x0f, 0x95, 0x3a,
+	0x4c, 0x7a, 0x99, 0xe4, 0x81, 0xc9, 0x04, 0x5d, 0xee, 0x30, 0xf9, 0x99, 0xe4, 0xc1, 0x87, 0xc5,
+	0x6f, 0xfe, 0xee, 0xde, 0x22, 0x0c, 0x55, 0x9e, 0xfa, 0x3e, 0x97, 0xf2, 0x28, 0xeb, 0x46, 0xfc,
+	0x15, 0x15, 0xf6, 0x08, 0x55, 0x47, 0x37, 0xb3, 0x33, 0x3e, 0xb0, 0x75, 0x66, 0xaa, 0xc6, 0xea,
+	0x7f, 0xcd, 0x07, 0x92, 0x4e, 0x0b, 0x96, 0xe2, 0xaf, 0x4b, 0xa8, 0x72, 0x94, 0x32, 0x9f, 0xdb,
+	0x13, 0xbe, 0xae, 0x55, 0x2d, 0xa6, 0x96, 0xc2, 0x4a, 0x9a, 0x5b, 0x85, 0x31, 0x17, 0x99, 0xb2,
+	0xf3, 0x69, 0x24, 0xea, 0x88, 0x94, 0xf3, 0x3e, 0xf7, 0xed, 0xad, 0xc5, 0x4a, 0xf8, 0x31, 0xaa,
+	0x05, 0xa1, 0x84, 0x97, 0x1a, 0xa9, 0x98, 0x7f, 0x66, 0xba, 0xdf, 0x76, 0x2e, 0x73, 0xb7, 0x6a,
+	0x0d, 0x87, 0x5a, 0x4f, 0x67, 0x24, 0xfc, 0x11, 0x6a, 0x4c, 0xc2, 0xa0, 0xb5, 0xe6, 0x6d, 0xa4,
+	0x8d, 0x2f, 0x73, 0xb7, 0x3e, 0x76, 0x05, 0x0b, 0x9d, 0x93, 0x75, 0xa6, 0x03, 0x7e, 0x9c, 0x75,
+	0xa0, 0xf8, 0x4a, 0xd4, 0x08, 0x5a, 0x1b, 0x85, 0x71, 0xa8, 0xa0, 0xd8, 0x16, 0xa9, 0x11, 0xf0,
+	0x47, 0xa8, 0x2c, 0x7a, 0x3c, 0x4d, 0xc3, 0x80, 0xcb, 0x16, 0xfa, 0x11, 0xcf, 0x3c, 0x74, 0xe2,
+	0xaf, 0x3b, 0x67, 0x5f, 0xa1, 0x62, 0x1e, 0x8b, 0x74, 0xd0, 0xaa, 0x4c, 0x3a, 0x67, 0x0c, 0x9f,
+	0x80, 0x9e, 0xce, 0x48, 0xb8, 0x8d, 0xb0, 0x0d, 0x4b, 0xb9, 0xca, 0xd2, 0xc4, 0x83, 0xf9, 0x5f,
+	0x85, 0x58, 0x98, 0x85, 0xc6, 0x4a, 0xc1, 0xb8, 0xc3, 0x14, 0xa3, 0x57, 0x34, 0xf8, 0x97, 0x08,
+	0x9b, 0x9c, 0x78, 0x5f, 0x48, 0x31, 0x7e, 0xa7, 0x32, 0x47, 0x0b, 0xe0, 0x37, 0x56, 0xdb, 0x66,
+	0xc7, 0x48, 0xfb, 0x52, 0x8c, 0xee, 0x70, 0x3f, 0x43, 0xfa, 0xb9, 0xc4, 0xb6, 0xdb, 0xdc, 0x49,
+	0xeb, 0x30, 0x55, 0x57, 0x2e, 0x73, 0x57, 0x5f, 0x56, 0x4d, 0x5b, 0xf5, 0xcd, 0x94, 0xce, 0x8a,
+	0xf8, 0x03, 0x73, 0x51, 0x85, 0x74, 0x9a, 0xc8, 0x06, 0x44, 0x02, 0x6d, 0xcc, 0xfa, 0x90, 0x41,
+	0x08, 0x9c, 0x91, 0xf0, 0xcf, 0x91, 0x63, 0xe2, 0xec, 0xe3, 0x81, 0x8e, 0x74, 0x20, 0x12, 0x92,
+	0x0a, 0xbe, 0x60, 0x82, 0xd8, 0x39, 0x19, 0x3f, 0x43, 0x4d, 0x1d, 0x3d, 0x35, 0x62, 0x06, 0x61,
+	0x05, 0x10, 0xee, 0x5c, 0xe6, 0xae, 0x7e, 0x36, 0x9a, 0x8c, 0x10, 0x80, 0x5c, 0x55, 0xed, 0x17,
+	0x4b, 0x45, 0x67, 0x71, 0xbf, 0x58, 0x5a, 0x76, 0x4a, 0xe3, 0xc2, 0xb1, 0xc3, 0x40, 0x57, 0x47,
+	0xf2, 0x14, 0x0b, 0xf9, 0x67, 0x01, 0x21, 0xd8, 0xbc, 0xf4, 0x16, 0x23, 0xf5, 0x74, 0x55, 0xfa,
+	0x26, 0x9f, 0x25, 0x0a, 0x26, 0x47, 0x51, 0x2f, 0x91, 0xdb, 0x5a, 0xc4, 0x6f, 0xa3, 0xc6, 0x09,
+	0x0b, 0x23, 0x78, 0xcb, 0xb3, 0x1e, 0xe6, 0x9e, 0x5f, 0x33, 0xea, 0x23, 0xeb, 0x37, 0x3d, 0xe3,
+	0x17, 0x66, 0x66, 0x3c, 0xa6, 0xa8, 0xa6, 0x4d, 0xdd, 0x34, 0xf4, 0xb9, 0x27, 0xb3, 0xd8, 0x5e,
+	0x0c, 0x37, 0xaf, 0xf7, 0x52, 0x43, 0x2b, 0x1d, 0x26, 0x0f, 0x34, 0xc6, 0x61, 0x16, 0x93, 0x3f,
+	0x17, 0x50, 0xeb, 0x70, 0x20, 0x15, 0x8f, 0xb7, 0xed, 0x92, 0x38, 0x79, 0xc8, 0x78, 0xc5, 0x6a,
+	0xf2, 0x00, 0x95, 0xc7, 0x2f, 0x0c, 0x76, 0xb6, 0x97, 0x7c, 0xfb, 0xb0, 0xa0, 0xa7, 0xfb, 0x29,
+	0x0f, 0x3b, 0xa7, 0xe6, 0x3a, 0xbc, 0x40, 0xad, 0x84, 0xff, 0x0f, 0xd5, 0xa6, 0x1f, 0x87, 0xa4,
+	0xd9, 0xb9, 0x68, 0x75, 0xea, 0x69, 0x48, 0x92, 0x1e, 0x42, 0x30, 0xa1, 0x76, 0xbb, 0xc2, 0x3f,
+	0xc5, 0x6f, 0xa3, 0x92, 0x79, 0x2a, 0x0d, 0x03, 0xbb, 0xae, 0x57, 0x2e, 0x72, 0x77, 0x19, 0x3c,
+	0xf6, 0x76, 0xe8, 0x32, 0x18, 0xf7, 0x02, 0xfc, 0xa6, 0x5e, 0xdd, 0x58, 0xaa, 0x3c, 0x4b, 0x7c,
+	0x1b, 0x88, 0x2b, 0xa0, 0x7b, 0x6e, 0xd8, 0xdf, 0x40, 0x88, 0x27, 0x81, 0x37, 0xd3, 0xb2, 0x32,
+	0x4f, 0x02, 0x63, 0x26, 0x4f, 0x10, 0x7a, 0xce, 0x59, 0xc0, 0xd3, 0xb9, 0x2e, 0x14, 0x66, 0xba,
+	0x30, 0x3a, 0x08, 0xdc, 0x9e, 0x1c, 0x04, 0xc8, 0x11, 0xaa, 0xda, 0x5a, 0xfc, 0x4c, 0xea, 0x15,
+	0xe6, 0x87, 0x47, 0xad, 0x89, 0x16, 0x4d, 0xc7, 0x4d, 0xe6, 0x8d, 0xa0, 0xb5, 0xc7, 0x03, 0xc5,
+	0x47, 0x4f, 0x3a, 0x46, 0x68, 0xff, 0xea, 0xdb, 0x8b, 0xb5, 0xc2, 0x77, 0x17, 0x6b, 0x85, 0xff,
+	0x5e, 0xac, 0x15, 0xfe, 0xf2, 0xfd, 0xda, 0xad, 0xef, 0xbe, 0x5f, 0xbb, 0xf5, 0xef, 0xef, 0xd7,
+	0x6e, 0x7d, 0x3e, 0x9d, 0x67, 0xde, 0xd3, 0x69, 0x9e, 0xbc, 0xe6, 0xf7, 0xb5, 0xc6, 0xe4, 0xfa,
+	0x78, 0x09, 0xde, 0xe9, 0xdf, 0xff, 0xdf, 0x00, 0xbb, 0x86, 0x64, 0x2b, 0xed, 0x17, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DeploymentPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
 			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	{
+		size := m.StorageSlotDeposit.Size()
+		i -= size
+		if _, err := m.StorageSlotDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.RoundDownPrecisionLoss {
 		i--
-		dAtA[i] = 0x32
+		if m.RoundDownPrecisionLoss {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
 	}
-	if len(m.EIP150Hash) > 0 {
-		i -= len(m.EIP150Hash)
-		copy(dAtA[i:], m.EIP150Hash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.EIP150Hash)))
+	if m.WeiConversionExponent != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.WeiConversionExponent))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x60
 	}
-	if m.EIP150Block != nil {
-		{
-			size := m.EIP150Block.Size()
-			i -= size
-			if _, err := m.EIP150Block.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if m.RefundQuotient != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.RefundQuotient))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxCalldataSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCalldataSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxTxSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxTxSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.FeeConversionRate.Size()
+		i -= size
+		if _, err := m.FeeConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AllowUnprotectedTxs {
+		i--
+		if m.AllowUnprotectedTxs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ExtraEIPs) > 0 {
+		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
+		var j3 int
+		for _, num1 := range m.ExtraEIPs {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
 			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvm(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.DAOForkSupport {
+	if m.EnableCall {
 		i--
-		if m.DAOForkSupport {
+		if m.EnableCall {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -1111,34 +1595,27 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.DAOForkBlock != nil {
-		{
-			size := m.DAOForkBlock.Size()
-			i -= size
-			if _, err := m.DAOForkBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	if m.EnableCreate {
+		i--
+		if m.EnableCreate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.HomesteadBlock != nil {
-		{
-			size := m.HomesteadBlock.Size()
-			i -= size
-			if _, err := m.HomesteadBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
+	if len(m.EvmDenom) > 0 {
+		i -= len(m.EvmDenom)
+		copy(dAtA[i:], m.EvmDenom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.EvmDenom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *State) Marshal() (dAtA []byte, err error) {
+func (m *DeploymentPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1148,34 +1625,48 @@ func (m *State) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *State) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeploymentPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeploymentPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Value)))
+	if len(m.AllowedCodeHashes) > 0 {
+		for iNdEx := len(m.AllowedCodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCodeHashes[iNdEx])
+			copy(dAtA[i:], m.AllowedCodeHashes[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedCodeHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxJumpDests != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxJumpDests))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Key)))
+	if m.MaxCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCodeSize))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if len(m.DeniedPatterns) > 0 {
+		for iNdEx := len(m.DeniedPatterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedPatterns[iNdEx])
+			copy(dAtA[i:], m.DeniedPatterns[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.DeniedPatterns[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TransactionLogs) Marshal() (dAtA []byte, err error) {
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1185,41 +1676,34 @@ func (m *TransactionLogs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransactionLogs) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransactionLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvm(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Converter) > 0 {
+		i -= len(m.Converter)
+		copy(dAtA[i:], m.Converter)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Converter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Hash)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Log) Marshal() (dAtA []byte, err error) {
+func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1229,82 +1713,253 @@ func (m *Log) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Removed {
-		i--
-		if m.Removed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.CancunBlock != nil {
+		{
+			size := m.CancunBlock.Size()
+			i -= size
+			if _, err := m.CancunBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if m.Index != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.Index))
+	if m.ShanghaiBlock != nil {
+		{
+			size := m.ShanghaiBlock.Size()
+			i -= size
+			if _, err := m.ShanghaiBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockHash)))
+	if m.MergeNetsplitBlock != nil {
+		{
+			size := m.MergeNetsplitBlock.Size()
+			i -= size
+			if _, err := m.MergeNetsplitBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.GrayGlacierBlock != nil {
+		{
+			size := m.GrayGlacierBlock.Size()
+			i -= size
+			if _, err := m.GrayGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.ArrowGlacierBlock != nil {
+		{
+			size := m.ArrowGlacierBlock.Size()
+			i -= size
+			if _, err := m.ArrowGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.LondonBlock != nil {
+		{
+			size := m.LondonBlock.Size()
+			i -= size
+			if _, err := m.LondonBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.BerlinBlock != nil {
+		{
+			size := m.BerlinBlock.Size()
+			i -= size
+			if _, err := m.BerlinBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MuirGlacierBlock != nil {
+		{
+			size := m.MuirGlacierBlock.Size()
+			i -= size
+			if _, err := m.MuirGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.IstanbulBlock != nil {
+		{
+			size := m.IstanbulBlock.Size()
+			i -= size
+			if _, err := m.IstanbulBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PetersburgBlock != nil {
+		{
+			size := m.PetersburgBlock.Size()
+			i -= size
+			if _, err := m.PetersburgBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ConstantinopleBlock != nil {
+		{
+			size := m.ConstantinopleBlock.Size()
+			i -= size
+			if _, err := m.ConstantinopleBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ByzantiumBlock != nil {
+		{
+			size := m.ByzantiumBlock.Size()
+			i -= size
+			if _, err := m.ByzantiumBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EIP158Block != nil {
+		{
+			size := m.EIP158Block.Size()
+			i -= size
+			if _, err := m.EIP158Block.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x3a
 	}
-	if m.TxIndex != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.TxIndex))
+	if m.EIP155Block != nil {
+		{
+			size := m.EIP155Block.Size()
+			i -= size
+			if _, err := m.EIP155Block.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x32
 	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
+	if len(m.EIP150Hash) > 0 {
+		i -= len(m.EIP150Hash)
+		copy(dAtA[i:], m.EIP150Hash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.EIP150Hash)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.BlockNumber))
+	if m.EIP150Block != nil {
+		{
+			size := m.EIP150Block.Size()
+			i -= size
+			if _, err := m.EIP150Block.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Data)))
+	if m.DAOForkSupport {
 		i--
-		dAtA[i] = 0x1a
+		if m.DAOForkSupport {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Topics[iNdEx])
-			copy(dAtA[i:], m.Topics[iNdEx])
-			i = encodeVarintEvm(dAtA, i, uint64(len(m.Topics[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if m.DAOForkBlock != nil {
+		{
+			size := m.DAOForkBlock.Size()
+			i -= size
+			if _, err := m.DAOForkBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+	if m.HomesteadBlock != nil {
+		{
+			size := m.HomesteadBlock.Size()
+			i -= size
+			if _, err := m.HomesteadBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TxResult) Marshal() (dAtA []byte, err error) {
+func (m *State) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1314,24 +1969,190 @@ func (m *TxResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasUsed != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x12
 	}
-	if m.Reverted {
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Key)))
 		i--
-		if m.Reverted {
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionLogs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionLogs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Log) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Index != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Reverted {
+		i--
+		if m.Reverted {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -1432,6 +2253,30 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxReturnDataSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxReturnDataSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxStorageSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxStorageSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxStackSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxStackSize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxMemorySize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxMemorySize))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.TracerJsonConfig) > 0 {
 		i -= len(m.TracerJsonConfig)
 		copy(dAtA[i:], m.TracerJsonConfig)
@@ -1528,59 +2373,344 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BlockStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BlockStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmDenom)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.EnableCreate {
-		n += 2
+	{
+		size := m.GasPriceSum.Size()
+		i -= size
+		if _, err := m.GasPriceSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	if m.EnableCall {
-		n += 2
+	i--
+	dAtA[i] = 0x22
+	if m.GasUsed != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.ExtraEIPs) > 0 {
-		l = 0
-		for _, e := range m.ExtraEIPs {
-			l += sovEvm(uint64(e))
-		}
-		n += 1 + sovEvm(uint64(l)) + l
+	if m.FailedTxCount != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.FailedTxCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.ChainConfig.Size()
-	n += 1 + l + sovEvm(uint64(l))
-	if m.AllowUnprotectedTxs {
-		n += 2
+	if m.TxCount != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SystemContractDeployment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SystemContractDeployment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SystemContractDeployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HomesteadBlock != nil {
-		l = m.HomesteadBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if m.StorageSlots != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.StorageSlots))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.DAOForkBlock != nil {
+	if m.Height != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeaderHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Slots != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Slots))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EnableCreate {
+		n += 2
+	}
+	if m.EnableCall {
+		n += 2
+	}
+	if len(m.ExtraEIPs) > 0 {
+		l = 0
+		for _, e := range m.ExtraEIPs {
+			l += sovEvm(uint64(e))
+		}
+		n += 1 + sovEvm(uint64(l)) + l
+	}
+	l = m.ChainConfig.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.AllowUnprotectedTxs {
+		n += 2
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.FeeConversionRate.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.MaxTxSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxTxSize))
+	}
+	if m.MaxCalldataSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxCalldataSize))
+	}
+	if m.RefundQuotient != 0 {
+		n += 1 + sovEvm(uint64(m.RefundQuotient))
+	}
+	if m.WeiConversionExponent != 0 {
+		n += 1 + sovEvm(uint64(m.WeiConversionExponent))
+	}
+	if m.RoundDownPrecisionLoss {
+		n += 2
+	}
+	l = m.StorageSlotDeposit.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = m.DeploymentPolicy.Size()
+	n += 2 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *DeploymentPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeniedPatterns) > 0 {
+		for _, s := range m.DeniedPatterns {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.MaxCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxCodeSize))
+	}
+	if m.MaxJumpDests != 0 {
+		n += 1 + sovEvm(uint64(m.MaxJumpDests))
+	}
+	if len(m.AllowedCodeHashes) > 0 {
+		for _, s := range m.AllowedCodeHashes {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Converter)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HomesteadBlock != nil {
+		l = m.HomesteadBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.DAOForkBlock != nil {
 		l = m.DAOForkBlock.Size()
 		n += 1 + l + sovEvm(uint64(l))
 	}
@@ -1824,47 +2954,1312 @@ func (m *TraceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	if m.MaxMemorySize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxMemorySize))
+	}
+	if m.MaxStackSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxStackSize))
+	}
+	if m.MaxStorageSize != 0 {
+		n += 2 + sovEvm(uint64(m.MaxStorageSize))
+	}
+	if m.MaxReturnDataSize != 0 {
+		n += 2 + sovEvm(uint64(m.MaxReturnDataSize))
+	}
 	return n
 }
 
-func sovEvm(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BlockStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		n += 1 + sovEvm(uint64(m.TxCount))
+	}
+	if m.FailedTxCount != 0 {
+		n += 1 + sovEvm(uint64(m.FailedTxCount))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvm(uint64(m.GasUsed))
+	}
+	l = m.GasPriceSum.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
 }
-func sozEvm(x uint64) (n int) {
-	return sovEvm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SystemContractDeployment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvm(uint64(m.Height))
+	}
+	if m.StorageSlots != 0 {
+		n += 1 + sovEvm(uint64(m.StorageSlots))
+	}
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
+
+func (m *ChainEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovEvm(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovEvm(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *HeaderHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvm(uint64(m.Height))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Slots != 0 {
+		n += 1 + sovEvm(uint64(m.Slots))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovEvm(uint64(m.Bytes))
+	}
+	return n
+}
+
+func sovEvm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvm(x uint64) (n int) {
+	return sovEvm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCreate = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCall = bool(v != 0)
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExtraEIPs = append(m.ExtraEIPs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvm
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvm
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
+					m.ExtraEIPs = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExtraEIPs = append(m.ExtraEIPs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxSize", wireType)
+			}
+			m.MaxTxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalldataSize", wireType)
+			}
+			m.MaxCalldataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCalldataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundQuotient", wireType)
+			}
+			m.RefundQuotient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundQuotient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeiConversionExponent", wireType)
+			}
+			m.WeiConversionExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeiConversionExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundDownPrecisionLoss", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RoundDownPrecisionLoss = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageSlotDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageSlotDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeploymentPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeploymentPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploymentPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploymentPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedPatterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedPatterns = append(m.DeniedPatterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+			}
+			m.MaxCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJumpDests", wireType)
+			}
+			m.MaxJumpDests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxJumpDests |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCodeHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCodeHashes = append(m.AllowedCodeHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Converter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Converter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.HomesteadBlock = &v
+			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.DAOForkBlock = &v
+			if err := m.DAOForkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkSupport", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DAOForkSupport = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.EIP150Block = &v
+			if err := m.EIP150Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EIP150Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP155Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.EIP155Block = &v
+			if err := m.EIP155Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP158Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.EIP158Block = &v
+			if err := m.EIP158Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ByzantiumBlock = &v
+			if err := m.ByzantiumBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ConstantinopleBlock = &v
+			if err := m.ConstantinopleBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.PetersburgBlock = &v
+			if err := m.PetersburgBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.IstanbulBlock = &v
+			if err := m.IstanbulBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1892,13 +4287,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MuirGlacierBlock = &v
+			if err := m.MuirGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -1908,17 +4307,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableCreate = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.BerlinBlock = &v
+			if err := m.BerlinBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -1928,93 +4343,141 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableCall = bool(v != 0)
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.LondonBlock = &v
+			if err := m.LondonBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
 				}
-				m.ExtraEIPs = append(m.ExtraEIPs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvm
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvm
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ArrowGlacierBlock = &v
+			if err := m.ArrowGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrayGlacierBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
-					m.ExtraEIPs = make([]int64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GrayGlacierBlock = &v
+			if err := m.GrayGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeNetsplitBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExtraEIPs = append(m.ExtraEIPs, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MergeNetsplitBlock = &v
+			if err := m.MergeNetsplitBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2024,30 +4487,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ShanghaiBlock = &v
+			if err := m.ShanghaiBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2057,12 +4523,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowUnprotectedTxs = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.CancunBlock = &v
+			if err := m.CancunBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2084,7 +4566,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+func (m *State) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2107,15 +4589,15 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2143,15 +4625,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.HomesteadBlock = &v
-			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2179,35 +4657,61 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.DAOForkBlock = &v
-			if err := m.DAOForkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkSupport", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DAOForkSupport = bool(v != 0)
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2235,17 +4739,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.EIP150Block = &v
-			if err := m.EIP150Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2255,27 +4755,79 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EIP150Hash = string(dAtA[iNdEx:postIndex])
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Log) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP155Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2303,15 +4855,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.EIP155Block = &v
-			if err := m.EIP155Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP158Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2339,17 +4887,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.EIP158Block = &v
-			if err := m.EIP158Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2359,31 +4903,48 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ByzantiumBlock = &v
-			if err := m.ByzantiumBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2411,17 +4972,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ConstantinopleBlock = &v
-			if err := m.ConstantinopleBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			var stringLen uint64
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2431,31 +4988,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TxIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.PetersburgBlock = &v
-			if err := m.PetersburgBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2483,17 +5023,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.IstanbulBlock = &v
-			if err := m.IstanbulBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2503,33 +5039,16 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.MuirGlacierBlock = &v
-			if err := m.MuirGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2539,31 +5058,65 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
+			m.Removed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvm
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.BerlinBlock = &v
-			if err := m.BerlinBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
 			}
-			iNdEx = postIndex
-		case 17:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2591,17 +5144,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.LondonBlock = &v
-			if err := m.LondonBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2611,33 +5160,31 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ArrowGlacierBlock = &v
-			if err := m.ArrowGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bloom == nil {
+				m.Bloom = []byte{}
 			}
 			iNdEx = postIndex
-		case 20:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrayGlacierBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2647,33 +5194,30 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.GrayGlacierBlock = &v
-			if err := m.GrayGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeNetsplitBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2683,33 +5227,31 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.MergeNetsplitBlock = &v
-			if err := m.MergeNetsplitBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
 			}
 			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShanghaiBlock", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2719,33 +5261,17 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ShanghaiBlock = &v
-			if err := m.ShanghaiBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancunBlock", wireType)
+			m.Reverted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var stringLen uint64
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2755,28 +5281,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.CancunBlock = &v
-			if err := m.CancunBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2798,7 +5307,7 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
+func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2821,15 +5330,15 @@ func (m *State) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2857,11 +5366,11 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2889,7 +5398,7 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.StorageKeys = append(m.StorageKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2912,7 +5421,7 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
+func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2935,15 +5444,15 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2971,13 +5480,13 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Tracer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2987,81 +5496,127 @@ func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
+			m.Reexec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reexec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Log) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.DisableStack = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Log: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DisableStorage = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3071,27 +5626,71 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Overrides == nil {
+				m.Overrides = &ChainConfig{}
+			}
+			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableMemory = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableReturnData = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3119,13 +5718,13 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemorySize", wireType)
 			}
-			var byteLen int
+			m.MaxMemorySize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3135,31 +5734,35 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxMemorySize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStackSize", wireType)
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.MaxStackSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStackSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStorageSize", wireType)
 			}
-			m.BlockNumber = 0
+			m.MaxStorageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3169,16 +5772,16 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= uint64(b&0x7F) << shift
+				m.MaxStorageSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReturnDataSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxReturnDataSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3188,29 +5791,66 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxReturnDataSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvm
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
 			}
-			m.TxIndex = 0
+			m.TxCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3220,16 +5860,16 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				m.TxCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTxCount", wireType)
 			}
-			var stringLen uint64
+			m.FailedTxCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3239,29 +5879,16 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FailedTxCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.Index = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3271,16 +5898,16 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceSum", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3290,12 +5917,26 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Removed = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPriceSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3317,7 +5958,7 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxResult) Unmarshal(dAtA []byte) error {
+func (m *SystemContractDeployment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3340,15 +5981,15 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SystemContractDeployment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SystemContractDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3376,13 +6017,13 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3392,31 +6033,29 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bloom == nil {
-				m.Bloom = []byte{}
-			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3426,30 +6065,85 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvm
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageSlots", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.StorageSlots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageSlots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvm
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3459,31 +6153,29 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var v int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3493,17 +6185,16 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reverted = bool(v != 0)
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			m.GasUsed = 0
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3513,7 +6204,7 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
+				m.EndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3539,7 +6230,7 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessTuple) Unmarshal(dAtA []byte) error {
+func (m *HeaderHash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3562,17 +6253,17 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeaderHash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeaderHash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3582,27 +6273,14 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3630,7 +6308,7 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageKeys = append(m.StorageKeys, string(dAtA[iNdEx:postIndex]))
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3653,7 +6331,7 @@ func (m *AccessTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TraceConfig) Unmarshal(dAtA []byte) error {
+func (m *StorageUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3676,15 +6354,15 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3712,179 +6390,13 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tracer = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Timeout = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
-			}
-			m.Reexec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Reexec |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DisableStack = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DisableStorage = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Debug = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Overrides == nil {
-				m.Overrides = &ChainConfig{}
-			}
-			if err := m.Overrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
 			}
-			var v int
+			m.Slots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3894,37 +6406,16 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Slots |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableMemory = bool(v != 0)
-		case 12:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableReturnData = bool(v != 0)
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
 			}
-			var stringLen uint64
+			m.Bytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3934,24 +6425,11 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Bytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
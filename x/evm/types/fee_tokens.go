@@ -0,0 +1,95 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FeeConversionGasLimit is the gas limit shared by the EVM calls converting the fee tokens of a tx,
+// their gas being charged to the tx.
+const FeeConversionGasLimit uint64 = 300_000
+
+// feeConverterABIJSON is the ABI of the fee token converter contracts.
+const feeConverterABIJSON = `[
+	{
+		"type": "function",
+		"name": "quoteFee",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "token", "type": "address"},
+			{"name": "feeAmount", "type": "uint256"}
+		],
+		"outputs": [
+			{"name": "tokenAmount", "type": "uint256"}
+		]
+	},
+	{
+		"type": "function",
+		"name": "convertFee",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "token", "type": "address"},
+			{"name": "payer", "type": "address"},
+			{"name": "tokenAmount", "type": "uint256"},
+			{"name": "feeAmount", "type": "uint256"}
+		],
+		"outputs": []
+	}
+]`
+
+// FeeConverterABI is the ABI of the fee token converter contracts, called by the evm module account
+// to convert the fee tokens.
+var FeeConverterABI abi.ABI
+
+func init() {
+	var err error
+	FeeConverterABI, err = abi.JSON(strings.NewReader(feeConverterABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Validate performs a basic validation of the fee token addresses.
+func (ft FeeToken) Validate() error {
+	if !common.IsHexAddress(ft.Address) {
+		return fmt.Errorf("invalid fee token address %s", ft.Address)
+	}
+	if !common.IsHexAddress(ft.Converter) {
+		return fmt.Errorf("invalid converter address %s of fee token %s", ft.Converter, ft.Address)
+	}
+	return nil
+}
+
+func validateFeeTokens(feeTokens []FeeToken) error {
+	seen := make(map[common.Address]bool, len(feeTokens))
+	for _, feeToken := range feeTokens {
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+
+		token := common.HexToAddress(feeToken.Address)
+		if seen[token] {
+			return fmt.Errorf("duplicate fee token %s", feeToken.Address)
+		}
+		seen[token] = true
+	}
+	return nil
+}
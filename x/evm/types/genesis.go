@@ -16,7 +16,12 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	ethermint "github.com/evmos/ethermint/types"
 )
@@ -60,5 +65,76 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	var lastEpoch *ChainEpoch
+	for i, epoch := range gs.ChainEpochs {
+		if err := epoch.Validate(); err != nil {
+			return fmt.Errorf("invalid chain epoch %s: %w", epoch.ChainID, err)
+		}
+		if lastEpoch != nil && (lastEpoch.EndHeight == 0 || epoch.StartHeight <= lastEpoch.EndHeight) {
+			return fmt.Errorf("chain epoch %s overlaps the previous epoch %s", epoch.ChainID, lastEpoch.ChainID)
+		}
+		lastEpoch = &gs.ChainEpochs[i]
+	}
+
+	seenHeights := make(map[int64]bool)
+	for _, headerHash := range gs.HeaderHashes {
+		if seenHeights[headerHash.Height] {
+			return fmt.Errorf("duplicated header hash for height %d", headerHash.Height)
+		}
+		if err := headerHash.Validate(); err != nil {
+			return fmt.Errorf("invalid header hash for height %d: %w", headerHash.Height, err)
+		}
+		seenHeights[headerHash.Height] = true
+	}
+
+	if err := validatePausedContracts(gs.PausedContracts); err != nil {
+		return fmt.Errorf("invalid paused contracts: %w", err)
+	}
+
 	return gs.Params.Validate()
 }
+
+// Validate performs a basic validation of a ChainEpoch fields.
+func (e ChainEpoch) Validate() error {
+	if strings.TrimSpace(e.ChainID) == "" {
+		return errors.New("chain-id cannot be blank")
+	}
+	if e.StartHeight < 1 {
+		return fmt.Errorf("start height must be positive, got %d", e.StartHeight)
+	}
+	if e.EndHeight != 0 && e.EndHeight < e.StartHeight {
+		return fmt.Errorf("end height %d is lower than the start height %d", e.EndHeight, e.StartHeight)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of a HeaderHash fields.
+func (h HeaderHash) Validate() error {
+	if h.Height < 1 {
+		return fmt.Errorf("height must be positive, got %d", h.Height)
+	}
+	bz, err := hexutil.Decode(h.Hash)
+	if err != nil {
+		return err
+	}
+	if len(bz) != common.HashLength {
+		return fmt.Errorf("invalid hash length %d", len(bz))
+	}
+	return nil
+}
+
+// validatePausedContracts checks that the paused contracts are valid hex addresses without duplicate.
+func validatePausedContracts(addrs []string) error {
+	seen := make(map[common.Address]bool)
+	for _, addr := range addrs {
+		if err := ethermint.ValidateAddress(addr); err != nil {
+			return err
+		}
+		address := common.HexToAddress(addr)
+		if seen[address] {
+			return fmt.Errorf("duplicated contract %s", addr)
+		}
+		seen[address] = true
+	}
+	return nil
+}
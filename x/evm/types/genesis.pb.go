@@ -29,6 +29,13 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// chain_epochs is the history of the chain-ids the chain has run under.
+	ChainEpochs []ChainEpoch `protobuf:"bytes,3,rep,name=chain_epochs,json=chainEpochs,proto3" json:"chain_epochs"`
+	// header_hashes are the stored header hashes of the recent blocks, so that the
+	// BLOCKHASH opcode keeps resolving them across a chain-id upgrade.
+	HeaderHashes []HeaderHash `protobuf:"bytes,4,rep,name=header_hashes,json=headerHashes,proto3" json:"header_hashes"`
+	// paused_contracts are the hex addresses of the contracts whose execution is paused by governance.
+	PausedContracts []string `protobuf:"bytes,5,rep,name=paused_contracts,json=pausedContracts,proto3" json:"paused_contracts,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +85,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetChainEpochs() []ChainEpoch {
+	if m != nil {
+		return m.ChainEpochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeaderHashes() []HeaderHash {
+	if m != nil {
+		return m.HeaderHashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedContracts() []string {
+	if m != nil {
+		return m.PausedContracts
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,26 +180,32 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4e, 0x83, 0x40,
-	0x1c, 0xe6, 0x6c, 0x53, 0xec, 0xd5, 0xa8, 0xb9, 0x98, 0x48, 0x18, 0xae, 0xa4, 0x83, 0x61, 0x3a,
-	0xd2, 0x9a, 0x38, 0x2b, 0x8b, 0xab, 0xa1, 0x9b, 0xdb, 0x15, 0x7e, 0xa1, 0x0c, 0x70, 0x84, 0xbb,
-	0x12, 0x5d, 0x1d, 0x9d, 0x7c, 0x0e, 0x9f, 0xa4, 0x63, 0x47, 0x27, 0x35, 0xf0, 0x22, 0x86, 0x83,
-	0xd6, 0x28, 0xdb, 0x07, 0xdf, 0xbf, 0xdf, 0x7d, 0x98, 0x82, 0x5a, 0x43, 0x91, 0x26, 0x99, 0xf2,
-	0xa0, 0x4c, 0xbd, 0x72, 0xee, 0xc5, 0x90, 0x81, 0x4c, 0x24, 0xcb, 0x0b, 0xa1, 0x04, 0x39, 0x3f,
-	0xf0, 0x0c, 0xca, 0x94, 0x95, 0x73, 0xdb, 0xee, 0x39, 0x1a, 0x42, 0xab, 0xed, 0x8b, 0x58, 0xc4,
-	0x42, 0x43, 0xaf, 0x41, 0xed, 0xdf, 0xd9, 0x2b, 0xc2, 0x27, 0xf7, 0x6d, 0xea, 0x52, 0x71, 0x05,
-	0xc4, 0xc7, 0xc7, 0x3c, 0x0c, 0xc5, 0x26, 0x53, 0xd2, 0x42, 0xce, 0xc0, 0x9d, 0x2c, 0x1c, 0xf6,
-	0xbf, 0x87, 0x75, 0x8e, 0xbb, 0x56, 0xe8, 0x0f, 0xb7, 0x9f, 0x53, 0x23, 0x38, 0xf8, 0xc8, 0x0d,
-	0x1e, 0xe5, 0xbc, 0xe0, 0xa9, 0xb4, 0x8e, 0x1c, 0xe4, 0x4e, 0x16, 0x56, 0x3f, 0xe1, 0x41, 0xf3,
-	0x9d, 0xb3, 0x53, 0xcf, 0x5e, 0x10, 0x3e, 0xfd, 0x1b, 0x4d, 0x2c, 0x6c, 0xf2, 0x28, 0x2a, 0x40,
-	0x36, 0xd7, 0x20, 0x77, 0x1c, 0xec, 0x3f, 0x09, 0xc1, 0xc3, 0x50, 0x44, 0xa0, 0x2b, 0xc6, 0x81,
-	0xc6, 0xc4, 0xc7, 0xa6, 0x54, 0xa2, 0xe0, 0x31, 0x58, 0x03, 0x7d, 0xfb, 0x65, 0xbf, 0x59, 0x3f,
-	0xd3, 0x3f, 0x6b, 0x8a, 0xdf, 0xbf, 0xa6, 0xe6, 0xb2, 0xd5, 0x07, 0x7b, 0xa3, 0x7f, 0xbb, 0xad,
-	0x28, 0xda, 0x55, 0x14, 0x7d, 0x57, 0x14, 0xbd, 0xd5, 0xd4, 0xd8, 0xd5, 0xd4, 0xf8, 0xa8, 0xa9,
-	0xf1, 0x78, 0x15, 0x27, 0x6a, 0xbd, 0x59, 0xb1, 0x50, 0xa4, 0xcd, 0xae, 0x42, 0x7a, 0xbf, 0x73,
-	0x3f, 0xe9, 0xc1, 0xd5, 0x73, 0x0e, 0x72, 0x35, 0xd2, 0xd3, 0x5e, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe3, 0x61, 0xf0, 0x9f, 0xc0, 0x01, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0xab, 0xd3, 0x40,
+	0x14, 0x4d, 0x5e, 0xeb, 0xab, 0x9d, 0x56, 0xdf, 0x63, 0x10, 0x0c, 0x45, 0xf2, 0x42, 0x17, 0x12,
+	0x37, 0x09, 0xad, 0xe0, 0x5a, 0x53, 0x4a, 0xbb, 0x94, 0x74, 0xe7, 0xa6, 0x4c, 0x27, 0x97, 0x4c,
+	0x16, 0xc9, 0x84, 0xdc, 0x69, 0xd0, 0xad, 0xbf, 0xc0, 0xdf, 0xe1, 0xff, 0x10, 0xba, 0xec, 0xd2,
+	0x95, 0x4a, 0xfb, 0x47, 0x24, 0x93, 0x0f, 0xd1, 0xe0, 0xee, 0xe6, 0xdc, 0x73, 0xce, 0xbd, 0xb9,
+	0x73, 0x88, 0x0d, 0x4a, 0x40, 0x91, 0x26, 0x99, 0xf2, 0xa1, 0x4c, 0xfd, 0x72, 0xe1, 0xc7, 0x90,
+	0x01, 0x26, 0xe8, 0xe5, 0x85, 0x54, 0x92, 0xde, 0x77, 0x7d, 0x0f, 0xca, 0xd4, 0x2b, 0x17, 0xb3,
+	0x59, 0x4f, 0x51, 0x35, 0x34, 0x7b, 0xf6, 0x2c, 0x96, 0xb1, 0xd4, 0xa5, 0x5f, 0x55, 0x35, 0x3a,
+	0xff, 0x76, 0x43, 0xa6, 0x9b, 0xda, 0x75, 0xa7, 0x98, 0x02, 0x1a, 0x90, 0xc7, 0x8c, 0x73, 0x79,
+	0xcc, 0x14, 0x5a, 0xa6, 0x33, 0x70, 0x27, 0x4b, 0xc7, 0xfb, 0x77, 0x8e, 0xd7, 0x28, 0xde, 0xd5,
+	0xc4, 0x60, 0x78, 0xfa, 0xf1, 0x60, 0x84, 0x9d, 0x8e, 0xbe, 0x21, 0xb7, 0x39, 0x2b, 0x58, 0x8a,
+	0xd6, 0x8d, 0x63, 0xba, 0x93, 0xa5, 0xd5, 0x77, 0x78, 0xaf, 0xfb, 0x8d, 0xb2, 0x61, 0xd3, 0x35,
+	0x99, 0x72, 0xc1, 0x92, 0x6c, 0x0f, 0xb9, 0xe4, 0x02, 0xad, 0x81, 0x9e, 0xff, 0xa2, 0xaf, 0x5e,
+	0x55, 0xac, 0x75, 0x45, 0x6a, 0x1c, 0x26, 0xbc, 0x43, 0x90, 0x6e, 0xc8, 0x13, 0x01, 0x2c, 0x82,
+	0x62, 0x2f, 0x18, 0x0a, 0x40, 0x6b, 0xf8, 0x3f, 0x9f, 0xad, 0xa6, 0x6d, 0x19, 0xb6, 0x3e, 0x53,
+	0xd1, 0x21, 0x80, 0xf4, 0x15, 0xb9, 0xcf, 0xd9, 0x11, 0x21, 0xda, 0x73, 0x99, 0xa9, 0x82, 0x71,
+	0x85, 0xd6, 0x23, 0x67, 0xe0, 0x8e, 0xc3, 0xbb, 0x1a, 0x5f, 0xb5, 0xf0, 0xfc, 0xb3, 0x49, 0x9e,
+	0xfe, 0x7d, 0x15, 0x6a, 0x91, 0x11, 0x8b, 0xa2, 0x02, 0xb0, 0x3a, 0xa4, 0xe9, 0x8e, 0xc3, 0xf6,
+	0x93, 0x52, 0x32, 0xe4, 0x32, 0x02, 0x7d, 0x9d, 0x71, 0xa8, 0x6b, 0x1a, 0x90, 0x11, 0x2a, 0x59,
+	0xb0, 0x18, 0x9a, 0xdf, 0x7e, 0xde, 0x5f, 0x57, 0xbf, 0x50, 0x70, 0x57, 0x6d, 0xfa, 0xf5, 0xe7,
+	0xc3, 0x68, 0x57, 0xf3, 0xc3, 0x56, 0x18, 0xbc, 0x3d, 0x5d, 0x6c, 0xf3, 0x7c, 0xb1, 0xcd, 0x5f,
+	0x17, 0xdb, 0xfc, 0x72, 0xb5, 0x8d, 0xf3, 0xd5, 0x36, 0xbe, 0x5f, 0x6d, 0xe3, 0xc3, 0xcb, 0x38,
+	0x51, 0xe2, 0x78, 0xf0, 0xb8, 0x4c, 0xab, 0x48, 0x48, 0xf4, 0xff, 0x24, 0xe5, 0xa3, 0xce, 0x8a,
+	0xfa, 0x94, 0x03, 0x1e, 0x6e, 0x75, 0x2a, 0x5e, 0xff, 0x1e, 0x00, 0xb1, 0xde, 0x3b, 0x3d, 0x7b,
+	0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +228,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PausedContracts) > 0 {
+		for iNdEx := len(m.PausedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedContracts[iNdEx])
+			copy(dAtA[i:], m.PausedContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.HeaderHashes) > 0 {
+		for iNdEx := len(m.HeaderHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeaderHashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChainEpochs) > 0 {
+		for iNdEx := len(m.ChainEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -297,6 +368,24 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChainEpochs) > 0 {
+		for _, e := range m.ChainEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HeaderHashes) > 0 {
+		for _, e := range m.HeaderHashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedContracts) > 0 {
+		for _, s := range m.PausedContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -425,6 +514,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainEpochs = append(m.ChainEpochs, ChainEpoch{})
+			if err := m.ChainEpochs[len(m.ChainEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHashes = append(m.HeaderHashes, HeaderHash{})
+			if err := m.HeaderHashes[len(m.HeaderHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedContracts = append(m.PausedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
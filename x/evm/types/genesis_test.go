@@ -211,6 +211,80 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid chain epochs and header hashes",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ChainEpochs: []ChainEpoch{
+					{ChainID: "ethermint_9000-1", StartHeight: 1, EndHeight: 99},
+					{ChainID: "ethermint_9000-2", StartHeight: 100},
+				},
+				HeaderHashes: []HeaderHash{{Height: 99, Hash: suite.hash.Hex()}},
+			},
+			expPass: true,
+		},
+		{
+			name: "overlapping chain epochs",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ChainEpochs: []ChainEpoch{
+					{ChainID: "ethermint_9000-1", StartHeight: 1},
+					{ChainID: "ethermint_9000-2", StartHeight: 100},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid chain epoch",
+			genState: &GenesisState{
+				Params:      DefaultParams(),
+				ChainEpochs: []ChainEpoch{{ChainID: "ethermint_9000-1", StartHeight: 10, EndHeight: 5}},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated header hash",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				HeaderHashes: []HeaderHash{
+					{Height: 99, Hash: suite.hash.Hex()},
+					{Height: 99, Hash: suite.hash.Hex()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid header hash",
+			genState: &GenesisState{
+				Params:       DefaultParams(),
+				HeaderHashes: []HeaderHash{{Height: 99, Hash: "0x1234"}},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid paused contracts",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				PausedContracts: []string{suite.address},
+			},
+			expPass: true,
+		},
+		{
+			name: "duplicated paused contract",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				PausedContracts: []string{suite.address, suite.address},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid paused contract",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				PausedContracts: []string{"0x1234"},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,84 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// NewEthereumCallAcknowledgement returns the successful acknowledgement of a packet executing EVM
+// calls, the results being packed as the msg responses of a TxMsgData like the interchain accounts
+// host does. It's meant for the IBC middlewares calling the EVM contracts.
+func NewEthereumCallAcknowledgement(results ...*MsgEthereumCallResponse) (channeltypes.Acknowledgement, error) {
+	txMsgData := &sdk.TxMsgData{
+		MsgResponses: make([]*codectypes.Any, len(results)),
+	}
+	for i, result := range results {
+		any, err := codectypes.NewAnyWithValue(result)
+		if err != nil {
+			return channeltypes.Acknowledgement{}, err
+		}
+		txMsgData.MsgResponses[i] = any
+	}
+
+	bz, err := proto.Marshal(txMsgData)
+	if err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+
+	return channeltypes.NewResultAcknowledgement(bz), nil
+}
+
+// UnpackEthereumCallAcknowledgement decodes the EVM call results from the acknowledgement bytes of a
+// packet executed by an interchain account, or by an IBC middleware. The results are returned in the
+// order of the packet messages, nil for the other message types. An error acknowledgement is returned
+// as an error, the host chain only acknowledging the error code.
+func UnpackEthereumCallAcknowledgement(ackBz []byte) ([]*MsgEthereumCallResponse, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decode acknowledgement")
+	}
+
+	if !ack.Success() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "error acknowledgement: %s", ack.GetError())
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(ack.GetResult(), &txMsgData); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decode acknowledgement result")
+	}
+
+	typeURL := "/" + proto.MessageName(&MsgEthereumCallResponse{})
+	results := make([]*MsgEthereumCallResponse, len(txMsgData.MsgResponses))
+	for i, any := range txMsgData.MsgResponses {
+		if any.TypeUrl != typeURL {
+			continue
+		}
+
+		var result MsgEthereumCallResponse
+		if err := proto.Unmarshal(any.Value, &result); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to decode the result of msg %d", i)
+		}
+		results[i] = &result
+	}
+
+	return results, nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthereumCallAcknowledgement(t *testing.T) {
+	results := []*MsgEthereumCallResponse{
+		{Ret: []byte{0x2a}, GasUsed: 21000, Logs: []*Log{{Address: "0x0000000000000000000000000000000000000001", Data: []byte{1}}}},
+		{Ret: []byte{0x08, 0xc3, 0x79, 0xa0}, VmError: "execution reverted", GasUsed: 30000},
+	}
+
+	ack, err := NewEthereumCallAcknowledgement(results...)
+	require.NoError(t, err)
+	require.True(t, ack.Success())
+
+	decoded, err := UnpackEthereumCallAcknowledgement(ack.Acknowledgement())
+	require.NoError(t, err)
+	require.Equal(t, results, decoded)
+
+	// the other msg responses of an interchain accounts packet are skipped
+	sendResponse, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	require.NoError(t, err)
+	callResponse, err := codectypes.NewAnyWithValue(results[0])
+	require.NoError(t, err)
+	bz, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{sendResponse, callResponse}})
+	require.NoError(t, err)
+
+	decoded, err = UnpackEthereumCallAcknowledgement(channeltypes.NewResultAcknowledgement(bz).Acknowledgement())
+	require.NoError(t, err)
+	require.Equal(t, []*MsgEthereumCallResponse{nil, results[0]}, decoded)
+
+	// error acknowledgement
+	errAck := channeltypes.NewErrorAcknowledgement(ErrInvalidAmount)
+	_, err = UnpackEthereumCallAcknowledgement(errAck.Acknowledgement())
+	require.ErrorContains(t, err, "error acknowledgement")
+
+	_, err = UnpackEthereumCallAcknowledgement([]byte("invalid"))
+	require.Error(t, err)
+}
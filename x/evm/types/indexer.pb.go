@@ -0,0 +1,876 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/evm/v1/indexer.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryChainStatsRequest defines the request type for querying the aggregated statistics of a block
+// range.
+type QueryChainStatsRequest struct {
+	// from_block is the first block of the range
+	FromBlock int64 `protobuf:"varint,1,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	// to_block is the last block of the range, inclusive, the latest block when 0
+	ToBlock int64 `protobuf:"varint,2,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+}
+
+func (m *QueryChainStatsRequest) Reset()         { *m = QueryChainStatsRequest{} }
+func (m *QueryChainStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainStatsRequest) ProtoMessage()    {}
+func (*QueryChainStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535e2f3c463710a7, []int{0}
+}
+func (m *QueryChainStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainStatsRequest.Merge(m, src)
+}
+func (m *QueryChainStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainStatsRequest proto.InternalMessageInfo
+
+func (m *QueryChainStatsRequest) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *QueryChainStatsRequest) GetToBlock() int64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+// QueryChainStatsResponse returns the aggregated statistics of a block range.
+type QueryChainStatsResponse struct {
+	// from_block is the first block of the range
+	FromBlock int64 `protobuf:"varint,1,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
+	// to_block is the last block of the range
+	ToBlock int64 `protobuf:"varint,2,opt,name=to_block,json=toBlock,proto3" json:"to_block,omitempty"`
+	// block_count is the number of blocks with ethereum transactions in the range
+	BlockCount uint64 `protobuf:"varint,3,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// tx_count is the number of ethereum transactions
+	TxCount uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// failed_tx_count is the number of ethereum transactions failed in the EVM
+	FailedTxCount uint64 `protobuf:"varint,5,opt,name=failed_tx_count,json=failedTxCount,proto3" json:"failed_tx_count,omitempty"`
+	// gas_used is the gas used by the ethereum transactions
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// average_gas_price is the average effective gas price of the ethereum transactions
+	AverageGasPrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=average_gas_price,json=averageGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"average_gas_price"`
+	// failure_ratio is the ratio of the ethereum transactions failed in the EVM
+	FailureRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=failure_ratio,json=failureRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"failure_ratio"`
+}
+
+func (m *QueryChainStatsResponse) Reset()         { *m = QueryChainStatsResponse{} }
+func (m *QueryChainStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainStatsResponse) ProtoMessage()    {}
+func (*QueryChainStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535e2f3c463710a7, []int{1}
+}
+func (m *QueryChainStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainStatsResponse.Merge(m, src)
+}
+func (m *QueryChainStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainStatsResponse proto.InternalMessageInfo
+
+func (m *QueryChainStatsResponse) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *QueryChainStatsResponse) GetToBlock() int64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+func (m *QueryChainStatsResponse) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *QueryChainStatsResponse) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *QueryChainStatsResponse) GetFailedTxCount() uint64 {
+	if m != nil {
+		return m.FailedTxCount
+	}
+	return 0
+}
+
+func (m *QueryChainStatsResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryChainStatsRequest)(nil), "ethermint.evm.v1.QueryChainStatsRequest")
+	proto.RegisterType((*QueryChainStatsResponse)(nil), "ethermint.evm.v1.QueryChainStatsResponse")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/indexer.proto", fileDescriptor_535e2f3c463710a7) }
+
+var fileDescriptor_535e2f3c463710a7 = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0xae, 0xd2, 0x40,
+	0x18, 0x85, 0x5b, 0xb9, 0x5e, 0xee, 0x1d, 0x31, 0xe8, 0xc4, 0x68, 0x25, 0xb1, 0x10, 0x16, 0xa4,
+	0x2e, 0x9c, 0x06, 0x7d, 0x01, 0x03, 0x26, 0x86, 0x9d, 0x16, 0xdd, 0xb0, 0x69, 0x86, 0xf6, 0xa7,
+	0x34, 0xd0, 0x4e, 0x99, 0x99, 0x36, 0x65, 0xed, 0x0b, 0xf8, 0x58, 0x2c, 0x59, 0x1a, 0x17, 0xc4,
+	0xc0, 0x8b, 0x98, 0x99, 0x36, 0x60, 0xd4, 0x85, 0xde, 0xd5, 0x4c, 0xce, 0x39, 0xf3, 0x65, 0xfe,
+	0xfc, 0x07, 0xd9, 0x20, 0x97, 0xc0, 0x93, 0x38, 0x95, 0x2e, 0x14, 0x89, 0x5b, 0x0c, 0xdd, 0x38,
+	0x0d, 0xa1, 0x04, 0x4e, 0x32, 0xce, 0x24, 0xc3, 0x8f, 0xce, 0x3e, 0x81, 0x22, 0x21, 0xc5, 0xb0,
+	0xf3, 0x24, 0x62, 0x11, 0xd3, 0xa6, 0xab, 0x6e, 0x55, 0xae, 0xef, 0xa1, 0xa7, 0x1f, 0x73, 0xe0,
+	0xdb, 0xf1, 0x92, 0xc6, 0xe9, 0x54, 0x52, 0x29, 0x3c, 0xd8, 0xe4, 0x20, 0x24, 0x7e, 0x81, 0xd0,
+	0x82, 0xb3, 0xc4, 0x9f, 0xaf, 0x59, 0xb0, 0xb2, 0xcc, 0x9e, 0xe9, 0x34, 0xbc, 0x5b, 0xa5, 0x8c,
+	0x94, 0x80, 0x9f, 0xa3, 0x1b, 0xc9, 0x6a, 0xf3, 0x9e, 0x36, 0x9b, 0x92, 0x69, 0xab, 0xff, 0xa5,
+	0x81, 0x9e, 0xfd, 0x01, 0x15, 0x19, 0x4b, 0x05, 0xdc, 0x9d, 0x8a, 0xbb, 0xe8, 0x81, 0xd6, 0xfd,
+	0x80, 0xe5, 0xa9, 0xb4, 0x1a, 0x3d, 0xd3, 0xb9, 0xf2, 0x90, 0x96, 0xc6, 0x4a, 0xd1, 0x6f, 0xcb,
+	0xda, 0xbd, 0xd2, 0x6e, 0x53, 0x96, 0x95, 0x35, 0x40, 0xed, 0x05, 0x8d, 0xd7, 0x10, 0xfa, 0xe7,
+	0xc4, 0x7d, 0x9d, 0x78, 0x58, 0xc9, 0x9f, 0xca, 0x33, 0x22, 0xa2, 0xc2, 0xcf, 0x05, 0x84, 0xd6,
+	0x75, 0x85, 0x88, 0xa8, 0xf8, 0x2c, 0x20, 0xc4, 0x33, 0xf4, 0x98, 0x16, 0xc0, 0x69, 0x04, 0xbe,
+	0x8a, 0x64, 0x3c, 0x0e, 0xc0, 0x6a, 0xf6, 0x4c, 0xe7, 0x76, 0x44, 0x76, 0x87, 0xae, 0xf1, 0xfd,
+	0xd0, 0x1d, 0x44, 0xb1, 0x5c, 0xe6, 0x73, 0x12, 0xb0, 0xc4, 0x0d, 0x98, 0x48, 0x98, 0xa8, 0x8f,
+	0x57, 0x22, 0x5c, 0xb9, 0x72, 0x9b, 0x81, 0x20, 0x93, 0x54, 0x7a, 0xed, 0x1a, 0xf4, 0x9e, 0x8a,
+	0x0f, 0x0a, 0x83, 0xa7, 0x48, 0xff, 0x23, 0xe7, 0xe0, 0x73, 0x2a, 0x63, 0x66, 0xdd, 0xfc, 0x37,
+	0xf7, 0x1d, 0x04, 0x5e, 0xab, 0x86, 0x78, 0x8a, 0xf1, 0x7a, 0x83, 0x5a, 0x93, 0xaa, 0x12, 0x7a,
+	0x17, 0x98, 0x22, 0x74, 0xd9, 0x07, 0x76, 0xc8, 0xef, 0x05, 0x21, 0x7f, 0xef, 0x41, 0xe7, 0xe5,
+	0x3f, 0x24, 0xab, 0xe5, 0x8e, 0xde, 0xee, 0x8e, 0xb6, 0xb9, 0x3f, 0xda, 0xe6, 0x8f, 0xa3, 0x6d,
+	0x7e, 0x3d, 0xd9, 0xc6, 0xfe, 0x64, 0x1b, 0xdf, 0x4e, 0xb6, 0x31, 0xfb, 0x75, 0x04, 0x28, 0xd4,
+	0x04, 0x97, 0xfe, 0x96, 0xba, 0xc1, 0x7a, 0x8c, 0xf9, 0xb5, 0x6e, 0xe5, 0x9b, 0x9f, 0x03, 0x00,
+	0xe1, 0xd8, 0x1a, 0xbe, 0xdf, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IndexerQueryClient is the client API for IndexerQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IndexerQueryClient interface {
+	// ChainStats queries the aggregated statistics of the ethereum transactions executed in a block
+	// range.
+	ChainStats(ctx context.Context, in *QueryChainStatsRequest, opts ...grpc.CallOption) (*QueryChainStatsResponse, error)
+}
+
+type indexerQueryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewIndexerQueryClient(cc grpc1.ClientConn) IndexerQueryClient {
+	return &indexerQueryClient{cc}
+}
+
+func (c *indexerQueryClient) ChainStats(ctx context.Context, in *QueryChainStatsRequest, opts ...grpc.CallOption) (*QueryChainStatsResponse, error) {
+	out := new(QueryChainStatsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.IndexerQuery/ChainStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IndexerQueryServer is the server API for IndexerQuery service.
+type IndexerQueryServer interface {
+	// ChainStats queries the aggregated statistics of the ethereum transactions executed in a block
+	// range.
+	ChainStats(context.Context, *QueryChainStatsRequest) (*QueryChainStatsResponse, error)
+}
+
+// UnimplementedIndexerQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedIndexerQueryServer struct {
+}
+
+func (*UnimplementedIndexerQueryServer) ChainStats(ctx context.Context, req *QueryChainStatsRequest) (*QueryChainStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainStats not implemented")
+}
+
+func RegisterIndexerQueryServer(s grpc1.Server, srv IndexerQueryServer) {
+	s.RegisterService(&_IndexerQuery_serviceDesc, srv)
+}
+
+func _IndexerQuery_ChainStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerQueryServer).ChainStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.IndexerQuery/ChainStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerQueryServer).ChainStats(ctx, req.(*QueryChainStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _IndexerQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethermint.evm.v1.IndexerQuery",
+	HandlerType: (*IndexerQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChainStats",
+			Handler:    _IndexerQuery_ChainStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ethermint/evm/v1/indexer.proto",
+}
+
+func (m *QueryChainStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToBlock != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromBlock != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FailureRatio.Size()
+		i -= size
+		if _, err := m.FailureRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.AverageGasPrice.Size()
+		i -= size
+		if _, err := m.AverageGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIndexer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.GasUsed != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FailedTxCount != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.FailedTxCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToBlock != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromBlock != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIndexer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIndexer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryChainStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromBlock != 0 {
+		n += 1 + sovIndexer(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovIndexer(uint64(m.ToBlock))
+	}
+	return n
+}
+
+func (m *QueryChainStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromBlock != 0 {
+		n += 1 + sovIndexer(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovIndexer(uint64(m.ToBlock))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovIndexer(uint64(m.BlockCount))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovIndexer(uint64(m.TxCount))
+	}
+	if m.FailedTxCount != 0 {
+		n += 1 + sovIndexer(uint64(m.FailedTxCount))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovIndexer(uint64(m.GasUsed))
+	}
+	l = m.AverageGasPrice.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	l = m.FailureRatio.Size()
+	n += 1 + l + sovIndexer(uint64(l))
+	return n
+}
+
+func sovIndexer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIndexer(x uint64) (n int) {
+	return sovIndexer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryChainStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			}
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			}
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTxCount", wireType)
+			}
+			m.FailedTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailureRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndexer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIndexer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIndexer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIndexer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIndexer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIndexer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIndexer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIndexer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIndexer = fmt.Errorf("proto: unexpected end of group")
+)
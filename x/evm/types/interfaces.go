@@ -22,6 +22,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/ethereum/go-ethereum/core"
@@ -49,6 +50,8 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	IterateAllDenomMetaData(ctx sdk.Context, cb func(banktypes.Metadata) bool)
 }
 
 // StakingKeeper returns the historical headers kept in store.
@@ -73,6 +76,13 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// AccessListRecorder records the accounts and storage slots accessed by the evm
+// messages, as tracked by the EIP-2929 access list.
+type AccessListRecorder interface {
+	// RecordAccessList is called after the execution of every message once the Berlin hard fork is enabled.
+	RecordAccessList(ctx sdk.Context, msg core.Message, accessList ethtypes.AccessList)
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
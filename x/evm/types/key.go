@@ -41,6 +41,13 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	_ // the block statistics, moved to the evm indexer
+	prefixSystemContract
+	prefixHeaderHash
+	prefixChainEpoch
+	prefixStorageUsage
+	prefixPausedContract
+	prefixParamsHistory
 )
 
 // prefix bytes for the EVM transient store
@@ -49,6 +56,10 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientBlockStats
+	prefixTransientReservedBalance
+	prefixTransientLastAdmittedTx
+	prefixTransientFeeConversionGas
 )
 
 // KVStore key prefixes
@@ -56,16 +67,44 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+	// KeyPrefixSystemContract stores the audit records of the system contracts deployed by upgrade handlers.
+	KeyPrefixSystemContract = []byte{prefixSystemContract}
+	// KeyPrefixHeaderHash stores the header hashes of the recent blocks by height, for the BLOCKHASH opcode.
+	KeyPrefixHeaderHash = []byte{prefixHeaderHash}
+	// KeyPrefixChainEpoch stores the history of the chain-ids by start height.
+	KeyPrefixChainEpoch = []byte{prefixChainEpoch}
+	// KeyPrefixStorageUsage stores the storage used by the contracts by address.
+	KeyPrefixStorageUsage = []byte{prefixStorageUsage}
+	// KeyPrefixPausedContract stores the addresses of the contracts whose execution is paused by governance.
+	KeyPrefixPausedContract = []byte{prefixPausedContract}
+	// KeyPrefixParamsHistory stores the params by the height of the block they were changed in.
+	KeyPrefixParamsHistory = []byte{prefixParamsHistory}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom      = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex    = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize    = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed    = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBlockStats = []byte{prefixTransientBlockStats}
+	// KeyPrefixTransientReservedBalance is only written during CheckTx, the reservations being
+	// discarded with the check state on commit.
+	KeyPrefixTransientReservedBalance = []byte{prefixTransientReservedBalance}
+	// KeyPrefixTransientLastAdmittedTx stores the number of messages of the last ethereum tx of the
+	// block which passed the ante handler, to report it when the block gas check rejects it.
+	KeyPrefixTransientLastAdmittedTx = []byte{prefixTransientLastAdmittedTx}
+	// KeyPrefixTransientFeeConversionGas stores the gas used by the fee token conversions by tx hash.
+	KeyPrefixTransientFeeConversionGas = []byte{prefixTransientFeeConversionGas}
 )
 
+// HeaderHashRetention is the number of blocks for which the header hashes are kept in the store, the
+// BLOCKHASH opcode only returning the hashes of the last 256 blocks.
+const HeaderHashRetention = 256
+
+// MaxBundleCalls is the max number of calls simulated by a single SimulateBundle query.
+const MaxBundleCalls = 100
+
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
 func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
@@ -57,6 +57,16 @@ func (tx TransactionLogs) Validate() error {
 		if log.TxHash != tx.Hash {
 			return fmt.Errorf("log tx hash mismatch (%s ≠ %s)", log.TxHash, tx.Hash)
 		}
+		// the logs are kept in emission order, with consecutive indexes within the block
+		if i > 0 {
+			first := tx.Logs[0]
+			if log.Index != first.Index+uint64(i) {
+				return fmt.Errorf("log %d index out of order (%d ≠ %d)", i, log.Index, first.Index+uint64(i))
+			}
+			if log.TxIndex != first.TxIndex || log.BlockHash != first.BlockHash || log.BlockNumber != first.BlockNumber {
+				return fmt.Errorf("log %d block or tx index mismatch", i)
+			}
+		}
 	}
 	return nil
 }
@@ -81,6 +81,38 @@ func TestTransactionLogsValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"logs in emission order",
+			TransactionLogs{
+				Hash: common.BytesToHash([]byte("tx_hash")).String(),
+				Logs: []*Log{newTestLog(addr, 1, 3), newTestLog(addr, 1, 4), newTestLog(addr, 1, 5)},
+			},
+			true,
+		},
+		{
+			"logs out of order",
+			TransactionLogs{
+				Hash: common.BytesToHash([]byte("tx_hash")).String(),
+				Logs: []*Log{newTestLog(addr, 1, 4), newTestLog(addr, 1, 3)},
+			},
+			false,
+		},
+		{
+			"log index gap",
+			TransactionLogs{
+				Hash: common.BytesToHash([]byte("tx_hash")).String(),
+				Logs: []*Log{newTestLog(addr, 1, 3), newTestLog(addr, 1, 5)},
+			},
+			false,
+		},
+		{
+			"tx index mismatch",
+			TransactionLogs{
+				Hash: common.BytesToHash([]byte("tx_hash")).String(),
+				Logs: []*Log{newTestLog(addr, 1, 3), newTestLog(addr, 2, 4)},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -94,6 +126,19 @@ func TestTransactionLogsValidate(t *testing.T) {
 	}
 }
 
+func newTestLog(addr string, txIndex, index uint64) *Log {
+	return &Log{
+		Address:     addr,
+		Topics:      []string{common.BytesToHash([]byte("topic")).String()},
+		Data:        []byte("data"),
+		BlockNumber: 1,
+		TxHash:      common.BytesToHash([]byte("tx_hash")).String(),
+		TxIndex:     txIndex,
+		BlockHash:   common.BytesToHash([]byte("block_hash")).String(),
+		Index:       index,
+	}
+}
+
 func TestValidateLog(t *testing.T) {
 	addr := tests.GenerateAddress().String()
 
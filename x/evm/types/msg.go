@@ -16,6 +16,9 @@
 package types
 
 import (
+	"bytes"
+	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -27,6 +30,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -45,6 +49,9 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgEthereumCall{}
+	_ sdk.Msg    = &MsgRestoreContract{}
+	_ sdk.Msg    = &MsgSetContractsPaused{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -53,6 +60,8 @@ var (
 const (
 	// TypeMsgEthereumTx defines the type string of an Ethereum transaction
 	TypeMsgEthereumTx = "ethereum_tx"
+	// TypeMsgEthereumCall defines the type string of an EVM call executed by a Cosmos account
+	TypeMsgEthereumCall = "ethereum_call"
 )
 
 // NewTx returns a reference to a new Ethereum transaction message.
@@ -256,23 +265,42 @@ func (msg MsgEthereumTx) GetSignBytes() []byte {
 // The function will fail if the sender address is not defined for the msg or if
 // the sender is not registered on the keyring
 func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.Signer) error {
+	return msg.SignWith(context.Background(), ethSigner, NewKeyringSigner(keyringSigner))
+}
+
+// SignWith signs the transaction with the signer on behalf of the sender, like Sign. An asynchronous
+// signer returns a *PendingSignatureError, the transaction being left unsigned until the signature of
+// the request is applied with ApplySignature.
+func (msg *MsgEthereumTx) SignWith(ctx context.Context, ethSigner ethtypes.Signer, signer Signer) error {
 	from := msg.GetFrom()
 	if from.Empty() {
 		return fmt.Errorf("sender address not defined for message")
 	}
 
-	tx := msg.AsTransaction()
-	txHash := ethSigner.Hash(tx)
+	txHash := ethSigner.Hash(msg.AsTransaction())
+	sig, err := signer.SignHash(ctx, common.BytesToAddress(from), txHash)
+	if err != nil {
+		return err
+	}
+
+	return msg.ApplySignature(ethSigner, sig)
+}
 
-	sig, _, err := keyringSigner.SignByAddress(from, txHash.Bytes())
+// ApplySignature populates the V, R, S fields of the transaction with the [R || S || V] signature of
+// its sender, V being 0 or 1.
+func (msg *MsgEthereumTx) ApplySignature(ethSigner ethtypes.Signer, sig []byte) error {
+	tx, err := msg.AsTransaction().WithSignature(ethSigner, sig)
 	if err != nil {
 		return err
 	}
 
-	tx, err = tx.WithSignature(ethSigner, sig)
+	sender, err := ethtypes.Sender(ethSigner, tx)
 	if err != nil {
 		return err
 	}
+	if from := msg.GetFrom(); !from.Empty() && !bytes.Equal(sender.Bytes(), from) {
+		return fmt.Errorf("transaction signed by %s instead of the sender %s", sender, common.BytesToAddress(from))
+	}
 
 	return msg.FromEthereumTx(tx)
 }
@@ -427,6 +455,19 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 	return tx, nil
 }
 
+// BuildTxBytes builds the cosmos transaction wrapping the ethereum transaction
+// and encodes it with the given tx config. The returned bytes can be broadcasted
+// through the cosmos tx service (gRPC BroadcastTx or its REST gateway) and are
+// routed to the ethereum ante handler by the extension option.
+func (msg *MsgEthereumTx) BuildTxBytes(txConfig client.TxConfig, evmDenom string) ([]byte, error) {
+	tx, err := msg.BuildTx(txConfig.NewTxBuilder(), evmDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return txConfig.TxEncoder()(tx)
+}
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
@@ -447,3 +488,140 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgRestoreContract message.
+func (m MsgRestoreContract) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRestoreContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Contract.Code) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "contract code cannot be empty")
+	}
+
+	if _, err := hex.DecodeString(m.Contract.Code); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "contract code must be hex encoded")
+	}
+
+	return m.Contract.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRestoreContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetContractsPaused message.
+func (m MsgSetContractsPaused) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetContractsPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Addresses) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "contract addresses cannot be empty")
+	}
+
+	return validatePausedContracts(m.Addresses)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetContractsPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgEthereumCall returns a new MsgEthereumCall executing a call of the given contract, or a
+// contract creation if to is nil, on behalf of the sender.
+func NewMsgEthereumCall(sender sdk.AccAddress, to *common.Address, data []byte, value sdkmath.Int, gasLimit uint64) *MsgEthereumCall {
+	msg := &MsgEthereumCall{
+		Sender:   sender.String(),
+		Data:     data,
+		Value:    value,
+		GasLimit: gasLimit,
+	}
+	if to != nil {
+		msg.To = to.Hex()
+	}
+	return msg
+}
+
+// Route returns the route value of a MsgEthereumCall.
+func (m MsgEthereumCall) Route() string { return RouterKey }
+
+// Type returns the type value of a MsgEthereumCall.
+func (m MsgEthereumCall) Type() string { return TypeMsgEthereumCall }
+
+// GetSigners returns the expected signers for a MsgEthereumCall message.
+func (m MsgEthereumCall) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgEthereumCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.To != "" {
+		if err := types.ValidateAddress(m.To); err != nil {
+			return errorsmod.Wrap(err, "invalid to address")
+		}
+	} else if len(m.Data) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "contract creation without init code")
+	}
+
+	if m.Value.IsNil() || m.Value.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "value cannot be nil or negative: %s", m.Value)
+	}
+
+	if m.GasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidGasLimit, "gas limit must not be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgEthereumCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// CallerAddress returns the EVM address executing the calls of a MsgEthereumCall sender. The
+// 20 bytes accounts keep their address. The 32 bytes module derived accounts (interchain
+// accounts, group policies) have no EVM address, they use the first 20 bytes of their ADR-028
+// address hash under the evm module, like the SDK derives the addresses of the module accounts.
+func CallerAddress(sender sdk.AccAddress) common.Address {
+	if len(sender) == common.AddressLength {
+		return common.BytesToAddress(sender)
+	}
+	return common.BytesToAddress(address.Hash(ModuleName, sender)[:common.AddressLength])
+}
+
+// Failed returns if the call failed with a vm error
+func (m *MsgEthereumCallResponse) Failed() bool {
+	return len(m.VmError) > 0
+}
+
+// GetToAddress returns the callee address, nil for a contract creation.
+func (m MsgEthereumCall) GetToAddress() *common.Address {
+	if m.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(m.To)
+	return &to
+}
@@ -13,6 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/ethermint/tests"
 
@@ -110,6 +113,33 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_BuildTx() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumTx_BuildTxBytes() {
+	msg := types.NewTx(suite.chainID, 0, &suite.to, nil, 100000, big.NewInt(1), nil, nil, []byte("test"), nil)
+	msg.From = suite.from.Hex()
+	err := msg.Sign(ethtypes.LatestSignerForChainID(suite.chainID), suite.signer)
+	suite.Require().NoError(err)
+
+	bz, err := msg.BuildTxBytes(suite.clientCtx.TxConfig, "aphoton")
+	suite.Require().NoError(err)
+
+	tx, err := suite.clientCtx.TxConfig.TxDecoder()(bz)
+	suite.Require().NoError(err)
+
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	suite.Require().True(ok)
+	suite.Require().Len(extTx.GetExtensionOptions(), 1)
+	suite.Require().Equal("/ethermint.evm.v1.ExtensionOptionsEthereumTx", extTx.GetExtensionOptions()[0].GetTypeUrl())
+
+	suite.Require().Len(tx.GetMsgs(), 1)
+	decoded, ok := tx.GetMsgs()[0].(*types.MsgEthereumTx)
+	suite.Require().True(ok)
+	suite.Require().Equal(msg.Hash, decoded.Hash)
+
+	msg.Data = nil
+	_, err = msg.BuildTxBytes(suite.clientCtx.TxConfig, "aphoton")
+	suite.Require().Error(err)
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 	hundredInt := big.NewInt(100)
 	zeroInt := big.NewInt(0)
@@ -586,6 +616,106 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_Getters() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumCall_ValidateBasic() {
+	sender := sdk.AccAddress(suite.from.Bytes())
+
+	testCases := []struct {
+		msg    string
+		call   *types.MsgEthereumCall
+		expErr bool
+	}{
+		{"pass call", types.NewMsgEthereumCall(sender, &suite.to, nil, sdkmath.NewInt(1), 21000), false},
+		{"pass create", types.NewMsgEthereumCall(sender, nil, []byte{0x60}, sdkmath.ZeroInt(), 100000), false},
+		{"invalid sender", &types.MsgEthereumCall{Sender: "foo", To: suite.to.Hex(), Value: sdkmath.ZeroInt(), GasLimit: 21000}, true},
+		{"invalid to", &types.MsgEthereumCall{Sender: sender.String(), To: invalidFromAddress, Value: sdkmath.ZeroInt(), GasLimit: 21000}, true},
+		{"create without init code", types.NewMsgEthereumCall(sender, nil, nil, sdkmath.ZeroInt(), 100000), true},
+		{"nil value", &types.MsgEthereumCall{Sender: sender.String(), To: suite.to.Hex(), GasLimit: 21000}, true},
+		{"negative value", types.NewMsgEthereumCall(sender, &suite.to, nil, sdkmath.NewInt(-1), 21000), true},
+		{"zero gas limit", types.NewMsgEthereumCall(sender, &suite.to, nil, sdkmath.ZeroInt(), 0), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.call.ValidateBasic()
+		if tc.expErr {
+			suite.Require().Error(err, tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().Equal([]sdk.AccAddress{sender}, tc.call.GetSigners())
+			suite.Require().NotEmpty(tc.call.GetSignBytes())
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestCallerAddress() {
+	// the 20 bytes accounts keep their address
+	suite.Require().Equal(suite.from, types.CallerAddress(suite.from.Bytes()))
+
+	// the 32 bytes accounts get a derived address, not a truncation of their address
+	moduleAddr := address.Module(types.ModuleName, []byte("account"))
+	suite.Require().Len(moduleAddr, 32)
+	caller := types.CallerAddress(moduleAddr)
+	suite.Require().NotEqual(common.BytesToAddress(moduleAddr), caller)
+	suite.Require().NotEqual(common.BytesToAddress(moduleAddr[:common.AddressLength]), caller)
+	suite.Require().Equal(caller, types.CallerAddress(moduleAddr))
+	suite.Require().NotEqual(caller, types.CallerAddress(address.Module(types.ModuleName, []byte("other"))))
+}
+
+func (suite *MsgsTestSuite) TestMsgRestoreContract_ValidateBasic() {
+	authority := sdk.AccAddress(suite.from.Bytes())
+	storage := types.Storage{types.NewState(common.BytesToHash([]byte{1}), common.BytesToHash([]byte{2}))}
+
+	testCases := []struct {
+		msg     string
+		restore *types.MsgRestoreContract
+		expErr  bool
+	}{
+		{"pass", &types.MsgRestoreContract{Authority: authority.String(), Contract: types.GenesisAccount{Address: suite.to.Hex(), Code: "6080", Storage: storage}}, false},
+		{"invalid authority", &types.MsgRestoreContract{Authority: "foo", Contract: types.GenesisAccount{Address: suite.to.Hex(), Code: "6080"}}, true},
+		{"invalid address", &types.MsgRestoreContract{Authority: authority.String(), Contract: types.GenesisAccount{Address: invalidFromAddress, Code: "6080"}}, true},
+		{"empty code", &types.MsgRestoreContract{Authority: authority.String(), Contract: types.GenesisAccount{Address: suite.to.Hex()}}, true},
+		{"invalid code", &types.MsgRestoreContract{Authority: authority.String(), Contract: types.GenesisAccount{Address: suite.to.Hex(), Code: "0x60zz"}}, true},
+		{"duplicated storage key", &types.MsgRestoreContract{Authority: authority.String(), Contract: types.GenesisAccount{Address: suite.to.Hex(), Code: "6080", Storage: append(storage, storage...)}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.restore.ValidateBasic()
+		if tc.expErr {
+			suite.Require().Error(err, tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().Equal([]sdk.AccAddress{authority}, tc.restore.GetSigners())
+			suite.Require().NotEmpty(tc.restore.GetSignBytes())
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetContractsPaused_ValidateBasic() {
+	authority := sdk.AccAddress(suite.from.Bytes())
+
+	testCases := []struct {
+		msg    string
+		pause  *types.MsgSetContractsPaused
+		expErr bool
+	}{
+		{"pass", &types.MsgSetContractsPaused{Authority: authority.String(), Addresses: []string{suite.to.Hex()}, Paused: true}, false},
+		{"invalid authority", &types.MsgSetContractsPaused{Authority: "foo", Addresses: []string{suite.to.Hex()}}, true},
+		{"empty addresses", &types.MsgSetContractsPaused{Authority: authority.String()}, true},
+		{"invalid address", &types.MsgSetContractsPaused{Authority: authority.String(), Addresses: []string{invalidFromAddress}}, true},
+		{"duplicated address", &types.MsgSetContractsPaused{Authority: authority.String(), Addresses: []string{suite.to.Hex(), suite.to.Hex()}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.pause.ValidateBasic()
+		if tc.expErr {
+			suite.Require().Error(err, tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().Equal([]sdk.AccAddress{authority}, tc.pause.GetSigners())
+			suite.Require().NotEmpty(tc.pause.GetSignBytes())
+		}
+	}
+}
+
 func (suite *MsgsTestSuite) TestFromEthereumTx() {
 	privkey, _ := ethsecp256k1.GenerateKey()
 	ethPriv, err := privkey.ToECDSA()
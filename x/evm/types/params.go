@@ -17,10 +17,13 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/params"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/types"
@@ -37,6 +40,13 @@ var (
 	DefaultEnableCall = true
 )
 
+// DisabledRefundQuotient is the refund quotient disabling the gas refunds.
+const DisabledRefundQuotient = math.MaxUint64
+
+// MaxWeiConversionExponent is the max exponent of the conversion of the evm denom to wei, an evm
+// denom of 0 decimals.
+const MaxWeiConversionExponent = 18
+
 // AvailableExtraEIPs define the list of all EIPs that can be enabled by the
 // EVM interpreter. These EIPs are applied in order and can override the
 // instruction sets from the latest hard fork enabled by the ChainConfig. For
@@ -44,6 +54,19 @@ var (
 // https://github.com/ethereum/go-ethereum/blob/master/core/vm/interpreter.go#L97
 var AvailableExtraEIPs = []int64{1344, 1884, 2200, 2929, 3198, 3529}
 
+// conflictingEIPs lists the EIPs overriding the gas of the same opcodes in incompatible ways, the
+// resulting instruction set being inconsistent whatever the order of activation: the SSTORE gas of
+// EIP-3529 relies on the cold/warm access costs while EIP-2200 sets a constant SLOAD gas.
+var conflictingEIPs = map[int64][]int64{
+	2200: {3529},
+}
+
+// supersedingEIPs lists the EIPs replacing all the gas changes of another EIP when applied after
+// it, which resolves the conflicts of the latter.
+var supersedingEIPs = map[int64]int64{
+	2200: 2929,
+}
+
 // NewParams creates a new Params instance
 func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64) Params {
 	return Params{
@@ -66,6 +89,23 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           nil,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		FeeDenom:            "",
+		FeeConversionRate:   sdk.OneDec(),
+		StorageSlotDeposit:  sdkmath.ZeroInt(),
+	}
+}
+
+// GasRefundQuotient returns the quotient capping the gas refunds to gas used / quotient, the
+// quotient of the fork is used unless it's overridden by the RefundQuotient param.
+func (p Params) GasRefundQuotient(isLondon bool) uint64 {
+	switch {
+	case p.RefundQuotient > 0:
+		return p.RefundQuotient
+	case isLondon:
+		// After EIP-3529: refunds are capped to gasUsed / 5
+		return params.RefundQuotientEIP3529
+	default:
+		return params.RefundQuotient
 	}
 }
 
@@ -91,6 +131,31 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeDenom(p.FeeDenom, p.FeeConversionRate); err != nil {
+		return err
+	}
+
+	if p.WeiConversionExponent > MaxWeiConversionExponent {
+		return fmt.Errorf("wei conversion exponent %d exceeds the max %d", p.WeiConversionExponent, MaxWeiConversionExponent)
+	}
+
+	if !p.StorageSlotDeposit.IsNil() && p.StorageSlotDeposit.IsNegative() {
+		return fmt.Errorf("storage slot deposit cannot be negative: %s", p.StorageSlotDeposit)
+	}
+
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	// the converters pay the fees in evm denom
+	if len(p.FeeTokens) > 0 && p.IsDualGasToken() {
+		return fmt.Errorf("fee tokens can't be set along with the fee denom %s", p.FeeDenom)
+	}
+
+	if err := p.DeploymentPolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid deployment policy: %w", err)
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -103,6 +168,101 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// GasFeeDenom returns the denom paying the gas fees, the EVM denom unless a separate fee denom is set.
+func (p Params) GasFeeDenom() string {
+	if p.FeeDenom == "" {
+		return p.EvmDenom
+	}
+	return p.FeeDenom
+}
+
+// HasFeeTokens returns true if ERC20 fee tokens can be converted to pay the gas fees.
+func (p Params) HasFeeTokens() bool {
+	return len(p.FeeTokens) > 0
+}
+
+// IsDualGasToken returns true if the gas fees are paid in a fee denom different from the EVM denom.
+func (p Params) IsDualGasToken() bool {
+	return p.FeeDenom != "" && p.FeeDenom != p.EvmDenom
+}
+
+// ToFeeAmount converts an amount of the EVM denom, e.g. a gas fee computed from the gas price, to the
+// fee denom at the fee conversion rate. The amount is rounded up when charged and down when refunded.
+func (p Params) ToFeeAmount(amount sdkmath.Int, roundUp bool) sdkmath.Int {
+	if !p.IsDualGasToken() {
+		// the gas fees are rounded in favor of the chain rather than rejected on precision loss
+		if p.WeiConversionExponent == 0 {
+			return amount
+		}
+		quo, rem := new(big.Int).QuoRem(amount.BigInt(), p.weiPerUnit(), new(big.Int))
+		if roundUp && rem.Sign() > 0 {
+			quo.Add(quo, big.NewInt(1))
+		}
+		return sdkmath.NewIntFromBigInt(quo)
+	}
+
+	converted := sdk.NewDecFromInt(amount).Mul(p.FeeConversionRate)
+	if roundUp {
+		return converted.Ceil().TruncateInt()
+	}
+	return converted.TruncateInt()
+}
+
+// ToEVMAmount converts an amount of the fee denom to the EVM denom at the fee conversion rate, rounded down.
+func (p Params) ToEVMAmount(amount sdkmath.Int) sdkmath.Int {
+	if !p.IsDualGasToken() {
+		return sdkmath.NewIntFromBigInt(p.ToWei(amount))
+	}
+	return sdk.NewDecFromInt(amount).Quo(p.FeeConversionRate).TruncateInt()
+}
+
+// StorageSlotDepositWei returns the wei amount charged for each created storage slot, nil if the
+// storage deposits are disabled.
+func (p Params) StorageSlotDepositWei() *big.Int {
+	if p.StorageSlotDeposit.IsNil() || !p.StorageSlotDeposit.IsPositive() {
+		return nil
+	}
+	return p.ToWei(p.StorageSlotDeposit)
+}
+
+// ToWei converts an amount of the evm denom, e.g. a bank balance, to wei.
+func (p Params) ToWei(amount sdkmath.Int) *big.Int {
+	if p.WeiConversionExponent == 0 {
+		return amount.BigInt()
+	}
+	return new(big.Int).Mul(amount.BigInt(), p.weiPerUnit())
+}
+
+// FromWei converts a wei amount to the evm denom. A remainder smaller than one unit of the evm denom
+// is rounded down if RoundDownPrecisionLoss is set, it's rejected with ErrPrecisionLoss otherwise.
+func (p Params) FromWei(amount *big.Int) (sdkmath.Int, error) {
+	if p.WeiConversionExponent == 0 {
+		return sdkmath.NewIntFromBigInt(amount), nil
+	}
+
+	quo, rem := new(big.Int).QuoRem(amount, p.weiPerUnit(), new(big.Int))
+	if rem.Sign() != 0 && !p.RoundDownPrecisionLoss {
+		return sdkmath.Int{}, errorsmod.Wrapf(
+			ErrPrecisionLoss, "%s wei is not a multiple of 10^%d wei", amount, p.WeiConversionExponent,
+		)
+	}
+	return sdkmath.NewIntFromBigInt(quo), nil
+}
+
+// weiPerUnit returns the wei amount of one unit of the evm denom.
+func (p Params) weiPerUnit() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(p.WeiConversionExponent)), nil)
+}
+
+// FeeCoins returns the coins of the fee denom paying the gas fee amount, expressed in the EVM denom.
+func (p Params) FeeCoins(amount sdkmath.Int, roundUp bool) sdk.Coins {
+	fee := p.ToFeeAmount(amount, roundUp)
+	if !fee.IsPositive() {
+		return sdk.Coins{}
+	}
+	return sdk.Coins{{Denom: p.GasFeeDenom(), Amount: fee}}
+}
+
 func validateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
@@ -112,6 +272,21 @@ func validateEVMDenom(i interface{}) error {
 	return sdk.ValidateDenom(denom)
 }
 
+func validateFeeDenom(denom string, conversionRate sdk.Dec) error {
+	if denom == "" {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	if conversionRate.IsNil() || !conversionRate.IsPositive() {
+		return fmt.Errorf("fee conversion rate must be positive: %s", conversionRate)
+	}
+	return nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -126,10 +301,27 @@ func validateEIPs(i interface{}) error {
 		return fmt.Errorf("invalid EIP slice type: %T", i)
 	}
 
-	for _, eip := range eips {
+	// the EIPs are applied in order, the instruction set is unambiguous if they are sorted
+	positions := make(map[int64]int, len(eips))
+	for i, eip := range eips {
 		if !vm.ValidEip(int(eip)) {
 			return fmt.Errorf("EIP %d is not activateable, valid EIPS are: %s", eip, vm.ActivateableEips())
 		}
+		if i > 0 && eip <= eips[i-1] {
+			return fmt.Errorf("EIPs must be in strictly increasing order, EIP %d follows EIP %d", eip, eips[i-1])
+		}
+		positions[eip] = i
+	}
+
+	for i, eip := range eips {
+		if j, found := positions[supersedingEIPs[eip]]; found && j > i {
+			continue
+		}
+		for _, conflict := range conflictingEIPs[eip] {
+			if _, found := positions[conflict]; found {
+				return fmt.Errorf("EIP %d conflicts with EIP %d", eip, conflict)
+			}
+		}
 	}
 
 	return nil
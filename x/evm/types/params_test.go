@@ -1,15 +1,19 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestParamsValidate(t *testing.T) {
-	extraEips := []int64{2929, 1884, 1344}
+	extraEips := []int64{1344, 1884, 2929}
 	testCases := []struct {
 		name     string
 		params   Params
@@ -41,6 +45,93 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"unsorted eips",
+			NewParams("ara", false, true, true, DefaultChainConfig(), []int64{2929, 1884, 1344}),
+			true,
+		},
+		{
+			"valid fee denom",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenom = "ufee"
+				params.FeeConversionRate = sdk.NewDecWithPrec(5, 1)
+				return params
+			}(),
+			false,
+		},
+		{
+			"invalid fee denom",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenom = "@!#"
+				return params
+			}(),
+			true,
+		},
+		{
+			"fee denom without conversion rate",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenom = "ufee"
+				params.FeeConversionRate = sdk.Dec{}
+				return params
+			}(),
+			true,
+		},
+		{
+			"zero fee conversion rate",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenom = "ufee"
+				params.FeeConversionRate = sdk.ZeroDec()
+				return params
+			}(),
+			true,
+		},
+		{
+			"valid fee tokens",
+			func() Params {
+				params := DefaultParams()
+				params.FeeTokens = []FeeToken{
+					{Address: "0x1000000000000000000000000000000000000001", Converter: "0x2000000000000000000000000000000000000002"},
+					{Address: "0x3000000000000000000000000000000000000003", Converter: "0x2000000000000000000000000000000000000002"},
+				}
+				return params
+			}(),
+			false,
+		},
+		{
+			"invalid fee token converter",
+			func() Params {
+				params := DefaultParams()
+				params.FeeTokens = []FeeToken{{Address: "0x1000000000000000000000000000000000000001", Converter: "converter"}}
+				return params
+			}(),
+			true,
+		},
+		{
+			"duplicate fee token",
+			func() Params {
+				params := DefaultParams()
+				params.FeeTokens = []FeeToken{
+					{Address: "0x1000000000000000000000000000000000000001", Converter: "0x2000000000000000000000000000000000000002"},
+					{Address: "0x1000000000000000000000000000000000000001", Converter: "0x3000000000000000000000000000000000000003"},
+				}
+				return params
+			}(),
+			true,
+		},
+		{
+			"fee tokens with fee denom",
+			func() Params {
+				params := DefaultParams()
+				params.FeeDenom = "ufee"
+				params.FeeTokens = []FeeToken{{Address: "0x1000000000000000000000000000000000000001", Converter: "0x2000000000000000000000000000000000000002"}}
+				return params
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -54,6 +145,84 @@ func TestParamsValidate(t *testing.T) {
 	}
 }
 
+func TestParamsFeeConversion(t *testing.T) {
+	params := DefaultParams()
+	require.False(t, params.IsDualGasToken())
+	require.Equal(t, DefaultEVMDenom, params.GasFeeDenom())
+	require.Equal(t, sdkmath.NewInt(15), params.ToFeeAmount(sdkmath.NewInt(15), true))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DefaultEVMDenom, 15)), params.FeeCoins(sdkmath.NewInt(15), true))
+
+	// the fee denom is ignored if it is the evm denom
+	params.FeeDenom = DefaultEVMDenom
+	params.FeeConversionRate = sdk.NewDec(2)
+	require.False(t, params.IsDualGasToken())
+	require.Equal(t, sdkmath.NewInt(15), params.ToEVMAmount(sdkmath.NewInt(15)))
+
+	params.FeeDenom = "ufee"
+	params.FeeConversionRate = sdk.NewDecWithPrec(5, 1)
+	require.True(t, params.IsDualGasToken())
+	require.Equal(t, "ufee", params.GasFeeDenom())
+	require.Equal(t, sdkmath.NewInt(8), params.ToFeeAmount(sdkmath.NewInt(15), true))
+	require.Equal(t, sdkmath.NewInt(7), params.ToFeeAmount(sdkmath.NewInt(15), false))
+	require.Equal(t, sdkmath.NewInt(30), params.ToEVMAmount(sdkmath.NewInt(15)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufee", 8)), params.FeeCoins(sdkmath.NewInt(15), true))
+	require.Equal(t, sdk.Coins{}, params.FeeCoins(sdkmath.NewInt(1), false))
+}
+
+func TestParamsGasRefundQuotient(t *testing.T) {
+	p := DefaultParams()
+	require.Equal(t, params.RefundQuotient, p.GasRefundQuotient(false))
+	require.Equal(t, params.RefundQuotientEIP3529, p.GasRefundQuotient(true))
+
+	p.RefundQuotient = 10
+	require.Equal(t, uint64(10), p.GasRefundQuotient(false))
+	require.Equal(t, uint64(10), p.GasRefundQuotient(true))
+}
+
+func TestParamsWeiConversion(t *testing.T) {
+	p := DefaultParams()
+	require.Equal(t, big.NewInt(1234), p.ToWei(sdkmath.NewInt(1234)))
+	amount, err := p.FromWei(big.NewInt(1234))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1234), amount)
+
+	p.WeiConversionExponent = 3
+	require.NoError(t, p.Validate())
+	require.Equal(t, big.NewInt(1234000), p.ToWei(sdkmath.NewInt(1234)))
+	amount, err = p.FromWei(big.NewInt(1234000))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1234), amount)
+	_, err = p.FromWei(big.NewInt(1234567))
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	// the gas fees are rounded instead of rejected
+	require.Equal(t, sdkmath.NewInt(1235), p.ToFeeAmount(sdkmath.NewInt(1234567), true))
+	require.Equal(t, sdkmath.NewInt(1234), p.ToFeeAmount(sdkmath.NewInt(1234567), false))
+	require.Equal(t, sdkmath.NewInt(1234000), p.ToEVMAmount(sdkmath.NewInt(1234)))
+
+	p.RoundDownPrecisionLoss = true
+	amount, err = p.FromWei(big.NewInt(1234567))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1234), amount)
+
+	p.WeiConversionExponent = MaxWeiConversionExponent + 1
+	require.Error(t, p.Validate())
+}
+
+func TestParamsStorageSlotDeposit(t *testing.T) {
+	p := DefaultParams()
+	require.Nil(t, p.StorageSlotDepositWei())
+	require.Nil(t, Params{}.StorageSlotDepositWei())
+
+	p.StorageSlotDeposit = sdkmath.NewInt(5)
+	p.WeiConversionExponent = 12
+	require.NoError(t, p.Validate())
+	require.Equal(t, big.NewInt(5_000_000_000_000), p.StorageSlotDepositWei())
+
+	p.StorageSlotDeposit = sdkmath.NewInt(-1)
+	require.Error(t, p.Validate())
+}
+
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []int64{2929, 1884, 1344}
 	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips)
@@ -69,6 +238,36 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.NoError(t, validateBool(true))
 	require.Error(t, validateEIPs(""))
 	require.NoError(t, validateEIPs([]int64{1884}))
+	require.NoError(t, validateEIPs(AvailableExtraEIPs))
+	require.Error(t, validateEIPs([]int64{3529, 2200}))
+}
+
+func TestValidateEIPs(t *testing.T) {
+	testCases := []struct {
+		name   string
+		eips   []int64
+		expErr string
+	}{
+		{"empty", nil, ""},
+		{"sorted", []int64{1884, 2929, 3529}, ""},
+		{"all the available eips", AvailableExtraEIPs, ""},
+		{"invalid eip", []int64{1}, "EIP 1 is not activateable"},
+		{"unsorted", []int64{3529, 2929, 1884}, "EIP 2929 follows EIP 3529"},
+		{"duplicate", []int64{1884, 1884}, "EIP 1884 follows EIP 1884"},
+		{"conflicting", []int64{2200, 3529}, "EIP 2200 conflicts with EIP 3529"},
+		{"conflict superseded", []int64{2200, 2929, 3529}, ""},
+		{"superseding eip applied first", []int64{2929, 2200, 3529}, "strictly increasing order"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateEIPs(tc.eips)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
 }
 
 func TestValidateChainConfig(t *testing.T) {
@@ -0,0 +1,62 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// StorageProof defines the value of a contract storage slot together with its
+// ICS23 merkle proof against the application hash.
+type StorageProof struct {
+	// Address of the contract
+	Address common.Address
+	// Key of the storage slot
+	Key common.Hash
+	// Value of the storage slot, the empty hash if the slot is not set
+	Value common.Hash
+	// Height of the IAVL version the proof was generated at. The proof verifies
+	// against the application hash of the block at Height + 1.
+	Height int64
+	// Proof operations, an existence proof if the slot is set or a
+	// non-existence proof otherwise
+	Proof *tmcrypto.ProofOps
+}
+
+// Verify checks the proof against the given application hash. Slots holding the
+// empty hash are verified as absent from the store.
+func (p StorageProof) Verify(appHash []byte) error {
+	if p.Proof == nil {
+		return fmt.Errorf("missing proof for storage slot %s", p.Key.Hex())
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(StoreKey), merkle.KeyEncodingURL).
+		AppendKey(StateKey(p.Address, p.Key.Bytes()), merkle.KeyEncodingURL).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if p.Value == (common.Hash{}) {
+		return prt.VerifyAbsence(p.Proof, appHash, keyPath)
+	}
+
+	return prt.VerifyValue(p.Proof, appHash, keyPath, p.Value.Bytes())
+}
@@ -16,9 +16,29 @@
 package types
 
 import (
+	"context"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"google.golang.org/grpc/metadata"
 )
 
+// GRPCRequestIDHeader is the gRPC metadata key of the ID of the JSON-RPC request a query is issued
+// for, which tags the logs of the query execution.
+const GRPCRequestIDHeader = "x-ethermint-request-id"
+
+// RequestIDFromIncomingContext returns the ID of the JSON-RPC request of an incoming gRPC query, empty
+// if the query isn't issued for a JSON-RPC request.
+func RequestIDFromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ids := md.Get(GRPCRequestIDHeader); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
 func (m QueryTraceTxRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, msg := range m.Predecessors {
@@ -0,0 +1,46 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// MakeSigner returns the signer of the transactions included in the block at the given height. It
+// accepts the transaction types of the forks active at that height according to the chain config:
+// dynamic fee (London), access list (Berlin), replay protected legacy (EIP-155) and Homestead. The
+// block height is the block number of the EVM, so that the forks activate mid-chain at their height.
+//
+// It's the signer verifying the transactions submitted to the chain, use TxSigner to recover the
+// sender of a transaction already accepted by the chain.
+func MakeSigner(cfg *params.ChainConfig, height int64) ethtypes.Signer {
+	return ethtypes.MakeSigner(cfg, big.NewInt(height))
+}
+
+// TxSigner returns the signer recovering the sender of a transaction accepted by the chain, e.g. when
+// decoding the transactions of a block. It's keyed off the chain-id the transaction is signed for
+// instead of the current one, so that the transactions of the previous chain-id epochs are recovered
+// too. The latest signer is backwards compatible with all the transaction types, the unprotected
+// transactions being recovered with the Homestead signer as their chain-id is zero.
+func TxSigner(tx *ethtypes.Transaction) ethtypes.Signer {
+	if !tx.Protected() {
+		return ethtypes.HomesteadSigner{}
+	}
+	return ethtypes.LatestSignerForChainID(tx.ChainId())
+}
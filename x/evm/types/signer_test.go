@@ -0,0 +1,79 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	cfg := DefaultChainConfig().EthereumConfig(big.NewInt(9000))
+	cfg.BerlinBlock = big.NewInt(5)
+	cfg.LondonBlock = big.NewInt(10)
+
+	to := common.Address{1}
+	txs := []ethtypes.TxData{
+		&ethtypes.LegacyTx{Nonce: 1, To: &to, Gas: 21000, GasPrice: big.NewInt(1)},
+		&ethtypes.AccessListTx{ChainID: cfg.ChainID, Nonce: 1, To: &to, Gas: 21000, GasPrice: big.NewInt(1)},
+		&ethtypes.DynamicFeeTx{ChainID: cfg.ChainID, Nonce: 1, To: &to, Gas: 21000, GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1)},
+	}
+
+	testCases := []struct {
+		height     int64
+		expTxTypes int
+	}{
+		{1, 1},  // EIP-155
+		{5, 2},  // Berlin
+		{9, 2},  // before London
+		{10, 3}, // London
+	}
+
+	for _, tc := range testCases {
+		signer := MakeSigner(cfg, tc.height)
+		for i, txData := range txs {
+			tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSigner(cfg), txData)
+			require.NoError(t, err)
+
+			sender, err := signer.Sender(tx)
+			if i < tc.expTxTypes {
+				require.NoError(t, err, "height %d, tx type %d", tc.height, tx.Type())
+				require.Equal(t, from, sender)
+			} else {
+				require.Error(t, err, "height %d, tx type %d", tc.height, tx.Type())
+			}
+		}
+	}
+}
+
+func TestTxSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.Address{1}
+
+	// a transaction of a previous chain-id epoch
+	prevChainID := big.NewInt(9000)
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(prevChainID), &ethtypes.DynamicFeeTx{
+		ChainID: prevChainID, Nonce: 1, To: &to, Gas: 21000, GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1),
+	})
+	require.NoError(t, err)
+	sender, err := ethtypes.Sender(TxSigner(tx), tx)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+
+	// an unprotected transaction
+	tx, err = ethtypes.SignNewTx(key, ethtypes.HomesteadSigner{}, &ethtypes.LegacyTx{Nonce: 1, To: &to, Gas: 21000, GasPrice: big.NewInt(1)})
+	require.NoError(t, err)
+	require.False(t, tx.Protected())
+	sender, err = ethtypes.Sender(TxSigner(tx), tx)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+}
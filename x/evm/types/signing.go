@@ -0,0 +1,121 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// KeyringSignerName is the name of the default signer, signing with the keys of the keyring.
+const KeyringSignerName = "keyring"
+
+// Signer signs the hashes of the Ethereum transactions on behalf of the accounts. It abstracts the
+// keyring away from the RPC and CLI send paths, so that the threshold (MPC/TSS) signing providers can
+// be plugged in with RegisterSigner.
+type Signer interface {
+	// SignHash returns the [R || S || V] signature of the hash by the address, V being 0 or 1. An
+	// asynchronous signer returns a *PendingSignatureError identifying the signing request instead.
+	SignHash(ctx context.Context, address common.Address, hash common.Hash) ([]byte, error)
+}
+
+// AsyncSigner is a Signer whose signatures are produced asynchronously, e.g. once all the parties of
+// a threshold signing protocol took part in it.
+type AsyncSigner interface {
+	Signer
+	// Signature returns the signature of the signing request, or ErrSignaturePending while it isn't
+	// produced yet.
+	Signature(ctx context.Context, requestID string) ([]byte, error)
+}
+
+// PendingSignatureError is returned by the asynchronous signers once the signing request is
+// submitted, the signature being fetched later with the request id.
+type PendingSignatureError struct {
+	RequestID string
+}
+
+func (e *PendingSignatureError) Error() string {
+	return fmt.Sprintf("%s: signing request %s", ErrSignaturePending, e.RequestID)
+}
+
+// Is makes the error match ErrSignaturePending.
+func (e *PendingSignatureError) Is(target error) bool {
+	return target == ErrSignaturePending
+}
+
+// ErrorData returns the request id as the data of the JSON-RPC error.
+func (e *PendingSignatureError) ErrorData() interface{} {
+	return e.RequestID
+}
+
+// keyringSigner signs with the keys of the keyring.
+type keyringSigner struct {
+	keyring keyring.Signer
+}
+
+// NewKeyringSigner returns the Signer signing with the keys of the keyring.
+func NewKeyringSigner(kr keyring.Signer) Signer {
+	return keyringSigner{keyring: kr}
+}
+
+// SignHash implements Signer.
+func (s keyringSigner) SignHash(_ context.Context, address common.Address, hash common.Hash) ([]byte, error) {
+	sig, _, err := s.keyring.SignByAddress(sdk.AccAddress(address.Bytes()), hash.Bytes())
+	return sig, err
+}
+
+var (
+	signersMtx sync.RWMutex
+	signers    = make(map[string]Signer)
+)
+
+// RegisterSigner registers the signer under the name, to be selected by the RPC and CLI send paths.
+// It's meant to be called when the application is initialized.
+func RegisterSigner(name string, signer Signer) error {
+	signersMtx.Lock()
+	defer signersMtx.Unlock()
+
+	if name == KeyringSignerName {
+		return fmt.Errorf("signer name %s is reserved", name)
+	}
+	if _, ok := signers[name]; ok {
+		return fmt.Errorf("signer %s is already registered", name)
+	}
+	signers[name] = signer
+	return nil
+}
+
+// GetSigner returns the signer registered under the name, the keyring signer being returned for
+// KeyringSignerName or an empty name.
+func GetSigner(name string, kr keyring.Signer) (Signer, error) {
+	if name == "" || name == KeyringSignerName {
+		return NewKeyringSigner(kr), nil
+	}
+
+	signersMtx.RLock()
+	defer signersMtx.RUnlock()
+
+	signer, ok := signers[name]
+	if !ok {
+		return nil, fmt.Errorf("signer %s is not registered", name)
+	}
+	return signer, nil
+}
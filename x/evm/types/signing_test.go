@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// asyncSigner produces the signatures of the keyring once the signing requests are approved.
+type asyncSigner struct {
+	keyring  types.Signer
+	requests map[string]common.Hash
+	approved map[string]bool
+	address  common.Address
+}
+
+func newAsyncSigner(kr keyring.Signer) *asyncSigner {
+	return &asyncSigner{
+		keyring:  types.NewKeyringSigner(kr),
+		requests: make(map[string]common.Hash),
+		approved: make(map[string]bool),
+	}
+}
+
+func (s *asyncSigner) SignHash(_ context.Context, address common.Address, hash common.Hash) ([]byte, error) {
+	id := hash.Hex()
+	s.requests[id] = hash
+	s.address = address
+	return nil, &types.PendingSignatureError{RequestID: id}
+}
+
+func (s *asyncSigner) Signature(ctx context.Context, requestID string) ([]byte, error) {
+	if !s.approved[requestID] {
+		return nil, types.ErrSignaturePending
+	}
+	return s.keyring.SignHash(ctx, s.address, s.requests[requestID])
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumTx_SignWith() {
+	ethSigner := ethtypes.LatestSignerForChainID(suite.chainID)
+	newMsg := func() *types.MsgEthereumTx {
+		msg := types.NewTx(suite.chainID, 0, &suite.to, nil, 100000, nil, nil, nil, []byte("test"), nil)
+		msg.From = suite.from.Hex()
+		return msg
+	}
+
+	// the keyring signer signs synchronously
+	msg := newMsg()
+	suite.Require().NoError(msg.SignWith(context.Background(), ethSigner, types.NewKeyringSigner(suite.signer)))
+	sender, err := msg.GetSender(suite.chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, sender)
+
+	// the async signer leaves the tx pending until the request is signed
+	signer := newAsyncSigner(suite.signer)
+	msg = newMsg()
+	err = msg.SignWith(context.Background(), ethSigner, signer)
+	suite.Require().ErrorIs(err, types.ErrSignaturePending)
+	pending, ok := err.(*types.PendingSignatureError)
+	suite.Require().True(ok)
+	suite.Require().Equal(pending.RequestID, pending.ErrorData())
+
+	_, err = signer.Signature(context.Background(), pending.RequestID)
+	suite.Require().ErrorIs(err, types.ErrSignaturePending)
+	signer.approved[pending.RequestID] = true
+	sig, err := signer.Signature(context.Background(), pending.RequestID)
+	suite.Require().NoError(err)
+	suite.Require().NoError(msg.ApplySignature(ethSigner, sig))
+	sender, err = msg.GetSender(suite.chainID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, sender)
+
+	// the signature of another account is rejected
+	other, otherKey := tests.NewAddrKey()
+	otherSig, err := types.NewKeyringSigner(tests.NewSigner(otherKey)).SignHash(context.Background(), other, ethSigner.Hash(newMsg().AsTransaction()))
+	suite.Require().NoError(err)
+	suite.Require().Error(newMsg().ApplySignature(ethSigner, otherSig))
+}
+
+func (suite *MsgsTestSuite) TestRegisterSigner() {
+	signer := newAsyncSigner(suite.signer)
+	suite.Require().Error(types.RegisterSigner(types.KeyringSignerName, signer))
+	suite.Require().NoError(types.RegisterSigner("test-mpc", signer))
+	suite.Require().Error(types.RegisterSigner("test-mpc", signer))
+
+	registered, err := types.GetSigner("test-mpc", suite.signer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(signer, registered)
+	_, err = types.GetSigner("unknown", suite.signer)
+	suite.Require().Error(err)
+	registered, err = types.GetSigner("", suite.signer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewKeyringSigner(suite.signer), registered)
+}
@@ -0,0 +1,906 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/evm/v1/stream.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamLogsRequest defines the request type for the LogStream/StreamLogs RPC method.
+type StreamLogsRequest struct {
+	// addresses are the hex addresses of the contracts emitting the logs, any contract when empty
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// topics are the accepted topics at each position of the logs topics, any topic for an empty
+	// position
+	Topics []TopicsFilter `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics"`
+	// from_height is the first block whose logs are streamed, 0 to only stream the logs of the new
+	// blocks
+	FromHeight int64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+}
+
+func (m *StreamLogsRequest) Reset()         { *m = StreamLogsRequest{} }
+func (m *StreamLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamLogsRequest) ProtoMessage()    {}
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9622c7753b3c309, []int{0}
+}
+func (m *StreamLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamLogsRequest.Merge(m, src)
+}
+func (m *StreamLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamLogsRequest proto.InternalMessageInfo
+
+func (m *StreamLogsRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *StreamLogsRequest) GetTopics() []TopicsFilter {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *StreamLogsRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+// TopicsFilter defines the accepted hex topics at a position of the logs topics.
+type TopicsFilter struct {
+	// topics are the accepted topics, any topic when empty
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (m *TopicsFilter) Reset()         { *m = TopicsFilter{} }
+func (m *TopicsFilter) String() string { return proto.CompactTextString(m) }
+func (*TopicsFilter) ProtoMessage()    {}
+func (*TopicsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9622c7753b3c309, []int{1}
+}
+func (m *TopicsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicsFilter.Merge(m, src)
+}
+func (m *TopicsFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicsFilter proto.InternalMessageInfo
+
+func (m *TopicsFilter) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+// StreamLogsResponse defines a log streamed by the LogStream/StreamLogs RPC method.
+type StreamLogsResponse struct {
+	// log is the log matching the filter
+	Log *Log `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *StreamLogsResponse) Reset()         { *m = StreamLogsResponse{} }
+func (m *StreamLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamLogsResponse) ProtoMessage()    {}
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9622c7753b3c309, []int{2}
+}
+func (m *StreamLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamLogsResponse.Merge(m, src)
+}
+func (m *StreamLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamLogsResponse proto.InternalMessageInfo
+
+func (m *StreamLogsResponse) GetLog() *Log {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StreamLogsRequest)(nil), "ethermint.evm.v1.StreamLogsRequest")
+	proto.RegisterType((*TopicsFilter)(nil), "ethermint.evm.v1.TopicsFilter")
+	proto.RegisterType((*StreamLogsResponse)(nil), "ethermint.evm.v1.StreamLogsResponse")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/stream.proto", fileDescriptor_d9622c7753b3c309) }
+
+var fileDescriptor_d9622c7753b3c309 = []byte{
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0x4d, 0x4b, 0xc3, 0x40,
+	0x14, 0xcc, 0x1a, 0x29, 0x74, 0xeb, 0x41, 0x17, 0x95, 0x10, 0x74, 0x1b, 0xaa, 0xd4, 0x9c, 0x12,
+	0x5b, 0xaf, 0x0a, 0xd2, 0x83, 0x78, 0xe8, 0x29, 0x7a, 0xd1, 0x8b, 0xf4, 0xe3, 0x75, 0x13, 0x68,
+	0xfa, 0x62, 0x76, 0x1b, 0xf4, 0x5f, 0xf4, 0x67, 0xf5, 0xd8, 0xa3, 0x27, 0x91, 0xf6, 0x8f, 0x48,
+	0x36, 0xa5, 0x2d, 0x06, 0xbc, 0xbd, 0x9d, 0x37, 0x33, 0x3b, 0xbc, 0xa1, 0xe7, 0xa0, 0x42, 0x48,
+	0xe3, 0x68, 0xa2, 0x7c, 0xc8, 0x62, 0x3f, 0x6b, 0xf9, 0x52, 0xa5, 0xd0, 0x8b, 0xbd, 0x24, 0x45,
+	0x85, 0xec, 0x70, 0xb3, 0xf6, 0x20, 0x8b, 0xbd, 0xac, 0x65, 0xdb, 0x25, 0x01, 0x64, 0x6b, 0xb6,
+	0x7d, 0x2c, 0x50, 0xa0, 0x1e, 0xfd, 0x7c, 0x2a, 0xd0, 0xc6, 0x8c, 0xd0, 0xa3, 0x27, 0x6d, 0xda,
+	0x45, 0x21, 0x03, 0x78, 0x9f, 0x82, 0x54, 0xec, 0x8c, 0x56, 0x7b, 0xc3, 0x61, 0x0a, 0x52, 0x82,
+	0xb4, 0x88, 0x63, 0xba, 0xd5, 0x60, 0x0b, 0xb0, 0x5b, 0x5a, 0x51, 0x98, 0x44, 0x03, 0x69, 0xed,
+	0x39, 0xa6, 0x5b, 0x6b, 0x73, 0xef, 0x6f, 0x10, 0xef, 0x59, 0xef, 0x1f, 0xa2, 0xb1, 0x82, 0xb4,
+	0xb3, 0x3f, 0xff, 0xae, 0x1b, 0xc1, 0x5a, 0xc3, 0xea, 0xb4, 0x36, 0x4a, 0x31, 0x7e, 0x0b, 0x21,
+	0x12, 0xa1, 0xb2, 0x4c, 0x87, 0xb8, 0x66, 0x40, 0x73, 0xe8, 0x51, 0x23, 0x8d, 0x26, 0x3d, 0xd8,
+	0x95, 0xb3, 0xd3, 0xcd, 0x77, 0x45, 0x92, 0xf5, 0xab, 0x71, 0x47, 0xd9, 0x6e, 0x72, 0x99, 0xe0,
+	0x44, 0x02, 0xbb, 0xa2, 0xe6, 0x18, 0x85, 0x45, 0x1c, 0xe2, 0xd6, 0xda, 0x27, 0xe5, 0x64, 0x5d,
+	0x14, 0x41, 0xce, 0x68, 0x8f, 0x68, 0xb5, 0x8b, 0xa2, 0x70, 0x60, 0x2f, 0x94, 0x6e, 0xbd, 0xd8,
+	0x45, 0x59, 0x56, 0xba, 0x91, 0x7d, 0xf9, 0x3f, 0xa9, 0x88, 0x73, 0x4d, 0x3a, 0xf7, 0xf3, 0x25,
+	0x27, 0x8b, 0x25, 0x27, 0x3f, 0x4b, 0x4e, 0x66, 0x2b, 0x6e, 0x2c, 0x56, 0xdc, 0xf8, 0x5a, 0x71,
+	0xe3, 0xb5, 0x29, 0x22, 0x15, 0x4e, 0xfb, 0xde, 0x00, 0xe3, 0xbc, 0x27, 0x94, 0xfe, 0xb6, 0xbe,
+	0x0f, 0x5d, 0xa0, 0xfa, 0x4c, 0x40, 0xf6, 0x2b, 0xba, 0xaa, 0x9b, 0xdf, 0x01, 0x00, 0x39, 0xd7,
+	0x53, 0x13, 0x0f, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LogStreamClient is the client API for LogStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LogStreamClient interface {
+	// StreamLogs streams the logs matching the filter, with the semantics of the eth_subscribe logs
+	// subscriptions, optionally starting at a past block to resume a stream.
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (LogStream_StreamLogsClient, error)
+}
+
+type logStreamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewLogStreamClient(cc grpc1.ClientConn) LogStreamClient {
+	return &logStreamClient{cc}
+}
+
+func (c *logStreamClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (LogStream_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LogStream_serviceDesc.Streams[0], "/ethermint.evm.v1.LogStream/StreamLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logStreamStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogStream_StreamLogsClient interface {
+	Recv() (*StreamLogsResponse, error)
+	grpc.ClientStream
+}
+
+type logStreamStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *logStreamStreamLogsClient) Recv() (*StreamLogsResponse, error) {
+	m := new(StreamLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LogStreamServer is the server API for LogStream service.
+type LogStreamServer interface {
+	// StreamLogs streams the logs matching the filter, with the semantics of the eth_subscribe logs
+	// subscriptions, optionally starting at a past block to resume a stream.
+	StreamLogs(*StreamLogsRequest, LogStream_StreamLogsServer) error
+}
+
+// UnimplementedLogStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedLogStreamServer struct {
+}
+
+func (*UnimplementedLogStreamServer) StreamLogs(req *StreamLogsRequest, srv LogStream_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+
+func RegisterLogStreamServer(s grpc1.Server, srv LogStreamServer) {
+	s.RegisterService(&_LogStream_serviceDesc, srv)
+}
+
+func _LogStream_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogStreamServer).StreamLogs(m, &logStreamStreamLogsServer{stream})
+}
+
+type LogStream_StreamLogsServer interface {
+	Send(*StreamLogsResponse) error
+	grpc.ServerStream
+}
+
+type logStreamStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *logStreamStreamLogsServer) Send(m *StreamLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _LogStream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethermint.evm.v1.LogStream",
+	HandlerType: (*LogStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _LogStream_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ethermint/evm/v1/stream.proto",
+}
+
+func (m *StreamLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintStream(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintStream(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovStream(uint64(m.FromHeight))
+	}
+	return n
+}
+
+func (m *TopicsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StreamLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, TopicsFilter{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicsFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicsFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &Log{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,93 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/holiman/uint256"
+)
+
+var _ vm.EVMLogger = &LimitedStructLogger{}
+
+// LimitedStructLogger wraps the go-ethereum struct logger, capping the size of the
+// memory, stack, storage and return data captured at each step so that tracing
+// memory heavy transactions doesn't exhaust the node memory.
+type LimitedStructLogger struct {
+	*logger.StructLogger
+
+	maxMemorySize     uint64
+	maxStackSize      uint64
+	maxStorageSize    uint64
+	maxReturnDataSize uint64
+}
+
+// NewLimitedStructLogger creates a struct logger with the capture limits of the
+// trace config. A zero limit means unlimited.
+func NewLimitedStructLogger(logCfg *logger.Config, traceConfig *TraceConfig) *LimitedStructLogger {
+	return &LimitedStructLogger{
+		StructLogger:      logger.NewStructLogger(logCfg),
+		maxMemorySize:     memoryWordsSize(traceConfig.MaxMemorySize),
+		maxStackSize:      traceConfig.MaxStackSize,
+		maxStorageSize:    traceConfig.MaxStorageSize,
+		maxReturnDataSize: traceConfig.MaxReturnDataSize,
+	}
+}
+
+// memoryWordsSize rounds the memory limit up to a multiple of the 32 bytes words the memory is
+// reported in, so that a non-zero limit captures at least one word.
+func memoryWordsSize(size uint64) uint64 {
+	if size > math.MaxUint64-31 {
+		return math.MaxUint64 &^ 31
+	}
+	return (size + 31) &^ 31
+}
+
+// CaptureState implements vm.EVMLogger, truncating the captured state of the step
+// to the configured limits.
+func (l *LimitedStructLogger) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	n := len(l.StructLogs())
+	l.StructLogger.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+
+	logs := l.StructLogs()
+	if len(logs) == n {
+		// the step was not captured
+		return
+	}
+	log := &logs[n]
+
+	// copy the truncated data so that the full snapshots can be garbage collected
+	if l.maxMemorySize > 0 && uint64(len(log.Memory)) > l.maxMemorySize {
+		log.Memory = common.CopyBytes(log.Memory[:l.maxMemorySize])
+	}
+
+	if l.maxStackSize > 0 && uint64(len(log.Stack)) > l.maxStackSize {
+		log.Stack = append([]uint256.Int(nil), log.Stack[uint64(len(log.Stack))-l.maxStackSize:]...)
+	}
+
+	if l.maxStorageSize > 0 && uint64(len(log.Storage)) > l.maxStorageSize {
+		// only keep the slot accessed by the SLOAD or SSTORE operation
+		slot := common.Hash(scope.Stack.Back(0).Bytes32())
+		log.Storage = logger.Storage{slot: log.Storage[slot]}
+	}
+
+	if l.maxReturnDataSize > 0 && uint64(len(log.ReturnData)) > l.maxReturnDataSize {
+		log.ReturnData = common.CopyBytes(log.ReturnData[:l.maxReturnDataSize])
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// TxResponseJSONVersion is the version of the TxResponseJSON shape. It's only bumped when a field
+// is renamed, removed or changes of encoding, adding a field keeps the version.
+const TxResponseJSONVersion = 1
+
+// TxResponseJSON defines the stable JSON shape of the MsgEthereumTxResponse embedded in the
+// DeliverTx result data, for the indexers which don't decode protobuf. The byte slices are hex
+// encoded and the numbers are hex quantities, like in the ethereum JSON-RPC.
+type TxResponseJSON struct {
+	Version         int             `json:"version"`
+	Hash            common.Hash     `json:"hash"`
+	Ret             hexutil.Bytes   `json:"ret"`
+	VMError         string          `json:"vmError"`
+	Failed          bool            `json:"failed"`
+	GasUsed         hexutil.Uint64  `json:"gasUsed"`
+	ContractAddress *common.Address `json:"contractAddress"`
+	Logs            []*ethtypes.Log `json:"logs"`
+}
+
+// ToJSON returns the tx response in the stable JSON shape.
+func (m *MsgEthereumTxResponse) ToJSON() *TxResponseJSON {
+	res := &TxResponseJSON{
+		Version: TxResponseJSONVersion,
+		Hash:    common.HexToHash(m.Hash),
+		Ret:     m.Ret,
+		VMError: m.VmError,
+		Failed:  m.Failed(),
+		GasUsed: hexutil.Uint64(m.GasUsed),
+		Logs:    LogsToEthereum(m.Logs),
+	}
+	// the empty values are encoded the same whatever the response
+	if res.Ret == nil {
+		res.Ret = hexutil.Bytes{}
+	}
+	if res.Logs == nil {
+		res.Logs = []*ethtypes.Log{}
+	}
+	if m.ContractAddress != "" {
+		contractAddress := common.HexToAddress(m.ContractAddress)
+		res.ContractAddress = &contractAddress
+	}
+	return res
+}
+
+// DecodeTxResponsesJSON decodes the responses of all the ethereum txs of a DeliverTx result data,
+// in the stable JSON shape, skipping the responses of the other msgs.
+func DecodeTxResponsesJSON(in []byte) ([]*TxResponseJSON, error) {
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(in, &txMsgData); err != nil {
+		return nil, err
+	}
+
+	typeURL := "/" + proto.MessageName(&MsgEthereumTxResponse{})
+	responses := []*TxResponseJSON{}
+	for _, msgResponse := range txMsgData.MsgResponses {
+		if msgResponse.TypeUrl != typeURL {
+			continue
+		}
+
+		var res MsgEthereumTxResponse
+		if err := proto.Unmarshal(msgResponse.Value, &res); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to unmarshal tx response message data")
+		}
+		responses = append(responses, res.ToJSON())
+	}
+	return responses, nil
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestDecodeTxResponsesJSON(t *testing.T) {
+	contractAddress := common.BigToAddress(common.Big1)
+	txHash := common.BigToHash(common.Big2)
+	creation := &evmtypes.MsgEthereumTxResponse{
+		Hash:            txHash.Hex(),
+		Ret:             []byte{0xca, 0xfe},
+		GasUsed:         53000,
+		ContractAddress: contractAddress.Hex(),
+		Logs: []*evmtypes.Log{{
+			Address: contractAddress.Hex(),
+			Topics:  []string{txHash.Hex()},
+			Data:    []byte{1},
+			TxHash:  txHash.Hex(),
+		}},
+	}
+	failed := &evmtypes.MsgEthereumTxResponse{
+		Hash:    txHash.Hex(),
+		VmError: "execution reverted",
+		GasUsed: 21000,
+	}
+
+	txMsgData := &sdk.TxMsgData{MsgResponses: []*codectypes.Any{
+		codectypes.UnsafePackAny(creation),
+		codectypes.UnsafePackAny(&banktypes.MsgSendResponse{}),
+		codectypes.UnsafePackAny(failed),
+	}}
+	bz, err := proto.Marshal(txMsgData)
+	require.NoError(t, err)
+
+	responses, err := evmtypes.DecodeTxResponsesJSON(bz)
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+
+	out, err := json.Marshal(responses[1])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"version": 1,
+		"hash": "`+txHash.Hex()+`",
+		"ret": "0x",
+		"vmError": "execution reverted",
+		"failed": true,
+		"gasUsed": "0x5208",
+		"contractAddress": null,
+		"logs": []
+	}`, string(out))
+
+	require.Equal(t, &contractAddress, responses[0].ContractAddress)
+	require.Equal(t, "0xcafe", responses[0].Ret.String())
+	require.False(t, responses[0].Failed)
+	require.Len(t, responses[0].Logs, 1)
+	require.Equal(t, contractAddress, responses[0].Logs[0].Address)
+
+	_, err = evmtypes.DecodeTxResponsesJSON([]byte{0xff})
+	require.Error(t, err)
+}
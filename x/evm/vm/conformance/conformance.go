@@ -0,0 +1,177 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+
+// Package conformance defines the tests that gate the EVM implementations used by the EVM module.
+// An alternative interpreter (e.g. evmone via cgo or an instrumented build) is only safe to swap
+// in with the `evm.interpreter` app configuration once its constructor passes them.
+package conformance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/x/evm/types"
+	evm "github.com/evmos/ethermint/x/evm/vm"
+)
+
+const gasLimit uint64 = 1_000_000
+
+var (
+	caller   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	receiver = common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	// returnCode stores 42 in memory and returns the 32 bytes word
+	returnCode = hexutil.MustDecode("0x602a60005260206000f3")
+	// storageCode stores 42 in the slot 0, loads it back and returns it
+	storageCode = hexutil.MustDecode("0x602a60005560005460005260206000f3")
+	// revertCode stores 42 in memory and reverts with the 32 bytes word
+	revertCode = hexutil.MustDecode("0x602a60005260206000fd")
+	// initCode deploys returnCode
+	initCode = hexutil.MustDecode("0x69602a60005260206000f3600052600a6016f3")
+
+	word42 = common.LeftPadBytes([]byte{42}, 32)
+)
+
+// Run runs the conformance tests against the EVM implementations built by the constructor, the
+// results and gas consumption must match the ones of the go-ethereum EVM.
+func Run(t *testing.T, constructor evm.Constructor) {
+	t.Run("return", func(t *testing.T) {
+		e, _ := newEVM(t, constructor, returnCode)
+		ret, leftOverGas, err := e.Call(vm.AccountRef(caller), contract, nil, gasLimit, big.NewInt(0))
+		require.NoError(t, err)
+		require.Equal(t, word42, ret)
+		require.Equal(t, uint64(18), gasLimit-leftOverGas)
+	})
+
+	t.Run("storage", func(t *testing.T) {
+		e, stateDB := newEVM(t, constructor, storageCode)
+		ret, leftOverGas, err := e.Call(vm.AccountRef(caller), contract, nil, gasLimit, big.NewInt(0))
+		require.NoError(t, err)
+		require.Equal(t, word42, ret)
+		require.Equal(t, uint64(22224), gasLimit-leftOverGas)
+		require.Equal(t, common.BytesToHash(word42), stateDB.GetState(contract, common.Hash{}))
+	})
+
+	t.Run("static call write protection", func(t *testing.T) {
+		e, stateDB := newEVM(t, constructor, storageCode)
+		_, leftOverGas, err := e.StaticCall(vm.AccountRef(caller), contract, nil, gasLimit)
+		require.ErrorIs(t, err, vm.ErrWriteProtection)
+		require.Zero(t, leftOverGas)
+		require.Equal(t, common.Hash{}, stateDB.GetState(contract, common.Hash{}))
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		e, _ := newEVM(t, constructor, revertCode)
+		ret, leftOverGas, err := e.Call(vm.AccountRef(caller), contract, nil, gasLimit, big.NewInt(0))
+		require.ErrorIs(t, err, vm.ErrExecutionReverted)
+		require.Equal(t, word42, ret)
+		require.Equal(t, uint64(18), gasLimit-leftOverGas)
+	})
+
+	t.Run("out of gas", func(t *testing.T) {
+		e, _ := newEVM(t, constructor, returnCode)
+		_, leftOverGas, err := e.Call(vm.AccountRef(caller), contract, nil, 10, big.NewInt(0))
+		require.ErrorIs(t, err, vm.ErrOutOfGas)
+		require.Zero(t, leftOverGas)
+	})
+
+	t.Run("invalid opcode", func(t *testing.T) {
+		e, _ := newEVM(t, constructor, []byte{byte(vm.INVALID)})
+		_, leftOverGas, err := e.Call(vm.AccountRef(caller), contract, nil, gasLimit, big.NewInt(0))
+		require.Error(t, err)
+		require.Zero(t, leftOverGas)
+	})
+
+	t.Run("create", func(t *testing.T) {
+		e, stateDB := newEVM(t, constructor, nil)
+		nonce := stateDB.GetNonce(caller)
+		ret, addr, _, err := e.Create(vm.AccountRef(caller), initCode, gasLimit, big.NewInt(0))
+		require.NoError(t, err)
+		require.Equal(t, returnCode, ret)
+		require.Equal(t, crypto.CreateAddress(caller, nonce), addr)
+		require.Equal(t, returnCode, stateDB.GetCode(addr))
+		require.Equal(t, nonce+1, stateDB.GetNonce(caller))
+
+		ret, _, err = e.Call(vm.AccountRef(caller), addr, nil, gasLimit, big.NewInt(0))
+		require.NoError(t, err)
+		require.Equal(t, word42, ret)
+	})
+
+	t.Run("value transfer", func(t *testing.T) {
+		e, stateDB := newEVM(t, constructor, nil)
+		balance := stateDB.GetBalance(caller)
+		_, leftOverGas, err := e.Call(vm.AccountRef(caller), receiver, nil, gasLimit, big.NewInt(100))
+		require.NoError(t, err)
+		require.Equal(t, gasLimit, leftOverGas)
+		require.Equal(t, new(big.Int).Sub(balance, big.NewInt(100)), stateDB.GetBalance(caller))
+		require.Equal(t, big.NewInt(100), stateDB.GetBalance(receiver))
+	})
+
+	t.Run("precompile", func(t *testing.T) {
+		e, _ := newEVM(t, constructor, nil)
+		sha256 := common.BytesToAddress([]byte{2})
+		ret, leftOverGas, err := e.Call(vm.AccountRef(caller), sha256, []byte("abc"), gasLimit, big.NewInt(0))
+		require.NoError(t, err)
+		require.Equal(t, hexutil.MustDecode("0xba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"), ret)
+		require.Equal(t, uint64(72), gasLimit-leftOverGas)
+	})
+}
+
+// newEVM returns an EVM built by the constructor on top of an in-memory state, in which the caller
+// is funded and the contract account holds the code.
+func newEVM(t *testing.T, constructor evm.Constructor, code []byte) (evm.EVM, *state.StateDB) {
+	stateDB, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+
+	stateDB.SetBalance(caller, big.NewInt(1_000_000_000))
+	if code != nil {
+		stateDB.SetCode(contract, code)
+	}
+
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(1),
+		Difficulty:  big.NewInt(0),
+		GasLimit:    gasLimit,
+		BaseFee:     big.NewInt(0),
+	}
+	txCtx := vm.TxContext{
+		Origin:   caller,
+		GasPrice: big.NewInt(0),
+	}
+	chainConfig := types.DefaultChainConfig().EthereumConfig(big.NewInt(9000))
+
+	e := constructor(blockCtx, txCtx, stateDB, chainConfig, vm.Config{}, nil)
+
+	// warm up the accounts of the transaction like the state transition does
+	rules := chainConfig.Rules(blockCtx.BlockNumber, false)
+	stateDB.PrepareAccessList(caller, &contract, e.ActivePrecompiles(rules), nil)
+
+	return e, stateDB
+}
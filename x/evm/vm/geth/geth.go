@@ -25,11 +25,19 @@ import (
 	evm "github.com/evmos/ethermint/x/evm/vm"
 )
 
+// ConstructorName is the name of the go-ethereum EVM in the constructors registry, it's the
+// default `evm.interpreter`.
+const ConstructorName = "geth"
+
 var (
 	_ evm.EVM         = (*EVM)(nil)
 	_ evm.Constructor = NewEVM
 )
 
+func init() {
+	evm.RegisterConstructor(ConstructorName, NewEVM)
+}
+
 // EVM is the wrapper for the go-ethereum EVM.
 type EVM struct {
 	*vm.EVM
@@ -0,0 +1,12 @@
+package geth_test
+
+import (
+	"testing"
+
+	"github.com/evmos/ethermint/x/evm/vm/conformance"
+	"github.com/evmos/ethermint/x/evm/vm/geth"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, geth.NewEVM)
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package vm
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	constructorsMtx sync.RWMutex
+	constructors    = make(map[string]Constructor)
+)
+
+// RegisterConstructor registers an EVM implementation under the given name, so that it can be
+// selected with the `evm.interpreter` app configuration. It's meant to be called from the init
+// function of the package providing the implementation, and panics if the name is empty or
+// already registered.
+//
+// NOTE: the interpreter is part of the state machine, all the validators of a chain must run the
+// same implementation, and it must pass the conformance tests of the conformance package.
+func RegisterConstructor(name string, constructor Constructor) {
+	if name == "" {
+		panic("evm constructor name cannot be empty")
+	}
+	if constructor == nil {
+		panic(fmt.Sprintf("evm constructor %s cannot be nil", name))
+	}
+
+	constructorsMtx.Lock()
+	defer constructorsMtx.Unlock()
+
+	if _, ok := constructors[name]; ok {
+		panic(fmt.Sprintf("evm constructor %s already registered", name))
+	}
+	constructors[name] = constructor
+}
+
+// GetConstructor returns the EVM implementation registered under the given name.
+func GetConstructor(name string) (Constructor, error) {
+	constructorsMtx.RLock()
+	defer constructorsMtx.RUnlock()
+
+	constructor, ok := constructors[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown evm interpreter %s, available interpreters: %v", name, constructorNames())
+	}
+	return constructor, nil
+}
+
+// ConstructorNames returns the sorted names of the registered EVM implementations.
+func ConstructorNames() []string {
+	constructorsMtx.RLock()
+	defer constructorsMtx.RUnlock()
+
+	return constructorNames()
+}
+
+func constructorNames() []string {
+	names := make([]string, 0, len(constructors))
+	for name := range constructors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
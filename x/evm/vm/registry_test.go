@@ -0,0 +1,26 @@
+package vm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	evm "github.com/evmos/ethermint/x/evm/vm"
+	"github.com/evmos/ethermint/x/evm/vm/geth"
+)
+
+func TestConstructorsRegistry(t *testing.T) {
+	constructor, err := evm.GetConstructor(geth.ConstructorName)
+	require.NoError(t, err)
+	require.NotNil(t, constructor)
+
+	_, err = evm.GetConstructor("unknown")
+	require.Error(t, err)
+
+	require.Panics(t, func() { evm.RegisterConstructor(geth.ConstructorName, geth.NewEVM) })
+	require.Panics(t, func() { evm.RegisterConstructor("", geth.NewEVM) })
+	require.Panics(t, func() { evm.RegisterConstructor("nil", nil) })
+
+	evm.RegisterConstructor("instrumented", geth.NewEVM)
+	require.Equal(t, []string{geth.ConstructorName, "instrumented"}, evm.ConstructorNames())
+}
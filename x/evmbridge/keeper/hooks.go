@@ -0,0 +1,100 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/evmos/ethermint/x/evmbridge/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks relays the contract events matching the routes as IBC packets
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the EVM hooks of the module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing sends a packet for every log of the tx matching a route, a failure to send
+// it reverting the tx.
+func (h Hooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	params := h.k.GetParams(ctx)
+	if len(params.Routes) == 0 {
+		return nil
+	}
+
+	for _, log := range receipt.Logs {
+		for _, route := range params.Routes {
+			if !route.Match(log.Address, log.Topics) {
+				continue
+			}
+
+			if _, err := h.k.SendEVMEventPacket(ctx, route.ChannelId, types.NewEVMEventPacketData(log), params.PacketTimeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// SendEVMEventPacket sends the packet of a contract event on a channel bound to the module port
+func (k Keeper) SendEVMEventPacket(
+	ctx sdk.Context,
+	channelID string,
+	data types.EVMEventPacketData,
+	timeout time.Duration,
+) (uint64, error) {
+	portID := k.GetPort(ctx)
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !ok {
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "port %s, channel %s", portID, channelID)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, portID, channelID, clienttypes.ZeroHeight(), timeoutTimestamp, data.GetBytes())
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrSendPacket, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendPacket,
+			sdk.NewAttribute(types.AttributeKeyContract, data.Contract),
+			sdk.NewAttribute(types.AttributeKeyEventSignature, data.Topics[0]),
+			sdk.NewAttribute(types.AttributeKeyTxHash, data.TxHash),
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return sequence, nil
+}
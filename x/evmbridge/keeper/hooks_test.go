@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/x/evmbridge/keeper"
+	"github.com/evmos/ethermint/x/evmbridge/types"
+)
+
+type sentPacket struct {
+	channel string
+	timeout uint64
+	data    []byte
+}
+
+// mockICS4Wrapper records the sent packets
+type mockICS4Wrapper struct {
+	packets []sentPacket
+	err     error
+}
+
+func (m *mockICS4Wrapper) SendPacket(
+	_ sdk.Context, _ *capabilitytypes.Capability, _, sourceChannel string,
+	_ clienttypes.Height, timeoutTimestamp uint64, data []byte,
+) (uint64, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	m.packets = append(m.packets, sentPacket{sourceChannel, timeoutTimestamp, data})
+	return uint64(len(m.packets)), nil
+}
+
+// mockScopedKeeper owns the capabilities of the given names
+type mockScopedKeeper map[string]bool
+
+func (m mockScopedKeeper) GetCapability(_ sdk.Context, name string) (*capabilitytypes.Capability, bool) {
+	if !m[name] {
+		return nil, false
+	}
+	return capabilitytypes.NewCapability(1), true
+}
+
+func (m mockScopedKeeper) AuthenticateCapability(_ sdk.Context, _ *capabilitytypes.Capability, name string) bool {
+	return m[name]
+}
+
+func (m mockScopedKeeper) ClaimCapability(_ sdk.Context, _ *capabilitytypes.Capability, name string) error {
+	m[name] = true
+	return nil
+}
+
+type mockPortKeeper struct{}
+
+func (mockPortKeeper) BindPort(_ sdk.Context, _ string) *capabilitytypes.Capability {
+	return capabilitytypes.NewCapability(1)
+}
+
+func TestPostTxProcessing(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test")).
+		WithBlockTime(time.Unix(1000, 0))
+	encodingConfig := encoding.MakeConfig(nil)
+
+	ics4Wrapper := &mockICS4Wrapper{}
+	scopedKeeper := mockScopedKeeper{}
+	k := keeper.NewKeeper(
+		encodingConfig.Codec, authtypes.NewModuleAddress(govtypes.ModuleName), storeKey,
+		ics4Wrapper, mockPortKeeper{}, scopedKeeper,
+	)
+	k.SetPort(ctx, types.PortID)
+	require.NoError(t, k.BindPort(ctx, types.PortID))
+	require.True(t, k.IsBound(ctx, types.PortID))
+	scopedKeeper[host.ChannelCapabilityPath(types.PortID, "channel-0")] = true
+
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	signature := crypto.Keccak256Hash([]byte("Bridge(address,uint256)"))
+	params := types.NewParams([]types.Route{
+		{Contract: contract.Hex(), EventSignature: signature.Hex(), ChannelId: "channel-0"},
+	}, time.Minute)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	txHash := common.HexToHash("0x01")
+	receipt := &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{
+			{Address: contract, Topics: []common.Hash{signature, {2}}, Data: []byte{3}, TxHash: txHash, Index: 4},
+			// other event of the contract
+			{Address: contract, Topics: []common.Hash{{5}}, TxHash: txHash, Index: 5},
+			// same event of another contract
+			{Address: common.Address{6}, Topics: []common.Hash{signature}, TxHash: txHash, Index: 6},
+		},
+	}
+
+	hooks := k.Hooks()
+	require.NoError(t, hooks.PostTxProcessing(ctx, nil, receipt))
+	require.Len(t, ics4Wrapper.packets, 1)
+
+	packet := ics4Wrapper.packets[0]
+	require.Equal(t, "channel-0", packet.channel)
+	require.Equal(t, uint64(ctx.BlockTime().Add(time.Minute).UnixNano()), packet.timeout)
+
+	var data types.EVMEventPacketData
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(packet.data, &data))
+	require.Equal(t, types.NewEVMEventPacketData(receipt.Logs[0]), data)
+	require.Equal(t, []string{signature.Hex(), common.Hash{2}.Hex()}, data.Topics)
+	require.Equal(t, uint64(4), data.LogIndex)
+
+	// the sending failure reverts the tx
+	ics4Wrapper.err = errors.New("channel closed")
+	require.ErrorIs(t, hooks.PostTxProcessing(ctx, nil, receipt), types.ErrSendPacket)
+
+	// the channel of the route isn't bound to the module
+	ics4Wrapper.err = nil
+	delete(scopedKeeper, host.ChannelCapabilityPath(types.PortID, "channel-0"))
+	require.Error(t, hooks.PostTxProcessing(ctx, nil, receipt))
+
+	// no route
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	require.NoError(t, hooks.PostTxProcessing(ctx, nil, &ethtypes.Receipt{Logs: receipt.Logs}))
+	require.Len(t, ics4Wrapper.packets, 1)
+}
@@ -0,0 +1,31 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+// EVM bridge module events
+const (
+	EventTypeSendPacket    = "evm_event_packet"
+	EventTypeRecvPacket    = "recv_evm_event_packet"
+	EventTypeAckPacket     = "evm_event_packet_ack"
+	EventTypeTimeoutPacket = "evm_event_packet_timeout"
+
+	AttributeKeyContract       = "contract"
+	AttributeKeyEventSignature = "eventSignature"
+	AttributeKeyChannel        = "channel"
+	AttributeKeySequence       = "sequence"
+	AttributeKeyTxHash         = "txHash"
+	AttributeKeyAckError       = "error"
+)
@@ -0,0 +1,46 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+const (
+	// ModuleName string name of module
+	ModuleName = "evmbridge"
+
+	// StoreKey key for the EVM bridge module state, the module name having the evm store key
+	// as prefix
+	StoreKey = "eventbridge"
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+
+	// PortID is the default port id bound by the module
+	PortID = ModuleName
+
+	// Version defines the current version of the EVM bridge channels
+	Version = "evmbridge-1"
+)
+
+// prefix bytes for the EVM bridge persistent store
+const (
+	prefixParams = iota + 1
+	prefixPort
+)
+
+// KVStore key prefixes
+var (
+	ParamsKey = []byte{prefixParams}
+	PortKey   = []byte{prefixPort}
+)
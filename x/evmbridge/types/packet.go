@@ -0,0 +1,50 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// NewEVMEventPacketData returns the packet data of a contract event log
+func NewEVMEventPacketData(log *ethtypes.Log) EVMEventPacketData {
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.Hex()
+	}
+
+	return EVMEventPacketData{
+		Contract: log.Address.Hex(),
+		Topics:   topics,
+		Data:     log.Data,
+		TxHash:   log.TxHash.Hex(),
+		LogIndex: uint64(log.Index),
+	}
+}
+
+// ValidateBasic performs a stateless validation of the packet data
+func (p EVMEventPacketData) ValidateBasic() error {
+	if len(p.Topics) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "missing event signature topic")
+	}
+	return nil
+}
+
+// GetBytes returns the JSON encoding of the packet data
+func (p EVMEventPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&p)
+}
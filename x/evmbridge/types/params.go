@@ -0,0 +1,84 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"time"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// DefaultPacketTimeout is the default timeout of the sent packets
+const DefaultPacketTimeout = 10 * time.Minute
+
+// NewParams creates a new Params instance
+func NewParams(routes []Route, packetTimeout time.Duration) Params {
+	return Params{
+		Routes:        routes,
+		PacketTimeout: packetTimeout,
+	}
+}
+
+// DefaultParams returns default EVM bridge module parameters, without any route
+func DefaultParams() Params {
+	return NewParams(nil, DefaultPacketTimeout)
+}
+
+// Validate performs basic validation on EVM bridge parameters.
+func (p Params) Validate() error {
+	if p.PacketTimeout <= 0 {
+		return fmt.Errorf("packet timeout must be positive: %s", p.PacketTimeout)
+	}
+
+	seen := make(map[Route]bool, len(p.Routes))
+	for i, route := range p.Routes {
+		if err := route.Validate(); err != nil {
+			return fmt.Errorf("invalid route %d: %w", i, err)
+		}
+		if seen[route] {
+			return fmt.Errorf("duplicate route %d", i)
+		}
+		seen[route] = true
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the route
+func (r Route) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(r.Contract); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+
+	signature, err := hexutil.Decode(r.EventSignature)
+	if err != nil || len(signature) != common.HashLength {
+		return fmt.Errorf("invalid event signature hash: %s", r.EventSignature)
+	}
+
+	return host.ChannelIdentifierValidator(r.ChannelId)
+}
+
+// Match returns true if the log topics and address match the route.
+func (r Route) Match(address common.Address, topics []common.Hash) bool {
+	return len(topics) > 0 &&
+		common.HexToAddress(r.Contract) == address &&
+		common.HexToHash(r.EventSignature) == topics[0]
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	signature := crypto.Keccak256Hash([]byte("Bridge(address,uint256)"))
+	route := Route{Contract: contract.Hex(), EventSignature: signature.Hex(), ChannelId: "channel-0"}
+
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid route", NewParams([]Route{route}, DefaultPacketTimeout), false},
+		{"zero timeout", NewParams([]Route{route}, 0), true},
+		{"duplicate route", NewParams([]Route{route, route}, DefaultPacketTimeout), true},
+		{"invalid contract", NewParams([]Route{{Contract: "0x1", EventSignature: route.EventSignature, ChannelId: "channel-0"}}, DefaultPacketTimeout), true},
+		{"zero contract", NewParams([]Route{{Contract: common.Address{}.Hex(), EventSignature: route.EventSignature, ChannelId: "channel-0"}}, DefaultPacketTimeout), true},
+		{"invalid signature", NewParams([]Route{{Contract: route.Contract, EventSignature: "0x1234", ChannelId: "channel-0"}}, DefaultPacketTimeout), true},
+		{"invalid channel", NewParams([]Route{{Contract: route.Contract, EventSignature: route.EventSignature, ChannelId: "c"}}, DefaultPacketTimeout), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestRouteMatch(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	signature := crypto.Keccak256Hash([]byte("Bridge(address,uint256)"))
+	route := Route{Contract: contract.Hex(), EventSignature: signature.Hex(), ChannelId: "channel-0"}
+
+	require.True(t, route.Match(contract, []common.Hash{signature, {1}}))
+	require.False(t, route.Match(contract, []common.Hash{{1}}))
+	require.False(t, route.Match(contract, nil))
+	require.False(t, route.Match(common.Address{1}, []common.Hash{signature}))
+}
+
+func TestGenesisValidate(t *testing.T) {
+	require.NoError(t, DefaultGenesisState().Validate())
+	require.Error(t, NewGenesisState(DefaultParams(), "").Validate())
+	require.Error(t, NewGenesisState(Params{}, PortID).Validate())
+}
@@ -329,7 +329,7 @@ var _ = Describe("Feemarket", func() {
 						Expect(res.IsOK()).To(Equal(false), "transaction should have failed")
 						Expect(
 							strings.Contains(res.GetLog(),
-								"provided fee < minimum global fee"),
+								"transaction underpriced"),
 						).To(BeTrue(), res.GetLog())
 					},
 					Entry("legacy tx", func() txParams {
@@ -352,7 +352,7 @@ var _ = Describe("Feemarket", func() {
 						Expect(res.IsOK()).To(Equal(false), "transaction should have failed")
 						Expect(
 							strings.Contains(res.GetLog(),
-								"insufficient fee"),
+								"transaction underpriced"),
 						).To(BeTrue(), res.GetLog())
 					},
 					Entry("legacy tx", func() txParams {
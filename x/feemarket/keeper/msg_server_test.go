@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/evmos/ethermint/x/feemarket/types"
@@ -17,6 +18,14 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			request:   &types.MsgUpdateParams{Authority: "foobar"},
 			expectErr: true,
 		},
+		{
+			name: "fail - invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(true, 0, 0, 1000000000, 0, sdk.ZeroDec(), types.DefaultMinGasMultiplier),
+			},
+			expectErr: true,
+		},
 		{
 			name: "pass - valid Update msg",
 			request: &types.MsgUpdateParams{